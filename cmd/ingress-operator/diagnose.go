@@ -0,0 +1,238 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	operatorclient "github.com/openshift/cluster-ingress-operator/pkg/operator/client"
+	operatorcontroller "github.com/openshift/cluster-ingress-operator/pkg/operator/controller"
+	ingresscontroller "github.com/openshift/cluster-ingress-operator/pkg/operator/controller/ingress"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
+	utilyaml "k8s.io/apimachinery/pkg/util/yaml"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/clientcmd"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/config"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+type DiagnoseOptions struct {
+	// MustGatherDir is the path of a must-gather directory from which to
+	// read the cluster state.  If it is empty, the cluster state is read
+	// from the cluster.
+	MustGatherDir string
+	// Kubeconfig is the path of the kubeconfig file for reading the
+	// cluster state from the cluster.  If it is empty, the default
+	// kubeconfig is used.
+	Kubeconfig string
+	// OperatorNamespace is the namespace of the ingresscontrollers.
+	OperatorNamespace string
+	// IngressController is the name of the ingresscontroller to diagnose.
+	// If it is empty, all ingresscontrollers are diagnosed.
+	IngressController string
+	// IngressControllerImage is the pullspec of the ingress controller
+	// image.  If it is empty, the image of each current router deployment
+	// is assumed.
+	IngressControllerImage string
+	// Output is the output format, either "text" or "json".
+	Output string
+}
+
+func NewDiagnoseCommand() *cobra.Command {
+	var options DiagnoseOptions
+
+	cmd := &cobra.Command{
+		Use:   "diagnose",
+		Short: "Diagnose ingresscontrollers",
+		Long: `diagnose reads the state of a cluster, either from the cluster or from a
+must-gather directory, and reports likely root causes of problems with the
+cluster's ingresscontrollers.  For each ingresscontroller, it compares the
+operands with the ones the operator would reconcile, recomputes the status
+conditions, checks the default certificate, and checks the publishing status
+of the wildcard DNS records.  Must-gather directories do not include secrets,
+so the default certificate can only be checked when reading from the cluster.`,
+		Run: func(cmd *cobra.Command, args []string) {
+			if err := diagnose(&options, os.Stdout); err != nil {
+				log.Error(err, "error diagnosing")
+				os.Exit(1)
+			}
+		},
+	}
+
+	cmd.Flags().StringVarP(&options.MustGatherDir, "must-gather", "d", "", "must-gather directory to read the cluster state from instead of the cluster")
+	cmd.Flags().StringVarP(&options.Kubeconfig, "kubeconfig", "k", "", "kubeconfig file for reading the cluster state from the cluster")
+	cmd.Flags().StringVarP(&options.OperatorNamespace, "namespace", "n", operatorcontroller.DefaultOperatorNamespace, "namespace of the ingresscontrollers")
+	cmd.Flags().StringVarP(&options.IngressController, "ingresscontroller", "", "", "name of the ingresscontroller to diagnose (default all)")
+	cmd.Flags().StringVarP(&options.IngressControllerImage, "image", "i", "", "image of the ingress controller (default the image of each router deployment)")
+	cmd.Flags().StringVarP(&options.Output, "output", "o", "text", "output format, either \"text\" or \"json\"")
+
+	return cmd
+}
+
+func diagnose(opts *DiagnoseOptions, out io.Writer) error {
+	if opts.Output != "text" && opts.Output != "json" {
+		return fmt.Errorf("unsupported output format %q", opts.Output)
+	}
+
+	var (
+		reader client.Reader
+		err    error
+	)
+	if len(opts.MustGatherDir) != 0 {
+		reader, err = newMustGatherReader(opts.MustGatherDir)
+	} else {
+		reader, err = newClusterReader(opts.Kubeconfig)
+	}
+	if err != nil {
+		return err
+	}
+
+	inputs, err := ingresscontroller.GatherDiagnosisInputs(context.TODO(), reader, opts.OperatorNamespace)
+	if err != nil {
+		return err
+	}
+	var diagnoses []*ingresscontroller.Diagnosis
+	for _, input := range inputs {
+		if len(opts.IngressController) != 0 && input.IngressController.Name != opts.IngressController {
+			continue
+		}
+		input.IngressControllerImage = opts.IngressControllerImage
+		diagnoses = append(diagnoses, ingresscontroller.Diagnose(input))
+	}
+	if len(opts.IngressController) != 0 && len(diagnoses) == 0 {
+		return fmt.Errorf("ingresscontroller %s/%s not found", opts.OperatorNamespace, opts.IngressController)
+	}
+
+	if opts.Output == "json" {
+		encoder := json.NewEncoder(out)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(diagnoses)
+	}
+	for _, d := range diagnoses {
+		fmt.Fprintf(out, "ingresscontroller %s:\n", d.IngressController)
+		if len(d.Findings) == 0 {
+			fmt.Fprintf(out, "  no problems found\n")
+		}
+		for _, finding := range d.Findings {
+			fmt.Fprintf(out, "  %-7s  %s: %s\n", finding.Severity, finding.Object, finding.Message)
+		}
+	}
+	return nil
+}
+
+// newClusterReader returns a reader for the cluster state in the cluster
+// that the given kubeconfig file, or the default kubeconfig if it is empty,
+// specifies.
+func newClusterReader(kubeconfig string) (client.Reader, error) {
+	kubeConfig := config.GetConfig
+	if len(kubeconfig) != 0 {
+		kubeConfig = func() (*rest.Config, error) {
+			return clientcmd.BuildConfigFromFlags("", kubeconfig)
+		}
+	}
+	restConfig, err := kubeConfig()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get kube config: %w", err)
+	}
+	return operatorclient.NewClient(restConfig)
+}
+
+// newMustGatherReader returns a reader for the cluster state in the given
+// must-gather directory.  The reader serves the objects in the YAML files in
+// the directory from memory.  Files with objects of types that the operator
+// does not know are ignored.
+func newMustGatherReader(dir string) (client.Reader, error) {
+	scheme := operatorclient.GetScheme()
+	decoder := serializer.NewCodecFactory(scheme).UniversalDeserializer()
+
+	// The same object may appear in more than one file, for example in a
+	// file for a list of resources and in a file for the single resource,
+	// so objects are keyed by type, namespace, and name.
+	objects := map[string]runtime.Object{}
+	var add func(obj runtime.Object) error
+	add = func(obj runtime.Object) error {
+		if unknown, ok := obj.(*runtime.Unknown); ok {
+			decoded, _, err := decoder.Decode(unknown.Raw, nil, nil)
+			if err != nil {
+				// Must-gather directories contain objects
+				// of types that the operator does not know
+				// and YAML files that are not objects.
+				return nil
+			}
+			obj = decoded
+		}
+		if meta.IsListType(obj) {
+			items, err := meta.ExtractList(obj)
+			if err != nil {
+				return err
+			}
+			for _, item := range items {
+				if err := add(item); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+		accessor, err := meta.Accessor(obj)
+		if err != nil {
+			return err
+		}
+		gvks, _, err := scheme.ObjectKinds(obj)
+		if err != nil {
+			return err
+		}
+		objects[fmt.Sprintf("%s/%s/%s", gvks[0], accessor.GetNamespace(), accessor.GetName())] = obj
+		return nil
+	}
+
+	err := filepath.WalkDir(dir, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if entry.IsDir() || (!strings.HasSuffix(path, ".yaml") && !strings.HasSuffix(path, ".yml")) {
+			return nil
+		}
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		yamlDecoder := utilyaml.NewYAMLOrJSONDecoder(bytes.NewReader(data), 4096)
+		for {
+			var raw runtime.RawExtension
+			if err := yamlDecoder.Decode(&raw); err != nil {
+				// Either the end of the file is reached or
+				// the file is not valid YAML, which
+				// must-gather directories may contain too.
+				return nil
+			}
+			if len(raw.Raw) == 0 {
+				continue
+			}
+			if err := add(&runtime.Unknown{Raw: raw.Raw}); err != nil {
+				return fmt.Errorf("failed to read %s: %w", path, err)
+			}
+		}
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to read must-gather directory %s: %w", dir, err)
+	}
+
+	objs := make([]runtime.Object, 0, len(objects))
+	for _, obj := range objects {
+		objs = append(objs, obj)
+	}
+	return fake.NewClientBuilder().WithScheme(scheme).WithRuntimeObjects(objs...).Build(), nil
+}
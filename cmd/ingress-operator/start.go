@@ -10,11 +10,13 @@ import (
 	"github.com/spf13/cobra"
 	"gopkg.in/fsnotify.v1"
 
+	"github.com/openshift/cluster-ingress-operator/pkg/dns"
 	"github.com/openshift/cluster-ingress-operator/pkg/operator"
 
 	operatorconfig "github.com/openshift/cluster-ingress-operator/pkg/operator/config"
 	operatorcontroller "github.com/openshift/cluster-ingress-operator/pkg/operator/controller"
 	canarycontroller "github.com/openshift/cluster-ingress-operator/pkg/operator/controller/canary"
+	dnscontroller "github.com/openshift/cluster-ingress-operator/pkg/operator/controller/dns"
 	ingresscontroller "github.com/openshift/cluster-ingress-operator/pkg/operator/controller/ingress"
 	routemetricscontroller "github.com/openshift/cluster-ingress-operator/pkg/operator/controller/route-metrics"
 	statuscontroller "github.com/openshift/cluster-ingress-operator/pkg/operator/controller/status"
@@ -51,6 +53,9 @@ type StartOptions struct {
 	CanaryImage string
 	// ReleaseVersion is the cluster version which the operator will converge to.
 	ReleaseVersion string
+	// WebhookCertDir is the directory with the serving certificate and key
+	// for the validating admission webhooks.
+	WebhookCertDir string
 }
 
 func NewStartCommand() *cobra.Command {
@@ -73,6 +78,7 @@ func NewStartCommand() *cobra.Command {
 	cmd.Flags().StringVarP(&options.CanaryImage, "canary-image", "c", "", "image of the canary container that the operator will manage (optional)")
 	cmd.Flags().StringVarP(&options.ReleaseVersion, "release-version", "", statuscontroller.UnknownVersionValue, "the release version the operator should converge to (required)")
 	cmd.Flags().StringVarP(&options.MetricsListenAddr, "metrics-listen-addr", "", "127.0.0.1:60000", "metrics endpoint listen address (required)")
+	cmd.Flags().StringVarP(&options.WebhookCertDir, "webhook-cert-dir", "", "", "directory with the tls.crt and tls.key files for serving the validating admission webhooks (optional)")
 	cmd.Flags().StringVarP(&options.ShutdownFile, "shutdown-file", "s", defaultTrustedCABundle, "if provided, shut down the operator when this file changes")
 
 	if err := cmd.MarkFlagRequired("namespace"); err != nil {
@@ -122,6 +128,7 @@ func start(opts *StartOptions) error {
 		Namespace:              opts.OperatorNamespace,
 		IngressControllerImage: opts.IngressControllerImage,
 		CanaryImage:            opts.CanaryImage,
+		WebhookCertDir:         opts.WebhookCertDir,
 	}
 
 	// Start operator metrics.
@@ -138,6 +145,14 @@ func start(opts *StartOptions) error {
 	if err := routemetricscontroller.RegisterMetrics(); err != nil {
 		log.Error(err, "unable to register metrics for route_metrics_controller")
 	}
+	log.Info("registering Prometheus metrics for dns_controller")
+	if err := dnscontroller.RegisterMetrics(); err != nil {
+		log.Error(err, "unable to register metrics for dns_controller")
+	}
+	log.Info("registering Prometheus metrics for dns providers")
+	if err := dns.RegisterMetrics(); err != nil {
+		log.Error(err, "unable to register metrics for dns providers")
+	}
 
 	// Set up and start the file watcher.
 	watcher, err := fsnotify.NewWatcher()
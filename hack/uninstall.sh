@@ -31,6 +31,7 @@ if [ "$WHAT" == "all" ]; then
   oc delete clusterrolebindings/openshift-ingress-operator
   oc delete clusterrolebindings/openshift-ingress-router
   oc delete clusterrolebindings/router-monitoring
+  oc delete validatingwebhookconfigurations/ingress-operator
   oc delete customresourcedefinition.apiextensions.k8s.io/ingresscontrollers.operator.openshift.io
 fi
 
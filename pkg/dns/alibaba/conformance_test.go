@@ -0,0 +1,62 @@
+package alibaba
+
+import (
+	"testing"
+
+	configv1 "github.com/openshift/api/config/v1"
+	iov1 "github.com/openshift/api/operatoringress/v1"
+	"github.com/openshift/cluster-ingress-operator/pkg/dns/conformance"
+)
+
+// conformanceBackend is a conformance.Backend for a fakeService.
+type conformanceBackend struct {
+	service *fakeService
+	domain  string
+}
+
+// Records returns the records with the given name, combining the records with
+// the same type, one for each target, into a single record.
+func (b *conformanceBackend) Records(dnsName string) []conformance.Record {
+	rr := getRR(dnsName, b.domain)
+	var records []conformance.Record
+	indexes := map[string]int{}
+	for _, record := range b.service.records {
+		if record.RR != rr {
+			continue
+		}
+		i, ok := indexes[record.Type]
+		if !ok {
+			i = len(records)
+			indexes[record.Type] = i
+			records = append(records, conformance.Record{Type: iov1.DNSRecordType(record.Type), TTL: record.TTL})
+		}
+		records[i].Targets = append(records[i].Targets, record.Target)
+	}
+	return records
+}
+
+func (b *conformanceBackend) FailWrites(recordType iov1.DNSRecordType) {
+	b.service.failWrites = string(recordType)
+}
+
+func TestConformance(t *testing.T) {
+	for _, zt := range []zoneType{zoneTypePublicZone, zoneTypePrivateZone} {
+		t.Run(string(zt), func(t *testing.T) {
+			conformance.Run(t, func(t *testing.T) *conformance.Harness {
+				service := newFakeService()
+				domain := "example.com"
+				return &conformance.Harness{
+					Provider:    &provider{services: map[zoneType]Service{zt: service}},
+					Backend:     &conformanceBackend{service: service, domain: domain},
+					Zone:        configv1.DNSZone{ID: domain, Tags: map[string]string{"type": string(zt)}},
+					Domain:      domain,
+					CNAMETarget: "lb.example.com",
+					InvalidZones: []configv1.DNSZone{
+						{ID: domain},
+						{ID: domain, Tags: map[string]string{"type": "unknown"}},
+					},
+				}
+			})
+		})
+	}
+}
@@ -1,12 +1,15 @@
 package alibaba
 
 import (
+	"context"
 	"fmt"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk"
 	configv1 "github.com/openshift/api/config/v1"
 	iov1 "github.com/openshift/api/operatoringress/v1"
 	"github.com/openshift/cluster-ingress-operator/pkg/dns"
+	"github.com/openshift/cluster-ingress-operator/pkg/dns/alibaba/util"
 	logf "github.com/openshift/cluster-ingress-operator/pkg/log"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"strings"
 )
 
@@ -26,7 +29,8 @@ const (
 )
 
 var (
-	log = logf.Logger.WithName("dns")
+	_   dns.TTLAdjuster = &provider{}
+	log                 = logf.Logger.WithName("dns")
 )
 
 type Config struct {
@@ -89,19 +93,36 @@ func getRR(dnsName, domainName string) string {
 	return strings.TrimSuffix(dnsName, "."+domainName)
 }
 
-func (p *provider) Ensure(record *iov1.DNSRecord, zone configv1.DNSZone) error {
-	return p.doRequest(zone, record, actionEnsure)
+func (p *provider) Ensure(ctx context.Context, record *iov1.DNSRecord, zone configv1.DNSZone) error {
+	return p.doRequest(ctx, zone, record, actionEnsure)
 }
 
-func (p *provider) Delete(record *iov1.DNSRecord, zone configv1.DNSZone) error {
-	return p.doRequest(zone, record, actionDelete)
+func (p *provider) Delete(ctx context.Context, record *iov1.DNSRecord, zone configv1.DNSZone) error {
+	return p.doRequest(ctx, zone, record, actionDelete)
 }
 
-func (p *provider) Replace(record *iov1.DNSRecord, zone configv1.DNSZone) error {
-	return p.doRequest(zone, record, actionReplace)
+func (p *provider) Replace(ctx context.Context, record *iov1.DNSRecord, zone configv1.DNSZone) error {
+	return p.doRequest(ctx, zone, record, actionReplace)
 }
 
-func (p *provider) doRequest(zone configv1.DNSZone, record *iov1.DNSRecord, action action) error {
+// PublishedTTL returns the record's TTL clamped to the range of valid TTLs for
+// the zone's type.
+func (p *provider) PublishedTTL(ctx context.Context, record *iov1.DNSRecord, zone configv1.DNSZone) int64 {
+	ttl := record.Spec.RecordTTL
+	zoneInfo, err := p.parseZone(zone)
+	if err != nil {
+		return ttl
+	}
+	switch zoneInfo.Type {
+	case zoneTypePublicZone:
+		return util.Clamp(ttl, publicZoneMinTTL, publicZoneMaxTTL)
+	case zoneTypePrivateZone:
+		return util.Clamp(ttl, privateZoneMinTTL, privateZoneMaxTTL)
+	}
+	return ttl
+}
+
+func (p *provider) doRequest(ctx context.Context, zone configv1.DNSZone, record *iov1.DNSRecord, action action) error {
 	zoneInfo, err := p.parseZone(zone)
 	if err != nil {
 		return err
@@ -113,17 +134,84 @@ func (p *provider) doRequest(zone configv1.DNSZone, record *iov1.DNSRecord, acti
 	}
 
 	rr := getRR(record.Spec.DNSName, zoneInfo.Domain)
+	records, err := service.List(ctx, zoneInfo.ID, rr)
+	if err != nil {
+		return err
+	}
 
 	switch action {
 	case actionEnsure:
-		err = service.Add(zoneInfo.ID, rr, string(record.Spec.RecordType), record.Spec.Targets[0], record.Spec.RecordTTL)
+		return publish(ctx, service, zoneInfo.ID, rr, record, p.PublishedTTL(ctx, record, zone), records, false)
 	case actionReplace:
-		err = service.Update(zoneInfo.ID, rr, string(record.Spec.RecordType), record.Spec.Targets[0], record.Spec.RecordTTL)
+		return publish(ctx, service, zoneInfo.ID, rr, record, p.PublishedTTL(ctx, record, zone), records, true)
 	case actionDelete:
-		err = service.Delete(zoneInfo.ID, rr, record.Spec.Targets[0])
+		for _, r := range records {
+			if r.Type != string(record.Spec.RecordType) || !sets.NewString(record.Spec.Targets...).Has(r.Target) {
+				continue
+			}
+			if err := service.Delete(ctx, zoneInfo.ID, r.ID); err != nil {
+				return fmt.Errorf("failed to delete %s record %q with target %q: %w", r.Type, rr, r.Target, err)
+			}
+		}
+		return nil
 	default:
-		err = fmt.Errorf("unknown action %q", action)
+		return fmt.Errorf("unknown action %q", action)
+	}
+}
+
+// publish publishes one record for each of the given DNSRecord's targets with
+// the given TTL, given the records that are currently published with the same
+// name.  Records of the same type with other targets are updated to publish
+// the new targets or deleted.  If replace is true, records of a conflicting
+// type are handled the same way, which changes their type atomically.
+func publish(ctx context.Context, service Service, id, rr string, record *iov1.DNSRecord, ttl int64, records []Record, replace bool) error {
+	recordType := string(record.Spec.RecordType)
+	targets := sets.NewString(record.Spec.Targets...)
+	published := sets.NewString()
+	var reusable []Record
+	for _, r := range records {
+		switch {
+		case r.Type == recordType && targets.Has(r.Target) && !published.Has(r.Target):
+			published.Insert(r.Target)
+			if r.TTL != ttl {
+				if err := service.Update(ctx, id, r.ID, rr, recordType, r.Target, ttl); err != nil {
+					return fmt.Errorf("failed to update %s record %q with target %q: %w", recordType, rr, r.Target, err)
+				}
+			}
+		case r.Type == recordType, replace && dns.RecordTypesConflict(r.Type, recordType):
+			reusable = append(reusable, r)
+		}
 	}
 
-	return err
+	var missing []string
+	for _, target := range record.Spec.Targets {
+		if !published.Has(target) {
+			missing = append(missing, target)
+			published.Insert(target)
+		}
+	}
+
+	// Delete the records that are not needed before updating the others,
+	// because a CNAME record cannot coexist with other records.  If an
+	// update then fails, the record that failed to be updated is still
+	// published.
+	for len(reusable) > len(missing) {
+		r := reusable[len(reusable)-1]
+		if err := service.Delete(ctx, id, r.ID); err != nil {
+			return fmt.Errorf("failed to delete %s record %q with target %q: %w", r.Type, rr, r.Target, err)
+		}
+		reusable = reusable[:len(reusable)-1]
+	}
+	for i, target := range missing {
+		if i < len(reusable) {
+			if err := service.Update(ctx, id, reusable[i].ID, rr, recordType, target, ttl); err != nil {
+				return fmt.Errorf("failed to update %s record %q to %s record with target %q: %w", reusable[i].Type, rr, recordType, target, err)
+			}
+			continue
+		}
+		if err := service.Add(ctx, id, rr, recordType, target, ttl); err != nil {
+			return fmt.Errorf("failed to add %s record %q with target %q: %w", recordType, rr, target, err)
+		}
+	}
+	return nil
 }
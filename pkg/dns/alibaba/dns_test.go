@@ -1,36 +1,94 @@
 package alibaba
 
 import (
+	"context"
+	"fmt"
 	configv1 "github.com/openshift/api/config/v1"
 	iov1 "github.com/openshift/api/operatoringress/v1"
 	"github.com/openshift/cluster-ingress-operator/pkg/dns"
 	"github.com/stretchr/testify/assert"
+	"strconv"
 	"testing"
 )
 
+// fakeService is a Service that stores records in memory.  Like Alibaba Cloud
+// DNS, it rejects a duplicate record and a CNAME record with the same name as
+// another record, and vice versa, and it can change the type of a record that
+// it updates.
 type fakeService struct {
-	// records for id+rr to target
-	records map[string]string
+	nextID  int
+	records []Record
+	// failWrites makes adding or updating a record of the given type
+	// fail.
+	failWrites string
 	// lastAction records the last action performed
 	// can be "add", "update" or "delete"
 	lastAction string
 }
 
-func (p *fakeService) Add(id, rr, recordType, target string, ttl int64) error {
-	p.records[id+rr] = target
+func (p *fakeService) List(ctx context.Context, id, rr string) ([]Record, error) {
+	var records []Record
+	for _, record := range p.records {
+		if record.RR == rr {
+			records = append(records, record)
+		}
+	}
+	return records, nil
+}
+
+func (p *fakeService) Add(ctx context.Context, id, rr, recordType, target string, ttl int64) error {
+	if err := p.checkWrite("", rr, recordType, target); err != nil {
+		return err
+	}
+	p.nextID++
+	p.records = append(p.records, Record{ID: strconv.Itoa(p.nextID), RR: rr, Type: recordType, Target: target, TTL: ttl})
 	p.lastAction = "add"
 	return nil
 }
 
-func (p *fakeService) Update(id, rr, recordType, target string, ttl int64) error {
-	p.records[id+rr] = target
-	p.lastAction = "update"
-	return nil
+func (p *fakeService) Update(ctx context.Context, id, recordID, rr, recordType, target string, ttl int64) error {
+	for i, record := range p.records {
+		if record.ID != recordID {
+			continue
+		}
+		if err := p.checkWrite(recordID, rr, recordType, target); err != nil {
+			return err
+		}
+		p.records[i] = Record{ID: recordID, RR: rr, Type: recordType, Target: target, TTL: ttl}
+		p.lastAction = "update"
+		return nil
+	}
+	return fmt.Errorf("record %s not found", recordID)
+}
+
+func (p *fakeService) Delete(ctx context.Context, id, recordID string) error {
+	for i, record := range p.records {
+		if record.ID == recordID {
+			p.records = append(p.records[:i], p.records[i+1:]...)
+			p.lastAction = "delete"
+			return nil
+		}
+	}
+	return fmt.Errorf("record %s not found", recordID)
 }
 
-func (p *fakeService) Delete(id, rr, target string) error {
-	delete(p.records, id+rr)
-	p.lastAction = "delete"
+// checkWrite returns an error if a record with the given name, type, and
+// target cannot be written in place of the record with the given ID, if any.
+func (p *fakeService) checkWrite(recordID, rr, recordType, target string) error {
+	if len(p.failWrites) != 0 && recordType == p.failWrites {
+		return fmt.Errorf("injected failure")
+	}
+	for _, record := range p.records {
+		if record.ID == recordID || record.RR != rr {
+			continue
+		}
+		if record.Type == recordType && record.Target == target {
+			return fmt.Errorf("the %s record %s with value %s already exists", recordType, rr, target)
+		}
+		if record.Type != recordType && (record.Type == "CNAME" || recordType == "CNAME") {
+			return fmt.Errorf("a %s record with name %s conflicts with an existing %s record", recordType, rr, record.Type)
+		}
+	}
 	return nil
 }
 
@@ -42,9 +100,7 @@ func (p *fakeService) getLastAction() string {
 }
 
 func newFakeService() *fakeService {
-	return &fakeService{
-		records: make(map[string]string),
-	}
+	return &fakeService{}
 }
 
 func newFakeProvider(public, private Service) dns.Provider {
@@ -170,17 +226,24 @@ func TestProvider(t *testing.T) {
 	assert.Equal(t, "", servicePublic.getLastAction())
 
 	// test public zone ensure
-	assert.NoError(t, provider.Ensure(record, dnsZonePublic))
+	assert.NoError(t, provider.Ensure(context.Background(), record, dnsZonePublic))
 	assert.Equal(t, "add", servicePublic.getLastAction())
 	assert.Equal(t, "", servicePrivate.getLastAction())
 
 	// test private zone replace
-	assert.NoError(t, provider.Replace(record, dnsZonePrivate))
+	assert.NoError(t, provider.Replace(context.Background(), record, dnsZonePrivate))
+	assert.Equal(t, "", servicePublic.getLastAction())
+	assert.Equal(t, "add", servicePrivate.getLastAction())
+
+	// test private zone replace with a changed target
+	updated := record.DeepCopy()
+	updated.Spec.Targets = []string{"124.124.124.124"}
+	assert.NoError(t, provider.Replace(context.Background(), updated, dnsZonePrivate))
 	assert.Equal(t, "", servicePublic.getLastAction())
 	assert.Equal(t, "update", servicePrivate.getLastAction())
 
 	// test public zone delete
-	assert.NoError(t, provider.Delete(record, dnsZonePublic))
+	assert.NoError(t, provider.Delete(context.Background(), record, dnsZonePublic))
 	assert.Equal(t, "delete", servicePublic.getLastAction())
 	assert.Equal(t, "", servicePrivate.getLastAction())
 
@@ -191,12 +254,34 @@ func TestProvider(t *testing.T) {
 			"type": "unknown",
 		},
 	}
-	assert.Error(t, provider.Ensure(record, dnsZoneUnknown))
+	assert.Error(t, provider.Ensure(context.Background(), record, dnsZoneUnknown))
 
 	// test zone without type, should return error
 	dnsZoneNoType := configv1.DNSZone{
 		ID:   "example.com",
 		Tags: map[string]string{},
 	}
-	assert.Error(t, provider.Ensure(record, dnsZoneNoType))
+	assert.Error(t, provider.Ensure(context.Background(), record, dnsZoneNoType))
+}
+
+func TestPublishedTTL(t *testing.T) {
+	cases := []struct {
+		zoneType string
+		ttl      int64
+		expected int64
+	}{
+		{zoneType: "public", ttl: 30, expected: 600},
+		{zoneType: "public", ttl: 3600, expected: 3600},
+		{zoneType: "public", ttl: 100000, expected: 86400},
+		{zoneType: "private", ttl: 1, expected: 5},
+		{zoneType: "private", ttl: 30, expected: 30},
+		{zoneType: "unknown", ttl: 30, expected: 30},
+	}
+
+	p := &provider{}
+	for _, c := range cases {
+		record := &iov1.DNSRecord{Spec: iov1.DNSRecordSpec{RecordTTL: c.ttl}}
+		zone := configv1.DNSZone{ID: "example.com", Tags: map[string]string{"type": c.zoneType}}
+		assert.Equal(t, c.expected, p.PublishedTTL(context.Background(), record, zone), "zone type %s, TTL %d", c.zoneType, c.ttl)
+	}
 }
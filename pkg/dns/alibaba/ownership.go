@@ -0,0 +1,132 @@
+package alibaba
+
+import (
+	"context"
+	"fmt"
+
+	configv1 "github.com/openshift/api/config/v1"
+	iov1 "github.com/openshift/api/operatoringress/v1"
+	"github.com/openshift/cluster-ingress-operator/pkg/dns"
+)
+
+var _ dns.OwnershipRegistry = &provider{}
+
+// txtRecordType is the type of the companion TXT records that record the
+// owners of published records.
+const txtRecordType = "TXT"
+
+// GetOwner returns the owner that the companion TXT record of the given record
+// specifies, or the empty string if there is no companion TXT record.
+func (p *provider) GetOwner(ctx context.Context, record *iov1.DNSRecord, zone configv1.DNSZone) (string, error) {
+	service, zoneInfo, err := p.service(zone)
+	if err != nil {
+		return "", err
+	}
+	rr := getRR(dns.OwnershipRecordName(record), zoneInfo.Domain)
+	records, err := service.List(ctx, zoneInfo.ID, rr)
+	if err != nil {
+		return "", err
+	}
+	for _, r := range records {
+		if r.Type != txtRecordType {
+			continue
+		}
+		if owner, ok := dns.ParseOwnershipRecordValue(r.Target); ok {
+			return owner, nil
+		}
+	}
+	return "", nil
+}
+
+// SetOwner creates or updates the companion TXT record of the given record so
+// that it specifies the given owner.
+func (p *provider) SetOwner(ctx context.Context, record *iov1.DNSRecord, zone configv1.DNSZone, owner string) error {
+	service, zoneInfo, err := p.service(zone)
+	if err != nil {
+		return err
+	}
+	rr := getRR(dns.OwnershipRecordName(record), zoneInfo.Domain)
+	records, err := service.List(ctx, zoneInfo.ID, rr)
+	if err != nil {
+		return err
+	}
+	value := dns.OwnershipRecordValue(owner)
+	ttl := p.PublishedTTL(ctx, record, zone)
+	for _, r := range records {
+		if r.Type != txtRecordType {
+			continue
+		}
+		if r.Target == value && r.TTL == ttl {
+			return nil
+		}
+		if err := service.Update(ctx, zoneInfo.ID, r.ID, rr, txtRecordType, value, ttl); err != nil {
+			return fmt.Errorf("failed to update companion TXT record %q: %w", rr, err)
+		}
+		log.Info("updated companion TXT record", "zone", zone, "rr", rr, "owner", owner)
+		return nil
+	}
+	if err := service.Add(ctx, zoneInfo.ID, rr, txtRecordType, value, ttl); err != nil {
+		return fmt.Errorf("failed to add companion TXT record %q: %w", rr, err)
+	}
+	log.Info("added companion TXT record", "zone", zone, "rr", rr, "owner", owner)
+	return nil
+}
+
+// DeleteOwner deletes the companion TXT record of the given record, if it
+// exists.
+func (p *provider) DeleteOwner(ctx context.Context, record *iov1.DNSRecord, zone configv1.DNSZone) error {
+	service, zoneInfo, err := p.service(zone)
+	if err != nil {
+		return err
+	}
+	rr := getRR(dns.OwnershipRecordName(record), zoneInfo.Domain)
+	records, err := service.List(ctx, zoneInfo.ID, rr)
+	if err != nil {
+		return err
+	}
+	for _, r := range records {
+		if r.Type != txtRecordType {
+			continue
+		}
+		if err := service.Delete(ctx, zoneInfo.ID, r.ID); err != nil {
+			return fmt.Errorf("failed to delete companion TXT record %q: %w", rr, err)
+		}
+		log.Info("deleted companion TXT record", "zone", zone, "rr", rr)
+	}
+	return nil
+}
+
+// RecordExists returns a Boolean value indicating whether the given zone has a
+// record with the name of the given record that publishing the record would
+// overwrite, namely one with the same type, or replace, namely one with a
+// conflicting type.
+func (p *provider) RecordExists(ctx context.Context, record *iov1.DNSRecord, zone configv1.DNSZone) (bool, error) {
+	service, zoneInfo, err := p.service(zone)
+	if err != nil {
+		return false, err
+	}
+	records, err := service.List(ctx, zoneInfo.ID, getRR(record.Spec.DNSName, zoneInfo.Domain))
+	if err != nil {
+		return false, err
+	}
+	recordType := string(record.Spec.RecordType)
+	for _, r := range records {
+		if r.Type == recordType || dns.RecordTypesConflict(r.Type, recordType) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// service returns the service for the given zone's type and the parsed zone.
+func (p *provider) service(zone configv1.DNSZone) (Service, ZoneInfo, error) {
+	zoneInfo, err := p.parseZone(zone)
+	if err != nil {
+		return nil, ZoneInfo{}, err
+	}
+	service, ok := p.services[zoneInfo.Type]
+	if !ok {
+		return nil, ZoneInfo{}, fmt.Errorf("unknown zone type %s", zoneInfo.Type)
+	}
+	return service, zoneInfo, nil
+}
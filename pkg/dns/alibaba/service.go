@@ -1,6 +1,7 @@
 package alibaba
 
 import (
+	"context"
 	"fmt"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/endpoints"
@@ -9,8 +10,10 @@ import (
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/alidns"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/pvtz"
 	"github.com/openshift/cluster-ingress-operator/pkg/dns/alibaba/util"
+	"strconv"
 	"strings"
 	"sync"
+	"time"
 )
 
 var (
@@ -22,10 +25,37 @@ var (
 	}
 )
 
+const (
+	// publicZoneMinTTL and publicZoneMaxTTL are the bounds of a valid TTL
+	// for a record in a public zone.
+	publicZoneMinTTL, publicZoneMaxTTL int64 = 600, 86400
+	// privateZoneMinTTL and privateZoneMaxTTL are the bounds of a valid
+	// TTL for a record in a private zone.
+	privateZoneMinTTL, privateZoneMaxTTL int64 = 5, 86400
+)
+
+// Record is a DNS record in a zone.
+type Record struct {
+	// ID identifies the record.
+	ID string
+	// RR is the record's name, relative to the zone's domain.
+	RR string
+	// Type is the record's type.
+	Type string
+	// Target is the record's value.
+	Target string
+	// TTL is the record's TTL.
+	TTL int64
+}
+
 type Service interface {
-	Add(id, rr, recordType, target string, ttl int64) error
-	Update(id, rr, recordType, target string, ttl int64) error
-	Delete(id, rr, target string) error
+	// List returns the records with the given name.
+	List(ctx context.Context, id, rr string) ([]Record, error)
+	Add(ctx context.Context, id, rr, recordType, target string, ttl int64) error
+	// Update updates the record with the given ID.  Updating a record
+	// can change its type.
+	Update(ctx context.Context, id, recordID, rr, recordType, target string, ttl int64) error
+	Delete(ctx context.Context, id, recordID string) error
 }
 
 type Client struct {
@@ -39,7 +69,30 @@ type publicZoneService struct {
 	client *Client
 }
 
-func (d *publicZoneService) Add(id, rr, recordType, target string, ttl int64) error {
+func (d *publicZoneService) List(ctx context.Context, id, rr string) ([]Record, error) {
+	request := alidns.CreateDescribeDomainRecordsRequest()
+	request.Scheme = "https"
+	request.DomainName = id
+	request.KeyWord = rr
+	request.SearchMode = "EXACT"
+	request.PageSize = requests.NewInteger(500)
+
+	response := alidns.CreateDescribeDomainRecordsResponse()
+	if err := d.client.DoActionWithSetDomain(ctx, request, response); err != nil {
+		return nil, fmt.Errorf("failed on describe domain records: %w", err)
+	}
+
+	var records []Record
+	for _, record := range response.DomainRecords.Record {
+		if record.RR != rr {
+			continue
+		}
+		records = append(records, Record{ID: record.RecordId, RR: record.RR, Type: record.Type, Target: record.Value, TTL: record.TTL})
+	}
+	return records, nil
+}
+
+func (d *publicZoneService) Add(ctx context.Context, id, rr, recordType, target string, ttl int64) error {
 	request := alidns.CreateAddDomainRecordRequest()
 	request.Scheme = "https"
 	request.DomainName = id
@@ -48,22 +101,17 @@ func (d *publicZoneService) Add(id, rr, recordType, target string, ttl int64) er
 	request.Value = target
 
 	// A valid TTL for public zone must be in the range of 600 to 86400.
-	clampedTTL := util.Clamp(ttl, 600, 86400)
+	clampedTTL := util.Clamp(ttl, publicZoneMinTTL, publicZoneMaxTTL)
 	if clampedTTL != ttl {
-		log.Info(fmt.Sprintf("record's TTL for public zone must be in the range of 600 to 86400, set it to %d", clampedTTL), "record", rr)
+		log.Info(fmt.Sprintf("record's TTL for public zone must be in the range of %d to %d, set it to %d", publicZoneMinTTL, publicZoneMaxTTL, clampedTTL), "record", rr)
 	}
 	request.TTL = requests.NewInteger64(clampedTTL)
 
 	response := alidns.CreateAddDomainRecordResponse()
-	return d.client.DoActionWithSetDomain(request, response)
+	return d.client.DoActionWithSetDomain(ctx, request, response)
 }
 
-func (d *publicZoneService) Update(id, rr, recordType, target string, ttl int64) error {
-	recordID, err := d.getRecordID(id, rr, "")
-	if err != nil {
-		return err
-	}
-
+func (d *publicZoneService) Update(ctx context.Context, id, recordID, rr, recordType, target string, ttl int64) error {
 	request := alidns.CreateUpdateDomainRecordRequest()
 	request.Scheme = "https"
 	request.RecordId = recordID
@@ -72,50 +120,23 @@ func (d *publicZoneService) Update(id, rr, recordType, target string, ttl int64)
 	request.Value = target
 
 	// A valid TTL for public zone must be in the range of 600 to 86400.
-	clampedTTL := util.Clamp(ttl, 600, 86400)
+	clampedTTL := util.Clamp(ttl, publicZoneMinTTL, publicZoneMaxTTL)
 	if clampedTTL != ttl {
-		log.Info(fmt.Sprintf("record's TTL for public zone must be in the range of 600 to 86400, set it to %d", clampedTTL), "record", rr)
+		log.Info(fmt.Sprintf("record's TTL for public zone must be in the range of %d to %d, set it to %d", publicZoneMinTTL, publicZoneMaxTTL, clampedTTL), "record", rr)
 	}
 	request.TTL = requests.NewInteger64(clampedTTL)
 
 	response := alidns.CreateUpdateDomainRecordResponse()
-	return d.client.DoActionWithSetDomain(request, response)
+	return d.client.DoActionWithSetDomain(ctx, request, response)
 }
 
-func (d *publicZoneService) Delete(id, rr, target string) error {
-	recordID, err := d.getRecordID(id, rr, target)
-	if err != nil {
-		return err
-	}
-
+func (d *publicZoneService) Delete(ctx context.Context, id, recordID string) error {
 	request := alidns.CreateDeleteDomainRecordRequest()
 	request.Scheme = "https"
 	request.RecordId = recordID
 
 	response := alidns.CreateDeleteDomainRecordResponse()
-	return d.client.DoActionWithSetDomain(request, response)
-}
-
-// getRecordID finds the ID by dns name and an optional argument target.
-func (d *publicZoneService) getRecordID(id, dnsName, target string) (string, error) {
-	request := alidns.CreateDescribeDomainRecordsRequest()
-	request.Scheme = "https"
-	request.DomainName = id
-	request.KeyWord = dnsName
-	request.SearchMode = "EXACT"
-
-	response := alidns.CreateDescribeDomainRecordsResponse()
-	if err := d.client.DoActionWithSetDomain(request, response); err != nil {
-		return "", fmt.Errorf("failed on describe domain records: %w", err)
-	}
-
-	for _, record := range response.DomainRecords.Record {
-		if record.RR == dnsName && (target == "" || target == record.Value) {
-			return record.RecordId, nil
-		}
-	}
-
-	return "", fmt.Errorf("cannot find record %q for domain %q", dnsName, id)
+	return d.client.DoActionWithSetDomain(ctx, request, response)
 }
 
 // privateZoneService is an implementation of the Service interface for public zones,
@@ -127,10 +148,38 @@ type privateZoneService struct {
 	mutex   sync.Mutex
 }
 
-func (p *privateZoneService) Add(zoneName, rr, recordType, target string, ttl int64) error {
+func (p *privateZoneService) List(ctx context.Context, zoneName, rr string) ([]Record, error) {
 	// The first argument "id" in Service is actually zone name in the implementation of private zone.
 	// The zone name is used to lookup zone ID used in following requests.
-	id, err := p.lookupPrivateZoneID(zoneName)
+	id, err := p.lookupPrivateZoneID(ctx, zoneName)
+	if err != nil {
+		return nil, fmt.Errorf("failed lookup private zone id: %w", err)
+	}
+
+	request := pvtz.CreateDescribeZoneRecordsRequest()
+	request.Scheme = "https"
+	request.ZoneId = id
+	request.Keyword = rr
+	request.SearchMode = "EXACT"
+	request.PageSize = requests.NewInteger(100)
+
+	response := pvtz.CreateDescribeZoneRecordsResponse()
+	if err := p.client.DoActionWithSetDomain(ctx, request, response); err != nil {
+		return nil, fmt.Errorf("failed on describe pvtz records: %w", err)
+	}
+
+	var records []Record
+	for _, record := range response.Records.Record {
+		if record.Rr != rr {
+			continue
+		}
+		records = append(records, Record{ID: strconv.FormatInt(record.RecordId, 10), RR: record.Rr, Type: record.Type, Target: record.Value, TTL: int64(record.Ttl)})
+	}
+	return records, nil
+}
+
+func (p *privateZoneService) Add(ctx context.Context, zoneName, rr, recordType, target string, ttl int64) error {
+	id, err := p.lookupPrivateZoneID(ctx, zoneName)
 	if err != nil {
 		return fmt.Errorf("failed lookup private zone id: %w", err)
 	}
@@ -143,88 +192,56 @@ func (p *privateZoneService) Add(zoneName, rr, recordType, target string, ttl in
 	request.Value = target
 
 	// A valid TTL for private zone must be in the range of 5 to 86400.
-	clampedTTL := util.Clamp(ttl, 5, 86400)
+	clampedTTL := util.Clamp(ttl, privateZoneMinTTL, privateZoneMaxTTL)
 	if clampedTTL != ttl {
-		log.Info(fmt.Sprintf("record's TTL for private zone must be in the range of 600 to 86400, set it to %d", clampedTTL), "record", rr)
+		log.Info(fmt.Sprintf("record's TTL for private zone must be in the range of %d to %d, set it to %d", privateZoneMinTTL, privateZoneMaxTTL, clampedTTL), "record", rr)
 	}
 	request.Ttl = requests.NewInteger64(clampedTTL)
 
 	response := pvtz.CreateAddZoneRecordResponse()
-	return p.client.DoActionWithSetDomain(request, response)
+	return p.client.DoActionWithSetDomain(ctx, request, response)
 }
 
-func (p *privateZoneService) Update(zoneName, rr, recordType, target string, ttl int64) error {
-	id, err := p.lookupPrivateZoneID(zoneName)
+func (p *privateZoneService) Update(ctx context.Context, zoneName, recordID, rr, recordType, target string, ttl int64) error {
+	id, err := strconv.ParseInt(recordID, 10, 64)
 	if err != nil {
-		return fmt.Errorf("failed lookup private zone id: %w", err)
-	}
-
-	recordID, err := p.getRecordID(id, rr, "")
-	if err != nil {
-		return err
+		return fmt.Errorf("invalid pvtz record id %q: %w", recordID, err)
 	}
 
 	request := pvtz.CreateUpdateZoneRecordRequest()
 	request.Scheme = "https"
-	request.RecordId = requests.NewInteger64(recordID)
+	request.RecordId = requests.NewInteger64(id)
 	request.Rr = rr
 	request.Type = recordType
 	request.Value = target
 
 	// A valid TTL for private zone must be in the range of 5 to 86400.
-	clampedTTL := util.Clamp(ttl, 5, 86400)
+	clampedTTL := util.Clamp(ttl, privateZoneMinTTL, privateZoneMaxTTL)
 	if clampedTTL != ttl {
-		log.Info(fmt.Sprintf("record's TTL for private zone must be in the range of 600 to 86400, set it to %d", clampedTTL), "record", rr)
+		log.Info(fmt.Sprintf("record's TTL for private zone must be in the range of %d to %d, set it to %d", privateZoneMinTTL, privateZoneMaxTTL, clampedTTL), "record", rr)
 	}
 	request.Ttl = requests.NewInteger64(clampedTTL)
 
 	response := pvtz.CreateUpdateZoneRecordResponse()
-	return p.client.DoActionWithSetDomain(request, response)
+	return p.client.DoActionWithSetDomain(ctx, request, response)
 }
 
-func (p *privateZoneService) Delete(zoneName, rr, target string) error {
-	id, err := p.lookupPrivateZoneID(zoneName)
+func (p *privateZoneService) Delete(ctx context.Context, zoneName, recordID string) error {
+	id, err := strconv.ParseInt(recordID, 10, 64)
 	if err != nil {
-		return fmt.Errorf("failed lookup private zone id: %w", err)
-	}
-
-	recordID, err := p.getRecordID(id, rr, target)
-	if err != nil {
-		return err
+		return fmt.Errorf("invalid pvtz record id %q: %w", recordID, err)
 	}
 
 	request := pvtz.CreateDeleteZoneRecordRequest()
 	request.Scheme = "https"
-	request.RecordId = requests.NewInteger64(recordID)
+	request.RecordId = requests.NewInteger64(id)
 
 	response := pvtz.CreateDeleteZoneRecordResponse()
-	return p.client.DoActionWithSetDomain(request, response)
-}
-
-// getRecordID finds the ID by dns name and an optional argument target.
-func (p *privateZoneService) getRecordID(id, dnsName, target string) (int64, error) {
-	request := pvtz.CreateDescribeZoneRecordsRequest()
-	request.Scheme = "https"
-	request.ZoneId = id
-	request.Keyword = dnsName
-	request.SearchMode = "EXACT"
-
-	response := pvtz.CreateDescribeZoneRecordsResponse()
-	if err := p.client.DoActionWithSetDomain(request, response); err != nil {
-		return 0, fmt.Errorf("failed on describe pvtz records: %w", err)
-	}
-
-	for _, record := range response.Records.Record {
-		if record.Rr == dnsName && (target == "" || target == record.Value) {
-			return record.RecordId, nil
-		}
-	}
-
-	return 0, fmt.Errorf("cannot find record %q for pvtz %q", dnsName, id)
+	return p.client.DoActionWithSetDomain(ctx, request, response)
 }
 
 // lookupPrivateZoneID finds zone ID, and caches it when the zone ID is retrieved successfully.
-func (p *privateZoneService) lookupPrivateZoneID(zoneName string) (string, error) {
+func (p *privateZoneService) lookupPrivateZoneID(ctx context.Context, zoneName string) (string, error) {
 	p.mutex.Lock()
 	defer p.mutex.Unlock()
 
@@ -241,7 +258,7 @@ func (p *privateZoneService) lookupPrivateZoneID(zoneName string) (string, error
 	request.PageSize = requests.NewInteger(100)
 	response := pvtz.CreateDescribeZonesResponse()
 
-	if err := p.client.DoActionWithSetDomain(request, response); err != nil {
+	if err := p.client.DoActionWithSetDomain(ctx, request, response); err != nil {
 		return "", fmt.Errorf("failed on describe private zones: %w", err)
 	}
 
@@ -280,7 +297,17 @@ func NewClient(sdkClient *sdk.Client, regionID string) *Client {
 // DoActionWithSetDomain resolves the endpoint for the given API call, and does the request.
 // For some reason, the SDK will return an error if there's no endpoint for this region,
 // so it's necessary to set a default endpoint manually for now.
-func (client *Client) DoActionWithSetDomain(request requests.AcsRequest, response responses.AcsResponse) error {
+// The SDK does not accept a context, so the request's timeouts are bounded by
+// the context's deadline instead.
+func (client *Client) DoActionWithSetDomain(ctx context.Context, request requests.AcsRequest, response responses.AcsResponse) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	if deadline, ok := ctx.Deadline(); ok {
+		timeout := time.Until(deadline)
+		request.SetConnectTimeout(timeout)
+		request.SetReadTimeout(timeout)
+	}
 	endpoint, err := endpoints.Resolve(&endpoints.ResolveParam{
 		Product:  strings.ToLower(request.GetProduct()),
 		RegionId: strings.ToLower(client.RegionID),
@@ -296,5 +323,11 @@ func (client *Client) DoActionWithSetDomain(request requests.AcsRequest, respons
 	}
 	request.SetDomain(endpoint)
 
-	return client.DoAction(request, response)
+	if err := client.DoAction(request, response); err != nil {
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return fmt.Errorf("%v: %w", err, ctxErr)
+		}
+		return err
+	}
+	return nil
 }
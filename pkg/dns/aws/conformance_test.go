@@ -0,0 +1,83 @@
+package aws
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/route53"
+
+	configv1 "github.com/openshift/api/config/v1"
+	iov1 "github.com/openshift/api/operatoringress/v1"
+	"github.com/openshift/cluster-ingress-operator/pkg/dns/conformance"
+)
+
+// conformanceBackend is a conformance.Backend for a fakeRoute53.
+type conformanceBackend struct {
+	fake   *fakeRoute53
+	zoneID string
+}
+
+// Records returns the record sets with the given name, reporting an alias
+// record set as a CNAME record.
+func (b *conformanceBackend) Records(dnsName string) []conformance.Record {
+	var records []conformance.Record
+	for _, set := range b.fake.sets[b.zoneID] {
+		if recordNamesEqual(aws.StringValue(set.Name), dnsName) {
+			records = append(records, conformanceRecord(set))
+		}
+	}
+	return records
+}
+
+func (b *conformanceBackend) FailWrites(recordType iov1.DNSRecordType) {
+	if len(recordType) == 0 {
+		b.fake.failWrites = nil
+		return
+	}
+	b.fake.failWrites = func(set *route53.ResourceRecordSet) bool {
+		return conformanceRecord(set).Type == recordType
+	}
+}
+
+// conformanceRecord returns the given record set as a conformance.Record.
+func conformanceRecord(set *route53.ResourceRecordSet) conformance.Record {
+	record := conformance.Record{
+		Type: iov1.DNSRecordType(aws.StringValue(set.Type)),
+		TTL:  aws.Int64Value(set.TTL),
+	}
+	if set.AliasTarget != nil {
+		record.Type = iov1.CNAMERecordType
+		record.Targets = []string{aws.StringValue(set.AliasTarget.DNSName)}
+	}
+	for _, rr := range set.ResourceRecords {
+		record.Targets = append(record.Targets, aws.StringValue(rr.Value))
+	}
+	return record
+}
+
+func TestConformance(t *testing.T) {
+	for _, govCloud := range []bool{false, true} {
+		name := "commercial"
+		if govCloud {
+			name = "GovCloud"
+		}
+		t.Run(name, func(t *testing.T) {
+			conformance.Run(t, func(t *testing.T) *conformance.Harness {
+				fake := newFakeRoute53()
+				return &conformance.Harness{
+					Provider: &Provider{
+						route53:   fake,
+						govCloud:  govCloud,
+						idsToTags: map[string]map[string]string{},
+						lbZones:   map[string]string{"lb.example.com": "ZLB"},
+					},
+					Backend:      &conformanceBackend{fake: fake, zoneID: "Z1"},
+					Zone:         configv1.DNSZone{ID: "Z1"},
+					Domain:       "example.com",
+					CNAMETarget:  "lb.example.com",
+					InvalidZones: []configv1.DNSZone{{}},
+				}
+			})
+		})
+	}
+}
@@ -1,6 +1,8 @@
 package aws
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"reflect"
 	"regexp"
@@ -23,6 +25,8 @@ import (
 	"github.com/aws/aws-sdk-go/service/resourcegroupstaggingapi"
 	"github.com/aws/aws-sdk-go/service/route53"
 
+	"golang.org/x/time/rate"
+
 	kerrors "k8s.io/apimachinery/pkg/util/errors"
 
 	configv1 "github.com/openshift/api/config/v1"
@@ -45,25 +49,32 @@ const (
 )
 
 var (
-	_   dns.Provider = &Provider{}
-	log              = logf.Logger.WithName("dns")
+	_   dns.Provider                = &Provider{}
+	_   dns.AtomicOwnershipRegistry = &Provider{}
+	_   dns.BatchProvider           = &Provider{}
+	_   dns.ThrottleClassifier      = &Provider{}
+	_   dns.RateLimitedProvider     = &Provider{}
+	log                             = logf.Logger.WithName("dns")
 
 	hostedZoneIDRegex = regexp.MustCompile("^/?hostedzone/([^/]+)$")
 )
 
-// Provider is a dns.Provider for AWS Route53. It only supports DNSRecords of
-// type CNAME, and the CNAME records are implemented as A records using the
-// Route53 Alias feature.
-//
-// TODO: Records are considered owned by the manager if they exist in a managed
-// zone and if their names match expectations. This is relatively dangerous
-// compared to storing additional metadata (like tags or TXT records).
+// Provider is a dns.Provider for AWS Route53. It supports DNSRecords of type
+// CNAME and A.  CNAME records are implemented as A records using the Route53
+// Alias feature; A records are implemented as plain records with the record's
+// targets as values.  The provider implements
+// dns.AtomicOwnershipRegistry using companion TXT records, which it can write
+// in the same change batch as the records that they describe.
 type Provider struct {
 	elb     *elb.ELB
 	elbv2   *elbv2.ELBV2
-	route53 *route53.Route53
+	route53 route53Client
 	tags    *resourcegroupstaggingapi.ResourceGroupsTaggingAPI
 
+	// govCloud indicates whether the Route 53 client uses a GovCloud
+	// endpoint, in which case alias records are not supported.
+	govCloud bool
+
 	config Config
 
 	// lock protects access to everything below.
@@ -76,6 +87,20 @@ type Provider struct {
 
 	// lbZones is a cache of load balancer DNS names to LB hosted zone IDs.
 	lbZones map[string]string
+
+	// limiter, if not nil, limits the rate of calls to the AWS APIs; see
+	// SetRateLimiter.
+	limiter *rate.Limiter
+}
+
+// route53Client is the subset of the Route 53 API that the provider uses.  It
+// is satisfied by *route53.Route53 and allows tests to use a fake client.
+type route53Client interface {
+	ListHostedZones(*route53.ListHostedZonesInput) (*route53.ListHostedZonesOutput, error)
+	ListHostedZonesPagesWithContext(aws.Context, *route53.ListHostedZonesInput, func(*route53.ListHostedZonesOutput, bool) bool, ...request.Option) error
+	ListTagsForResourcesWithContext(aws.Context, *route53.ListTagsForResourcesInput, ...request.Option) (*route53.ListTagsForResourcesOutput, error)
+	ListResourceRecordSetsWithContext(aws.Context, *route53.ListResourceRecordSetsInput, ...request.Option) (*route53.ListResourceRecordSetsOutput, error)
+	ChangeResourceRecordSetsWithContext(aws.Context, *route53.ChangeResourceRecordSetsInput, ...request.Option) (*route53.ChangeResourceRecordSetsOutput, error)
 }
 
 // Config is the necessary input to configure the manager.
@@ -213,13 +238,15 @@ func NewProvider(config Config, operatorReleaseVersion string) (*Provider, error
 	if tagConfig != nil {
 		tags = resourcegroupstaggingapi.New(sess, tagConfig)
 	}
+	r53 := route53.New(sess, r53Config)
 	p := &Provider{
 		elb: elb.New(sess, elbConfig),
 		// TODO: Add custom endpoint support for elbv2. See the following for details:
 		// https://docs.aws.amazon.com/general/latest/gr/elb.html
 		elbv2:     elbv2.New(sess, aws.NewConfig().WithRegion(region)),
-		route53:   route53.New(sess, r53Config),
+		route53:   r53,
 		tags:      tags,
+		govCloud:  clientEndpointIsGovCloud(&r53.Client.ClientInfo),
 		config:    config,
 		idsToTags: map[string]map[string]string{},
 		lbZones:   map[string]string{},
@@ -258,7 +285,7 @@ func validateServiceEndpoints(provider *Provider) error {
 // getZoneID finds the ID of given zoneConfig in Route53. If an ID is already
 // known, return that; otherwise, use tags to search for the zone. Returns an
 // error if the zone can't be found.
-func (m *Provider) getZoneID(zoneConfig configv1.DNSZone) (string, error) {
+func (m *Provider) getZoneID(ctx context.Context, zoneConfig configv1.DNSZone) (string, error) {
 	m.lock.Lock()
 	defer m.lock.Unlock()
 
@@ -267,6 +294,11 @@ func (m *Provider) getZoneID(zoneConfig configv1.DNSZone) (string, error) {
 		return zoneConfig.ID, nil
 	}
 
+	// Without an ID or tags, any zone would match.
+	if len(zoneConfig.Tags) == 0 {
+		return "", fmt.Errorf("zone has neither an ID nor tags")
+	}
+
 	// If the ID for these tags is already cached, use it
 	for id, tags := range m.idsToTags {
 		if reflect.DeepEqual(tags, zoneConfig.Tags) {
@@ -278,9 +310,9 @@ func (m *Provider) getZoneID(zoneConfig configv1.DNSZone) (string, error) {
 	var id string
 	var err error
 	if m.tags != nil {
-		id, err = m.lookupZoneID(zoneConfig)
+		id, err = m.lookupZoneID(ctx, zoneConfig)
 	} else {
-		id, err = m.lookupZoneIDWithoutResourceTagging(zoneConfig)
+		id, err = m.lookupZoneIDWithoutResourceTagging(ctx, zoneConfig)
 	}
 	if err != nil {
 		return id, err
@@ -293,7 +325,7 @@ func (m *Provider) getZoneID(zoneConfig configv1.DNSZone) (string, error) {
 	return id, nil
 }
 
-func (m *Provider) lookupZoneID(zoneConfig configv1.DNSZone) (string, error) {
+func (m *Provider) lookupZoneID(ctx context.Context, zoneConfig configv1.DNSZone) (string, error) {
 	var id string
 	// Even though we use filters when getting resources, the resources are still
 	// paginated as though no filter were applied.  If the desired resource is not
@@ -320,7 +352,10 @@ func (m *Provider) lookupZoneID(zoneConfig configv1.DNSZone) (string, error) {
 			Values: []*string{aws.String(v)},
 		})
 	}
-	outerError := m.tags.GetResourcesPages(&resourcegroupstaggingapi.GetResourcesInput{
+	if err := m.wait(ctx); err != nil {
+		return "", err
+	}
+	outerError := m.tags.GetResourcesPagesWithContext(ctx, &resourcegroupstaggingapi.GetResourcesInput{
 		ResourceTypeFilters: []*string{aws.String("route53:hostedzone")},
 		TagFilters:          tagFilters,
 	}, f)
@@ -333,7 +368,7 @@ func (m *Provider) lookupZoneID(zoneConfig configv1.DNSZone) (string, error) {
 	return id, nil
 }
 
-func (m *Provider) lookupZoneIDWithoutResourceTagging(zoneConfig configv1.DNSZone) (string, error) {
+func (m *Provider) lookupZoneIDWithoutResourceTagging(ctx context.Context, zoneConfig configv1.DNSZone) (string, error) {
 	var id string
 	var innerError error
 	searchZones := func(resp *route53.ListHostedZonesOutput, lastPage bool) (shouldContinue bool) {
@@ -349,7 +384,7 @@ func (m *Provider) lookupZoneIDWithoutResourceTagging(zoneConfig configv1.DNSZon
 			}
 			input.ResourceIds[i] = &zoneID
 		}
-		output, err := m.route53.ListTagsForResources(input)
+		output, err := m.route53.ListTagsForResourcesWithContext(ctx, input)
 		if err != nil {
 			innerError = err
 			return false
@@ -363,7 +398,8 @@ func (m *Provider) lookupZoneIDWithoutResourceTagging(zoneConfig configv1.DNSZon
 		return true
 	}
 	// the maximum page size is limited to 10 because the call to ListTagsForResources only supports 10 resources in a single call.
-	outerError := m.route53.ListHostedZonesPages(
+	outerError := m.route53.ListHostedZonesPagesWithContext(
+		ctx,
 		&route53.ListHostedZonesInput{MaxItems: aws.String("10")},
 		searchZones,
 	)
@@ -410,7 +446,7 @@ func zoneIDFromResource(resource string) (string, error) {
 
 // getLBHostedZone finds the hosted zone ID of an ELB whose DNS name matches the
 // name parameter. Results are cached.
-func (m *Provider) getLBHostedZone(name string) (string, error) {
+func (m *Provider) getLBHostedZone(ctx context.Context, name string) (string, error) {
 	m.lock.Lock()
 	defer m.lock.Unlock()
 
@@ -431,7 +467,10 @@ func (m *Provider) getLBHostedZone(name string) (string, error) {
 		}
 		return true
 	}
-	err := m.elb.DescribeLoadBalancersPages(&elb.DescribeLoadBalancersInput{}, elbFn)
+	if err := m.wait(ctx); err != nil {
+		return "", err
+	}
+	err := m.elb.DescribeLoadBalancersPagesWithContext(ctx, &elb.DescribeLoadBalancersInput{}, elbFn)
 	if err != nil {
 		return "", fmt.Errorf("failed to describe classic load balancers: %v", err)
 	}
@@ -448,7 +487,10 @@ func (m *Provider) getLBHostedZone(name string) (string, error) {
 			}
 			return true
 		}
-		err := m.elbv2.DescribeLoadBalancersPages(&elbv2.DescribeLoadBalancersInput{}, elbv2Fn)
+		if err := m.wait(ctx); err != nil {
+			return "", err
+		}
+		err := m.elbv2.DescribeLoadBalancersPagesWithContext(ctx, &elbv2.DescribeLoadBalancersInput{}, elbv2Fn)
 		if err != nil {
 			return "", fmt.Errorf("failed to describe network load balancers: %v", err)
 		}
@@ -468,114 +510,333 @@ const (
 	deleteAction action = "DELETE"
 )
 
-func (m *Provider) Ensure(record *iov1.DNSRecord, zone configv1.DNSZone) error {
-	return m.change(record, zone, upsertAction)
+func (m *Provider) Ensure(ctx context.Context, record *iov1.DNSRecord, zone configv1.DNSZone) error {
+	return m.change(ctx, record, zone, upsertAction)
+}
+
+func (m *Provider) Delete(ctx context.Context, record *iov1.DNSRecord, zone configv1.DNSZone) error {
+	return m.change(ctx, record, zone, deleteAction)
 }
 
-func (m *Provider) Delete(record *iov1.DNSRecord, zone configv1.DNSZone) error {
-	return m.change(record, zone, deleteAction)
+// Replace upserts the given record and, in the same atomic change batch,
+// deletes any record set with the same name whose type conflicts with the
+// record's, as when the record changes from an alias (or, in GovCloud, a CNAME
+// record) to an A record or vice versa.
+func (m *Provider) Replace(ctx context.Context, record *iov1.DNSRecord, zone configv1.DNSZone) error {
+	prepared, err := m.prepareChange(ctx, record, zone, upsertAction)
+	if err != nil {
+		return err
+	}
+	if err := m.addConflictDeletions(ctx, prepared); err != nil {
+		return err
+	}
+	return m.applyPreparedChange(ctx, prepared, zone)
 }
 
-func (m *Provider) Replace(record *iov1.DNSRecord, zone configv1.DNSZone) error {
-	return m.change(record, zone, upsertAction)
+// EnsureWithOwner upserts the given record and, in the same atomic change
+// batch, its companion TXT record specifying the given owner.
+func (m *Provider) EnsureWithOwner(ctx context.Context, record *iov1.DNSRecord, zone configv1.DNSZone, owner string) error {
+	prepared, err := m.prepareChange(ctx, record, zone, upsertAction)
+	if err != nil {
+		return err
+	}
+	m.addOwnershipChange(prepared, owner)
+	return m.applyPreparedChange(ctx, prepared, zone)
 }
 
-// change will perform an action on a record. The target must correspond to the
-// hostname of an ELB which will be automatically discovered.
-func (m *Provider) change(record *iov1.DNSRecord, zone configv1.DNSZone, action action) error {
-	if record.Spec.RecordType != iov1.CNAMERecordType {
-		return fmt.Errorf("unsupported record type %s", record.Spec.RecordType)
+// ReplaceWithOwner is like Replace but also upserts the companion TXT record of
+// the given record specifying the given owner in the same atomic change batch.
+func (m *Provider) ReplaceWithOwner(ctx context.Context, record *iov1.DNSRecord, zone configv1.DNSZone, owner string) error {
+	prepared, err := m.prepareChange(ctx, record, zone, upsertAction)
+	if err != nil {
+		return err
+	}
+	if err := m.addConflictDeletions(ctx, prepared); err != nil {
+		return err
 	}
-	// TODO: handle >0 targets
+	m.addOwnershipChange(prepared, owner)
+	return m.applyPreparedChange(ctx, prepared, zone)
+}
+
+// change will perform an action on a record. For a CNAME record, the target
+// must correspond to the hostname of an ELB which will be automatically
+// discovered. For an A record, the targets are IP addresses.
+func (m *Provider) change(ctx context.Context, record *iov1.DNSRecord, zone configv1.DNSZone, action action) error {
+	prepared, err := m.prepareChange(ctx, record, zone, action)
+	if err != nil {
+		return err
+	}
+	return m.applyPreparedChange(ctx, prepared, zone)
+}
+
+// preparedChange is a Route 53 change that performs an action on a record,
+// along with the ID of the hosted zone to which the change applies.
+type preparedChange struct {
+	record *iov1.DNSRecord
+	action action
+	zoneID string
+	change *route53.Change
+	// deletions are changes that delete record sets that conflict with
+	// the change's record set.  They are submitted before the change in
+	// the same change batch.
+	deletions []*route53.Change
+	// ownership, if not nil, is a change that upserts the record's
+	// companion TXT record set.  It is submitted after the change in the
+	// same change batch.
+	ownership *route53.Change
+}
+
+// prepareChange validates the given record, looks up the hosted zone of the
+// given zone and, for a CNAME record, the hosted zone of the load balancer, and
+// returns the Route 53 change that performs the given action on the record.
+func (m *Provider) prepareChange(ctx context.Context, record *iov1.DNSRecord, zone configv1.DNSZone, action action) (*preparedChange, error) {
+	switch record.Spec.RecordType {
+	case iov1.CNAMERecordType, iov1.ARecordType:
+	default:
+		return nil, fmt.Errorf("unsupported record type %s", record.Spec.RecordType)
+	}
+	if len(record.Spec.Targets) == 0 {
+		return nil, fmt.Errorf("target is required")
+	}
+	// TODO: handle >0 targets for CNAME records.
 	domain, target := record.Spec.DNSName, record.Spec.Targets[0]
 	if len(domain) == 0 {
-		return fmt.Errorf("domain is required")
+		return nil, fmt.Errorf("domain is required")
 	}
 	if len(target) == 0 {
-		return fmt.Errorf("target is required")
+		return nil, fmt.Errorf("target is required")
 	}
 
-	zoneID, err := m.getZoneID(zone)
+	zoneID, err := m.getZoneID(ctx, zone)
 	if err != nil {
-		return fmt.Errorf("failed to find hosted zone for record: %v", err)
+		return nil, fmt.Errorf("failed to find hosted zone for record: %w", err)
 	}
 
-	// Find the target hosted zone of the load balancer attached to the service.
-	targetHostedZoneID, err := m.getLBHostedZone(target)
-	if err != nil {
-		return fmt.Errorf("failed to get hosted zone for load balancer target %q: %v", target, err)
+	var change *route53.Change
+	if record.Spec.RecordType == iov1.CNAMERecordType {
+		// Find the target hosted zone of the load balancer attached to the service.
+		targetHostedZoneID, err := m.getLBHostedZone(ctx, target)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get hosted zone for load balancer target %q: %w", target, err)
+		}
+		change = m.aliasRecordChange(domain, target, targetHostedZoneID, string(action), record.Spec.RecordTTL)
+	} else {
+		change = addressRecordChange(domain, record.Spec.Targets, string(action), record.Spec.RecordTTL)
 	}
 
-	// Configure records.
-	err = m.updateRecord(domain, zoneID, target, targetHostedZoneID, string(action), record.Spec.RecordTTL)
+	return &preparedChange{
+		record: record,
+		action: action,
+		zoneID: zoneID,
+		change: change,
+	}, nil
+}
+
+// addConflictDeletions adds to the given prepared upsert change the deletions
+// of the record sets in the hosted zone that have the same name as the
+// change's record set and a conflicting type.  The deletions are submitted in the same change batch as the upsert, so Route 53
+// applies all of them or none of them.
+func (m *Provider) addConflictDeletions(ctx context.Context, prepared *preparedChange) error {
+	set := prepared.change.ResourceRecordSet
+	input := &route53.ListResourceRecordSetsInput{
+		HostedZoneId:    aws.String(prepared.zoneID),
+		StartRecordName: set.Name,
+	}
+	resp, err := m.route53.ListResourceRecordSetsWithContext(ctx, input)
 	if err != nil {
-		return fmt.Errorf("failed to update alias in zone %s: %v", zoneID, err)
+		return fmt.Errorf("failed to list resource record sets in zone %s: %w", prepared.zoneID, err)
+	}
+	for _, current := range resp.ResourceRecordSets {
+		if !recordNamesEqual(aws.StringValue(current.Name), aws.StringValue(set.Name)) {
+			break
+		}
+		if !dns.RecordTypesConflict(aws.StringValue(current.Type), aws.StringValue(set.Type)) {
+			continue
+		}
+		log.Info("deleting conflicting record set", "zone id", prepared.zoneID, "record", current)
+		prepared.deletions = append(prepared.deletions, &route53.Change{
+			Action:            aws.String(string(deleteAction)),
+			ResourceRecordSet: current,
+		})
+	}
+	return nil
+}
+
+// applyPreparedChange submits the given prepared change on its own and
+// finishes it.
+func (m *Provider) applyPreparedChange(ctx context.Context, prepared *preparedChange, zone configv1.DNSZone) error {
+	if err := m.submitChanges(ctx, prepared.zoneID, []*preparedChange{prepared}); err != nil {
+		if prepared.record.Spec.RecordType == iov1.CNAMERecordType {
+			return fmt.Errorf("failed to update alias in zone %s: %w", prepared.zoneID, err)
+		}
+		return fmt.Errorf("failed to update %s record in zone %s: %w", prepared.record.Spec.RecordType, prepared.zoneID, err)
 	}
-	switch action {
+	return m.finishChange(ctx, prepared, zone)
+}
+
+// finishChange logs the given submitted change.
+func (m *Provider) finishChange(ctx context.Context, prepared *preparedChange, zone configv1.DNSZone) error {
+	switch prepared.action {
 	case upsertAction:
-		log.Info("upserted DNS record", "record", record.Spec, "zone", zone)
+		log.Info("upserted DNS record", "record", prepared.record.Spec, "zone", zone)
 	case deleteAction:
-		log.Info("deleted DNS record", "record", record.Spec, "zone", zone)
+		log.Info("deleted DNS record", "record", prepared.record.Spec, "zone", zone)
 	}
 	return nil
 }
 
-// updateRecord creates or updates a DNS record for domain in zoneID pointed at
-// target in targetHostedZoneID. An Alias record type is used for all regions
-// other than GovCloud (CNAME). See the following for additional details:
-// https://docs.aws.amazon.com/govcloud-us/latest/UserGuide/govcloud-r53.html
-// Note that by API contract, TTL cannot be specified for an AliasTarget.
-func (m *Provider) updateRecord(domain, zoneID, target, targetHostedZoneID, action string, ttl int64) error {
-	input := route53.ChangeResourceRecordSetsInput{HostedZoneId: aws.String(zoneID)}
-	if clientEndpointIsGovCloud(&m.route53.Client.ClientInfo) {
-		record := route53.ResourceRecord{Value: aws.String(target)}
-		input.ChangeBatch = &route53.ChangeBatch{
-			Changes: []*route53.Change{
-				{
-					Action: aws.String(action),
-					ResourceRecordSet: &route53.ResourceRecordSet{
-						Name:            aws.String(domain),
-						Type:            aws.String(route53.RRTypeCname),
-						TTL:             aws.Int64(ttl),
-						ResourceRecords: []*route53.ResourceRecord{&record},
-					},
-				},
-			},
+// ApplyBatch applies the given changes to the given zone with a single
+// ChangeResourceRecordSets call.  Route 53 applies a batch of changes
+// atomically, so if the call fails for a reason other than throttling, the
+// changes are applied individually in order to determine which of them failed.
+func (m *Provider) ApplyBatch(ctx context.Context, zone configv1.DNSZone, changes []dns.Change) []error {
+	errs := make([]error, len(changes))
+	var (
+		prepared []*preparedChange
+		indexes  []int
+	)
+	for i, change := range changes {
+		action := upsertAction
+		if change.Action == dns.DeleteAction {
+			action = deleteAction
 		}
-	} else {
-		input.ChangeBatch = &route53.ChangeBatch{
-			Changes: []*route53.Change{
-				{
-					Action: aws.String(action),
-					ResourceRecordSet: &route53.ResourceRecordSet{
-						Name: aws.String(domain),
-						Type: aws.String(route53.RRTypeA),
-						AliasTarget: &route53.AliasTarget{
-							HostedZoneId:         aws.String(targetHostedZoneID),
-							DNSName:              aws.String(target),
-							EvaluateTargetHealth: aws.Bool(false),
-						},
-					},
-				},
-			},
+		p, err := m.prepareChange(ctx, change.Record, zone, action)
+		if err == nil && change.Action == dns.ReplaceAction {
+			err = m.addConflictDeletions(ctx, p)
 		}
+		if err != nil {
+			errs[i] = err
+			continue
+		}
+		if action == upsertAction && len(change.Owner) != 0 {
+			m.addOwnershipChange(p, change.Owner)
+		}
+		prepared = append(prepared, p)
+		indexes = append(indexes, i)
 	}
-	resp, err := m.route53.ChangeResourceRecordSets(&input)
+	if len(prepared) == 0 {
+		return errs
+	}
+	if len(prepared) == 1 {
+		errs[indexes[0]] = m.applyPreparedChange(ctx, prepared[0], zone)
+		return errs
+	}
+	if err := m.submitChanges(ctx, prepared[0].zoneID, prepared); err != nil {
+		if m.IsThrottled(err) || ctx.Err() != nil {
+			for _, i := range indexes {
+				errs[i] = fmt.Errorf("failed to update records in zone %s: %w", prepared[0].zoneID, err)
+			}
+			return errs
+		}
+		log.Info("failed to apply batch of changes; applying changes individually", "zone id", prepared[0].zoneID, "changes", len(prepared), "error", err.Error())
+		for j, i := range indexes {
+			errs[i] = m.applyPreparedChange(ctx, prepared[j], zone)
+		}
+		return errs
+	}
+	for j, i := range indexes {
+		errs[i] = m.finishChange(ctx, prepared[j], zone)
+	}
+	return errs
+}
+
+// IsThrottled returns a Boolean value indicating whether the given error means
+// that an AWS API throttled a request.
+func (m *Provider) IsThrottled(err error) bool {
+	var aerr awserr.Error
+	if !errors.As(err, &aerr) {
+		return false
+	}
+	switch aerr.Code() {
+	case route53.ErrCodeThrottlingException, route53.ErrCodePriorRequestNotComplete, "Throttling", "RequestLimitExceeded":
+		return true
+	}
+	return request.IsErrorThrottle(aerr)
+}
+
+// submitChanges submits the given prepared changes to the hosted zone with the
+// given ID in a single ChangeResourceRecordSets call.  Deleting a single record
+// that does not exist is not an error.
+func (m *Provider) submitChanges(ctx context.Context, zoneID string, prepared []*preparedChange) error {
+	input := route53.ChangeResourceRecordSetsInput{
+		HostedZoneId: aws.String(zoneID),
+		ChangeBatch:  &route53.ChangeBatch{},
+	}
+	for _, p := range prepared {
+		input.ChangeBatch.Changes = append(input.ChangeBatch.Changes, p.deletions...)
+		input.ChangeBatch.Changes = append(input.ChangeBatch.Changes, p.change)
+		if p.ownership != nil {
+			input.ChangeBatch.Changes = append(input.ChangeBatch.Changes, p.ownership)
+		}
+	}
+	resp, err := m.route53.ChangeResourceRecordSetsWithContext(ctx, &input)
 	if err != nil {
-		if action == string(deleteAction) {
+		if len(prepared) == 1 && prepared[0].action == deleteAction {
 			if aerr, ok := err.(awserr.Error); ok {
 				if strings.Contains(aerr.Message(), "not found") {
-					log.Info("record not found", "zone id", zoneID, "domain", domain, "target", target)
+					log.Info("record not found", "zone id", zoneID, "record", prepared[0].change.ResourceRecordSet)
 					return nil
 				}
 			}
 		}
-		return fmt.Errorf("couldn't update DNS record in zone %s: %v", zoneID, err)
+		return fmt.Errorf("couldn't update DNS record in zone %s: %w", zoneID, err)
+	}
+	for _, p := range prepared {
+		log.Info("updated DNS record", "zone id", zoneID, "record", p.change.ResourceRecordSet, "response", resp)
 	}
-	log.Info("updated DNS record", "zone id", zoneID, "domain", domain, "target", target, "response", resp)
 	return nil
 }
 
+// addressRecordChange returns a change that creates, updates, or deletes (as
+// specified by action) an A record for domain with the given IP addresses.
+func addressRecordChange(domain string, addresses []string, action string, ttl int64) *route53.Change {
+	records := make([]*route53.ResourceRecord, len(addresses))
+	for i := range addresses {
+		records[i] = &route53.ResourceRecord{Value: aws.String(addresses[i])}
+	}
+	return &route53.Change{
+		Action: aws.String(action),
+		ResourceRecordSet: &route53.ResourceRecordSet{
+			Name:            aws.String(domain),
+			Type:            aws.String(route53.RRTypeA),
+			TTL:             aws.Int64(ttl),
+			ResourceRecords: records,
+		},
+	}
+}
+
+// aliasRecordChange returns a change that creates, updates, or deletes (as
+// specified by action) a DNS record for domain pointed at target in
+// targetHostedZoneID. An Alias record type is used for all regions other than
+// GovCloud (CNAME). See the following for additional details:
+// https://docs.aws.amazon.com/govcloud-us/latest/UserGuide/govcloud-r53.html
+// Note that by API contract, TTL cannot be specified for an AliasTarget.
+func (m *Provider) aliasRecordChange(domain, target, targetHostedZoneID, action string, ttl int64) *route53.Change {
+	if m.govCloud {
+		return &route53.Change{
+			Action: aws.String(action),
+			ResourceRecordSet: &route53.ResourceRecordSet{
+				Name:            aws.String(domain),
+				Type:            aws.String(route53.RRTypeCname),
+				TTL:             aws.Int64(ttl),
+				ResourceRecords: []*route53.ResourceRecord{{Value: aws.String(target)}},
+			},
+		}
+	}
+	return &route53.Change{
+		Action: aws.String(action),
+		ResourceRecordSet: &route53.ResourceRecordSet{
+			Name: aws.String(domain),
+			Type: aws.String(route53.RRTypeA),
+			AliasTarget: &route53.AliasTarget{
+				HostedZoneId:         aws.String(targetHostedZoneID),
+				DNSName:              aws.String(target),
+				EvaluateTargetHealth: aws.Bool(false),
+			},
+		},
+	}
+}
+
 // clientEndpointIsGovCloud returns true if the provided client info
 // references a US GovCloud API endpoint.
 func clientEndpointIsGovCloud(clientInfo *metadata.ClientInfo) bool {
@@ -1,13 +1,23 @@
 package aws
 
 import (
+	"context"
+	"fmt"
 	"testing"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/stretchr/testify/assert"
 
 	"github.com/aws/aws-sdk-go/service/route53"
 	configv1 "github.com/openshift/api/config/v1"
+	iov1 "github.com/openshift/api/operatoringress/v1"
+	"github.com/openshift/cluster-ingress-operator/pkg/dns"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"golang.org/x/time/rate"
 )
 
 func TestZoneMatchesTags(t *testing.T) {
@@ -133,3 +143,169 @@ func TestZoneIDFromResource(t *testing.T) {
 		})
 	}
 }
+
+// TestApplyBatch verifies that ApplyBatch applies several changes to a zone
+// with a single ChangeResourceRecordSets call and reports invalid changes
+// individually.
+func TestApplyBatch(t *testing.T) {
+	fake := newFakeRoute53()
+	p := &Provider{
+		route53:   fake,
+		idsToTags: map[string]map[string]string{},
+		lbZones:   map[string]string{"lb.example.com": "ZLB"},
+	}
+	newRecord := func(name string, recordType iov1.DNSRecordType, target string) *iov1.DNSRecord {
+		return &iov1.DNSRecord{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Spec: iov1.DNSRecordSpec{
+				DNSName:    name + ".example.com.",
+				RecordType: recordType,
+				Targets:    []string{target},
+				RecordTTL:  30,
+			},
+		}
+	}
+	changes := []dns.Change{
+		{Action: dns.EnsureAction, Record: newRecord("a", iov1.ARecordType, "192.0.2.1")},
+		{Action: dns.ReplaceAction, Record: newRecord("replaced", iov1.ARecordType, "192.0.2.3")},
+		{Action: dns.EnsureAction, Record: newRecord("alias", iov1.CNAMERecordType, "lb.example.com")},
+		{Action: dns.EnsureAction, Record: newRecord("invalid", iov1.DNSRecordType("MX"), "mail.example.com")},
+		{Action: dns.DeleteAction, Record: newRecord("deleted", iov1.ARecordType, "192.0.2.2")},
+	}
+	errs := p.ApplyBatch(context.Background(), configv1.DNSZone{ID: "Z1"}, changes)
+	if !assert.Len(t, errs, len(changes)) {
+		return
+	}
+	for i, err := range errs {
+		if changes[i].Record.Name == "invalid" {
+			assert.Error(t, err)
+		} else {
+			assert.NoError(t, err)
+		}
+	}
+	assert.Equal(t, 1, fake.calls)
+	if assert.Len(t, fake.changes["Z1"], 4) {
+		assert.Equal(t, route53.ChangeActionUpsert, aws.StringValue(fake.changes["Z1"][1].Action))
+		assert.Equal(t, "replaced.example.com.", aws.StringValue(fake.changes["Z1"][1].ResourceRecordSet.Name))
+		assert.Equal(t, "lb.example.com", aws.StringValue(fake.changes["Z1"][2].ResourceRecordSet.AliasTarget.DNSName))
+		assert.Equal(t, route53.ChangeActionDelete, aws.StringValue(fake.changes["Z1"][3].Action))
+	}
+}
+
+// TestSetRateLimiter verifies that the provider waits for the rate limiter that
+// it is given before each call to the Route 53 API.
+func TestSetRateLimiter(t *testing.T) {
+	fake := newFakeRoute53()
+	p := &Provider{route53: fake, idsToTags: map[string]map[string]string{}}
+	p.SetRateLimiter(rate.NewLimiter(rate.Every(time.Hour), 1))
+	zone := configv1.DNSZone{ID: "Z1"}
+	record := &iov1.DNSRecord{
+		ObjectMeta: metav1.ObjectMeta{Name: "a"},
+		Spec: iov1.DNSRecordSpec{
+			DNSName:    "a.example.com.",
+			RecordType: iov1.ARecordType,
+			Targets:    []string{"192.0.2.1"},
+			RecordTTL:  30,
+		},
+	}
+	assert.NoError(t, p.Ensure(context.Background(), record, zone))
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	assert.Error(t, p.Ensure(ctx, record, zone))
+	assert.Equal(t, 1, fake.calls)
+}
+
+// TestReplaceDeletesConflictingRecordSets verifies that Replace deletes the
+// record sets with the record's name whose types conflict with the record's in
+// the same change batch as the upsert, and keeps the record sets that can
+// coexist with the record.
+func TestReplaceDeletesConflictingRecordSets(t *testing.T) {
+	const name = "www.example.com."
+	recordSet := func(recordType string) *route53.ResourceRecordSet {
+		return &route53.ResourceRecordSet{Name: aws.String(name), Type: aws.String(recordType), TTL: aws.Int64(30), ResourceRecords: []*route53.ResourceRecord{{Value: aws.String("192.0.2.9")}}}
+	}
+	testCases := []struct {
+		name        string
+		current     []*route53.ResourceRecordSet
+		expectTypes []string
+	}{
+		{
+			name:        "no record set",
+			expectTypes: []string{"A"},
+		},
+		{
+			name:        "A record set updated",
+			current:     []*route53.ResourceRecordSet{recordSet("A")},
+			expectTypes: []string{"A"},
+		},
+		{
+			name:        "CNAME record set replaced by an A record",
+			current:     []*route53.ResourceRecordSet{recordSet("CNAME")},
+			expectTypes: []string{"A"},
+		},
+		{
+			name:        "TXT record set kept",
+			current:     []*route53.ResourceRecordSet{recordSet("TXT")},
+			expectTypes: []string{"A", "TXT"},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			fake := newFakeRoute53()
+			fake.sets["Z1"] = tc.current
+			p := &Provider{route53: fake, idsToTags: map[string]map[string]string{}}
+			record := &iov1.DNSRecord{
+				Spec: iov1.DNSRecordSpec{
+					DNSName:    name,
+					RecordType: iov1.ARecordType,
+					Targets:    []string{"192.0.2.1"},
+					RecordTTL:  30,
+				},
+			}
+			if !assert.NoError(t, p.Replace(context.Background(), record, configv1.DNSZone{ID: "Z1"})) {
+				return
+			}
+			assert.Equal(t, 1, fake.calls)
+			var types []string
+			for _, set := range fake.sets["Z1"] {
+				types = append(types, aws.StringValue(set.Type))
+			}
+			assert.Equal(t, tc.expectTypes, types)
+		})
+	}
+}
+
+func TestIsThrottled(t *testing.T) {
+	p := &Provider{}
+	cases := []struct {
+		name     string
+		err      error
+		expected bool
+	}{
+		{
+			name:     "throttling",
+			err:      awserr.New("Throttling", "Rate exceeded", nil),
+			expected: true,
+		},
+		{
+			name:     "wrapped prior request not complete",
+			err:      fmt.Errorf("failed to update alias in zone Z1: %w", awserr.New(route53.ErrCodePriorRequestNotComplete, "", nil)),
+			expected: true,
+		},
+		{
+			name:     "invalid change batch",
+			err:      awserr.New(route53.ErrCodeInvalidChangeBatch, "", nil),
+			expected: false,
+		},
+		{
+			name:     "not an AWS error",
+			err:      fmt.Errorf("target is required"),
+			expected: false,
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			assert.Equal(t, c.expected, p.IsThrottled(c.err))
+		})
+	}
+}
@@ -0,0 +1,118 @@
+package aws
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/route53"
+)
+
+// fakeRoute53 is a fake route53Client that stores resource record sets in
+// memory and records the changes to resource record sets.
+type fakeRoute53 struct {
+	route53Client
+
+	calls      int
+	failWrites func(*route53.ResourceRecordSet) bool
+	sets       map[string][]*route53.ResourceRecordSet
+	changes    map[string][]*route53.Change
+}
+
+func newFakeRoute53() *fakeRoute53 {
+	return &fakeRoute53{
+		sets:    map[string][]*route53.ResourceRecordSet{},
+		changes: map[string][]*route53.Change{},
+	}
+}
+
+// ChangeResourceRecordSets applies the given change batch atomically.  Like
+// Route 53, it rejects a batch that leaves a CNAME record set with the same
+// name as another record set.  It also rejects every batch that creates or
+// updates a record set for which failWrites, if set, returns true.
+func (f *fakeRoute53) ChangeResourceRecordSets(input *route53.ChangeResourceRecordSetsInput) (*route53.ChangeResourceRecordSetsOutput, error) {
+	f.calls++
+	zoneID := aws.StringValue(input.HostedZoneId)
+	sets := f.sets[zoneID]
+	for _, change := range input.ChangeBatch.Changes {
+		set := change.ResourceRecordSet
+		var newSets []*route53.ResourceRecordSet
+		for _, s := range sets {
+			if recordSetKey(s) != recordSetKey(set) {
+				newSets = append(newSets, s)
+			}
+		}
+		if aws.StringValue(change.Action) != route53.ChangeActionDelete {
+			if f.failWrites != nil && f.failWrites(set) {
+				return nil, awserr.New(route53.ErrCodeInvalidInput, "injected failure", nil)
+			}
+			newSets = append(newSets, set)
+		}
+		sort.Slice(newSets, func(i, j int) bool { return recordSetKey(newSets[i]) < recordSetKey(newSets[j]) })
+		sets = newSets
+	}
+	types := map[string][]string{}
+	for _, s := range sets {
+		name := aws.StringValue(s.Name)
+		types[name] = append(types[name], aws.StringValue(s.Type))
+	}
+	for name, t := range types {
+		for _, recordType := range t {
+			if recordType == route53.RRTypeCname && len(t) > 1 {
+				return nil, awserr.New(route53.ErrCodeInvalidChangeBatch, fmt.Sprintf("RRSet of type CNAME with DNS name %s is not permitted as it conflicts with other records with the same DNS name", name), nil)
+			}
+		}
+	}
+	f.changes[zoneID] = append(f.changes[zoneID], input.ChangeBatch.Changes...)
+	f.sets[zoneID] = sets
+	return &route53.ChangeResourceRecordSetsOutput{}, nil
+}
+
+func (f *fakeRoute53) ChangeResourceRecordSetsWithContext(ctx aws.Context, input *route53.ChangeResourceRecordSetsInput, opts ...request.Option) (*route53.ChangeResourceRecordSetsOutput, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return f.ChangeResourceRecordSets(input)
+}
+
+// ListResourceRecordSets returns the record sets in the zone in order,
+// starting with the first record set whose name, type, and set identifier are
+// not less than the given ones.
+func (f *fakeRoute53) ListResourceRecordSets(input *route53.ListResourceRecordSetsInput) (*route53.ListResourceRecordSetsOutput, error) {
+	start := recordSetKey(&route53.ResourceRecordSet{
+		Name:          input.StartRecordName,
+		Type:          input.StartRecordType,
+		SetIdentifier: input.StartRecordIdentifier,
+	})
+	output := &route53.ListResourceRecordSetsOutput{}
+	for _, set := range f.sets[aws.StringValue(input.HostedZoneId)] {
+		if recordSetKey(set) >= start {
+			output.ResourceRecordSets = append(output.ResourceRecordSets, set)
+		}
+	}
+	return output, nil
+}
+
+func (f *fakeRoute53) ListResourceRecordSetsWithContext(ctx aws.Context, input *route53.ListResourceRecordSetsInput, opts ...request.Option) (*route53.ListResourceRecordSetsOutput, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return f.ListResourceRecordSets(input)
+}
+
+// recordSetKey returns a key that identifies the given record set and orders
+// record sets by name, type, and set identifier.
+func recordSetKey(set *route53.ResourceRecordSet) string {
+	return strings.Join([]string{aws.StringValue(set.Name), aws.StringValue(set.Type), aws.StringValue(set.SetIdentifier)}, "\x00")
+}
+
+func (f *fakeRoute53) lastChange(zoneID string) *route53.Change {
+	changes := f.changes[zoneID]
+	if len(changes) == 0 {
+		return nil
+	}
+	return changes[len(changes)-1]
+}
@@ -0,0 +1,177 @@
+package aws
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	iov1 "github.com/openshift/api/operatoringress/v1"
+	"github.com/openshift/cluster-ingress-operator/pkg/dns"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/route53"
+
+	configv1 "github.com/openshift/api/config/v1"
+)
+
+// GetOwner returns the owner that the companion TXT record of the given record
+// specifies, or the empty string if there is no companion TXT record.
+func (m *Provider) GetOwner(ctx context.Context, record *iov1.DNSRecord, zone configv1.DNSZone) (string, error) {
+	zoneID, set, err := m.getOwnershipRecordSet(ctx, record, zone)
+	if err != nil || set == nil {
+		return "", err
+	}
+	for _, rr := range set.ResourceRecords {
+		if owner, ok := dns.ParseOwnershipRecordValue(aws.StringValue(rr.Value)); ok {
+			return owner, nil
+		}
+	}
+	log.Info("companion TXT record has no owner", "zone id", zoneID, "name", aws.StringValue(set.Name))
+	return "", nil
+}
+
+// SetOwner creates or updates the companion TXT record of the given record so
+// that it specifies the given owner.
+func (m *Provider) SetOwner(ctx context.Context, record *iov1.DNSRecord, zone configv1.DNSZone, owner string) error {
+	set := ownershipRecordSet(record, owner)
+	zoneID, err := m.getZoneID(ctx, zone)
+	if err != nil {
+		return fmt.Errorf("failed to find hosted zone for record: %v", err)
+	}
+	if err := m.changeOwnershipRecordSet(ctx, zoneID, route53.ChangeActionUpsert, set); err != nil {
+		return err
+	}
+	log.Info("upserted companion TXT record", "zone id", zoneID, "name", aws.StringValue(set.Name), "owner", owner)
+	return nil
+}
+
+// DeleteOwner deletes the companion TXT record of the given record, if it
+// exists.
+func (m *Provider) DeleteOwner(ctx context.Context, record *iov1.DNSRecord, zone configv1.DNSZone) error {
+	zoneID, set, err := m.getOwnershipRecordSet(ctx, record, zone)
+	if err != nil || set == nil {
+		return err
+	}
+	if err := m.changeOwnershipRecordSet(ctx, zoneID, route53.ChangeActionDelete, set); err != nil {
+		return err
+	}
+	log.Info("deleted companion TXT record", "zone id", zoneID, "name", aws.StringValue(set.Name))
+	return nil
+}
+
+// RecordExists returns a Boolean value indicating whether the given zone has a
+// record set with the name of the given record that publishing the record would
+// overwrite, namely one with the same type, or delete, namely one with a
+// conflicting type.
+func (m *Provider) RecordExists(ctx context.Context, record *iov1.DNSRecord, zone configv1.DNSZone) (bool, error) {
+	zoneID, err := m.getZoneID(ctx, zone)
+	if err != nil {
+		return false, fmt.Errorf("failed to find hosted zone for record: %v", err)
+	}
+	recordType := m.recordSetType(record)
+	input := &route53.ListResourceRecordSetsInput{
+		HostedZoneId:    aws.String(zoneID),
+		StartRecordName: aws.String(record.Spec.DNSName),
+	}
+	resp, err := m.route53.ListResourceRecordSetsWithContext(ctx, input)
+	if err != nil {
+		return false, fmt.Errorf("failed to list resource record sets in zone %s: %v", zoneID, err)
+	}
+	for _, set := range resp.ResourceRecordSets {
+		if !recordNamesEqual(aws.StringValue(set.Name), record.Spec.DNSName) {
+			break
+		}
+		currentType := aws.StringValue(set.Type)
+		if dns.RecordTypesConflict(currentType, recordType) || currentType == recordType {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// recordSetType returns the type of the record set as which the given record is
+// published.  A CNAME record is published as an alias A record except in
+// GovCloud.
+func (m *Provider) recordSetType(record *iov1.DNSRecord) string {
+	if record.Spec.RecordType == iov1.CNAMERecordType && !m.govCloud {
+		return route53.RRTypeA
+	}
+	return string(record.Spec.RecordType)
+}
+
+// ownershipRecordSet returns the companion TXT record set of the given record
+// that specifies the given owner.
+func ownershipRecordSet(record *iov1.DNSRecord, owner string) *route53.ResourceRecordSet {
+	return &route53.ResourceRecordSet{
+		Name: aws.String(dns.OwnershipRecordName(record)),
+		Type: aws.String(route53.RRTypeTxt),
+		TTL:  aws.Int64(record.Spec.RecordTTL),
+		ResourceRecords: []*route53.ResourceRecord{{
+			Value: aws.String(fmt.Sprintf("%q", dns.OwnershipRecordValue(owner))),
+		}},
+	}
+}
+
+// addOwnershipChange adds to the given prepared upsert change the upsert of the
+// companion TXT record set of the change's record that specifies the given
+// owner.
+func (m *Provider) addOwnershipChange(prepared *preparedChange, owner string) {
+	prepared.ownership = &route53.Change{
+		Action:            aws.String(route53.ChangeActionUpsert),
+		ResourceRecordSet: ownershipRecordSet(prepared.record, owner),
+	}
+}
+
+// getOwnershipRecordSet returns the ID of the given zone and the current
+// companion TXT record set of the given record in that zone, or nil if the
+// record has no companion TXT record.
+func (m *Provider) getOwnershipRecordSet(ctx context.Context, record *iov1.DNSRecord, zone configv1.DNSZone) (string, *route53.ResourceRecordSet, error) {
+	zoneID, err := m.getZoneID(ctx, zone)
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to find hosted zone for record: %v", err)
+	}
+	name := dns.OwnershipRecordName(record)
+	input := &route53.ListResourceRecordSetsInput{
+		HostedZoneId:    aws.String(zoneID),
+		StartRecordName: aws.String(name),
+		StartRecordType: aws.String(route53.RRTypeTxt),
+	}
+	resp, err := m.route53.ListResourceRecordSetsWithContext(ctx, input)
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to list resource record sets in zone %s: %v", zoneID, err)
+	}
+	// The list starts with the companion TXT record set if it exists.
+	if sets := resp.ResourceRecordSets; len(sets) > 0 && recordNamesEqual(aws.StringValue(sets[0].Name), name) && aws.StringValue(sets[0].Type) == route53.RRTypeTxt {
+		return zoneID, sets[0], nil
+	}
+	return zoneID, nil, nil
+}
+
+// changeOwnershipRecordSet performs the given action on the given companion
+// TXT record set in the given zone.
+func (m *Provider) changeOwnershipRecordSet(ctx context.Context, zoneID, action string, set *route53.ResourceRecordSet) error {
+	input := &route53.ChangeResourceRecordSetsInput{
+		HostedZoneId: aws.String(zoneID),
+		ChangeBatch: &route53.ChangeBatch{
+			Changes: []*route53.Change{{
+				Action:            aws.String(action),
+				ResourceRecordSet: set,
+			}},
+		},
+	}
+	if _, err := m.route53.ChangeResourceRecordSetsWithContext(ctx, input); err != nil {
+		return fmt.Errorf("couldn't update companion TXT record in zone %s: %v", zoneID, err)
+	}
+	return nil
+}
+
+// recordNamesEqual returns a Boolean value indicating whether the given DNS
+// names are equal, ignoring case and any trailing dot.
+func recordNamesEqual(a, b string) bool {
+	// Route 53 returns the "*" of a wildcard name as the escape sequence
+	// "\052".
+	normalize := func(name string) string {
+		return strings.TrimSuffix(strings.ReplaceAll(name, `\052`, "*"), ".")
+	}
+	return strings.EqualFold(normalize(a), normalize(b))
+}
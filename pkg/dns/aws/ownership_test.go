@@ -0,0 +1,169 @@
+package aws
+
+import (
+	"context"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/route53"
+	"github.com/stretchr/testify/assert"
+
+	configv1 "github.com/openshift/api/config/v1"
+	iov1 "github.com/openshift/api/operatoringress/v1"
+	"github.com/openshift/cluster-ingress-operator/pkg/dns"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// TestOwnershipRegistry verifies that the provider stores the owner of a
+// record in a companion TXT record, that the companion TXT records of
+// different records are independent, and that deleting the owner deletes the
+// companion TXT record.
+func TestOwnershipRegistry(t *testing.T) {
+	fake := newFakeRoute53()
+	p := &Provider{route53: fake, idsToTags: map[string]map[string]string{}}
+	zone := configv1.DNSZone{ID: "Z1"}
+	newRecord := func(name string) *iov1.DNSRecord {
+		return &iov1.DNSRecord{
+			Spec: iov1.DNSRecordSpec{
+				DNSName:    name,
+				RecordType: iov1.ARecordType,
+				Targets:    []string{"192.0.2.1"},
+				RecordTTL:  30,
+			},
+		}
+	}
+	recordA, recordB := newRecord("*.apps.example.com."), newRecord("*.other.example.com.")
+
+	owner, err := p.GetOwner(context.Background(), recordA, zone)
+	assert.NoError(t, err)
+	assert.Empty(t, owner)
+
+	assert.NoError(t, p.SetOwner(context.Background(), recordA, zone, "infra-id=a,ingresscontroller=default"))
+	assert.NoError(t, p.SetOwner(context.Background(), recordB, zone, "infra-id=b,ingresscontroller=default"))
+	set := fake.lastChange("Z1").ResourceRecordSet
+	assert.Equal(t, "_ingress-owner-a.other.example.com.", aws.StringValue(set.Name))
+	assert.Equal(t, route53.RRTypeTxt, aws.StringValue(set.Type))
+	assert.Equal(t, `"heritage=openshift-ingress-operator,infra-id=b,ingresscontroller=default"`, aws.StringValue(set.ResourceRecords[0].Value))
+
+	owner, err = p.GetOwner(context.Background(), recordA, zone)
+	assert.NoError(t, err)
+	assert.Equal(t, "infra-id=a,ingresscontroller=default", owner)
+	owner, err = p.GetOwner(context.Background(), recordB, zone)
+	assert.NoError(t, err)
+	assert.Equal(t, "infra-id=b,ingresscontroller=default", owner)
+
+	assert.NoError(t, p.DeleteOwner(context.Background(), recordA, zone))
+	assert.NoError(t, p.DeleteOwner(context.Background(), recordA, zone))
+	owner, err = p.GetOwner(context.Background(), recordA, zone)
+	assert.NoError(t, err)
+	assert.Empty(t, owner)
+	owner, err = p.GetOwner(context.Background(), recordB, zone)
+	assert.NoError(t, err)
+	assert.Equal(t, "infra-id=b,ingresscontroller=default", owner)
+}
+
+// TestEnsureWithOwner verifies that the provider upserts a record and its
+// companion TXT record in a single change batch, both when it publishes the
+// record directly and when it publishes the record in a batch of changes.
+func TestEnsureWithOwner(t *testing.T) {
+	fake := newFakeRoute53()
+	p := &Provider{route53: fake, idsToTags: map[string]map[string]string{}}
+	zone := configv1.DNSZone{ID: "Z1"}
+	newRecord := func(name string) *iov1.DNSRecord {
+		return &iov1.DNSRecord{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Spec: iov1.DNSRecordSpec{
+				DNSName:    name + ".example.com.",
+				RecordType: iov1.ARecordType,
+				Targets:    []string{"192.0.2.1"},
+				RecordTTL:  30,
+			},
+		}
+	}
+
+	record := newRecord("a")
+	assert.NoError(t, p.EnsureWithOwner(context.Background(), record, zone, "infra-id=a,ingresscontroller=default"))
+	assert.Equal(t, 1, fake.calls)
+	if assert.Len(t, fake.changes["Z1"], 2) {
+		assert.Equal(t, "a.example.com.", aws.StringValue(fake.changes["Z1"][0].ResourceRecordSet.Name))
+		assert.Equal(t, "_ingress-owner-a.a.example.com.", aws.StringValue(fake.changes["Z1"][1].ResourceRecordSet.Name))
+	}
+	owner, err := p.GetOwner(context.Background(), record, zone)
+	assert.NoError(t, err)
+	assert.Equal(t, "infra-id=a,ingresscontroller=default", owner)
+
+	changes := []dns.Change{
+		{Action: dns.EnsureAction, Record: newRecord("b"), Owner: "infra-id=a,ingresscontroller=b"},
+		{Action: dns.ReplaceAction, Record: newRecord("c"), Owner: "infra-id=a,ingresscontroller=c"},
+	}
+	for _, err := range p.ApplyBatch(context.Background(), zone, changes) {
+		assert.NoError(t, err)
+	}
+	assert.Equal(t, 2, fake.calls)
+	for _, change := range changes {
+		owner, err := p.GetOwner(context.Background(), change.Record, zone)
+		assert.NoError(t, err)
+		assert.Equal(t, change.Owner, owner)
+	}
+}
+
+// TestRecordExists verifies that RecordExists reports record sets that
+// publishing a record would overwrite or delete and ignores companion TXT
+// records.
+func TestRecordExists(t *testing.T) {
+	const name = "www.example.com."
+	testCases := []struct {
+		description string
+		recordType  iov1.DNSRecordType
+		sets        []*route53.ResourceRecordSet
+		expected    bool
+	}{
+		{
+			description: "no record sets",
+			recordType:  iov1.ARecordType,
+		},
+		{
+			description: "record set of the same type",
+			recordType:  iov1.ARecordType,
+			sets:        []*route53.ResourceRecordSet{{Name: aws.String(name), Type: aws.String("A")}},
+			expected:    true,
+		},
+		{
+			description: "alias record set for a CNAME record",
+			recordType:  iov1.CNAMERecordType,
+			sets:        []*route53.ResourceRecordSet{{Name: aws.String(name), Type: aws.String("A")}},
+			expected:    true,
+		},
+		{
+			description: "conflicting CNAME record set",
+			recordType:  iov1.ARecordType,
+			sets:        []*route53.ResourceRecordSet{{Name: aws.String(name), Type: aws.String("CNAME")}},
+			expected:    true,
+		},
+		{
+			description: "record set of another type that can coexist",
+			recordType:  iov1.ARecordType,
+			sets:        []*route53.ResourceRecordSet{{Name: aws.String(name), Type: aws.String("AAAA")}},
+		},
+		{
+			description: "companion TXT record set only",
+			recordType:  iov1.ARecordType,
+			sets:        []*route53.ResourceRecordSet{{Name: aws.String("_ingress-owner-a.www.example.com."), Type: aws.String("TXT")}},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			fake := newFakeRoute53()
+			fake.sets["Z1"] = tc.sets
+			p := &Provider{route53: fake, idsToTags: map[string]map[string]string{}}
+			record := &iov1.DNSRecord{
+				Spec: iov1.DNSRecordSpec{DNSName: name, RecordType: tc.recordType},
+			}
+			exists, err := p.RecordExists(context.Background(), record, configv1.DNSZone{ID: "Z1"})
+			assert.NoError(t, err)
+			assert.Equal(t, tc.expected, exists)
+		})
+	}
+}
@@ -0,0 +1,78 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/route53"
+
+	"golang.org/x/time/rate"
+)
+
+// SetRateLimiter makes the provider wait for the given limiter before each call
+// to the Route 53, tagging, and load balancer APIs, so that the lookups that
+// prepare a change and the changes that are applied individually when a batch
+// fails are rate limited along with the batches themselves.
+func (m *Provider) SetRateLimiter(limiter *rate.Limiter) {
+	m.limiter = limiter
+	m.route53 = &rateLimitedRoute53{route53Client: m.route53, limiter: limiter}
+}
+
+// wait blocks until the provider's rate limiter, if any, allows an API call or
+// the given context is done.
+func (m *Provider) wait(ctx context.Context) error {
+	if m.limiter == nil {
+		return nil
+	}
+	return m.limiter.Wait(ctx)
+}
+
+// rateLimitedRoute53 is a route53Client that waits for a rate limiter before
+// each request that the wrapped client makes, including each page of a
+// paginated call.  ListHostedZones is called only when the provider is created
+// and is not rate limited.
+type rateLimitedRoute53 struct {
+	route53Client
+
+	limiter *rate.Limiter
+}
+
+func (c *rateLimitedRoute53) ListHostedZonesPagesWithContext(ctx aws.Context, input *route53.ListHostedZonesInput, fn func(*route53.ListHostedZonesOutput, bool) bool, opts ...request.Option) error {
+	if err := c.limiter.Wait(ctx); err != nil {
+		return err
+	}
+	var waitErr error
+	err := c.route53Client.ListHostedZonesPagesWithContext(ctx, input, func(output *route53.ListHostedZonesOutput, lastPage bool) bool {
+		if !fn(output, lastPage) || lastPage {
+			return false
+		}
+		waitErr = c.limiter.Wait(ctx)
+		return waitErr == nil
+	}, opts...)
+	if err != nil {
+		return err
+	}
+	return waitErr
+}
+
+func (c *rateLimitedRoute53) ListTagsForResourcesWithContext(ctx aws.Context, input *route53.ListTagsForResourcesInput, opts ...request.Option) (*route53.ListTagsForResourcesOutput, error) {
+	if err := c.limiter.Wait(ctx); err != nil {
+		return nil, err
+	}
+	return c.route53Client.ListTagsForResourcesWithContext(ctx, input, opts...)
+}
+
+func (c *rateLimitedRoute53) ListResourceRecordSetsWithContext(ctx aws.Context, input *route53.ListResourceRecordSetsInput, opts ...request.Option) (*route53.ListResourceRecordSetsOutput, error) {
+	if err := c.limiter.Wait(ctx); err != nil {
+		return nil, err
+	}
+	return c.route53Client.ListResourceRecordSetsWithContext(ctx, input, opts...)
+}
+
+func (c *rateLimitedRoute53) ChangeResourceRecordSetsWithContext(ctx aws.Context, input *route53.ChangeResourceRecordSetsInput, opts ...request.Option) (*route53.ChangeResourceRecordSetsOutput, error) {
+	if err := c.limiter.Wait(ctx); err != nil {
+		return nil, err
+	}
+	return c.route53Client.ChangeResourceRecordSetsWithContext(ctx, input, opts...)
+}
@@ -2,9 +2,12 @@ package client
 
 import (
 	"context"
+	"net/http"
+	"strings"
 
 	"github.com/Azure/azure-sdk-for-go/profiles/2018-03-01/dns/mgmt/dns"
 	privatedns "github.com/Azure/azure-sdk-for-go/services/privatedns/mgmt/2018-09-01/privatedns"
+	"github.com/Azure/go-autorest/autorest"
 	"github.com/Azure/go-autorest/autorest/azure"
 	"github.com/pkg/errors"
 )
@@ -12,8 +15,27 @@ import (
 type DNSClient interface {
 	Put(ctx context.Context, zone Zone, arec ARecord) error
 	Delete(ctx context.Context, zone Zone, arec ARecord) error
+	// Get returns the record with the given name and type, or nil if the
+	// record does not exist.
+	Get(ctx context.Context, zone Zone, name string, recordType RecordType) (*ARecord, error)
+	// LookupPrivateZone returns the Azure Private DNS zone in the
+	// subscription that has all the given tags.  It returns an error if
+	// no zone or more than one zone has the tags.
+	LookupPrivateZone(ctx context.Context, tags map[string]string) (*Zone, error)
 }
 
+// RecordType is the type of an ARecord: "A", "CNAME", or "TXT".
+type RecordType string
+
+const (
+	// RecordTypeA is the type of a record with IPv4 addresses.
+	RecordTypeA RecordType = "A"
+	// RecordTypeCNAME is the type of a record with a canonical name.
+	RecordTypeCNAME RecordType = "CNAME"
+	// RecordTypeTXT is the type of a record with text values.
+	RecordTypeTXT RecordType = "TXT"
+)
+
 type Config struct {
 	Environment    azure.Environment
 	SubscriptionID string
@@ -22,13 +44,17 @@ type Config struct {
 	TenantID       string
 }
 
-// ARecord is a DNS A record.
+// ARecord is a DNS record: an A record, a CNAME record, or a TXT record.
 type ARecord struct {
 	// Name is the record name.
 	Name string
 
-	// Address is the IPv4 address of the A record.
-	Address string
+	// Type is the record type.  If empty, RecordTypeA is assumed.
+	Type RecordType
+
+	// Addresses are the IPv4 addresses of an A record, the canonical
+	// name of a CNAME record, or the values of a TXT record.
+	Addresses []string
 
 	//TTL is the Time To Live property of the A record
 	TTL int64
@@ -38,7 +64,9 @@ type ARecord struct {
 }
 
 type dnsClient struct {
-	recordSetClient, privateRecordSetClient DNSClient
+	recordSetClient        *recordSetClient
+	privateRecordSetClient *privateRecordSetClient
+	privateZonesClient     privatedns.PrivateZonesClient
 }
 
 // New returns an authenticated DNSClient
@@ -53,14 +81,19 @@ func New(config Config, userAgentExtension string) (DNSClient, error) {
 		return nil, errors.Wrap(err, "failed to create privateRecordSetClient")
 	}
 
-	return &dnsClient{recordSetClient: rsc, privateRecordSetClient: prsc}, nil
+	pzc, err := newPrivateZonesClient(config, userAgentExtension)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create privateZonesClient")
+	}
+
+	return &dnsClient{recordSetClient: rsc, privateRecordSetClient: prsc, privateZonesClient: pzc}, nil
 }
 
 func (c *dnsClient) Put(ctx context.Context, zone Zone, arec ARecord) error {
-	switch zone.Provider {
-	case "Microsoft.Network/privateDnsZones":
+	switch {
+	case zone.IsPrivate():
 		return c.privateRecordSetClient.Put(ctx, zone, arec)
-	case "Microsoft.Network/dnszones":
+	case zone.IsPublic():
 		return c.recordSetClient.Put(ctx, zone, arec)
 	default:
 		return errors.Errorf("unsupported Zone provider %s", zone.Provider)
@@ -68,16 +101,68 @@ func (c *dnsClient) Put(ctx context.Context, zone Zone, arec ARecord) error {
 }
 
 func (c *dnsClient) Delete(ctx context.Context, zone Zone, arec ARecord) error {
-	switch zone.Provider {
-	case "Microsoft.Network/privateDnsZones":
+	switch {
+	case zone.IsPrivate():
 		return c.privateRecordSetClient.Delete(ctx, zone, arec)
-	case "Microsoft.Network/dnszones":
+	case zone.IsPublic():
 		return c.recordSetClient.Delete(ctx, zone, arec)
 	default:
 		return errors.Errorf("unsupported Zone provider %s", zone.Provider)
 	}
 }
 
+func (c *dnsClient) Get(ctx context.Context, zone Zone, name string, recordType RecordType) (*ARecord, error) {
+	switch {
+	case zone.IsPrivate():
+		return c.privateRecordSetClient.Get(ctx, zone, name, recordType)
+	case zone.IsPublic():
+		return c.recordSetClient.Get(ctx, zone, name, recordType)
+	default:
+		return nil, errors.Errorf("unsupported Zone provider %s", zone.Provider)
+	}
+}
+
+func (c *dnsClient) LookupPrivateZone(ctx context.Context, tags map[string]string) (*Zone, error) {
+	iter, err := c.privateZonesClient.ListComplete(ctx, nil)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to list private dns zones")
+	}
+	var zones []*Zone
+	for iter.NotDone() {
+		pz := iter.Value()
+		if pz.ID != nil && zoneHasTags(pz.Tags, tags) {
+			zone, err := ParseZone(*pz.ID)
+			if err != nil {
+				return nil, errors.Wrapf(err, "failed to parse private dns zone id %s", *pz.ID)
+			}
+			zones = append(zones, zone)
+		}
+		if err := iter.NextWithContext(ctx); err != nil {
+			return nil, errors.Wrap(err, "failed to list private dns zones")
+		}
+	}
+	switch len(zones) {
+	case 0:
+		return nil, errors.Errorf("found no private dns zone with tags %v", tags)
+	case 1:
+		return zones[0], nil
+	default:
+		return nil, errors.Errorf("found %d private dns zones with tags %v", len(zones), tags)
+	}
+}
+
+// zoneHasTags returns a Boolean value indicating whether the given zone tags
+// include all the given tags.
+func zoneHasTags(zoneTags map[string]*string, tags map[string]string) bool {
+	for k, v := range tags {
+		zv, ok := zoneTags[k]
+		if !ok || zv == nil || *zv != v {
+			return false
+		}
+	}
+	return true
+}
+
 type recordSetClient struct {
 	client dns.RecordSetsClient
 }
@@ -98,35 +183,102 @@ func (c *recordSetClient) Put(ctx context.Context, zone Zone, arec ARecord) erro
 	rs := dns.RecordSet{
 		RecordSetProperties: &dns.RecordSetProperties{
 			TTL: &arec.TTL,
-			ARecords: &[]dns.ARecord{
-				{Ipv4Address: &arec.Address},
-			},
 		},
 	}
+	recordType := publicRecordType(arec.Type)
+	switch recordType {
+	case dns.CNAME:
+		if len(arec.Addresses) != 0 {
+			rs.RecordSetProperties.CnameRecord = &dns.CnameRecord{Cname: &arec.Addresses[0]}
+		}
+	case dns.TXT:
+		records := make([]dns.TxtRecord, len(arec.Addresses))
+		for i := range arec.Addresses {
+			records[i] = dns.TxtRecord{Value: &[]string{arec.Addresses[i]}}
+		}
+		rs.RecordSetProperties.TxtRecords = &records
+	default:
+		records := make([]dns.ARecord, len(arec.Addresses))
+		for i := range arec.Addresses {
+			records[i] = dns.ARecord{Ipv4Address: &arec.Addresses[i]}
+		}
+		rs.RecordSetProperties.ARecords = &records
+	}
 	if arec.Label != "" {
 		ownedValue := "owned"
 		rs.RecordSetProperties.Metadata = map[string]*string{arec.Label: &ownedValue}
 	}
-	_, err := c.client.CreateOrUpdate(ctx, zone.ResourceGroup, zone.Name, arec.Name, dns.A, rs, "", "")
+	_, err := c.client.CreateOrUpdate(ctx, zone.ResourceGroup, zone.Name, arec.Name, recordType, rs, "", "")
 	if err != nil {
-		return errors.Wrapf(err, "failed to update dns a record: %s.%s", arec.Name, zone.Name)
+		return errors.Wrapf(err, "failed to update dns %s record: %s.%s", recordType, arec.Name, zone.Name)
 	}
 	return nil
 }
 
 func (c *recordSetClient) Delete(ctx context.Context, zone Zone, arec ARecord) error {
-	_, err := c.client.Get(ctx, zone.ResourceGroup, zone.Name, arec.Name, dns.A)
+	recordType := publicRecordType(arec.Type)
+	_, err := c.client.Get(ctx, zone.ResourceGroup, zone.Name, arec.Name, recordType)
 	if err != nil {
-		// TODO: How do we interpret this as a notfound error?
-		return nil
+		if isNotFound(err) {
+			return nil
+		}
+		return errors.Wrapf(err, "failed to get dns %s record: %s.%s", recordType, arec.Name, zone.Name)
 	}
-	_, err = c.client.Delete(ctx, zone.ResourceGroup, zone.Name, arec.Name, dns.A, "")
+	_, err = c.client.Delete(ctx, zone.ResourceGroup, zone.Name, arec.Name, recordType, "")
 	if err != nil {
-		return errors.Wrapf(err, "failed to delete dns a record: %s.%s", arec.Name, zone.Name)
+		return errors.Wrapf(err, "failed to delete dns %s record: %s.%s", recordType, arec.Name, zone.Name)
 	}
 	return nil
 }
 
+func (c *recordSetClient) Get(ctx context.Context, zone Zone, name string, recordType RecordType) (*ARecord, error) {
+	rs, err := c.client.Get(ctx, zone.ResourceGroup, zone.Name, name, publicRecordType(recordType))
+	if err != nil {
+		if isNotFound(err) {
+			return nil, nil
+		}
+		return nil, errors.Wrapf(err, "failed to get dns %s record: %s.%s", recordType, name, zone.Name)
+	}
+	arec := &ARecord{Name: name, Type: recordType}
+	if props := rs.RecordSetProperties; props != nil {
+		if props.TTL != nil {
+			arec.TTL = *props.TTL
+		}
+		if props.ARecords != nil {
+			for _, r := range *props.ARecords {
+				if r.Ipv4Address != nil {
+					arec.Addresses = append(arec.Addresses, *r.Ipv4Address)
+				}
+			}
+		}
+		if props.CnameRecord != nil && props.CnameRecord.Cname != nil {
+			arec.Addresses = append(arec.Addresses, *props.CnameRecord.Cname)
+		}
+		if props.TxtRecords != nil {
+			for _, r := range *props.TxtRecords {
+				if r.Value != nil {
+					arec.Addresses = append(arec.Addresses, strings.Join(*r.Value, ""))
+				}
+			}
+		}
+		arec.Label = ownedLabel(props.Metadata)
+	}
+	return arec, nil
+}
+
+// publicRecordType returns the public Azure DNS record type for the given
+// record type.
+func publicRecordType(recordType RecordType) dns.RecordType {
+	switch recordType {
+	case RecordTypeCNAME:
+		return dns.CNAME
+	case RecordTypeTXT:
+		return dns.TXT
+	default:
+		return dns.A
+	}
+}
+
 type privateRecordSetClient struct {
 	client privatedns.RecordSetsClient
 }
@@ -143,31 +295,135 @@ func newPrivateRecordSetClient(config Config, userAgentExtension string) (*priva
 	return &privateRecordSetClient{client: prc}, nil
 }
 
+func newPrivateZonesClient(config Config, userAgentExtension string) (privatedns.PrivateZonesClient, error) {
+	authorizer, err := getAuthorizerForResource(config)
+	if err != nil {
+		return privatedns.PrivateZonesClient{}, err
+	}
+
+	pzc := privatedns.NewPrivateZonesClientWithBaseURI(config.Environment.ResourceManagerEndpoint, config.SubscriptionID)
+	pzc.AddToUserAgent(userAgentExtension)
+	pzc.Authorizer = authorizer
+	return pzc, nil
+}
+
 func (c *privateRecordSetClient) Put(ctx context.Context, zone Zone, arec ARecord) error {
 	rs := privatedns.RecordSet{
 		RecordSetProperties: &privatedns.RecordSetProperties{
 			TTL: &arec.TTL,
-			ARecords: &[]privatedns.ARecord{
-				{Ipv4Address: &arec.Address},
-			},
 		},
 	}
-	_, err := c.client.CreateOrUpdate(ctx, zone.ResourceGroup, zone.Name, privatedns.A, arec.Name, rs, "", "")
+	recordType := privateRecordType(arec.Type)
+	switch recordType {
+	case privatedns.CNAME:
+		if len(arec.Addresses) != 0 {
+			rs.RecordSetProperties.CnameRecord = &privatedns.CnameRecord{Cname: &arec.Addresses[0]}
+		}
+	case privatedns.TXT:
+		records := make([]privatedns.TxtRecord, len(arec.Addresses))
+		for i := range arec.Addresses {
+			records[i] = privatedns.TxtRecord{Value: &[]string{arec.Addresses[i]}}
+		}
+		rs.RecordSetProperties.TxtRecords = &records
+	default:
+		records := make([]privatedns.ARecord, len(arec.Addresses))
+		for i := range arec.Addresses {
+			records[i] = privatedns.ARecord{Ipv4Address: &arec.Addresses[i]}
+		}
+		rs.RecordSetProperties.ARecords = &records
+	}
+	if arec.Label != "" {
+		ownedValue := "owned"
+		rs.RecordSetProperties.Metadata = map[string]*string{arec.Label: &ownedValue}
+	}
+	_, err := c.client.CreateOrUpdate(ctx, zone.ResourceGroup, zone.Name, recordType, arec.Name, rs, "", "")
 	if err != nil {
-		return errors.Wrapf(err, "failed to update dns a record: %s.%s", arec.Name, zone.Name)
+		return errors.Wrapf(err, "failed to update dns %s record: %s.%s", recordType, arec.Name, zone.Name)
 	}
 	return nil
 }
 
 func (c *privateRecordSetClient) Delete(ctx context.Context, zone Zone, arec ARecord) error {
-	_, err := c.client.Get(ctx, zone.ResourceGroup, zone.Name, privatedns.A, arec.Name)
+	recordType := privateRecordType(arec.Type)
+	_, err := c.client.Get(ctx, zone.ResourceGroup, zone.Name, recordType, arec.Name)
 	if err != nil {
-		// TODO: How do we interpret this as a notfound error?
-		return nil
+		if isNotFound(err) {
+			return nil
+		}
+		return errors.Wrapf(err, "failed to get dns %s record: %s.%s", recordType, arec.Name, zone.Name)
 	}
-	_, err = c.client.Delete(ctx, zone.ResourceGroup, zone.Name, privatedns.A, arec.Name, "")
+	_, err = c.client.Delete(ctx, zone.ResourceGroup, zone.Name, recordType, arec.Name, "")
 	if err != nil {
-		return errors.Wrapf(err, "failed to delete dns a record: %s.%s", arec.Name, zone.Name)
+		return errors.Wrapf(err, "failed to delete dns %s record: %s.%s", recordType, arec.Name, zone.Name)
 	}
 	return nil
 }
+
+func (c *privateRecordSetClient) Get(ctx context.Context, zone Zone, name string, recordType RecordType) (*ARecord, error) {
+	rs, err := c.client.Get(ctx, zone.ResourceGroup, zone.Name, privateRecordType(recordType), name)
+	if err != nil {
+		if isNotFound(err) {
+			return nil, nil
+		}
+		return nil, errors.Wrapf(err, "failed to get dns %s record: %s.%s", recordType, name, zone.Name)
+	}
+	arec := &ARecord{Name: name, Type: recordType}
+	if props := rs.RecordSetProperties; props != nil {
+		if props.TTL != nil {
+			arec.TTL = *props.TTL
+		}
+		if props.ARecords != nil {
+			for _, r := range *props.ARecords {
+				if r.Ipv4Address != nil {
+					arec.Addresses = append(arec.Addresses, *r.Ipv4Address)
+				}
+			}
+		}
+		if props.CnameRecord != nil && props.CnameRecord.Cname != nil {
+			arec.Addresses = append(arec.Addresses, *props.CnameRecord.Cname)
+		}
+		if props.TxtRecords != nil {
+			for _, r := range *props.TxtRecords {
+				if r.Value != nil {
+					arec.Addresses = append(arec.Addresses, strings.Join(*r.Value, ""))
+				}
+			}
+		}
+		arec.Label = ownedLabel(props.Metadata)
+	}
+	return arec, nil
+}
+
+// privateRecordType returns the Azure Private DNS record type for the given
+// record type.
+func privateRecordType(recordType RecordType) privatedns.RecordType {
+	switch recordType {
+	case RecordTypeCNAME:
+		return privatedns.CNAME
+	case RecordTypeTXT:
+		return privatedns.TXT
+	default:
+		return privatedns.A
+	}
+}
+
+// ownedLabel returns the metadata label that marks a record as owned, or the
+// empty string if the given metadata has no such label.
+func ownedLabel(metadata map[string]*string) string {
+	for k, v := range metadata {
+		if v != nil && *v == "owned" {
+			return k
+		}
+	}
+	return ""
+}
+
+// isNotFound returns a Boolean value indicating whether the given error means
+// that the requested resource does not exist.
+func isNotFound(err error) bool {
+	var derr autorest.DetailedError
+	if errors.As(err, &derr) {
+		return derr.StatusCode == http.StatusNotFound
+	}
+	return false
+}
@@ -2,27 +2,128 @@ package client
 
 import (
 	"context"
+	"fmt"
+	"sort"
 )
 
+// FakeDNSClient is a DNSClient that stores records in memory and records the
+// last call for each record.
 type FakeDNSClient struct {
-	fakeARM map[string]string
+	fakeARM      map[string]string
+	records      map[fakeRecordKey]ARecord
+	privateZones []fakePrivateZone
+	lookups      int
+	failWrites   RecordType
+}
+
+// fakeRecordKey identifies a record in a FakeDNSClient.
+type fakeRecordKey struct {
+	resourceGroup, zone, name string
+	recordType                RecordType
+}
+
+type fakePrivateZone struct {
+	id   string
+	tags map[string]string
 }
 
 func NewFake(config Config) (*FakeDNSClient, error) {
-	return &FakeDNSClient{fakeARM: map[string]string{}}, nil
+	return &FakeDNSClient{fakeARM: map[string]string{}, records: map[fakeRecordKey]ARecord{}}, nil
 }
 
+// Put stores the given record.  Like Azure DNS, it rejects a CNAME record with
+// the same name as a record of another type, and vice versa.
 func (c *FakeDNSClient) Put(ctx context.Context, zone Zone, arec ARecord) error {
 	c.fakeARM[zone.ResourceGroup+zone.Name+arec.Name] = "PUT"
+	recordType := normalizeRecordType(arec.Type)
+	if len(c.failWrites) != 0 && recordType == c.failWrites {
+		return fmt.Errorf("failed to update dns %s record: %s.%s: injected failure", recordType, arec.Name, zone.Name)
+	}
+	for key := range c.records {
+		if key.resourceGroup != zone.ResourceGroup || key.zone != zone.Name || key.name != arec.Name || key.recordType == recordType {
+			continue
+		}
+		if key.recordType == RecordTypeCNAME || recordType == RecordTypeCNAME {
+			return fmt.Errorf("failed to update dns %s record: %s.%s: conflicts with %s record", recordType, arec.Name, zone.Name, key.recordType)
+		}
+	}
+	arec.Type = recordType
+	arec.Addresses = append([]string(nil), arec.Addresses...)
+	c.records[fakeRecordKey{zone.ResourceGroup, zone.Name, arec.Name, recordType}] = arec
 	return nil
 }
 
 func (c *FakeDNSClient) Delete(ctx context.Context, zone Zone, arec ARecord) error {
 	c.fakeARM[zone.ResourceGroup+zone.Name+arec.Name] = "DELETE"
+	delete(c.records, fakeRecordKey{zone.ResourceGroup, zone.Name, arec.Name, normalizeRecordType(arec.Type)})
 	return nil
 }
 
+func (c *FakeDNSClient) Get(ctx context.Context, zone Zone, name string, recordType RecordType) (*ARecord, error) {
+	arec, ok := c.records[fakeRecordKey{zone.ResourceGroup, zone.Name, name, normalizeRecordType(recordType)}]
+	if !ok {
+		return nil, nil
+	}
+	return &arec, nil
+}
+
+func (c *FakeDNSClient) LookupPrivateZone(ctx context.Context, tags map[string]string) (*Zone, error) {
+	c.lookups++
+	for _, pz := range c.privateZones {
+		matches := true
+		for k, v := range tags {
+			if pz.tags[k] != v {
+				matches = false
+				break
+			}
+		}
+		if matches {
+			return ParseZone(pz.id)
+		}
+	}
+	return nil, fmt.Errorf("found no private dns zone with tags %v", tags)
+}
+
+// AddPrivateZone adds a private zone with the given ID and tags for
+// LookupPrivateZone to find.
+func (c *FakeDNSClient) AddPrivateZone(id string, tags map[string]string) {
+	c.privateZones = append(c.privateZones, fakePrivateZone{id: id, tags: tags})
+}
+
+// Lookups returns the number of times LookupPrivateZone has been called.
+func (c *FakeDNSClient) Lookups() int {
+	return c.lookups
+}
+
+// FailWrites makes Put fail for records of the given type until FailWrites is
+// called with the empty string.
+func (c *FakeDNSClient) FailWrites(recordType RecordType) {
+	c.failWrites = recordType
+}
+
+// Records returns the stored records with the given name in the given zone,
+// ordered by type.
+func (c *FakeDNSClient) Records(rg, zone, name string) []ARecord {
+	var records []ARecord
+	for key, arec := range c.records {
+		if key.resourceGroup == rg && key.zone == zone && key.name == name {
+			records = append(records, arec)
+		}
+	}
+	sort.Slice(records, func(i, j int) bool { return records[i].Type < records[j].Type })
+	return records
+}
+
 func (c *FakeDNSClient) RecordedCall(rg, zone, rel string) (string, bool) {
 	call, ok := c.fakeARM[rg+zone+rel]
 	return call, ok
 }
+
+// normalizeRecordType returns the given record type, or RecordTypeA if the
+// record type is empty.
+func normalizeRecordType(recordType RecordType) RecordType {
+	if len(recordType) == 0 {
+		return RecordTypeA
+	}
+	return recordType
+}
@@ -6,6 +6,15 @@ import (
 	"strings"
 )
 
+const (
+	// PublicZoneProvider is the resource provider of public Azure DNS
+	// zones.
+	PublicZoneProvider = "Microsoft.Network/dnszones"
+	// PrivateZoneProvider is the resource provider of Azure Private DNS
+	// zones.
+	PrivateZoneProvider = "Microsoft.Network/privateDnsZones"
+)
+
 type Zone struct {
 	SubscriptionID string
 	ResourceGroup  string
@@ -13,6 +22,19 @@ type Zone struct {
 	Name           string
 }
 
+// IsPrivate returns a Boolean value indicating whether the zone is an Azure
+// Private DNS zone.  Azure resource IDs are case-insensitive, so the provider
+// is compared without regard to case.
+func (z Zone) IsPrivate() bool {
+	return strings.EqualFold(z.Provider, PrivateZoneProvider)
+}
+
+// IsPublic returns a Boolean value indicating whether the zone is a public
+// Azure DNS zone.
+func (z Zone) IsPublic() bool {
+	return strings.EqualFold(z.Provider, PublicZoneProvider)
+}
+
 func ParseZone(id string) (*Zone, error) {
 	s := strings.Split(id, "/")
 	if len(s) < 9 {
@@ -7,6 +7,33 @@ import (
 	"github.com/openshift/cluster-ingress-operator/pkg/dns/azure/client"
 )
 
+// TestZoneIsPrivate verifies that IsPrivate and IsPublic recognize the zone
+// providers regardless of case.
+func TestZoneIsPrivate(t *testing.T) {
+	testCases := []struct {
+		id              string
+		private, public bool
+	}{
+		{"/subscriptions/sub/resourceGroups/test-rg/providers/Microsoft.Network/privateDnsZones/dnszone.io", true, false},
+		{"/subscriptions/sub/resourceGroups/test-rg/providers/microsoft.network/privatednszones/dnszone.io", true, false},
+		{"/subscriptions/sub/resourceGroups/test-rg/providers/Microsoft.Network/dnszones/dnszone.io", false, true},
+		{"/subscriptions/sub/resourceGroups/test-rg/providers/Microsoft.Network/dnsZones/dnszone.io", false, true},
+		{"/subscriptions/sub/resourceGroups/test-rg/providers/Microsoft.Compute/disks/disk", false, false},
+	}
+	for _, tc := range testCases {
+		zone, err := client.ParseZone(tc.id)
+		if err != nil {
+			t.Fatalf("failed to parse zone %s: %v", tc.id, err)
+		}
+		if zone.IsPrivate() != tc.private {
+			t.Errorf("%s: expected IsPrivate to return %t", tc.id, tc.private)
+		}
+		if zone.IsPublic() != tc.public {
+			t.Errorf("%s: expected IsPublic to return %t", tc.id, tc.public)
+		}
+	}
+}
+
 func TestFunction(t *testing.T) {
 	var zoneTests = []struct {
 		desc     string
@@ -0,0 +1,69 @@
+package azure_test
+
+import (
+	"strings"
+	"testing"
+
+	configv1 "github.com/openshift/api/config/v1"
+	iov1 "github.com/openshift/api/operatoringress/v1"
+	"github.com/openshift/cluster-ingress-operator/pkg/dns/azure"
+	"github.com/openshift/cluster-ingress-operator/pkg/dns/azure/client"
+	"github.com/openshift/cluster-ingress-operator/pkg/dns/conformance"
+)
+
+// conformanceBackend is a conformance.Backend for a FakeDNSClient.
+type conformanceBackend struct {
+	*client.FakeDNSClient
+	zone client.Zone
+}
+
+func (b *conformanceBackend) Records(dnsName string) []conformance.Record {
+	name := strings.TrimSuffix(strings.TrimSuffix(dnsName, "."), "."+b.zone.Name)
+	var records []conformance.Record
+	for _, arec := range b.FakeDNSClient.Records(b.zone.ResourceGroup, b.zone.Name, name) {
+		records = append(records, conformance.Record{
+			Type:    iov1.DNSRecordType(arec.Type),
+			Targets: arec.Addresses,
+			TTL:     arec.TTL,
+		})
+	}
+	return records
+}
+
+func (b *conformanceBackend) FailWrites(recordType iov1.DNSRecordType) {
+	b.FakeDNSClient.FailWrites(client.RecordType(recordType))
+}
+
+func TestConformance(t *testing.T) {
+	for _, provider := range []string{"dnszones", "privateDnsZones"} {
+		t.Run(provider, func(t *testing.T) {
+			conformance.Run(t, func(t *testing.T) *conformance.Harness {
+				fc, err := client.NewFake(client.Config{})
+				if err != nil {
+					t.Fatalf("failed to create fake client: %v", err)
+				}
+				p, err := azure.NewFakeProvider(azure.Config{InfraID: "test"}, fc)
+				if err != nil {
+					t.Fatalf("failed to create provider: %v", err)
+				}
+				zoneID := "/subscriptions/E540B02D-5CCE-4D47-A13B-EB05A19D696E/resourceGroups/test-rg/providers/Microsoft.Network/" + provider + "/example.com"
+				zone, err := client.ParseZone(zoneID)
+				if err != nil {
+					t.Fatalf("failed to parse zone: %v", err)
+				}
+				return &conformance.Harness{
+					Provider:    p,
+					Backend:     &conformanceBackend{FakeDNSClient: fc, zone: *zone},
+					Zone:        configv1.DNSZone{ID: zoneID},
+					Domain:      "example.com",
+					CNAMETarget: "lb.example.com",
+					InvalidZones: []configv1.DNSZone{
+						{},
+						{ID: "zone"},
+						{ID: "/subscriptions/subscription/resourceGroups/rg"},
+					},
+				}
+			})
+		})
+	}
+}
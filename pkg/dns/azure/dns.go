@@ -3,7 +3,9 @@ package azure
 import (
 	"context"
 	"fmt"
+	"sort"
 	"strings"
+	"sync"
 
 	"github.com/Azure/go-autorest/autorest/azure"
 	"github.com/pkg/errors"
@@ -44,10 +46,18 @@ type provider struct {
 	config       Config
 	client       client.DNSClient
 	clientConfig client.Config
+
+	// lock protects zonesByTags.
+	lock sync.Mutex
+	// zonesByTags caches the private zones that have been looked up by
+	// tags, keyed by the zone's tags.
+	zonesByTags map[string]*client.Zone
 }
 
 // NewProvider creates a new dns.Provider for Azure. It only supports DNSRecords with
-// type A.
+// type A or CNAME.  Records are published to public Azure DNS zones or to Azure
+// Private DNS zones depending on the zone's ID.  A zone that is specified by
+// tags instead of by ID is looked up among the subscription's Private DNS zones.
 func NewProvider(config Config, operatorReleaseVersion string) (dns.Provider, error) {
 	var env azure.Environment
 	var err error
@@ -70,21 +80,22 @@ func NewProvider(config Config, operatorReleaseVersion string) (dns.Provider, er
 	if err != nil {
 		return nil, err
 	}
-	return &provider{config: config, client: c}, nil
+	return &provider{config: config, client: c, zonesByTags: map[string]*client.Zone{}}, nil
 }
 
 func userAgent(operatorReleaseVersion string) string {
 	return fmt.Sprintf("%s/%s", "openshift.io ingress-operator", operatorReleaseVersion)
 }
 
-func (m *provider) Ensure(record *iov1.DNSRecord, zone configv1.DNSZone) error {
-	if record.Spec.RecordType != iov1.ARecordType {
-		return fmt.Errorf("only A record types are supported")
+func (m *provider) Ensure(ctx context.Context, record *iov1.DNSRecord, zone configv1.DNSZone) error {
+	recordType, err := recordTypeFor(record)
+	if err != nil {
+		return err
 	}
 
-	targetZone, err := client.ParseZone(zone.ID)
+	targetZone, err := m.targetZone(ctx, zone)
 	if err != nil {
-		return errors.Wrap(err, "failed to parse zoneID")
+		return err
 	}
 
 	metadataLabel := m.config.InfraID
@@ -93,16 +104,16 @@ func (m *provider) Ensure(record *iov1.DNSRecord, zone configv1.DNSZone) error {
 		return err
 	}
 	ARecord := client.ARecord{
-		Address: record.Spec.Targets[0],
-		Name:    ARecordName,
-		TTL:     record.Spec.RecordTTL,
+		Type:      recordType,
+		Addresses: record.Spec.Targets,
+		Name:      ARecordName,
+		TTL:       record.Spec.RecordTTL,
 	}
 	if metadataLabel != "" {
 		ARecord.Label = fmt.Sprintf("kubernetes.io_cluster.%s", metadataLabel)
 	}
 
-	// TODO: handle >0 targets
-	err = m.client.Put(context.TODO(), *targetZone, ARecord)
+	err = m.client.Put(ctx, *targetZone, ARecord)
 
 	if err == nil {
 		log.Info("upserted DNS record", "record", record.Spec, "zone", zone)
@@ -111,10 +122,15 @@ func (m *provider) Ensure(record *iov1.DNSRecord, zone configv1.DNSZone) error {
 	return err
 }
 
-func (m *provider) Delete(record *iov1.DNSRecord, zone configv1.DNSZone) error {
-	targetZone, err := client.ParseZone(zone.ID)
+func (m *provider) Delete(ctx context.Context, record *iov1.DNSRecord, zone configv1.DNSZone) error {
+	recordType, err := recordTypeFor(record)
 	if err != nil {
-		return errors.Wrap(err, "failed to parse zoneID")
+		return err
+	}
+
+	targetZone, err := m.targetZone(ctx, zone)
+	if err != nil {
+		return err
 	}
 
 	ARecordName, err := getARecordName(record.Spec.DNSName, targetZone.Name)
@@ -122,14 +138,14 @@ func (m *provider) Delete(record *iov1.DNSRecord, zone configv1.DNSZone) error {
 		return err
 	}
 
-	// TODO: handle >0 targets
 	err = m.client.Delete(
-		context.TODO(),
+		ctx,
 		*targetZone,
 		client.ARecord{
-			Address: record.Spec.Targets[0],
-			Name:    ARecordName,
-			TTL:     record.Spec.RecordTTL,
+			Type:      recordType,
+			Addresses: record.Spec.Targets,
+			Name:      ARecordName,
+			TTL:       record.Spec.RecordTTL,
 		})
 
 	if err == nil {
@@ -139,8 +155,113 @@ func (m *provider) Delete(record *iov1.DNSRecord, zone configv1.DNSZone) error {
 	return err
 }
 
-func (m *provider) Replace(record *iov1.DNSRecord, zone configv1.DNSZone) error {
-	return m.Ensure(record, zone)
+// Replace publishes the given record, replacing a record with the same name
+// and a conflicting type.  Azure DNS does not allow a CNAME record set and a
+// record set of another type with the same name and cannot change the type of
+// a record set, so the conflicting record is deleted before the record is
+// published, and it is restored if publishing the record fails.
+func (m *provider) Replace(ctx context.Context, record *iov1.DNSRecord, zone configv1.DNSZone) error {
+	recordType, err := recordTypeFor(record)
+	if err != nil {
+		return err
+	}
+
+	targetZone, err := m.targetZone(ctx, zone)
+	if err != nil {
+		return err
+	}
+
+	ARecordName, err := getARecordName(record.Spec.DNSName, targetZone.Name)
+	if err != nil {
+		return err
+	}
+
+	var conflicts []*client.ARecord
+	for _, conflictingType := range []client.RecordType{client.RecordTypeA, client.RecordTypeCNAME} {
+		if !dns.RecordTypesConflict(string(conflictingType), string(recordType)) {
+			continue
+		}
+		current, err := m.client.Get(ctx, *targetZone, ARecordName, conflictingType)
+		if err != nil {
+			return err
+		}
+		if current != nil {
+			conflicts = append(conflicts, current)
+		}
+	}
+	for _, conflict := range conflicts {
+		if err := m.client.Delete(ctx, *targetZone, *conflict); err != nil {
+			return err
+		}
+		log.Info("deleted conflicting DNS record", "name", conflict.Name, "type", conflict.Type, "zone", zone)
+	}
+
+	if err := m.Ensure(ctx, record, zone); err != nil {
+		for _, conflict := range conflicts {
+			if restoreErr := m.client.Put(ctx, *targetZone, *conflict); restoreErr != nil {
+				log.Error(restoreErr, "failed to restore conflicting DNS record", "name", conflict.Name, "type", conflict.Type, "zone", zone)
+				continue
+			}
+			log.Info("restored conflicting DNS record", "name", conflict.Name, "type", conflict.Type, "zone", zone)
+		}
+		return err
+	}
+	return nil
+}
+
+// targetZone returns the Azure zone for the given zone, which is parsed from
+// the zone's ID or, if the zone has no ID, looked up by the zone's tags.
+func (m *provider) targetZone(ctx context.Context, zone configv1.DNSZone) (*client.Zone, error) {
+	if len(zone.ID) != 0 {
+		targetZone, err := client.ParseZone(zone.ID)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to parse zoneID")
+		}
+		return targetZone, nil
+	}
+	if len(zone.Tags) == 0 {
+		return nil, fmt.Errorf("zone has neither an ID nor tags")
+	}
+
+	key := tagsKey(zone.Tags)
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	if targetZone, ok := m.zonesByTags[key]; ok {
+		return targetZone, nil
+	}
+	targetZone, err := m.client.LookupPrivateZone(ctx, zone.Tags)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to find private zone by tags")
+	}
+	if m.zonesByTags == nil {
+		m.zonesByTags = map[string]*client.Zone{}
+	}
+	m.zonesByTags[key] = targetZone
+	log.Info("found private zone by tags", "tags", zone.Tags, "zone", targetZone.Name, "resourceGroup", targetZone.ResourceGroup)
+	return targetZone, nil
+}
+
+// tagsKey returns a string that uniquely identifies the given tags.
+func tagsKey(tags map[string]string) string {
+	pairs := make([]string, 0, len(tags))
+	for k, v := range tags {
+		pairs = append(pairs, k+"="+v)
+	}
+	sort.Strings(pairs)
+	return strings.Join(pairs, ",")
+}
+
+// recordTypeFor returns the Azure record type for the given DNSRecord, or an
+// error if the DNSRecord has a record type that the provider does not support.
+func recordTypeFor(record *iov1.DNSRecord) (client.RecordType, error) {
+	switch record.Spec.RecordType {
+	case iov1.ARecordType:
+		return client.RecordTypeA, nil
+	case iov1.CNAMERecordType:
+		return client.RecordTypeCNAME, nil
+	default:
+		return "", fmt.Errorf("only A and CNAME record types are supported")
+	}
 }
 
 // getARecordName extracts the ARecord subdomain name from the full domain string.
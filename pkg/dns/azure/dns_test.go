@@ -1,6 +1,7 @@
 package azure_test
 
 import (
+	"context"
 	"testing"
 
 	"github.com/pkg/errors"
@@ -43,7 +44,7 @@ func TestEnsureDNS(t *testing.T) {
 	dnsZone := configv1.DNSZone{
 		ID: "/subscriptions/E540B02D-5CCE-4D47-A13B-EB05A19D696E/resourceGroups/test-rg/providers/Microsoft.Network/dnszones/dnszone.io",
 	}
-	err = mgr.Ensure(&record, dnsZone)
+	err = mgr.Ensure(context.Background(), &record, dnsZone)
 	if err != nil {
 		t.Fatal("failed to ensure dns")
 		return
@@ -84,7 +85,7 @@ func TestDeleteDNS(t *testing.T) {
 	dnsZone := configv1.DNSZone{
 		ID: "/subscriptions/E540B02D-5CCE-4D47-A13B-EB05A19D696E/resourceGroups/test-rg/providers/Microsoft.Network/dnszones/dnszone.io",
 	}
-	err = mgr.Delete(&record, dnsZone)
+	err = mgr.Delete(context.Background(), &record, dnsZone)
 	if err != nil {
 		t.Error("failed to ensure dns")
 		return
@@ -96,3 +97,100 @@ func TestDeleteDNS(t *testing.T) {
 		t.Fatalf("expected the dns client 'Delete' func to be called, but found %s instead", recordedCall)
 	}
 }
+
+func TestEnsureDNSUnsupportedType(t *testing.T) {
+	c := client.Config{}
+	fc, _ := client.NewFake(c)
+	mgr, err := fakeManager(fc)
+	if err != nil {
+		t.Error("failed to steup the manager under test")
+	}
+	record := iov1.DNSRecord{
+		Spec: iov1.DNSRecordSpec{
+			DNSName:    "subdomain.dnszone.io.",
+			RecordType: iov1.DNSRecordType("MX"),
+			Targets:    []string{"mail.example.com"},
+		},
+	}
+	dnsZone := configv1.DNSZone{
+		ID: "/subscriptions/E540B02D-5CCE-4D47-A13B-EB05A19D696E/resourceGroups/test-rg/providers/Microsoft.Network/dnszones/dnszone.io",
+	}
+	if err := mgr.Ensure(context.Background(), &record, dnsZone); err == nil {
+		t.Fatal("expected an error for an unsupported record type")
+	}
+}
+
+func TestEnsureDNSPrivateZone(t *testing.T) {
+	testCases := []struct {
+		name string
+		zone configv1.DNSZone
+	}{
+		{
+			name: "zone by ID",
+			zone: configv1.DNSZone{
+				ID: "/subscriptions/E540B02D-5CCE-4D47-A13B-EB05A19D696E/resourceGroups/test-rg/providers/Microsoft.Network/privateDnsZones/dnszone.io",
+			},
+		},
+		{
+			name: "zone by tags",
+			zone: configv1.DNSZone{
+				Tags: map[string]string{"kubernetes.io_cluster.test": "owned"},
+			},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			fc, _ := client.NewFake(client.Config{})
+			fc.AddPrivateZone("/subscriptions/E540B02D-5CCE-4D47-A13B-EB05A19D696E/resourceGroups/other-rg/providers/Microsoft.Network/privateDnsZones/other.io", map[string]string{"kubernetes.io_cluster.other": "owned"})
+			fc.AddPrivateZone("/subscriptions/E540B02D-5CCE-4D47-A13B-EB05A19D696E/resourceGroups/test-rg/providers/Microsoft.Network/privateDnsZones/dnszone.io", map[string]string{"kubernetes.io_cluster.test": "owned"})
+			mgr, err := fakeManager(fc)
+			if err != nil {
+				t.Fatalf("failed to set up the manager under test: %v", err)
+			}
+			record := iov1.DNSRecord{
+				Spec: iov1.DNSRecordSpec{
+					DNSName:    "*.apps.dnszone.io.",
+					RecordType: iov1.ARecordType,
+					Targets:    []string{"10.0.0.4", "10.0.0.5"},
+					RecordTTL:  30,
+				},
+			}
+			// Ensure twice to verify that a zone that is looked up by
+			// tags is looked up only once.
+			for i := 0; i < 2; i++ {
+				if err := mgr.Ensure(context.Background(), &record, tc.zone); err != nil {
+					t.Fatalf("failed to ensure dns: %v", err)
+				}
+			}
+			if recordedCall, _ := fc.RecordedCall("test-rg", "dnszone.io", "*.apps"); recordedCall != "PUT" {
+				t.Fatalf("expected the dns client 'Put' func to be called, but found %s instead", recordedCall)
+			}
+			expectedLookups := 0
+			if len(tc.zone.ID) == 0 {
+				expectedLookups = 1
+			}
+			if fc.Lookups() != expectedLookups {
+				t.Errorf("expected %d private zone lookups, got %d", expectedLookups, fc.Lookups())
+			}
+		})
+	}
+}
+
+func TestEnsureDNSPrivateZoneNotFound(t *testing.T) {
+	fc, _ := client.NewFake(client.Config{})
+	mgr, err := fakeManager(fc)
+	if err != nil {
+		t.Fatalf("failed to set up the manager under test: %v", err)
+	}
+	record := iov1.DNSRecord{
+		Spec: iov1.DNSRecordSpec{
+			DNSName:    "*.apps.dnszone.io.",
+			RecordType: iov1.ARecordType,
+			Targets:    []string{"10.0.0.4"},
+		},
+	}
+	dnsZone := configv1.DNSZone{Tags: map[string]string{"kubernetes.io_cluster.test": "owned"}}
+	if err := mgr.Ensure(context.Background(), &record, dnsZone); err == nil {
+		t.Fatal("expected an error for a zone that cannot be found")
+	}
+}
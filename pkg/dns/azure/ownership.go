@@ -0,0 +1,118 @@
+package azure
+
+import (
+	"context"
+	"fmt"
+
+	configv1 "github.com/openshift/api/config/v1"
+	iov1 "github.com/openshift/api/operatoringress/v1"
+
+	"github.com/openshift/cluster-ingress-operator/pkg/dns"
+	"github.com/openshift/cluster-ingress-operator/pkg/dns/azure/client"
+)
+
+var _ dns.OwnershipRegistry = &provider{}
+
+// GetOwner returns the owner that the companion TXT record of the given record
+// specifies, or the empty string if there is no companion TXT record.
+func (m *provider) GetOwner(ctx context.Context, record *iov1.DNSRecord, zone configv1.DNSZone) (string, error) {
+	current, _, err := m.getOwnershipRecord(ctx, record, zone)
+	if err != nil || current == nil {
+		return "", err
+	}
+	for _, value := range current.Addresses {
+		if owner, ok := dns.ParseOwnershipRecordValue(value); ok {
+			return owner, nil
+		}
+	}
+	return "", nil
+}
+
+// SetOwner creates or updates the companion TXT record of the given record so
+// that it specifies the given owner.
+func (m *provider) SetOwner(ctx context.Context, record *iov1.DNSRecord, zone configv1.DNSZone, owner string) error {
+	targetZone, err := m.targetZone(ctx, zone)
+	if err != nil {
+		return err
+	}
+	name, err := getARecordName(dns.OwnershipRecordName(record), targetZone.Name)
+	if err != nil {
+		return err
+	}
+	txt := client.ARecord{
+		Name:      name,
+		Type:      client.RecordTypeTXT,
+		Addresses: []string{dns.OwnershipRecordValue(owner)},
+		TTL:       record.Spec.RecordTTL,
+	}
+	if err := m.client.Put(ctx, *targetZone, txt); err != nil {
+		return fmt.Errorf("failed to update companion TXT record in zone %s: %w", targetZone.Name, err)
+	}
+	log.Info("updated companion TXT record", "zone", zone, "name", name, "owner", owner)
+	return nil
+}
+
+// DeleteOwner deletes the companion TXT record of the given record, if it
+// exists.
+func (m *provider) DeleteOwner(ctx context.Context, record *iov1.DNSRecord, zone configv1.DNSZone) error {
+	current, targetZone, err := m.getOwnershipRecord(ctx, record, zone)
+	if err != nil || current == nil {
+		return err
+	}
+	if err := m.client.Delete(ctx, *targetZone, *current); err != nil {
+		return fmt.Errorf("failed to delete companion TXT record in zone %s: %w", targetZone.Name, err)
+	}
+	log.Info("deleted companion TXT record", "zone", zone, "name", current.Name)
+	return nil
+}
+
+// RecordExists returns a Boolean value indicating whether the given zone has a
+// record set with the name of the given record that publishing the record
+// would overwrite, namely one with the same type, or delete, namely one with a
+// conflicting type.
+func (m *provider) RecordExists(ctx context.Context, record *iov1.DNSRecord, zone configv1.DNSZone) (bool, error) {
+	recordType, err := recordTypeFor(record)
+	if err != nil {
+		return false, err
+	}
+	targetZone, err := m.targetZone(ctx, zone)
+	if err != nil {
+		return false, err
+	}
+	name, err := getARecordName(record.Spec.DNSName, targetZone.Name)
+	if err != nil {
+		return false, err
+	}
+	for _, currentType := range []client.RecordType{client.RecordTypeA, client.RecordTypeCNAME} {
+		if currentType != recordType && !dns.RecordTypesConflict(string(currentType), string(recordType)) {
+			continue
+		}
+		current, err := m.client.Get(ctx, *targetZone, name, currentType)
+		if err != nil {
+			return false, err
+		}
+		if current != nil {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// getOwnershipRecord returns the companion TXT record of the given record, or
+// nil if the record has no companion TXT record, and the Azure zone that
+// contains it.
+func (m *provider) getOwnershipRecord(ctx context.Context, record *iov1.DNSRecord, zone configv1.DNSZone) (*client.ARecord, *client.Zone, error) {
+	targetZone, err := m.targetZone(ctx, zone)
+	if err != nil {
+		return nil, nil, err
+	}
+	name, err := getARecordName(dns.OwnershipRecordName(record), targetZone.Name)
+	if err != nil {
+		return nil, nil, err
+	}
+	current, err := m.client.Get(ctx, *targetZone, name, client.RecordTypeTXT)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get companion TXT record in zone %s: %w", targetZone.Name, err)
+	}
+	return current, targetZone, nil
+}
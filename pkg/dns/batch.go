@@ -0,0 +1,443 @@
+package dns
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	iov1 "github.com/openshift/api/operatoringress/v1"
+
+	configv1 "github.com/openshift/api/config/v1"
+
+	"golang.org/x/time/rate"
+
+	"k8s.io/apimachinery/pkg/util/wait"
+)
+
+// ChangeAction is the action that a Change performs on a record.
+type ChangeAction string
+
+const (
+	// EnsureAction creates or updates a record, as Provider.Ensure does.
+	EnsureAction ChangeAction = "Ensure"
+	// DeleteAction deletes a record, as Provider.Delete does.
+	DeleteAction ChangeAction = "Delete"
+	// ReplaceAction replaces a record, as Provider.Replace does.
+	ReplaceAction ChangeAction = "Replace"
+)
+
+// Change is a change to a record in a zone.
+type Change struct {
+	// Action is the action to perform on the record.
+	Action ChangeAction
+	// Record is the record to change.
+	Record *iov1.DNSRecord
+	// Owner, if not empty, is the owner that the record's companion TXT
+	// record is made to specify in the same change.  It is used only for
+	// Ensure and Replace changes to a provider that implements
+	// AtomicOwnershipRegistry.
+	Owner string
+}
+
+// BatchProvider is implemented by a Provider that can apply several changes to
+// the same zone with fewer API calls than it would need to apply each change
+// individually.
+type BatchProvider interface {
+	// ApplyBatch applies the given changes to the given zone and returns
+	// one error, or nil, for each change, in the same order as the
+	// changes.  The given context bounds the calls that ApplyBatch makes
+	// to the provider's API.
+	ApplyBatch(ctx context.Context, zone configv1.DNSZone, changes []Change) []error
+}
+
+// ThrottleClassifier is implemented by a Provider that can tell whether an
+// error that it returned means that the provider's API throttled a request.
+type ThrottleClassifier interface {
+	// IsThrottled returns a Boolean value indicating whether the given
+	// error, which the provider returned, means that the provider's API
+	// throttled a request.
+	IsThrottled(err error) bool
+}
+
+// RateLimitedProvider is implemented by a Provider that can limit the rate of
+// each of its API calls using a BatchingProvider's rate limiter, including the
+// lookups that it makes to prepare a change and the calls that it makes when a
+// batch of changes is applied individually.
+type RateLimitedProvider interface {
+	// SetRateLimiter makes the provider wait for the given limiter before
+	// each call to its API.  It is called before the provider is used.
+	SetRateLimiter(limiter *rate.Limiter)
+}
+
+// BatchConfig configures a BatchingProvider.
+type BatchConfig struct {
+	// Interval is how long changes to a zone are collected before they are
+	// applied.
+	Interval time.Duration
+	// MaxBatchSize is the maximum number of changes that are passed to a
+	// BatchProvider in one call.
+	MaxBatchSize int
+	// Limiter limits the rate of calls to the provider.  A limiter can be
+	// shared by several BatchingProviders, for example so that the rate
+	// limit is kept when a provider is recreated with new credentials.
+	Limiter *rate.Limiter
+	// Backoff specifies the delays with which changes that the provider's
+	// API throttled are retried.  Once Backoff.Steps retries have been
+	// throttled, the throttle error is returned.
+	Backoff wait.Backoff
+}
+
+// DefaultBatchConfig returns a BatchConfig that is suitable for the cloud DNS
+// APIs, which typically allow a handful of requests per second per account.
+func DefaultBatchConfig() BatchConfig {
+	return BatchConfig{
+		Interval:     500 * time.Millisecond,
+		MaxBatchSize: 100,
+		Limiter:      rate.NewLimiter(rate.Limit(5), 10),
+		Backoff: wait.Backoff{
+			Duration: 1 * time.Second,
+			Factor:   2,
+			Jitter:   0.1,
+			Steps:    5,
+			Cap:      1 * time.Minute,
+		},
+	}
+}
+
+var _ Provider = &BatchingProvider{}
+
+// BatchingProvider is a Provider that queues changes per zone, coalesces the
+// changes that are queued for the same zone at about the same time, and applies
+// them using the provider that it wraps, limiting the rate of calls to the
+// wrapped provider and retrying changes that the provider's API throttles.
+// Changes are applied with a single call if the wrapped provider implements
+// BatchProvider, and throttle errors are recognized if the wrapped provider
+// implements ThrottleClassifier.  If the wrapped provider implements
+// RateLimitedProvider, it waits for the rate limiter before each of its API
+// calls; otherwise, the BatchingProvider waits for the rate limiter before each
+// call to the wrapped provider.  Ensure, Delete, and Replace block until the
+// change has been applied, so callers see the same results as they would if
+// they called the wrapped provider directly.  A call returns the context's
+// error when its context is done, even if the change is still queued or being
+// applied; a change whose context is done before it is applied is dropped.
+type BatchingProvider struct {
+	provider Provider
+	config   BatchConfig
+	// providerLimited indicates whether the wrapped provider waits for
+	// the rate limiter before each of its API calls.
+	providerLimited bool
+
+	lock   sync.Mutex
+	queues map[string]*zoneQueue
+}
+
+// zoneQueue holds the pending changes for a zone.
+type zoneQueue struct {
+	zone    configv1.DNSZone
+	pending []*pendingChange
+}
+
+// pendingChange is a queued change, the context of the call that queued it,
+// and the channels on which the result of applying the change is sent.  A
+// change supersedes a pending change with the same action for the same record,
+// in which case the callers that queued both changes receive the result of
+// applying the later one, which is applied with the later call's context.
+type pendingChange struct {
+	ctx     context.Context
+	change  Change
+	waiters []chan error
+}
+
+// NewBatchingProvider returns a BatchingProvider that applies changes using
+// the given provider.
+func NewBatchingProvider(provider Provider, config BatchConfig) *BatchingProvider {
+	if config.MaxBatchSize <= 0 {
+		config.MaxBatchSize = 1
+	}
+	if config.Limiter == nil {
+		config.Limiter = rate.NewLimiter(rate.Inf, 0)
+	}
+	limited, ok := provider.(RateLimitedProvider)
+	if ok {
+		limited.SetRateLimiter(config.Limiter)
+	}
+	return &BatchingProvider{
+		provider:        provider,
+		config:          config,
+		providerLimited: ok,
+		queues:          map[string]*zoneQueue{},
+	}
+}
+
+// Wait blocks until the rate limiter allows a call to the wrapped provider or
+// the given context is done.  Callers that use the wrapped provider directly,
+// for example to look up the owner of a record, call Wait before each call so
+// that the rate limit is kept.  If the wrapped provider limits the rate of its
+// own API calls, Wait returns immediately.
+func (p *BatchingProvider) Wait(ctx context.Context) error {
+	if p.providerLimited {
+		return ctx.Err()
+	}
+	return p.config.Limiter.Wait(ctx)
+}
+
+func (p *BatchingProvider) Ensure(ctx context.Context, record *iov1.DNSRecord, zone configv1.DNSZone) error {
+	return p.submit(ctx, zone, Change{Action: EnsureAction, Record: record})
+}
+
+func (p *BatchingProvider) Delete(ctx context.Context, record *iov1.DNSRecord, zone configv1.DNSZone) error {
+	return p.submit(ctx, zone, Change{Action: DeleteAction, Record: record})
+}
+
+func (p *BatchingProvider) Replace(ctx context.Context, record *iov1.DNSRecord, zone configv1.DNSZone) error {
+	return p.submit(ctx, zone, Change{Action: ReplaceAction, Record: record})
+}
+
+// EnsureWithOwner is like Ensure but also makes the companion TXT record of the
+// given record specify the given owner, as AtomicOwnershipRegistry does.  The
+// wrapped provider must implement AtomicOwnershipRegistry.
+func (p *BatchingProvider) EnsureWithOwner(ctx context.Context, record *iov1.DNSRecord, zone configv1.DNSZone, owner string) error {
+	return p.submit(ctx, zone, Change{Action: EnsureAction, Record: record, Owner: owner})
+}
+
+// ReplaceWithOwner is like Replace but also makes the companion TXT record of
+// the given record specify the given owner, as AtomicOwnershipRegistry does.
+// The wrapped provider must implement AtomicOwnershipRegistry.
+func (p *BatchingProvider) ReplaceWithOwner(ctx context.Context, record *iov1.DNSRecord, zone configv1.DNSZone, owner string) error {
+	return p.submit(ctx, zone, Change{Action: ReplaceAction, Record: record, Owner: owner})
+}
+
+// submit queues the given change for the given zone, starting a worker for the
+// zone if none is running, and waits for the result of applying the change or
+// for the given context to be done.
+func (p *BatchingProvider) submit(ctx context.Context, zone configv1.DNSZone, change Change) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	done := make(chan error, 1)
+	key := zoneKey(zone)
+
+	p.lock.Lock()
+	queue, ok := p.queues[key]
+	if !ok {
+		queue = &zoneQueue{zone: zone}
+		p.queues[key] = queue
+		go p.run(key, queue)
+	}
+	coalesced := false
+	for _, pending := range queue.pending {
+		if pending.change.Action == change.Action && changeKey(pending.change) == changeKey(change) {
+			pending.ctx = ctx
+			pending.change = change
+			pending.waiters = append(pending.waiters, done)
+			coalesced = true
+			break
+		}
+	}
+	if !coalesced {
+		queue.pending = append(queue.pending, &pendingChange{ctx: ctx, change: change, waiters: []chan error{done}})
+	}
+	dnsProviderQueueDepth.WithLabelValues(key).Set(float64(len(queue.pending)))
+	p.lock.Unlock()
+
+	select {
+	case err := <-done:
+		return err
+	case <-ctx.Done():
+		return fmt.Errorf("failed to apply %s change for record %s/%s: %w", change.Action, change.Record.Namespace, change.Record.Name, ctx.Err())
+	}
+}
+
+// run applies the changes that are queued for the zone with the given key
+// until the zone's queue is empty, at which point run removes the queue and
+// returns.
+func (p *BatchingProvider) run(key string, queue *zoneQueue) {
+	for {
+		time.Sleep(p.config.Interval)
+
+		p.lock.Lock()
+		pending := queue.pending
+		queue.pending = nil
+		if len(pending) == 0 {
+			delete(p.queues, key)
+			dnsProviderQueueDepth.DeleteLabelValues(key)
+			p.lock.Unlock()
+			return
+		}
+		dnsProviderQueueDepth.WithLabelValues(key).Set(0)
+		p.lock.Unlock()
+
+		for len(pending) != 0 {
+			n := len(pending)
+			if _, ok := p.provider.(BatchProvider); ok && n > p.config.MaxBatchSize {
+				n = p.config.MaxBatchSize
+			}
+			p.apply(queue.zone, pending[:n])
+			pending = pending[n:]
+		}
+	}
+}
+
+// apply applies the given pending changes to the given zone, retrying the
+// changes that the provider's API throttles, and sends the results to the
+// changes' waiters.
+func (p *BatchingProvider) apply(zone configv1.DNSZone, pending []*pendingChange) {
+	backoff := p.config.Backoff
+	for {
+		errs := p.applyOnce(zone, pending)
+		var throttled []*pendingChange
+		for i := range pending {
+			if errs[i] != nil && p.isThrottled(errs[i]) && backoff.Steps > 0 {
+				throttled = append(throttled, pending[i])
+				continue
+			}
+			for _, waiter := range pending[i].waiters {
+				waiter <- errs[i]
+			}
+		}
+		if len(throttled) == 0 {
+			return
+		}
+		delay := backoff.Step()
+		log.Info("dns provider throttled requests; will retry", "zone", zone, "changes", len(throttled), "delay", delay)
+		time.Sleep(delay)
+		pending = throttled
+	}
+}
+
+// applyOnce applies the given pending changes to the given zone, using a single
+// call if the provider implements BatchProvider, and returns one error for
+// each change.
+func (p *BatchingProvider) applyOnce(zone configv1.DNSZone, pending []*pendingChange) []error {
+	errs := make([]error, len(pending))
+	// Drop the changes whose callers have given up.
+	var (
+		live    []*pendingChange
+		indexes []int
+	)
+	for i := range pending {
+		if err := pending[i].ctx.Err(); err != nil {
+			errs[i] = err
+			continue
+		}
+		live = append(live, pending[i])
+		indexes = append(indexes, i)
+	}
+	if batcher, ok := p.provider.(BatchProvider); ok && len(live) != 0 {
+		changes := make([]Change, len(live))
+		for i := range live {
+			changes[i] = live[i].change
+		}
+		ctx, cancel := batchContext(live)
+		defer cancel()
+		var result []error
+		if err := p.Wait(ctx); err != nil {
+			result = make([]error, len(changes))
+			for i := range result {
+				result[i] = err
+			}
+		} else {
+			result = batcher.ApplyBatch(ctx, zone, changes)
+		}
+		for j, i := range indexes {
+			if len(result) != len(changes) {
+				errs[i] = fmt.Errorf("dns provider returned %d results for %d changes", len(result), len(changes))
+				continue
+			}
+			errs[i] = result[j]
+		}
+	} else {
+		for j, i := range indexes {
+			ctx := live[j].ctx
+			if err := p.Wait(ctx); err != nil {
+				errs[i] = err
+				continue
+			}
+			errs[i] = applyChange(ctx, p.provider, zone, live[j].change)
+		}
+	}
+	for _, err := range errs {
+		if err == nil {
+			continue
+		}
+		reason := "Error"
+		if p.isThrottled(err) {
+			reason = "Throttled"
+		}
+		dnsProviderAPIErrors.WithLabelValues(reason).Inc()
+	}
+	return errs
+}
+
+// isThrottled returns a Boolean value indicating whether the given error means
+// that the provider's API throttled a request.
+func (p *BatchingProvider) isThrottled(err error) bool {
+	classifier, ok := p.provider.(ThrottleClassifier)
+	return ok && classifier.IsThrottled(err)
+}
+
+// batchContext returns a context for a call that applies the given pending
+// changes.  The context is canceled once the contexts of all the changes are
+// done so that the call is not abandoned while any caller still waits for its
+// result.
+func batchContext(pending []*pendingChange) (context.Context, context.CancelFunc) {
+	ctx, cancel := context.WithCancel(context.Background())
+	go func() {
+		for _, p := range pending {
+			select {
+			case <-p.ctx.Done():
+			case <-ctx.Done():
+				return
+			}
+		}
+		cancel()
+	}()
+	return ctx, cancel
+}
+
+// applyChange applies the given change to the given zone using the
+// corresponding method of the given provider.
+func applyChange(ctx context.Context, provider Provider, zone configv1.DNSZone, change Change) error {
+	if registry, ok := provider.(AtomicOwnershipRegistry); ok && len(change.Owner) != 0 {
+		switch change.Action {
+		case EnsureAction:
+			return registry.EnsureWithOwner(ctx, change.Record, zone, change.Owner)
+		case ReplaceAction:
+			return registry.ReplaceWithOwner(ctx, change.Record, zone, change.Owner)
+		}
+	}
+	switch change.Action {
+	case EnsureAction:
+		return provider.Ensure(ctx, change.Record, zone)
+	case DeleteAction:
+		return provider.Delete(ctx, change.Record, zone)
+	case ReplaceAction:
+		return provider.Replace(ctx, change.Record, zone)
+	}
+	return fmt.Errorf("unsupported change action %q", change.Action)
+}
+
+// zoneKey returns a string that identifies the given zone by its ID or, if it
+// has no ID, by its tags.
+func zoneKey(zone configv1.DNSZone) string {
+	if len(zone.ID) != 0 {
+		return zone.ID
+	}
+	tags := make([]string, 0, len(zone.Tags))
+	for k, v := range zone.Tags {
+		tags = append(tags, k+"="+v)
+	}
+	sort.Strings(tags)
+	return strings.Join(tags, ",")
+}
+
+// changeKey returns a string that identifies the record of the given change.
+func changeKey(change Change) string {
+	if change.Record == nil {
+		return ""
+	}
+	return string(change.Record.UID) + "/" + change.Record.Namespace + "/" + change.Record.Name
+}
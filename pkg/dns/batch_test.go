@@ -0,0 +1,235 @@
+package dns
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	iov1 "github.com/openshift/api/operatoringress/v1"
+
+	configv1 "github.com/openshift/api/config/v1"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+
+	"golang.org/x/time/rate"
+)
+
+var errThrottled = errors.New("throttled")
+
+// fakeBatchProvider is a BatchProvider that records the batches that it is
+// asked to apply and throttles the first throttleCount batches.
+type fakeBatchProvider struct {
+	FakeProvider
+
+	lock          sync.Mutex
+	batches       [][]Change
+	throttleCount int
+}
+
+func (f *fakeBatchProvider) ApplyBatch(ctx context.Context, zone configv1.DNSZone, changes []Change) []error {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	f.batches = append(f.batches, changes)
+	errs := make([]error, len(changes))
+	if f.throttleCount > 0 {
+		f.throttleCount--
+		for i := range errs {
+			errs[i] = errThrottled
+		}
+	}
+	return errs
+}
+
+func (f *fakeBatchProvider) IsThrottled(err error) bool {
+	return errors.Is(err, errThrottled)
+}
+
+func newTestRecord(name string) *iov1.DNSRecord {
+	return &iov1.DNSRecord{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "openshift-ingress-operator", Name: name},
+		Spec: iov1.DNSRecordSpec{
+			DNSName:    name + ".example.com.",
+			RecordType: iov1.ARecordType,
+			Targets:    []string{"192.0.2.1"},
+			RecordTTL:  30,
+		},
+	}
+}
+
+// ensureConcurrently calls Ensure for each of the given records concurrently
+// and returns the errors.
+func ensureConcurrently(p *BatchingProvider, zone configv1.DNSZone, records []*iov1.DNSRecord) []error {
+	errs := make([]error, len(records))
+	var wg sync.WaitGroup
+	for i := range records {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			errs[i] = p.Ensure(context.Background(), records[i], zone)
+		}(i)
+	}
+	wg.Wait()
+	return errs
+}
+
+// TestBatchingProviderCoalescesChanges verifies that changes to the same zone
+// that are queued at about the same time are applied in batches of at most
+// MaxBatchSize changes and that changes to different zones are applied
+// separately.
+func TestBatchingProviderCoalescesChanges(t *testing.T) {
+	fake := &fakeBatchProvider{}
+	p := NewBatchingProvider(fake, BatchConfig{Interval: 100 * time.Millisecond, MaxBatchSize: 3})
+	var records []*iov1.DNSRecord
+	for i := 0; i < 5; i++ {
+		records = append(records, newTestRecord(fmt.Sprintf("record-%d", i)))
+	}
+	var wg sync.WaitGroup
+	for _, zone := range []configv1.DNSZone{{ID: "zone1"}, {Tags: map[string]string{"Name": "zone2"}}} {
+		wg.Add(1)
+		go func(zone configv1.DNSZone) {
+			defer wg.Done()
+			for _, err := range ensureConcurrently(p, zone, records) {
+				if err != nil {
+					t.Errorf("unexpected error: %v", err)
+				}
+			}
+		}(zone)
+	}
+	wg.Wait()
+	if len(fake.batches) != 4 {
+		t.Fatalf("expected 4 batches, got %d", len(fake.batches))
+	}
+	total := 0
+	for _, batch := range fake.batches {
+		if len(batch) > 3 {
+			t.Errorf("expected at most 3 changes in a batch, got %d", len(batch))
+		}
+		total += len(batch)
+	}
+	if total != 10 {
+		t.Errorf("expected 10 changes, got %d", total)
+	}
+}
+
+// TestBatchingProviderRetriesThrottledChanges verifies that changes that the
+// provider throttles are retried with backoff and that the throttle error is
+// returned once the backoff is exhausted.
+func TestBatchingProviderRetriesThrottledChanges(t *testing.T) {
+	testCases := []struct {
+		name          string
+		throttleCount int
+		expectErr     bool
+	}{
+		{name: "throttled once", throttleCount: 1, expectErr: false},
+		{name: "throttled until backoff is exhausted", throttleCount: 10, expectErr: true},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			fake := &fakeBatchProvider{throttleCount: tc.throttleCount}
+			p := NewBatchingProvider(fake, BatchConfig{
+				Interval:     10 * time.Millisecond,
+				MaxBatchSize: 10,
+				Backoff:      wait.Backoff{Duration: 10 * time.Millisecond, Factor: 1, Steps: 2},
+			})
+			err := p.Ensure(context.Background(), newTestRecord("record"), configv1.DNSZone{ID: "zone1"})
+			switch {
+			case tc.expectErr && !errors.Is(err, errThrottled):
+				t.Errorf("expected throttle error, got %v", err)
+			case !tc.expectErr && err != nil:
+				t.Errorf("unexpected error: %v", err)
+			}
+			expectedBatches := tc.throttleCount + 1
+			if expectedBatches > 3 {
+				expectedBatches = 3
+			}
+			if len(fake.batches) != expectedBatches {
+				t.Errorf("expected %d batches, got %d", expectedBatches, len(fake.batches))
+			}
+		})
+	}
+}
+
+// TestBatchingProviderWithoutBatchSupport verifies that changes are applied
+// individually with a provider that does not implement BatchProvider.
+func TestBatchingProviderWithoutBatchSupport(t *testing.T) {
+	p := NewBatchingProvider(&FakeProvider{}, BatchConfig{Interval: 10 * time.Millisecond})
+	zone := configv1.DNSZone{ID: "zone1"}
+	record := newTestRecord("record")
+	if err := p.Ensure(context.Background(), record, zone); err != nil {
+		t.Errorf("unexpected error from Ensure: %v", err)
+	}
+	if err := p.Replace(context.Background(), record, zone); err != nil {
+		t.Errorf("unexpected error from Replace: %v", err)
+	}
+	if err := p.Delete(context.Background(), record, zone); err != nil {
+		t.Errorf("unexpected error from Delete: %v", err)
+	}
+}
+
+// TestBatchingProviderHonoursContext verifies that a call returns the context's
+// error once its context is done and that a change whose context is done
+// before the change is applied is dropped.
+func TestBatchingProviderHonoursContext(t *testing.T) {
+	fake := &fakeBatchProvider{}
+	p := NewBatchingProvider(fake, BatchConfig{Interval: 200 * time.Millisecond, MaxBatchSize: 10})
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	start := time.Now()
+	err := p.Ensure(ctx, newTestRecord("record"), configv1.DNSZone{ID: "zone1"})
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("expected deadline exceeded error, got %v", err)
+	}
+	if elapsed := time.Since(start); elapsed >= 200*time.Millisecond {
+		t.Errorf("expected Ensure to return when its context was done, took %v", elapsed)
+	}
+	// Wait for the queued change to be dropped.
+	time.Sleep(300 * time.Millisecond)
+	fake.lock.Lock()
+	defer fake.lock.Unlock()
+	if len(fake.batches) != 0 {
+		t.Errorf("expected the change to be dropped, got %d batches", len(fake.batches))
+	}
+}
+
+// fakeRateLimitedProvider is a RateLimitedProvider that records the rate
+// limiter that it is given.
+type fakeRateLimitedProvider struct {
+	FakeProvider
+
+	limiter *rate.Limiter
+}
+
+func (f *fakeRateLimitedProvider) SetRateLimiter(limiter *rate.Limiter) {
+	f.limiter = limiter
+}
+
+// TestBatchingProviderWait verifies that a provider that implements
+// RateLimitedProvider is given the rate limiter and that Wait waits for the
+// rate limiter only if the provider does not wait for it itself.
+func TestBatchingProviderWait(t *testing.T) {
+	limiter := rate.NewLimiter(rate.Every(time.Hour), 1)
+	limited := &fakeRateLimitedProvider{}
+	p := NewBatchingProvider(limited, BatchConfig{Limiter: limiter})
+	if limited.limiter != limiter {
+		t.Errorf("expected the provider to be given the rate limiter")
+	}
+	for i := 0; i < 2; i++ {
+		if err := p.Wait(context.Background()); err != nil {
+			t.Errorf("unexpected error from Wait: %v", err)
+		}
+	}
+
+	p = NewBatchingProvider(&FakeProvider{}, BatchConfig{Limiter: limiter})
+	if err := p.Wait(context.Background()); err != nil {
+		t.Errorf("unexpected error from Wait: %v", err)
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if err := p.Wait(ctx); err == nil {
+		t.Errorf("expected Wait to fail once the rate limit was exceeded")
+	}
+}
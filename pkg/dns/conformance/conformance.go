@@ -0,0 +1,498 @@
+// Package conformance provides tests that verify that a dns.Provider behaves as
+// the dns.Provider interface specifies.  Each provider's tests run the suite
+// against the provider configured with an in-memory fake of the provider's API.
+package conformance
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+
+	configv1 "github.com/openshift/api/config/v1"
+	iov1 "github.com/openshift/api/operatoringress/v1"
+
+	"github.com/openshift/cluster-ingress-operator/pkg/dns"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// Record is a record as it is published in a zone.
+type Record struct {
+	// Type is the record's type.
+	Type iov1.DNSRecordType
+	// Targets are the record's targets, without trailing dots.
+	Targets []string
+	// TTL is the record's TTL.
+	TTL int64
+}
+
+// Backend is the in-memory fake of a provider's API that the provider under
+// test uses.
+type Backend interface {
+	// Records returns the records in the harness's zone that have the
+	// given fully qualified name, which has a trailing dot.  A record
+	// that the provider publishes as several records, for example one for
+	// each target, is returned as a single record.
+	Records(dnsName string) []Record
+}
+
+// FailureInjector is implemented by a Backend that can fail the requests with
+// which a provider creates or updates records of a given type, which the suite
+// uses to verify that a provider restores a record that it has deleted if
+// publishing the record that replaces it fails.
+type FailureInjector interface {
+	// FailWrites makes the backend fail requests that create or update
+	// records of the given type until FailWrites is called with the
+	// empty string.  A backend that applies several changes atomically
+	// fails the whole request.
+	FailWrites(recordType iov1.DNSRecordType)
+}
+
+// Harness is a provider under test and its backend.
+type Harness struct {
+	// Provider is the provider under test.
+	Provider dns.Provider
+	// Backend is the provider's backend.
+	Backend Backend
+	// Zone is the zone to which records are published.
+	Zone configv1.DNSZone
+	// Domain is the domain name of Zone, without a trailing dot.
+	Domain string
+	// CNAMETarget is a target that the provider accepts for a CNAME
+	// record, for example the host name of a load balancer that the
+	// provider can look up.
+	CNAMETarget string
+	// InvalidZones are zones that the provider must reject, for example
+	// because their IDs cannot be parsed.
+	InvalidZones []configv1.DNSZone
+}
+
+// publishedTTL returns the TTL with which the provider under test is expected
+// to publish the given record.
+func (h *Harness) publishedTTL(ctx context.Context, record *iov1.DNSRecord) int64 {
+	if adjuster, ok := h.Provider.(dns.TTLAdjuster); ok {
+		return adjuster.PublishedTTL(ctx, record, h.Zone)
+	}
+	return record.Spec.RecordTTL
+}
+
+// newRecord returns a DNSRecord with the given name, relative to the harness's
+// domain, type, and targets.
+func (h *Harness) newRecord(name string, recordType iov1.DNSRecordType, targets ...string) *iov1.DNSRecord {
+	return &iov1.DNSRecord{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "openshift-ingress-operator",
+			Name:      strings.ReplaceAll(name, "*", "wildcard") + "-" + strings.ToLower(string(recordType)),
+		},
+		Spec: iov1.DNSRecordSpec{
+			DNSName:    fmt.Sprintf("%s.%s.", name, h.Domain),
+			RecordType: recordType,
+			Targets:    targets,
+			RecordTTL:  30,
+		},
+	}
+}
+
+// Run runs the conformance tests.  The given function is called to create a
+// new harness, with a new provider and an empty backend, for each test.
+func Run(t *testing.T, newHarness func(t *testing.T) *Harness) {
+	t.Run("EnsureIsIdempotent", func(t *testing.T) {
+		testEnsureIsIdempotent(t, newHarness)
+	})
+	t.Run("EnsureMultipleTargets", func(t *testing.T) {
+		testEnsureMultipleTargets(t, newHarness)
+	})
+	t.Run("EnsurePublishesTTL", func(t *testing.T) {
+		testEnsurePublishesTTL(t, newHarness)
+	})
+	t.Run("ReplaceChangesTargets", func(t *testing.T) {
+		testReplaceChangesTargets(t, newHarness)
+	})
+	t.Run("ReplaceChangesRecordType", func(t *testing.T) {
+		testReplaceChangesRecordType(t, newHarness)
+	})
+	t.Run("ReplaceRestoresRecordOnFailure", func(t *testing.T) {
+		testReplaceRestoresRecordOnFailure(t, newHarness)
+	})
+	t.Run("DeleteRemovesRecord", func(t *testing.T) {
+		testDeleteRemovesRecord(t, newHarness)
+	})
+	t.Run("DeleteMissingRecord", func(t *testing.T) {
+		testDeleteMissingRecord(t, newHarness)
+	})
+	t.Run("InvalidZone", func(t *testing.T) {
+		testInvalidZone(t, newHarness)
+	})
+	t.Run("OwnershipRegistry", func(t *testing.T) {
+		testOwnershipRegistry(t, newHarness)
+	})
+	t.Run("RecordExists", func(t *testing.T) {
+		testRecordExists(t, newHarness)
+	})
+	t.Run("ReplaceDeletesReplacedOwner", func(t *testing.T) {
+		testReplaceDeletesReplacedOwner(t, newHarness)
+	})
+}
+
+// typeTransitions are the pairs of record types between which the type
+// transition tests replace records.
+var typeTransitions = []struct{ from, to iov1.DNSRecordType }{
+	{from: iov1.ARecordType, to: iov1.CNAMERecordType},
+	{from: iov1.CNAMERecordType, to: iov1.ARecordType},
+}
+
+// wildcardRecord returns a wildcard DNSRecord of the given type with a target
+// that the provider under test accepts.
+func (h *Harness) wildcardRecord(recordType iov1.DNSRecordType) *iov1.DNSRecord {
+	if recordType == iov1.CNAMERecordType {
+		return h.newRecord("*.apps", recordType, h.CNAMETarget)
+	}
+	return h.newRecord("*.apps", recordType, "192.0.2.1")
+}
+
+// testEnsureIsIdempotent verifies that ensuring a record that is already
+// published leaves the record as it is.
+func testEnsureIsIdempotent(t *testing.T, newHarness func(t *testing.T) *Harness) {
+	for _, recordType := range []iov1.DNSRecordType{iov1.ARecordType, iov1.CNAMERecordType} {
+		t.Run(string(recordType), func(t *testing.T) {
+			h := newHarness(t)
+			ctx := context.Background()
+			record := h.wildcardRecord(recordType)
+			for i := 0; i < 2; i++ {
+				if err := h.Provider.Ensure(ctx, record, h.Zone); err != nil {
+					t.Fatalf("failed to ensure %s record (attempt %d): %v", recordType, i+1, err)
+				}
+			}
+			expectRecords(t, h, record.Spec.DNSName, record)
+		})
+	}
+}
+
+// testEnsureMultipleTargets verifies that ensuring an A record with several
+// targets publishes every target and that deleting the record removes every
+// target.
+func testEnsureMultipleTargets(t *testing.T, newHarness func(t *testing.T) *Harness) {
+	h := newHarness(t)
+	ctx := context.Background()
+	record := h.newRecord("*.apps", iov1.ARecordType, "192.0.2.1", "192.0.2.2", "192.0.2.3")
+	if err := h.Provider.Ensure(ctx, record, h.Zone); err != nil {
+		t.Fatalf("failed to ensure A record: %v", err)
+	}
+	expectRecords(t, h, record.Spec.DNSName, record)
+	if err := h.Provider.Delete(ctx, record, h.Zone); err != nil {
+		t.Fatalf("failed to delete A record: %v", err)
+	}
+	expectRecords(t, h, record.Spec.DNSName)
+}
+
+// testEnsurePublishesTTL verifies that ensuring a record publishes it with the
+// record's TTL or, if the provider adjusts TTLs, with the TTL that the
+// provider reports it publishes.
+func testEnsurePublishesTTL(t *testing.T, newHarness func(t *testing.T) *Harness) {
+	for _, ttl := range []int64{30, 3600} {
+		t.Run(fmt.Sprintf("TTL %d", ttl), func(t *testing.T) {
+			h := newHarness(t)
+			ctx := context.Background()
+			record := h.wildcardRecord(iov1.ARecordType)
+			record.Spec.RecordTTL = ttl
+			if err := h.Provider.Ensure(ctx, record, h.Zone); err != nil {
+				t.Fatalf("failed to ensure A record: %v", err)
+			}
+			records := h.Backend.Records(record.Spec.DNSName)
+			if len(records) != 1 {
+				t.Fatalf("expected 1 record for %s, got %v", record.Spec.DNSName, records)
+			}
+			if expected := h.publishedTTL(ctx, record); records[0].TTL != expected {
+				t.Errorf("expected record with TTL %d to be published with TTL %d, got %d", ttl, expected, records[0].TTL)
+			}
+		})
+	}
+}
+
+// testReplaceChangesTargets verifies that replacing a record with a record of
+// the same type publishes the new targets and removes the old ones.
+func testReplaceChangesTargets(t *testing.T, newHarness func(t *testing.T) *Harness) {
+	testCases := []struct {
+		name     string
+		from, to []string
+	}{
+		{name: "one target", from: []string{"192.0.2.1"}, to: []string{"192.0.2.2"}},
+		{name: "more targets", from: []string{"192.0.2.1"}, to: []string{"192.0.2.1", "192.0.2.2"}},
+		{name: "fewer targets", from: []string{"192.0.2.1", "192.0.2.2"}, to: []string{"192.0.2.2"}},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			h := newHarness(t)
+			ctx := context.Background()
+			from := h.newRecord("*.apps", iov1.ARecordType, tc.from...)
+			to := h.newRecord("*.apps", iov1.ARecordType, tc.to...)
+			if err := h.Provider.Ensure(ctx, from, h.Zone); err != nil {
+				t.Fatalf("failed to ensure A record: %v", err)
+			}
+			if err := h.Provider.Replace(ctx, to, h.Zone); err != nil {
+				t.Fatalf("failed to replace A record: %v", err)
+			}
+			expectRecords(t, h, to.Spec.DNSName, to)
+		})
+	}
+}
+
+// testReplaceChangesRecordType verifies that Replace replaces an A record with
+// a CNAME record with the same name, and vice versa.
+func testReplaceChangesRecordType(t *testing.T, newHarness func(t *testing.T) *Harness) {
+	for _, transition := range typeTransitions {
+		t.Run(fmt.Sprintf("%s to %s", transition.from, transition.to), func(t *testing.T) {
+			h := newHarness(t)
+			ctx := context.Background()
+			from, to := h.wildcardRecord(transition.from), h.wildcardRecord(transition.to)
+			if err := h.Provider.Ensure(ctx, from, h.Zone); err != nil {
+				t.Fatalf("failed to ensure %s record: %v", from.Spec.RecordType, err)
+			}
+			if err := h.Provider.Replace(ctx, to, h.Zone); err != nil {
+				t.Fatalf("failed to replace %s record with %s record: %v", from.Spec.RecordType, to.Spec.RecordType, err)
+			}
+			expectRecords(t, h, to.Spec.DNSName, to)
+		})
+	}
+}
+
+// testReplaceRestoresRecordOnFailure verifies that if Replace fails to publish
+// a record that replaces a record of a conflicting type, the record of the
+// conflicting type is still published.
+func testReplaceRestoresRecordOnFailure(t *testing.T, newHarness func(t *testing.T) *Harness) {
+	for _, transition := range typeTransitions {
+		t.Run(fmt.Sprintf("%s to %s", transition.from, transition.to), func(t *testing.T) {
+			h := newHarness(t)
+			injector, ok := h.Backend.(FailureInjector)
+			if !ok {
+				t.Skip("backend cannot inject failures")
+			}
+			ctx := context.Background()
+			from, to := h.wildcardRecord(transition.from), h.wildcardRecord(transition.to)
+			if err := h.Provider.Ensure(ctx, from, h.Zone); err != nil {
+				t.Fatalf("failed to ensure %s record: %v", from.Spec.RecordType, err)
+			}
+			injector.FailWrites(to.Spec.RecordType)
+			err := h.Provider.Replace(ctx, to, h.Zone)
+			injector.FailWrites("")
+			if err == nil {
+				t.Fatalf("expected replacing %s record with %s record to fail", from.Spec.RecordType, to.Spec.RecordType)
+			}
+			expectRecords(t, h, from.Spec.DNSName, from)
+		})
+	}
+}
+
+// testDeleteRemovesRecord verifies that deleting a published record removes
+// it.
+func testDeleteRemovesRecord(t *testing.T, newHarness func(t *testing.T) *Harness) {
+	for _, recordType := range []iov1.DNSRecordType{iov1.ARecordType, iov1.CNAMERecordType} {
+		t.Run(string(recordType), func(t *testing.T) {
+			h := newHarness(t)
+			ctx := context.Background()
+			record := h.wildcardRecord(recordType)
+			if err := h.Provider.Ensure(ctx, record, h.Zone); err != nil {
+				t.Fatalf("failed to ensure %s record: %v", recordType, err)
+			}
+			if err := h.Provider.Delete(ctx, record, h.Zone); err != nil {
+				t.Fatalf("failed to delete %s record: %v", recordType, err)
+			}
+			expectRecords(t, h, record.Spec.DNSName)
+		})
+	}
+}
+
+// testDeleteMissingRecord verifies that deleting a record that is not
+// published succeeds.
+func testDeleteMissingRecord(t *testing.T, newHarness func(t *testing.T) *Harness) {
+	for _, recordType := range []iov1.DNSRecordType{iov1.ARecordType, iov1.CNAMERecordType} {
+		t.Run(string(recordType), func(t *testing.T) {
+			h := newHarness(t)
+			record := h.wildcardRecord(recordType)
+			if err := h.Provider.Delete(context.Background(), record, h.Zone); err != nil {
+				t.Fatalf("failed to delete %s record that does not exist: %v", recordType, err)
+			}
+			expectRecords(t, h, record.Spec.DNSName)
+		})
+	}
+}
+
+// testInvalidZone verifies that the provider rejects records for zones that it
+// cannot parse rather than publishing them somewhere else.
+func testInvalidZone(t *testing.T, newHarness func(t *testing.T) *Harness) {
+	h := newHarness(t)
+	if len(h.InvalidZones) == 0 {
+		t.Skip("harness specifies no invalid zones")
+	}
+	ctx := context.Background()
+	record := h.wildcardRecord(iov1.ARecordType)
+	for _, zone := range h.InvalidZones {
+		if err := h.Provider.Ensure(ctx, record, zone); err == nil {
+			t.Errorf("expected ensuring record in zone %+v to fail", zone)
+		}
+		if err := h.Provider.Replace(ctx, record, zone); err == nil {
+			t.Errorf("expected replacing record in zone %+v to fail", zone)
+		}
+		if err := h.Provider.Delete(ctx, record, zone); err == nil {
+			t.Errorf("expected deleting record in zone %+v to fail", zone)
+		}
+	}
+	expectRecords(t, h, record.Spec.DNSName)
+}
+
+// registry returns the provider under test as a dns.OwnershipRegistry, or
+// skips the test if the provider does not implement it.
+func (h *Harness) registry(t *testing.T) dns.OwnershipRegistry {
+	t.Helper()
+	registry, ok := h.Provider.(dns.OwnershipRegistry)
+	if !ok {
+		t.Skip("provider does not implement dns.OwnershipRegistry")
+	}
+	return registry
+}
+
+// testOwnershipRegistry verifies that the owner of a record can be set,
+// updated, looked up, and deleted, and that records of different types with
+// the same name have distinct owners.
+func testOwnershipRegistry(t *testing.T, newHarness func(t *testing.T) *Harness) {
+	h := newHarness(t)
+	registry := h.registry(t)
+	ctx := context.Background()
+	a, cname := h.wildcardRecord(iov1.ARecordType), h.wildcardRecord(iov1.CNAMERecordType)
+	expectOwner(t, registry, h.Zone, a, "")
+	if err := registry.SetOwner(ctx, a, h.Zone, dns.RecordOwner("cluster-1", "default")); err != nil {
+		t.Fatalf("failed to set owner: %v", err)
+	}
+	expectOwner(t, registry, h.Zone, a, dns.RecordOwner("cluster-1", "default"))
+	expectOwner(t, registry, h.Zone, cname, "")
+	if err := registry.SetOwner(ctx, a, h.Zone, dns.RecordOwner("cluster-1", "other")); err != nil {
+		t.Fatalf("failed to update owner: %v", err)
+	}
+	expectOwner(t, registry, h.Zone, a, dns.RecordOwner("cluster-1", "other"))
+	if err := registry.SetOwner(ctx, cname, h.Zone, dns.RecordOwner("cluster-2", "default")); err != nil {
+		t.Fatalf("failed to set owner: %v", err)
+	}
+	if err := registry.DeleteOwner(ctx, a, h.Zone); err != nil {
+		t.Fatalf("failed to delete owner: %v", err)
+	}
+	expectOwner(t, registry, h.Zone, a, "")
+	expectOwner(t, registry, h.Zone, cname, dns.RecordOwner("cluster-2", "default"))
+	if err := registry.DeleteOwner(ctx, a, h.Zone); err != nil {
+		t.Fatalf("failed to delete owner that does not exist: %v", err)
+	}
+}
+
+// testRecordExists verifies that RecordExists reports a record with the same
+// name and the same or a conflicting type, and ignores companion TXT records.
+func testRecordExists(t *testing.T, newHarness func(t *testing.T) *Harness) {
+	h := newHarness(t)
+	registry := h.registry(t)
+	ctx := context.Background()
+	a, cname := h.wildcardRecord(iov1.ARecordType), h.wildcardRecord(iov1.CNAMERecordType)
+	if err := registry.SetOwner(ctx, a, h.Zone, dns.RecordOwner("cluster-1", "default")); err != nil {
+		t.Fatalf("failed to set owner: %v", err)
+	}
+	expectRecordExists(t, registry, h.Zone, a, false)
+	if err := h.Provider.Ensure(ctx, a, h.Zone); err != nil {
+		t.Fatalf("failed to ensure A record: %v", err)
+	}
+	expectRecordExists(t, registry, h.Zone, a, true)
+	expectRecordExists(t, registry, h.Zone, cname, true)
+	expectRecordExists(t, registry, h.Zone, h.newRecord("other", iov1.ARecordType, "192.0.2.1"), false)
+}
+
+// testReplaceDeletesReplacedOwner verifies that after Replace replaces a record
+// of a conflicting type, the companion TXT record of the replaced record can
+// be deleted, as the DNS controller does, without affecting the owner of the
+// record that replaced it.
+func testReplaceDeletesReplacedOwner(t *testing.T, newHarness func(t *testing.T) *Harness) {
+	for _, transition := range typeTransitions {
+		t.Run(fmt.Sprintf("%s to %s", transition.from, transition.to), func(t *testing.T) {
+			h := newHarness(t)
+			registry := h.registry(t)
+			ctx := context.Background()
+			owner := dns.RecordOwner("cluster-1", "default")
+			from, to := h.wildcardRecord(transition.from), h.wildcardRecord(transition.to)
+			if err := registry.SetOwner(ctx, from, h.Zone, owner); err != nil {
+				t.Fatalf("failed to set owner of %s record: %v", from.Spec.RecordType, err)
+			}
+			if err := h.Provider.Ensure(ctx, from, h.Zone); err != nil {
+				t.Fatalf("failed to ensure %s record: %v", from.Spec.RecordType, err)
+			}
+			if err := registry.SetOwner(ctx, to, h.Zone, owner); err != nil {
+				t.Fatalf("failed to set owner of %s record: %v", to.Spec.RecordType, err)
+			}
+			if err := h.Provider.Replace(ctx, to, h.Zone); err != nil {
+				t.Fatalf("failed to replace %s record with %s record: %v", from.Spec.RecordType, to.Spec.RecordType, err)
+			}
+			replaced := dns.ReplacedRecords(to)
+			if len(replaced) != 1 || replaced[0].Spec.RecordType != from.Spec.RecordType {
+				t.Fatalf("expected %s record to be replaced by %s record, got %+v", from.Spec.RecordType, to.Spec.RecordType, replaced)
+			}
+			expectOwner(t, registry, h.Zone, replaced[0], owner)
+			if err := registry.DeleteOwner(ctx, replaced[0], h.Zone); err != nil {
+				t.Fatalf("failed to delete owner of replaced %s record: %v", from.Spec.RecordType, err)
+			}
+			expectOwner(t, registry, h.Zone, from, "")
+			expectOwner(t, registry, h.Zone, to, owner)
+			expectRecords(t, h, to.Spec.DNSName, to)
+		})
+	}
+}
+
+// expectOwner verifies that the owner of the given record is the given owner.
+func expectOwner(t *testing.T, registry dns.OwnershipRegistry, zone configv1.DNSZone, record *iov1.DNSRecord, expected string) {
+	t.Helper()
+	owner, err := registry.GetOwner(context.Background(), record, zone)
+	if err != nil {
+		t.Fatalf("failed to get owner of %s record: %v", record.Spec.RecordType, err)
+	}
+	if owner != expected {
+		t.Errorf("expected owner %q of %s record, got %q", expected, record.Spec.RecordType, owner)
+	}
+}
+
+// expectRecordExists verifies that RecordExists returns the given value for
+// the given record.
+func expectRecordExists(t *testing.T, registry dns.OwnershipRegistry, zone configv1.DNSZone, record *iov1.DNSRecord, expected bool) {
+	t.Helper()
+	exists, err := registry.RecordExists(context.Background(), record, zone)
+	if err != nil {
+		t.Fatalf("failed to look up %s record %s: %v", record.Spec.RecordType, record.Spec.DNSName, err)
+	}
+	if exists != expected {
+		t.Errorf("expected RecordExists to return %t for %s record %s, got %t", expected, record.Spec.RecordType, record.Spec.DNSName, exists)
+	}
+}
+
+// expectRecords verifies that the records with the given name in the
+// harness's zone have the types and targets of the given DNSRecords.
+func expectRecords(t *testing.T, h *Harness, dnsName string, expected ...*iov1.DNSRecord) {
+	t.Helper()
+	var want, got []string
+	for _, record := range expected {
+		want = append(want, recordString(record.Spec.RecordType, record.Spec.Targets))
+	}
+	for _, record := range h.Backend.Records(dnsName) {
+		got = append(got, recordString(record.Type, record.Targets))
+	}
+	sort.Strings(want)
+	sort.Strings(got)
+	if !reflect.DeepEqual(want, got) {
+		t.Errorf("expected records %v for %s, got %v", want, dnsName, got)
+	}
+}
+
+// recordString returns a string that identifies a record by its type and
+// targets.
+func recordString(recordType iov1.DNSRecordType, targets []string) string {
+	normalized := make([]string, len(targets))
+	for i := range targets {
+		normalized[i] = strings.TrimSuffix(targets[i], ".")
+	}
+	sort.Strings(normalized)
+	return fmt.Sprintf("%s %s", recordType, strings.Join(normalized, ","))
+}
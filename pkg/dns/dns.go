@@ -1,27 +1,64 @@
 package dns
 
 import (
+	"context"
+
 	iov1 "github.com/openshift/api/operatoringress/v1"
 
 	configv1 "github.com/openshift/api/config/v1"
 )
 
-// Provider knows how to manage DNS zones only as pertains to routing.
+// RecordTypesConflict returns a Boolean value indicating whether a record of
+// the given desired type cannot be published while a record of the given
+// current type with the same name exists, namely because one of the records is
+// an A record and the other is a CNAME record.  A provider's Replace method
+// removes a conflicting record when it publishes the desired record.
+func RecordTypesConflict(current, desired string) bool {
+	isAOrCNAME := func(recordType string) bool {
+		return recordType == string(iov1.ARecordType) || recordType == string(iov1.CNAMERecordType)
+	}
+	return current != desired && isAOrCNAME(current) && isAOrCNAME(desired)
+}
+
+// Provider knows how to manage DNS zones only as pertains to routing.  The
+// given context bounds the calls that a method makes to the provider's API;
+// when the context is canceled or its deadline is exceeded, the method returns
+// an error that wraps the context's error.
 type Provider interface {
 	// Ensure will create or update record.
-	Ensure(record *iov1.DNSRecord, zone configv1.DNSZone) error
+	Ensure(ctx context.Context, record *iov1.DNSRecord, zone configv1.DNSZone) error
+
+	// Delete will delete record.  Deleting a record that is not
+	// published is not an error.
+	Delete(ctx context.Context, record *iov1.DNSRecord, zone configv1.DNSZone) error
 
-	// Delete will delete record.
-	Delete(record *iov1.DNSRecord, zone configv1.DNSZone) error
+	// Replace will replace the record.  If a record with the same name
+	// but a conflicting type (see RecordTypesConflict) exists, Replace
+	// removes it, atomically if the provider's API allows it and otherwise
+	// by deleting it before publishing the record and restoring it if
+	// publishing the record fails.
+	Replace(ctx context.Context, record *iov1.DNSRecord, zone configv1.DNSZone) error
+}
 
-	// Replace will replace the record
-	Replace(record *iov1.DNSRecord, zone configv1.DNSZone) error
+// TTLAdjuster is implemented by a Provider that publishes a record with a TTL
+// other than the one that the record specifies if the specified TTL is outside
+// the range that the provider supports.
+type TTLAdjuster interface {
+	// PublishedTTL returns the TTL with which the provider publishes the
+	// given record to the given zone.
+	PublishedTTL(ctx context.Context, record *iov1.DNSRecord, zone configv1.DNSZone) int64
 }
 
 var _ Provider = &FakeProvider{}
 
 type FakeProvider struct{}
 
-func (_ *FakeProvider) Ensure(record *iov1.DNSRecord, zone configv1.DNSZone) error  { return nil }
-func (_ *FakeProvider) Delete(record *iov1.DNSRecord, zone configv1.DNSZone) error  { return nil }
-func (_ *FakeProvider) Replace(record *iov1.DNSRecord, zone configv1.DNSZone) error { return nil }
+func (_ *FakeProvider) Ensure(ctx context.Context, record *iov1.DNSRecord, zone configv1.DNSZone) error {
+	return nil
+}
+func (_ *FakeProvider) Delete(ctx context.Context, record *iov1.DNSRecord, zone configv1.DNSZone) error {
+	return nil
+}
+func (_ *FakeProvider) Replace(ctx context.Context, record *iov1.DNSRecord, zone configv1.DNSZone) error {
+	return nil
+}
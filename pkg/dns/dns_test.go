@@ -0,0 +1,25 @@
+package dns
+
+import (
+	"testing"
+)
+
+func TestRecordTypesConflict(t *testing.T) {
+	testCases := []struct {
+		current, desired string
+		expected         bool
+	}{
+		{"A", "CNAME", true},
+		{"CNAME", "A", true},
+		{"A", "A", false},
+		{"CNAME", "CNAME", false},
+		{"AAAA", "A", false},
+		{"AAAA", "CNAME", false},
+		{"TXT", "CNAME", false},
+	}
+	for _, tc := range testCases {
+		if actual := RecordTypesConflict(tc.current, tc.desired); actual != tc.expected {
+			t.Errorf("RecordTypesConflict(%q, %q): expected %t, got %t", tc.current, tc.desired, tc.expected, actual)
+		}
+	}
+}
@@ -0,0 +1,48 @@
+package gcp
+
+import (
+	"testing"
+
+	configv1 "github.com/openshift/api/config/v1"
+	iov1 "github.com/openshift/api/operatoringress/v1"
+	"github.com/openshift/cluster-ingress-operator/pkg/dns/conformance"
+)
+
+// conformanceBackend is a conformance.Backend for a fakeCloudDNS.
+type conformanceBackend struct {
+	fake *fakeCloudDNS
+	zone string
+}
+
+func (b *conformanceBackend) Records(dnsName string) []conformance.Record {
+	var records []conformance.Record
+	for _, set := range b.fake.sets[b.zone] {
+		if set.Name != dnsName {
+			continue
+		}
+		records = append(records, conformance.Record{
+			Type:    iov1.DNSRecordType(set.Type),
+			Targets: set.Rrdatas,
+			TTL:     set.Ttl,
+		})
+	}
+	return records
+}
+
+func (b *conformanceBackend) FailWrites(recordType iov1.DNSRecordType) {
+	b.fake.failWrites = string(recordType)
+}
+
+func TestConformance(t *testing.T) {
+	conformance.Run(t, func(t *testing.T) *conformance.Harness {
+		fake := newFakeCloudDNS()
+		return &conformance.Harness{
+			Provider:     &Provider{config: Config{Project: "project"}, client: fake},
+			Backend:      &conformanceBackend{fake: fake, zone: "zone"},
+			Zone:         configv1.DNSZone{ID: "zone"},
+			Domain:       "example.com",
+			CNAMETarget:  "lb.example.com.",
+			InvalidZones: []configv1.DNSZone{{}},
+		}
+	})
+}
@@ -0,0 +1,87 @@
+package gcp
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"reflect"
+
+	"google.golang.org/api/googleapi"
+
+	gdnsv1 "google.golang.org/api/dns/v1"
+)
+
+// fakeCloudDNS is a fake cloudDNSClient that stores resource record sets in
+// memory.
+type fakeCloudDNS struct {
+	// sets maps a zone to its resource record sets.
+	sets map[string][]*gdnsv1.ResourceRecordSet
+	// failWrites makes createChange fail every change that adds a
+	// resource record set of the given type.
+	failWrites string
+}
+
+func newFakeCloudDNS() *fakeCloudDNS {
+	return &fakeCloudDNS{sets: map[string][]*gdnsv1.ResourceRecordSet{}}
+}
+
+// createChange applies the given change atomically.  Like Cloud DNS, it
+// rejects a change that deletes a resource record set that does not exist or
+// does not match the given one, that adds a resource record set that already
+// exists, or that leaves a CNAME resource record set with the same name as
+// another resource record set.
+func (f *fakeCloudDNS) createChange(ctx context.Context, project, zone string, change *gdnsv1.Change) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	for _, addition := range change.Additions {
+		if len(f.failWrites) != 0 && addition.Type == f.failWrites {
+			return &googleapi.Error{Code: http.StatusInternalServerError, Message: "injected failure"}
+		}
+	}
+	sets := f.sets[zone]
+	for _, deletion := range change.Deletions {
+		index := -1
+		for i, set := range sets {
+			if set.Name == deletion.Name && set.Type == deletion.Type {
+				index = i
+			}
+		}
+		if index == -1 {
+			return &googleapi.Error{Code: http.StatusNotFound, Message: fmt.Sprintf("resource record set %s %s not found", deletion.Name, deletion.Type)}
+		}
+		if !reflect.DeepEqual(sets[index].Rrdatas, deletion.Rrdatas) || sets[index].Ttl != deletion.Ttl {
+			return &googleapi.Error{Code: http.StatusPreconditionFailed, Message: "conditionNotMet"}
+		}
+		sets = append(sets[:index:index], sets[index+1:]...)
+	}
+	for _, addition := range change.Additions {
+		for _, set := range sets {
+			if set.Name != addition.Name {
+				continue
+			}
+			if set.Type == addition.Type {
+				return &googleapi.Error{Code: http.StatusConflict, Message: "alreadyExists"}
+			}
+			if set.Type == "CNAME" || addition.Type == "CNAME" {
+				return &googleapi.Error{Code: http.StatusBadRequest, Message: "cnameResourceRecordSetConflict"}
+			}
+		}
+		sets = append(sets, addition)
+	}
+	f.sets[zone] = sets
+	return nil
+}
+
+func (f *fakeCloudDNS) listResourceRecordSets(ctx context.Context, project, zone, name, recordType string) ([]*gdnsv1.ResourceRecordSet, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	var sets []*gdnsv1.ResourceRecordSet
+	for _, set := range f.sets[zone] {
+		if set.Name == name && (len(recordType) == 0 || set.Type == recordType) {
+			sets = append(sets, set)
+		}
+	}
+	return sets, nil
+}
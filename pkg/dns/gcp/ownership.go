@@ -0,0 +1,107 @@
+package gcp
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"google.golang.org/api/googleapi"
+
+	configv1 "github.com/openshift/api/config/v1"
+
+	iov1 "github.com/openshift/api/operatoringress/v1"
+	"github.com/openshift/cluster-ingress-operator/pkg/dns"
+
+	gdnsv1 "google.golang.org/api/dns/v1"
+)
+
+var _ dns.OwnershipRegistry = &Provider{}
+
+// GetOwner returns the owner that the companion TXT record of the given record
+// specifies, or the empty string if there is no companion TXT record.
+func (p *Provider) GetOwner(ctx context.Context, record *iov1.DNSRecord, zone configv1.DNSZone) (string, error) {
+	resourceRecordSet, err := p.getOwnershipRecordSet(ctx, record, zone)
+	if err != nil || resourceRecordSet == nil {
+		return "", err
+	}
+	for _, rrdata := range resourceRecordSet.Rrdatas {
+		if owner, ok := dns.ParseOwnershipRecordValue(rrdata); ok {
+			return owner, nil
+		}
+	}
+	return "", nil
+}
+
+// SetOwner creates or updates the companion TXT record of the given record so
+// that it specifies the given owner.
+func (p *Provider) SetOwner(ctx context.Context, record *iov1.DNSRecord, zone configv1.DNSZone, owner string) error {
+	current, err := p.getOwnershipRecordSet(ctx, record, zone)
+	if err != nil {
+		return err
+	}
+	change := &gdnsv1.Change{
+		Additions: []*gdnsv1.ResourceRecordSet{{
+			Name:    dns.OwnershipRecordName(record),
+			Rrdatas: []string{fmt.Sprintf("%q", dns.OwnershipRecordValue(owner))},
+			Type:    "TXT",
+			Ttl:     record.Spec.RecordTTL,
+		}},
+	}
+	if current != nil {
+		change.Deletions = []*gdnsv1.ResourceRecordSet{current}
+	}
+	if err := p.client.createChange(ctx, p.config.Project, zone.ID, change); err != nil {
+		return fmt.Errorf("failed to update companion TXT record in zone %s: %w", zone.ID, err)
+	}
+	log.Info("updated companion TXT record", "zone", zone.ID, "name", dns.OwnershipRecordName(record), "owner", owner)
+	return nil
+}
+
+// DeleteOwner deletes the companion TXT record of the given record, if it
+// exists.
+func (p *Provider) DeleteOwner(ctx context.Context, record *iov1.DNSRecord, zone configv1.DNSZone) error {
+	current, err := p.getOwnershipRecordSet(ctx, record, zone)
+	if err != nil || current == nil {
+		return err
+	}
+	change := &gdnsv1.Change{Deletions: []*gdnsv1.ResourceRecordSet{current}}
+	err = p.client.createChange(ctx, p.config.Project, zone.ID, change)
+	if ae, ok := err.(*googleapi.Error); ok && ae.Code == http.StatusNotFound {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to delete companion TXT record in zone %s: %w", zone.ID, err)
+	}
+	log.Info("deleted companion TXT record", "zone", zone.ID, "name", dns.OwnershipRecordName(record))
+	return nil
+}
+
+// RecordExists returns a Boolean value indicating whether the given zone has a
+// resource record set with the name of the given record that publishing the
+// record would overwrite, namely one with the same type, or delete, namely one
+// with a conflicting type.
+func (p *Provider) RecordExists(ctx context.Context, record *iov1.DNSRecord, zone configv1.DNSZone) (bool, error) {
+	resourceRecordSets, err := p.client.listResourceRecordSets(ctx, p.config.Project, zone.ID, record.Spec.DNSName, "")
+	if err != nil {
+		return false, fmt.Errorf("failed to list resource record sets in zone %s: %w", zone.ID, err)
+	}
+	for _, resourceRecordSet := range resourceRecordSets {
+		if resourceRecordSet.Type == string(record.Spec.RecordType) || dns.RecordTypesConflict(resourceRecordSet.Type, string(record.Spec.RecordType)) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// getOwnershipRecordSet returns the companion TXT record set of the given
+// record, or nil if the record has no companion TXT record.
+func (p *Provider) getOwnershipRecordSet(ctx context.Context, record *iov1.DNSRecord, zone configv1.DNSZone) (*gdnsv1.ResourceRecordSet, error) {
+	resourceRecordSets, err := p.client.listResourceRecordSets(ctx, p.config.Project, zone.ID, dns.OwnershipRecordName(record), "TXT")
+	if err != nil {
+		return nil, fmt.Errorf("failed to list resource record sets in zone %s: %w", zone.ID, err)
+	}
+	if len(resourceRecordSets) == 0 {
+		return nil, nil
+	}
+	return resourceRecordSets[0], nil
+}
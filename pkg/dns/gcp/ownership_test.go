@@ -0,0 +1,118 @@
+package gcp
+
+import (
+	"context"
+	"testing"
+
+	configv1 "github.com/openshift/api/config/v1"
+	iov1 "github.com/openshift/api/operatoringress/v1"
+
+	gdnsv1 "google.golang.org/api/dns/v1"
+)
+
+// TestOwnershipRegistry verifies that the provider stores the owner of a
+// record in a companion TXT record, updates the owner in place, and deletes
+// the companion TXT record when asked to.
+func TestOwnershipRegistry(t *testing.T) {
+	ctx := context.Background()
+	fake := newFakeCloudDNS()
+	p := &Provider{config: Config{Project: "project"}, client: fake}
+	zone := configv1.DNSZone{ID: "zone"}
+	record := &iov1.DNSRecord{
+		Spec: iov1.DNSRecordSpec{
+			DNSName:    "*.apps.example.com.",
+			RecordType: iov1.ARecordType,
+			Targets:    []string{"192.0.2.1"},
+			RecordTTL:  30,
+		},
+	}
+
+	if owner, err := p.GetOwner(ctx, record, zone); err != nil || len(owner) != 0 {
+		t.Fatalf("expected no owner, got %q, %v", owner, err)
+	}
+	for _, owner := range []string{"infra-id=a,ingresscontroller=default", "infra-id=b,ingresscontroller=default"} {
+		if err := p.SetOwner(ctx, record, zone, owner); err != nil {
+			t.Fatalf("failed to set owner %q: %v", owner, err)
+		}
+		if actual, err := p.GetOwner(ctx, record, zone); err != nil || actual != owner {
+			t.Errorf("expected owner %q, got %q, %v", owner, actual, err)
+		}
+	}
+	sets := fake.sets["zone"]
+	if len(sets) != 1 {
+		t.Fatalf("expected 1 resource record set, got %#v", sets)
+	}
+	if sets[0].Name != "_ingress-owner-a.apps.example.com." || sets[0].Type != "TXT" {
+		t.Errorf("unexpected companion TXT record %s %s", sets[0].Name, sets[0].Type)
+	}
+	if expected := `"heritage=openshift-ingress-operator,infra-id=b,ingresscontroller=default"`; len(sets[0].Rrdatas) != 1 || sets[0].Rrdatas[0] != expected {
+		t.Errorf("expected companion TXT record value %s, got %v", expected, sets[0].Rrdatas)
+	}
+
+	for i := 0; i < 2; i++ {
+		if err := p.DeleteOwner(ctx, record, zone); err != nil {
+			t.Fatalf("failed to delete owner: %v", err)
+		}
+	}
+	if owner, err := p.GetOwner(ctx, record, zone); err != nil || len(owner) != 0 {
+		t.Errorf("expected no owner, got %q, %v", owner, err)
+	}
+	if len(fake.sets["zone"]) != 0 {
+		t.Errorf("expected no resource record sets, got %#v", fake.sets["zone"])
+	}
+}
+
+// TestRecordExists verifies that RecordExists reports resource record sets
+// that publishing a record would overwrite or delete.
+func TestRecordExists(t *testing.T) {
+	const name = "www.example.com."
+	testCases := []struct {
+		description string
+		recordType  iov1.DNSRecordType
+		sets        []*gdnsv1.ResourceRecordSet
+		expected    bool
+	}{
+		{
+			description: "no resource record sets",
+			recordType:  iov1.ARecordType,
+		},
+		{
+			description: "resource record set of the same type",
+			recordType:  iov1.ARecordType,
+			sets:        []*gdnsv1.ResourceRecordSet{{Name: name, Type: "A"}},
+			expected:    true,
+		},
+		{
+			description: "conflicting CNAME resource record set",
+			recordType:  iov1.ARecordType,
+			sets:        []*gdnsv1.ResourceRecordSet{{Name: name, Type: "CNAME"}},
+			expected:    true,
+		},
+		{
+			description: "resource record set of another type that can coexist",
+			recordType:  iov1.ARecordType,
+			sets:        []*gdnsv1.ResourceRecordSet{{Name: name, Type: "AAAA"}},
+		},
+		{
+			description: "companion TXT record only",
+			recordType:  iov1.ARecordType,
+			sets:        []*gdnsv1.ResourceRecordSet{{Name: "_ingress-owner-a." + name, Type: "TXT"}},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			fake := newFakeCloudDNS()
+			fake.sets["zone"] = tc.sets
+			p := &Provider{config: Config{Project: "project"}, client: fake}
+			record := &iov1.DNSRecord{Spec: iov1.DNSRecordSpec{DNSName: name, RecordType: tc.recordType}}
+			exists, err := p.RecordExists(context.Background(), record, configv1.DNSZone{ID: "zone"})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if exists != tc.expected {
+				t.Errorf("expected %t, got %t", tc.expected, exists)
+			}
+		})
+	}
+}
@@ -2,6 +2,7 @@ package gcp
 
 import (
 	"context"
+	"fmt"
 	"net/http"
 
 	"google.golang.org/api/googleapi"
@@ -24,8 +25,44 @@ var (
 type Provider struct {
 	// config is required input.
 	config Config
-	// dnsService provides DNS API access.
-	dnsService *gdnsv1.Service
+	// client provides DNS API access.
+	client cloudDNSClient
+}
+
+// cloudDNSClient is the subset of the Cloud DNS API that the provider uses.
+type cloudDNSClient interface {
+	// createChange applies the given change to the given zone.  Cloud DNS
+	// applies the additions and deletions of a change atomically.
+	createChange(ctx context.Context, project, zone string, change *gdnsv1.Change) error
+	// listResourceRecordSets returns the resource record sets in the given
+	// zone with the given name and, if recordType is not empty, the given
+	// type.
+	listResourceRecordSets(ctx context.Context, project, zone, name, recordType string) ([]*gdnsv1.ResourceRecordSet, error)
+}
+
+// serviceClient is a cloudDNSClient that uses the Cloud DNS API.
+type serviceClient struct {
+	service *gdnsv1.Service
+}
+
+func (c *serviceClient) createChange(ctx context.Context, project, zone string, change *gdnsv1.Change) error {
+	_, err := c.service.Changes.Create(project, zone, change).Context(ctx).Do()
+	return err
+}
+
+func (c *serviceClient) listResourceRecordSets(ctx context.Context, project, zone, name, recordType string) ([]*gdnsv1.ResourceRecordSet, error) {
+	call := c.service.ResourceRecordSets.List(project, zone).Name(name)
+	if len(recordType) != 0 {
+		call = call.Type(recordType)
+	}
+	var resourceRecordSets []*gdnsv1.ResourceRecordSet
+	if err := call.Pages(ctx, func(page *gdnsv1.ResourceRecordSetsListResponse) error {
+		resourceRecordSets = append(resourceRecordSets, page.Rrsets...)
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+	return resourceRecordSets, nil
 }
 
 type Config struct {
@@ -41,17 +78,19 @@ func New(config Config) (*Provider, error) {
 	}
 
 	provider := &Provider{
-		config:     config,
-		dnsService: dnsService,
+		config: config,
+		client: &serviceClient{service: dnsService},
 	}
 
 	return provider, nil
 }
 
-func (p *Provider) Ensure(record *iov1.DNSRecord, zone configv1.DNSZone) error {
+func (p *Provider) Ensure(ctx context.Context, record *iov1.DNSRecord, zone configv1.DNSZone) error {
+	if err := validateZone(zone); err != nil {
+		return err
+	}
 	change := &gdnsv1.Change{Additions: []*gdnsv1.ResourceRecordSet{resourceRecordSet(record)}}
-	call := p.dnsService.Changes.Create(p.config.Project, zone.ID, change)
-	_, err := call.Do()
+	err := p.client.createChange(ctx, p.config.Project, zone.ID, change)
 	// Since we don't yet handle updates, assume that existing records are correct.
 	if ae, ok := err.(*googleapi.Error); ok && ae.Code == http.StatusConflict {
 		return nil
@@ -59,40 +98,50 @@ func (p *Provider) Ensure(record *iov1.DNSRecord, zone configv1.DNSZone) error {
 	return err
 }
 
-func (p *Provider) Replace(record *iov1.DNSRecord, zone configv1.DNSZone) error {
-	ctx := context.Background()
-	oldRecord := p.dnsService.ResourceRecordSets.List(p.config.Project, zone.ID).Name(record.Spec.DNSName)
-	if err := oldRecord.Pages(ctx, func(page *gdnsv1.ResourceRecordSetsListResponse) error {
-		for _, resourceRecordSet := range page.Rrsets {
-			log.Info("found old DNS resource record set", "resourceRecordSet", resourceRecordSet)
-			change := &gdnsv1.Change{Deletions: []*gdnsv1.ResourceRecordSet{resourceRecordSet}}
-			call := p.dnsService.Changes.Create(p.config.Project, zone.ID, change)
-			_, err := call.Do()
-			if ae, ok := err.(*googleapi.Error); ok && ae.Code == http.StatusNotFound {
-				return nil
-			}
-			return err
-		}
-		return nil
-	}); err != nil {
+// Replace adds the given record and deletes the resource record sets that it
+// replaces, namely any resource record set with the same name and the same or
+// a conflicting type, in a single change, which Cloud DNS applies atomically.
+func (p *Provider) Replace(ctx context.Context, record *iov1.DNSRecord, zone configv1.DNSZone) error {
+	if err := validateZone(zone); err != nil {
 		return err
 	}
-	if err := p.Ensure(record, zone); err != nil {
+	resourceRecordSets, err := p.client.listResourceRecordSets(ctx, p.config.Project, zone.ID, record.Spec.DNSName, "")
+	if err != nil {
 		return err
 	}
-	return nil
+	change := &gdnsv1.Change{Additions: []*gdnsv1.ResourceRecordSet{resourceRecordSet(record)}}
+	for _, resourceRecordSet := range resourceRecordSets {
+		if resourceRecordSet.Type != string(record.Spec.RecordType) && !dns.RecordTypesConflict(resourceRecordSet.Type, string(record.Spec.RecordType)) {
+			continue
+		}
+		log.Info("found old DNS resource record set", "resourceRecordSet", resourceRecordSet)
+		change.Deletions = append(change.Deletions, resourceRecordSet)
+	}
+	return p.client.createChange(ctx, p.config.Project, zone.ID, change)
 }
 
-func (p *Provider) Delete(record *iov1.DNSRecord, zone configv1.DNSZone) error {
+func (p *Provider) Delete(ctx context.Context, record *iov1.DNSRecord, zone configv1.DNSZone) error {
+	if err := validateZone(zone); err != nil {
+		return err
+	}
 	change := &gdnsv1.Change{Deletions: []*gdnsv1.ResourceRecordSet{resourceRecordSet(record)}}
-	call := p.dnsService.Changes.Create(p.config.Project, zone.ID, change)
-	_, err := call.Do()
+	err := p.client.createChange(ctx, p.config.Project, zone.ID, change)
 	if ae, ok := err.(*googleapi.Error); ok && ae.Code == http.StatusNotFound {
 		return nil
 	}
 	return err
 }
 
+// validateZone returns an error if the given zone does not specify the name of
+// a managed zone.  Cloud DNS identifies managed zones by name only, so a zone
+// that is specified by tags cannot be used.
+func validateZone(zone configv1.DNSZone) error {
+	if len(zone.ID) == 0 {
+		return fmt.Errorf("zone has no ID")
+	}
+	return nil
+}
+
 func resourceRecordSet(record *iov1.DNSRecord) *gdnsv1.ResourceRecordSet {
 	return &gdnsv1.ResourceRecordSet{
 		Name:    record.Spec.DNSName,
@@ -1,6 +1,7 @@
 package client
 
 import (
+	"context"
 	"errors"
 
 	"github.com/IBM/go-sdk-core/v5/core"
@@ -46,7 +47,7 @@ func (c *FakeDnsClient) RecordedCall(zoneID string) (string, bool) {
 func (FakeDnsClient) NewListResourceRecordsOptions(instanceID string, dnszoneID string) *dnssvcsv1.ListResourceRecordsOptions {
 	return &dnssvcsv1.ListResourceRecordsOptions{}
 }
-func (fdc FakeDnsClient) ListResourceRecords(listResourceRecordsOptions *dnssvcsv1.ListResourceRecordsOptions) (result *dnssvcsv1.ListResourceRecords, response *core.DetailedResponse, err error) {
+func (fdc FakeDnsClient) ListResourceRecordsWithContext(ctx context.Context, listResourceRecordsOptions *dnssvcsv1.ListResourceRecordsOptions) (result *dnssvcsv1.ListResourceRecords, response *core.DetailedResponse, err error) {
 	fakeListDnsrecordsResp := &dnssvcsv1.ListResourceRecords{}
 	recordType := string(iov1.ARecordType)
 	rData := map[string]interface{}{"ip": fdc.ListAllDnsRecordsInputOutput.RecordTarget}
@@ -65,7 +66,7 @@ func (fdc FakeDnsClient) ListResourceRecords(listResourceRecordsOptions *dnssvcs
 func (FakeDnsClient) NewDeleteResourceRecordOptions(instanceID string, dnszoneID string, recordID string) *dnssvcsv1.DeleteResourceRecordOptions {
 	return &dnssvcsv1.DeleteResourceRecordOptions{InstanceID: &instanceID, DnszoneID: &dnszoneID, RecordID: &recordID}
 }
-func (fdc FakeDnsClient) DeleteResourceRecord(deleteResourceRecordOptions *dnssvcsv1.DeleteResourceRecordOptions) (response *core.DetailedResponse, err error) {
+func (fdc FakeDnsClient) DeleteResourceRecordWithContext(ctx context.Context, deleteResourceRecordOptions *dnssvcsv1.DeleteResourceRecordOptions) (response *core.DetailedResponse, err error) {
 	if fdc.DeleteDnsRecordInputOutput.InputId != *deleteResourceRecordOptions.RecordID {
 		return nil, errors.New("deleteDnsRecord: inputs don't match")
 	}
@@ -89,7 +90,10 @@ func (FakeDnsClient) NewResourceRecordUpdateInputRdataRdataCnameRecord(cname str
 func (FakeDnsClient) NewResourceRecordUpdateInputRdataRdataARecord(ip string) (_model *dnssvcsv1.ResourceRecordUpdateInputRdataRdataARecord, err error) {
 	return &dnssvcsv1.ResourceRecordUpdateInputRdataRdataARecord{Ip: &ip}, nil
 }
-func (fdc FakeDnsClient) UpdateResourceRecord(updateResourceRecordOptions *dnssvcsv1.UpdateResourceRecordOptions) (result *dnssvcsv1.ResourceRecord, response *core.DetailedResponse, err error) {
+func (FakeDnsClient) NewResourceRecordUpdateInputRdataRdataTxtRecord(text string) (_model *dnssvcsv1.ResourceRecordUpdateInputRdataRdataTxtRecord, err error) {
+	return &dnssvcsv1.ResourceRecordUpdateInputRdataRdataTxtRecord{Text: &text}, nil
+}
+func (fdc FakeDnsClient) UpdateResourceRecordWithContext(ctx context.Context, updateResourceRecordOptions *dnssvcsv1.UpdateResourceRecordOptions) (result *dnssvcsv1.ResourceRecord, response *core.DetailedResponse, err error) {
 	if fdc.UpdateDnsRecordInputOutput.InputId != *updateResourceRecordOptions.RecordID {
 		return nil, nil, errors.New("updateDnsRecord: inputs don't match")
 	}
@@ -113,12 +117,15 @@ func (FakeDnsClient) NewResourceRecordInputRdataRdataCnameRecord(cname string) (
 func (FakeDnsClient) NewResourceRecordInputRdataRdataARecord(ip string) (_model *dnssvcsv1.ResourceRecordInputRdataRdataARecord, err error) {
 	return nil, nil
 }
-func (FakeDnsClient) CreateResourceRecord(createResourceRecordOptions *dnssvcsv1.CreateResourceRecordOptions) (result *dnssvcsv1.ResourceRecord, response *core.DetailedResponse, err error) {
+func (FakeDnsClient) NewResourceRecordInputRdataRdataTxtRecord(text string) (_model *dnssvcsv1.ResourceRecordInputRdataRdataTxtRecord, err error) {
+	return nil, nil
+}
+func (FakeDnsClient) CreateResourceRecordWithContext(ctx context.Context, createResourceRecordOptions *dnssvcsv1.CreateResourceRecordOptions) (result *dnssvcsv1.ResourceRecord, response *core.DetailedResponse, err error) {
 	return nil, nil, nil
 }
 func (FakeDnsClient) NewGetDnszoneOptions(instanceID string, dnszoneID string) *dnssvcsv1.GetDnszoneOptions {
 	return nil
 }
-func (FakeDnsClient) GetDnszone(getDnszoneOptions *dnssvcsv1.GetDnszoneOptions) (result *dnssvcsv1.Dnszone, response *core.DetailedResponse, err error) {
+func (FakeDnsClient) GetDnszoneWithContext(ctx context.Context, getDnszoneOptions *dnssvcsv1.GetDnszoneOptions) (result *dnssvcsv1.Dnszone, response *core.DetailedResponse, err error) {
 	return nil, nil, nil
 }
@@ -0,0 +1,57 @@
+package private
+
+import (
+	"strings"
+	"testing"
+
+	configv1 "github.com/openshift/api/config/v1"
+	iov1 "github.com/openshift/api/operatoringress/v1"
+	"github.com/openshift/cluster-ingress-operator/pkg/dns/conformance"
+	common "github.com/openshift/cluster-ingress-operator/pkg/dns/ibm"
+)
+
+// conformanceBackend is a conformance.Backend for a fakeDNSSvcs.
+type conformanceBackend struct {
+	fake *fakeDNSSvcs
+}
+
+// Records returns the records with the given name, combining the records with
+// the same type, one for each target, into a single record.
+func (b *conformanceBackend) Records(dnsName string) []conformance.Record {
+	name := strings.TrimSuffix(dnsName, ".")
+	var records []conformance.Record
+	indexes := map[string]int{}
+	for _, record := range b.fake.records {
+		if *record.Name != name {
+			continue
+		}
+		i, ok := indexes[*record.Type]
+		if !ok {
+			i = len(records)
+			indexes[*record.Type] = i
+			records = append(records, conformance.Record{Type: iov1.DNSRecordType(*record.Type), TTL: *record.TTL})
+		}
+		target, _ := resourceRecordTarget(record)
+		records[i].Targets = append(records[i].Targets, target)
+	}
+	return records
+}
+
+func (b *conformanceBackend) FailWrites(recordType iov1.DNSRecordType) {
+	b.fake.failWrites = string(recordType)
+}
+
+func TestConformance(t *testing.T) {
+	conformance.Run(t, func(t *testing.T) *conformance.Harness {
+		fake := newFakeDNSSvcs()
+		zone := configv1.DNSZone{ID: "zoneID"}
+		return &conformance.Harness{
+			Provider:     &Provider{dnsService: fake, config: common.Config{InstanceID: "instanceID", Zones: []string{zone.ID}}},
+			Backend:      &conformanceBackend{fake: fake},
+			Zone:         zone,
+			Domain:       "example.com",
+			CNAMETarget:  "lb.example.com",
+			InvalidZones: []configv1.DNSZone{{}},
+		}
+	})
+}
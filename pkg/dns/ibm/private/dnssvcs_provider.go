@@ -1,6 +1,7 @@
 package private
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 	"strings"
@@ -20,8 +21,9 @@ import (
 )
 
 var (
-	_   dns.Provider = &Provider{}
-	log              = logf.Logger.WithName("dns")
+	_   dns.Provider    = &Provider{}
+	_   dns.TTLAdjuster = &Provider{}
+	log                 = logf.Logger.WithName("dns")
 
 	// validTTLs is a list of TTLs that are permitted by IBM Cloud DNS Services.
 	validTTLs = sets.NewInt64(1, 60, 120, 300, 600, 900, 1800, 3600, 7200, 18000, 43200)
@@ -71,15 +73,156 @@ func NewProvider(config common.Config) (*Provider, error) {
 	return provider, nil
 }
 
-func (p *Provider) Ensure(record *iov1.DNSRecord, zone configv1.DNSZone) error {
-	return p.createOrUpdateDNSRecord(record, zone)
+func (p *Provider) Ensure(ctx context.Context, record *iov1.DNSRecord, zone configv1.DNSZone) error {
+	return p.createOrUpdateDNSRecord(ctx, record, zone)
 }
 
-func (p *Provider) Replace(record *iov1.DNSRecord, zone configv1.DNSZone) error {
-	return p.createOrUpdateDNSRecord(record, zone)
+// Replace publishes the given record, replacing any record with the same name
+// and a conflicting type.  DNS Services cannot change the type of a record, so
+// the conflicting records are deleted before the record is published, and they
+// are restored if publishing the record fails.
+func (p *Provider) Replace(ctx context.Context, record *iov1.DNSRecord, zone configv1.DNSZone) error {
+	if err := common.ValidateInputDNSData(record, zone); err != nil {
+		return fmt.Errorf("replace: invalid dns input data: %w", err)
+	}
+	conflicts, err := p.deleteConflictingDNSRecords(ctx, record, zone)
+	if err != nil {
+		return err
+	}
+	if err := p.createOrUpdateDNSRecord(ctx, record, zone); err != nil {
+		p.restoreDNSRecords(ctx, zone, conflicts)
+		return err
+	}
+	return nil
+}
+
+// deleteConflictingDNSRecords deletes the records that have the same name as
+// the given record and a conflicting type and returns the deleted records.  If
+// deleting a record fails, the records that have already been deleted are
+// restored.
+func (p *Provider) deleteConflictingDNSRecords(ctx context.Context, record *iov1.DNSRecord, zone configv1.DNSZone) ([]dnssvcsv1.ResourceRecord, error) {
+	listOpt := p.dnsService.NewListResourceRecordsOptions(p.config.InstanceID, zone.ID)
+	dnsName := strings.TrimSuffix(record.Spec.DNSName, ".")
+	result, response, err := p.dnsService.ListResourceRecordsWithContext(ctx, listOpt)
+	if err != nil {
+		if response == nil || response.StatusCode != http.StatusNotFound {
+			return nil, fmt.Errorf("deleteConflictingDNSRecords: failed to list the dns record: %w", err)
+		}
+	}
+	if result == nil {
+		return nil, fmt.Errorf("deleteConflictingDNSRecords: ListResourceRecords returned nil as result")
+	}
+
+	var deleted []dnssvcsv1.ResourceRecord
+	for _, resourceRecord := range result.ResourceRecords {
+		if resourceRecord.Name == nil || *resourceRecord.Name != dnsName || resourceRecord.Type == nil {
+			continue
+		}
+		if !dns.RecordTypesConflict(*resourceRecord.Type, string(record.Spec.RecordType)) {
+			continue
+		}
+		delOpt := p.dnsService.NewDeleteResourceRecordOptions(p.config.InstanceID, zone.ID, *resourceRecord.ID)
+		if delResponse, err := p.dnsService.DeleteResourceRecordWithContext(ctx, delOpt); err != nil {
+			if delResponse == nil || delResponse.StatusCode != http.StatusNotFound {
+				p.restoreDNSRecords(ctx, zone, deleted)
+				return nil, fmt.Errorf("deleteConflictingDNSRecords: failed to delete the dns record: %w", err)
+			}
+		}
+		log.Info("deleted conflicting DNS record", "record", record.Spec, "zone", zone, "type", *resourceRecord.Type)
+		deleted = append(deleted, resourceRecord)
+	}
+	return deleted, nil
+}
+
+// restoreDNSRecords recreates the given deleted records.  Errors are logged
+// rather than returned because restoring records is a best-effort attempt to
+// undo a failed replacement, the error of which is returned to the caller.
+func (p *Provider) restoreDNSRecords(ctx context.Context, zone configv1.DNSZone, records []dnssvcsv1.ResourceRecord) {
+	for _, resourceRecord := range records {
+		target, err := resourceRecordTarget(resourceRecord)
+		if err != nil {
+			log.Error(err, "failed to restore DNS record", "name", *resourceRecord.Name, "zone", zone)
+			continue
+		}
+		createOpt := p.dnsService.NewCreateResourceRecordOptions(p.config.InstanceID, zone.ID)
+		createOpt.SetName(*resourceRecord.Name)
+		createOpt.SetType(*resourceRecord.Type)
+		inputRData, err := p.newInputRdata(*resourceRecord.Type, target)
+		if err != nil {
+			log.Error(err, "failed to restore DNS record", "name", *resourceRecord.Name, "zone", zone)
+			continue
+		}
+		createOpt.SetRdata(inputRData)
+		if resourceRecord.TTL != nil {
+			createOpt.SetTTL(*resourceRecord.TTL)
+		}
+		if _, _, err := p.dnsService.CreateResourceRecordWithContext(ctx, createOpt); err != nil {
+			log.Error(err, "failed to restore DNS record", "name", *resourceRecord.Name, "zone", zone)
+			continue
+		}
+		log.Info("restored DNS record", "name", *resourceRecord.Name, "type", *resourceRecord.Type, "zone", zone, "target", target)
+	}
 }
 
-func (p *Provider) Delete(record *iov1.DNSRecord, zone configv1.DNSZone) error {
+// resourceRecordTarget returns the target of the given A or CNAME record, or
+// the text of the given TXT record.
+func resourceRecordTarget(resourceRecord dnssvcsv1.ResourceRecord) (string, error) {
+	rData, ok := resourceRecord.Rdata.(map[string]interface{})
+	if !ok {
+		return "", fmt.Errorf("failed to get resource data: %v", resourceRecord.Rdata)
+	}
+	if resourceRecord.Type == nil {
+		return "", fmt.Errorf("failed to get resource type, resourceRecord.Type is nil")
+	}
+	switch *resourceRecord.Type {
+	case string(iov1.CNAMERecordType):
+		if value, ok := rData["cname"].(string); ok {
+			return value, nil
+		}
+		return "", fmt.Errorf("resource data has record with unknown rData cname type: %T", rData["cname"])
+	case string(iov1.ARecordType):
+		if value, ok := rData["ip"].(string); ok {
+			return value, nil
+		}
+		return "", fmt.Errorf("resource data has record with unknown rData ip type:  %T", rData["ip"])
+	case txtRecordType:
+		if value, ok := rData["text"].(string); ok {
+			return value, nil
+		}
+		return "", fmt.Errorf("resource data has record with unknown rData text type: %T", rData["text"])
+	default:
+		return "", fmt.Errorf("resource data has record with unknown type: %v", *resourceRecord.Type)
+	}
+}
+
+// newInputRdata returns the resource data with which to create a record of the
+// given type with the given target.
+func (p *Provider) newInputRdata(recordType, target string) (dnssvcsv1.ResourceRecordInputRdataIntf, error) {
+	switch recordType {
+	case string(iov1.CNAMERecordType):
+		inputRData, err := p.dnsService.NewResourceRecordInputRdataRdataCnameRecord(target)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create CNAME inputRData for the dns record: %w", err)
+		}
+		return inputRData, nil
+	case string(iov1.ARecordType):
+		inputRData, err := p.dnsService.NewResourceRecordInputRdataRdataARecord(target)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create A inputRData for the dns record: %w", err)
+		}
+		return inputRData, nil
+	case txtRecordType:
+		inputRData, err := p.dnsService.NewResourceRecordInputRdataRdataTxtRecord(target)
+		if err != nil {
+			return nil, fmt.Errorf("failed to create TXT inputRData for the dns record: %w", err)
+		}
+		return inputRData, nil
+	default:
+		return nil, fmt.Errorf("resource data has record with unknown type: %v", recordType)
+	}
+}
+
+func (p *Provider) Delete(ctx context.Context, record *iov1.DNSRecord, zone configv1.DNSZone) error {
 	if err := common.ValidateInputDNSData(record, zone); err != nil {
 		return fmt.Errorf("delete: invalid dns input data: %w", err)
 	}
@@ -90,7 +233,7 @@ func (p *Provider) Delete(record *iov1.DNSRecord, zone configv1.DNSZone) error {
 	// "." when it creates a wildcard DNS record.
 	dnsName := strings.TrimSuffix(record.Spec.DNSName, ".")
 
-	result, response, err := p.dnsService.ListResourceRecords(listOpt)
+	result, response, err := p.dnsService.ListResourceRecordsWithContext(ctx, listOpt)
 	if err != nil {
 		if response == nil || response.StatusCode != http.StatusNotFound {
 			return fmt.Errorf("delete: failed to list the dns record: %w", err)
@@ -101,29 +244,9 @@ func (p *Provider) Delete(record *iov1.DNSRecord, zone configv1.DNSZone) error {
 	}
 
 	for _, resourceRecord := range result.ResourceRecords {
-		var resourceRecordTarget string
-		rData, ok := resourceRecord.Rdata.(map[string]interface{})
-		if !ok {
-			return fmt.Errorf("delete: failed to get resource data: %v", resourceRecord.Rdata)
-		}
-		if resourceRecord.Type == nil {
-			return fmt.Errorf("delete: failed to get resource type, resourceRecord.Type is nil")
-		}
-		switch *resourceRecord.Type {
-		case string(iov1.CNAMERecordType):
-			if value, ok := rData["cname"].(string); ok {
-				resourceRecordTarget = value
-			} else {
-				return fmt.Errorf("delete: resource data has record with unknown rData cname type: %T", rData["cname"])
-			}
-		case string(iov1.ARecordType):
-			if value, ok := rData["ip"].(string); ok {
-				resourceRecordTarget = value
-			} else {
-				return fmt.Errorf("delete: resource data has record with unknown rData ip type:  %T", rData["ip"])
-			}
-		default:
-			return fmt.Errorf("delete: resource data has record with unknown type: %v", *resourceRecord.Type)
+		resourceRecordTarget, err := resourceRecordTarget(resourceRecord)
+		if err != nil {
+			return fmt.Errorf("delete: %w", err)
 		}
 
 		for _, target := range record.Spec.Targets {
@@ -133,7 +256,7 @@ func (p *Provider) Delete(record *iov1.DNSRecord, zone configv1.DNSZone) error {
 					continue
 				}
 				delOpt := p.dnsService.NewDeleteResourceRecordOptions(p.config.InstanceID, zone.ID, *resourceRecord.ID)
-				delResponse, err := p.dnsService.DeleteResourceRecord(delOpt)
+				delResponse, err := p.dnsService.DeleteResourceRecordWithContext(ctx, delOpt)
 				if err != nil {
 					if delResponse == nil || delResponse.StatusCode != http.StatusNotFound {
 						return fmt.Errorf("delete: failed to delete the dns record: %w", err)
@@ -152,6 +275,7 @@ func (p *Provider) Delete(record *iov1.DNSRecord, zone configv1.DNSZone) error {
 // validateDNSServices validates that provider clients can communicate with
 // associated API endpoints by having each client list zones of the instance.
 func validateDNSServices(provider *Provider) error {
+	ctx := context.TODO()
 	var errs []error
 
 	for _, zoneID := range provider.config.Zones {
@@ -159,13 +283,13 @@ func validateDNSServices(provider *Provider) error {
 			provider.config.InstanceID,
 			zoneID)
 
-		_, _, err := provider.dnsService.GetDnszone(getDnszoneOptions)
+		_, _, err := provider.dnsService.GetDnszoneWithContext(ctx, getDnszoneOptions)
 		if err != nil {
 			errs = append(errs, fmt.Errorf("failed to get dns zone: %w", err))
 		}
 
 		listOpt := provider.dnsService.NewListResourceRecordsOptions(provider.config.InstanceID, zoneID)
-		_, _, err = provider.dnsService.ListResourceRecords(listOpt)
+		_, _, err = provider.dnsService.ListResourceRecordsWithContext(ctx, listOpt)
 		if err != nil {
 			errs = append(errs, fmt.Errorf("failed to list dns records: %w", err))
 		}
@@ -173,8 +297,17 @@ func validateDNSServices(provider *Provider) error {
 	return kerrors.NewAggregate(errs)
 }
 
+// PublishedTTL returns the record's TTL if it is permitted by IBM Cloud DNS
+// Services, or defaultDNSSVCSRecordTTL otherwise.
+func (p *Provider) PublishedTTL(ctx context.Context, record *iov1.DNSRecord, zone configv1.DNSZone) int64 {
+	if !validTTLs.Has(record.Spec.RecordTTL) {
+		return defaultDNSSVCSRecordTTL
+	}
+	return record.Spec.RecordTTL
+}
+
 // createOrUpdateDNSRecord has the common logic for the Ensure and Update methods.
-func (p *Provider) createOrUpdateDNSRecord(record *iov1.DNSRecord, zone configv1.DNSZone) error {
+func (p *Provider) createOrUpdateDNSRecord(ctx context.Context, record *iov1.DNSRecord, zone configv1.DNSZone) error {
 	if err := common.ValidateInputDNSData(record, zone); err != nil {
 		return fmt.Errorf("createOrUpdateDNSRecord: invalid dns input data: %w", err)
 	}
@@ -185,12 +318,12 @@ func (p *Provider) createOrUpdateDNSRecord(record *iov1.DNSRecord, zone configv1
 	// "." when it creates a wildcard DNS record.
 	dnsName := strings.TrimSuffix(record.Spec.DNSName, ".")
 
-	if !validTTLs.Has(record.Spec.RecordTTL) {
+	ttl := p.PublishedTTL(ctx, record, zone)
+	if ttl != record.Spec.RecordTTL {
 		log.Info("Warning: TTL must be one of [1 60 120 300 600 900 1800 3600 7200 18000 43200]. RecordTTL set to default", "default DSNSVCS record TTL", defaultDNSSVCSRecordTTL)
-		record.Spec.RecordTTL = defaultDNSSVCSRecordTTL
 	}
 
-	listResult, response, err := p.dnsService.ListResourceRecords(listOpt)
+	listResult, response, err := p.dnsService.ListResourceRecordsWithContext(ctx, listOpt)
 	if err != nil {
 		if response == nil || response.StatusCode != http.StatusNotFound {
 			return fmt.Errorf("createOrUpdateDNSRecord: failed to list the dns record: %w", err)
@@ -200,72 +333,115 @@ func (p *Provider) createOrUpdateDNSRecord(record *iov1.DNSRecord, zone configv1
 		return fmt.Errorf("createOrUpdateDNSRecord: ListResourceRecords returned nil as result")
 	}
 
-	for _, target := range record.Spec.Targets {
-		updated := false
-		for _, resourceRecord := range listResult.ResourceRecords {
-			if *resourceRecord.Name == dnsName {
-				updateOpt := p.dnsService.NewUpdateResourceRecordOptions(p.config.InstanceID, zone.ID, *resourceRecord.ID)
-				updateOpt.SetName(dnsName)
+	// Each target is published as a separate record.  Records that
+	// already publish a target are updated in place, records that publish
+	// targets that are no longer wanted are reused for new targets, and
+	// any that remain are deleted.
+	published := map[string]dnssvcsv1.ResourceRecord{}
+	var stale []dnssvcsv1.ResourceRecord
+	for _, resourceRecord := range listResult.ResourceRecords {
+		if resourceRecord.Name == nil || *resourceRecord.Name != dnsName {
+			continue
+		}
+		if resourceRecord.Type == nil {
+			return fmt.Errorf("createOrUpdateDNSRecord: failed to get resource type, resourceRecord.Type is nil")
+		}
+		// Records of other types with the same name are distinct
+		// records or, if they conflict with the record, are replaced
+		// by Replace.
+		if *resourceRecord.Type != string(record.Spec.RecordType) {
+			continue
+		}
+		target, err := resourceRecordTarget(resourceRecord)
+		if err != nil {
+			return fmt.Errorf("createOrUpdateDNSRecord: %w", err)
+		}
+		if _, ok := published[target]; ok {
+			stale = append(stale, resourceRecord)
+			continue
+		}
+		published[target] = resourceRecord
+	}
+	targets := sets.NewString(record.Spec.Targets...)
+	for target, resourceRecord := range published {
+		if !targets.Has(target) {
+			stale = append(stale, resourceRecord)
+			delete(published, target)
+		}
+	}
 
-				if resourceRecord.Type == nil {
-					return fmt.Errorf("createOrUpdateDNSRecord: failed to get resource type, resourceRecord.Type is nil")
-				}
+	for _, target := range targets.List() {
+		resourceRecord, ok := published[target]
+		if !ok && len(stale) != 0 {
+			resourceRecord, stale = stale[0], stale[1:]
+			ok = true
+		}
+		if ok {
+			if err := p.updateDNSRecord(ctx, zone, resourceRecord, target, ttl); err != nil {
+				return fmt.Errorf("createOrUpdateDNSRecord: %w", err)
+			}
+			log.Info("updated DNS record", "record", record.Spec, "zone", zone, "target", target)
+			continue
+		}
+		createOpt := p.dnsService.NewCreateResourceRecordOptions(p.config.InstanceID, zone.ID)
+		createOpt.SetName(dnsName)
+		createOpt.SetType(string(record.Spec.RecordType))
 
-				// TODO DNS record update should handle the case where we have an A record and want a CNAME record or vice versa
-				switch *resourceRecord.Type {
-				case string(iov1.CNAMERecordType):
-					inputRData, err := p.dnsService.NewResourceRecordUpdateInputRdataRdataCnameRecord(target)
-					if err != nil {
-						return fmt.Errorf("createOrUpdateDNSRecord: failed to create CNAME inputRData for the dns record: %w", err)
-					}
-					updateOpt.SetRdata(inputRData)
-				case string(iov1.ARecordType):
-					inputRData, err := p.dnsService.NewResourceRecordUpdateInputRdataRdataARecord(target)
-					if err != nil {
-						return fmt.Errorf("createOrUpdateDNSRecord: failed to create A inputRData for the dns record: %w", err)
-					}
-					updateOpt.SetRdata(inputRData)
-				default:
-					return fmt.Errorf("createOrUpdateDNSRecord: resource data has record with unknown type: %v", *resourceRecord.Type)
-				}
-				updateOpt.SetTTL(record.Spec.RecordTTL)
-				_, _, err := p.dnsService.UpdateResourceRecord(updateOpt)
-				if err != nil {
-					return fmt.Errorf("createOrUpdateDNSRecord: failed to update the dns record: %w", err)
-				}
-				updated = true
-				log.Info("updated DNS record", "record", record.Spec, "zone", zone, "target", target)
+		inputRData, err := p.newInputRdata(string(record.Spec.RecordType), target)
+		if err != nil {
+			return fmt.Errorf("createOrUpdateDNSRecord: %w", err)
+		}
+		createOpt.SetRdata(inputRData)
+		createOpt.SetTTL(ttl)
+		_, _, err = p.dnsService.CreateResourceRecordWithContext(ctx, createOpt)
+		if err != nil {
+			return fmt.Errorf("createOrUpdateDNSRecord: failed to create the dns record: %w", err)
+		}
+		log.Info("created DNS record", "record", record.Spec, "zone", zone, "target", target)
+	}
+	for _, resourceRecord := range stale {
+		delOpt := p.dnsService.NewDeleteResourceRecordOptions(p.config.InstanceID, zone.ID, *resourceRecord.ID)
+		if delResponse, err := p.dnsService.DeleteResourceRecordWithContext(ctx, delOpt); err != nil {
+			if delResponse == nil || delResponse.StatusCode != http.StatusNotFound {
+				return fmt.Errorf("createOrUpdateDNSRecord: failed to delete the dns record: %w", err)
 			}
 		}
-		if !updated {
-			createOpt := p.dnsService.NewCreateResourceRecordOptions(p.config.InstanceID, zone.ID)
-			createOpt.SetName(dnsName)
-			createOpt.SetType(string(record.Spec.RecordType))
+		log.Info("deleted stale DNS record", "record", record.Spec, "zone", zone, "id", *resourceRecord.ID)
+	}
+	return nil
+}
 
-			switch record.Spec.RecordType {
-			case iov1.CNAMERecordType:
-				inputRData, err := p.dnsService.NewResourceRecordInputRdataRdataCnameRecord(target)
-				if err != nil {
-					return fmt.Errorf("createOrUpdateDNSRecord: failed to create CNAME inputRData for the dns record: %w", err)
-				}
-				createOpt.SetRdata(inputRData)
-			case iov1.ARecordType:
-				inputRData, err := p.dnsService.NewResourceRecordInputRdataRdataARecord(target)
-				if err != nil {
-					return fmt.Errorf("createOrUpdateDNSRecord: failed to create A inputRData for the dns record: %w", err)
-				}
-				createOpt.SetRdata(inputRData)
-			default:
-				return fmt.Errorf("createOrUpdateDNSRecord: resource data has record with unknown type: %v", record.Spec.RecordType)
+// updateDNSRecord updates the given record to publish the given target with
+// the given TTL.
+func (p *Provider) updateDNSRecord(ctx context.Context, zone configv1.DNSZone, resourceRecord dnssvcsv1.ResourceRecord, target string, ttl int64) error {
+	updateOpt := p.dnsService.NewUpdateResourceRecordOptions(p.config.InstanceID, zone.ID, *resourceRecord.ID)
+	updateOpt.SetName(*resourceRecord.Name)
 
-			}
-			createOpt.SetTTL(record.Spec.RecordTTL)
-			_, _, err := p.dnsService.CreateResourceRecord(createOpt)
-			if err != nil {
-				return fmt.Errorf("createOrUpdateDNSRecord: failed to create the dns record: %w", err)
-			}
-			log.Info("created DNS record", "record", record.Spec, "zone", zone, "target", target)
+	switch *resourceRecord.Type {
+	case string(iov1.CNAMERecordType):
+		inputRData, err := p.dnsService.NewResourceRecordUpdateInputRdataRdataCnameRecord(target)
+		if err != nil {
+			return fmt.Errorf("failed to create CNAME inputRData for the dns record: %w", err)
+		}
+		updateOpt.SetRdata(inputRData)
+	case string(iov1.ARecordType):
+		inputRData, err := p.dnsService.NewResourceRecordUpdateInputRdataRdataARecord(target)
+		if err != nil {
+			return fmt.Errorf("failed to create A inputRData for the dns record: %w", err)
 		}
+		updateOpt.SetRdata(inputRData)
+	case txtRecordType:
+		inputRData, err := p.dnsService.NewResourceRecordUpdateInputRdataRdataTxtRecord(target)
+		if err != nil {
+			return fmt.Errorf("failed to create TXT inputRData for the dns record: %w", err)
+		}
+		updateOpt.SetRdata(inputRData)
+	default:
+		return fmt.Errorf("resource data has record with unknown type: %v", *resourceRecord.Type)
+	}
+	updateOpt.SetTTL(ttl)
+	if _, _, err := p.dnsService.UpdateResourceRecordWithContext(ctx, updateOpt); err != nil {
+		return fmt.Errorf("failed to update the dns record: %w", err)
 	}
 	return nil
 }
@@ -0,0 +1,153 @@
+package private
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/networking-go-sdk/dnssvcsv1"
+)
+
+// fakeDNSSvcs is a DnsClient that stores resource records in memory.
+type fakeDNSSvcs struct {
+	nextID  int
+	records []dnssvcsv1.ResourceRecord
+	// failWrites makes creating or updating a record of the given type
+	// fail.
+	failWrites string
+}
+
+func newFakeDNSSvcs() *fakeDNSSvcs {
+	return &fakeDNSSvcs{}
+}
+
+func (f *fakeDNSSvcs) NewListResourceRecordsOptions(instanceID string, dnszoneID string) *dnssvcsv1.ListResourceRecordsOptions {
+	return &dnssvcsv1.ListResourceRecordsOptions{InstanceID: &instanceID, DnszoneID: &dnszoneID}
+}
+
+func (f *fakeDNSSvcs) ListResourceRecordsWithContext(ctx context.Context, opt *dnssvcsv1.ListResourceRecordsOptions) (*dnssvcsv1.ListResourceRecords, *core.DetailedResponse, error) {
+	result := &dnssvcsv1.ListResourceRecords{ResourceRecords: append([]dnssvcsv1.ResourceRecord{}, f.records...)}
+	return result, &core.DetailedResponse{StatusCode: http.StatusOK}, nil
+}
+
+func (f *fakeDNSSvcs) NewDeleteResourceRecordOptions(instanceID string, dnszoneID string, recordID string) *dnssvcsv1.DeleteResourceRecordOptions {
+	return &dnssvcsv1.DeleteResourceRecordOptions{InstanceID: &instanceID, DnszoneID: &dnszoneID, RecordID: &recordID}
+}
+
+func (f *fakeDNSSvcs) DeleteResourceRecordWithContext(ctx context.Context, opt *dnssvcsv1.DeleteResourceRecordOptions) (*core.DetailedResponse, error) {
+	for i, record := range f.records {
+		if *record.ID == *opt.RecordID {
+			f.records = append(f.records[:i], f.records[i+1:]...)
+			return &core.DetailedResponse{StatusCode: http.StatusNoContent}, nil
+		}
+	}
+	return &core.DetailedResponse{StatusCode: http.StatusNotFound}, fmt.Errorf("record %s not found", *opt.RecordID)
+}
+
+func (f *fakeDNSSvcs) NewUpdateResourceRecordOptions(instanceID string, dnszoneID string, recordID string) *dnssvcsv1.UpdateResourceRecordOptions {
+	return &dnssvcsv1.UpdateResourceRecordOptions{InstanceID: &instanceID, DnszoneID: &dnszoneID, RecordID: &recordID}
+}
+
+func (f *fakeDNSSvcs) NewResourceRecordUpdateInputRdataRdataCnameRecord(cname string) (*dnssvcsv1.ResourceRecordUpdateInputRdataRdataCnameRecord, error) {
+	return &dnssvcsv1.ResourceRecordUpdateInputRdataRdataCnameRecord{Cname: &cname}, nil
+}
+
+func (f *fakeDNSSvcs) NewResourceRecordUpdateInputRdataRdataARecord(ip string) (*dnssvcsv1.ResourceRecordUpdateInputRdataRdataARecord, error) {
+	return &dnssvcsv1.ResourceRecordUpdateInputRdataRdataARecord{Ip: &ip}, nil
+}
+
+func (f *fakeDNSSvcs) NewResourceRecordUpdateInputRdataRdataTxtRecord(text string) (*dnssvcsv1.ResourceRecordUpdateInputRdataRdataTxtRecord, error) {
+	return &dnssvcsv1.ResourceRecordUpdateInputRdataRdataTxtRecord{Text: &text}, nil
+}
+
+// UpdateResourceRecordWithContext updates a record.  Like DNS Services, it
+// does not change the record's type.
+func (f *fakeDNSSvcs) UpdateResourceRecordWithContext(ctx context.Context, opt *dnssvcsv1.UpdateResourceRecordOptions) (*dnssvcsv1.ResourceRecord, *core.DetailedResponse, error) {
+	for i, record := range f.records {
+		if *record.ID != *opt.RecordID {
+			continue
+		}
+		if len(f.failWrites) != 0 && *record.Type == f.failWrites {
+			return nil, &core.DetailedResponse{StatusCode: http.StatusInternalServerError}, fmt.Errorf("injected failure")
+		}
+		var rData map[string]interface{}
+		switch rd := opt.Rdata.(type) {
+		case *dnssvcsv1.ResourceRecordUpdateInputRdataRdataARecord:
+			rData = map[string]interface{}{"ip": *rd.Ip}
+		case *dnssvcsv1.ResourceRecordUpdateInputRdataRdataCnameRecord:
+			rData = map[string]interface{}{"cname": *rd.Cname}
+		case *dnssvcsv1.ResourceRecordUpdateInputRdataRdataTxtRecord:
+			rData = map[string]interface{}{"text": *rd.Text}
+		default:
+			return nil, &core.DetailedResponse{StatusCode: http.StatusBadRequest}, fmt.Errorf("unsupported rdata %T", opt.Rdata)
+		}
+		if opt.Name != nil {
+			name := strings.TrimSuffix(*opt.Name, ".")
+			f.records[i].Name = &name
+		}
+		f.records[i].Rdata = rData
+		f.records[i].TTL = opt.TTL
+		return &f.records[i], &core.DetailedResponse{StatusCode: http.StatusOK}, nil
+	}
+	return nil, &core.DetailedResponse{StatusCode: http.StatusNotFound}, fmt.Errorf("record %s not found", *opt.RecordID)
+}
+
+func (f *fakeDNSSvcs) NewCreateResourceRecordOptions(instanceID string, dnszoneID string) *dnssvcsv1.CreateResourceRecordOptions {
+	return &dnssvcsv1.CreateResourceRecordOptions{InstanceID: &instanceID, DnszoneID: &dnszoneID}
+}
+
+func (f *fakeDNSSvcs) NewResourceRecordInputRdataRdataCnameRecord(cname string) (*dnssvcsv1.ResourceRecordInputRdataRdataCnameRecord, error) {
+	return &dnssvcsv1.ResourceRecordInputRdataRdataCnameRecord{Cname: &cname}, nil
+}
+
+func (f *fakeDNSSvcs) NewResourceRecordInputRdataRdataARecord(ip string) (*dnssvcsv1.ResourceRecordInputRdataRdataARecord, error) {
+	return &dnssvcsv1.ResourceRecordInputRdataRdataARecord{Ip: &ip}, nil
+}
+
+func (f *fakeDNSSvcs) NewResourceRecordInputRdataRdataTxtRecord(text string) (*dnssvcsv1.ResourceRecordInputRdataRdataTxtRecord, error) {
+	return &dnssvcsv1.ResourceRecordInputRdataRdataTxtRecord{Text: &text}, nil
+}
+
+// CreateResourceRecordWithContext creates a record.  Like DNS Services, it
+// rejects a CNAME record with the same name as another record, and vice versa.
+func (f *fakeDNSSvcs) CreateResourceRecordWithContext(ctx context.Context, opt *dnssvcsv1.CreateResourceRecordOptions) (*dnssvcsv1.ResourceRecord, *core.DetailedResponse, error) {
+	name := strings.TrimSuffix(*opt.Name, ".")
+	recordType := *opt.Type
+	if len(f.failWrites) != 0 && recordType == f.failWrites {
+		return nil, &core.DetailedResponse{StatusCode: http.StatusInternalServerError}, fmt.Errorf("injected failure")
+	}
+	for _, record := range f.records {
+		if *record.Name != name || *record.Type == recordType {
+			continue
+		}
+		if *record.Type == "CNAME" || recordType == "CNAME" {
+			return nil, &core.DetailedResponse{StatusCode: http.StatusConflict}, fmt.Errorf("a %s record with name %s conflicts with an existing %s record", recordType, name, *record.Type)
+		}
+	}
+	var rData map[string]interface{}
+	switch rd := opt.Rdata.(type) {
+	case *dnssvcsv1.ResourceRecordInputRdataRdataARecord:
+		rData = map[string]interface{}{"ip": *rd.Ip}
+	case *dnssvcsv1.ResourceRecordInputRdataRdataCnameRecord:
+		rData = map[string]interface{}{"cname": *rd.Cname}
+	case *dnssvcsv1.ResourceRecordInputRdataRdataTxtRecord:
+		rData = map[string]interface{}{"text": *rd.Text}
+	default:
+		return nil, &core.DetailedResponse{StatusCode: http.StatusBadRequest}, fmt.Errorf("unsupported rdata %T", opt.Rdata)
+	}
+	f.nextID++
+	id := fmt.Sprintf("record-%d", f.nextID)
+	record := dnssvcsv1.ResourceRecord{ID: &id, Name: &name, Type: &recordType, Rdata: rData, TTL: opt.TTL}
+	f.records = append(f.records, record)
+	return &record, &core.DetailedResponse{StatusCode: http.StatusOK}, nil
+}
+
+func (f *fakeDNSSvcs) NewGetDnszoneOptions(instanceID string, dnszoneID string) *dnssvcsv1.GetDnszoneOptions {
+	return &dnssvcsv1.GetDnszoneOptions{InstanceID: &instanceID, DnszoneID: &dnszoneID}
+}
+
+func (f *fakeDNSSvcs) GetDnszoneWithContext(ctx context.Context, opt *dnssvcsv1.GetDnszoneOptions) (*dnssvcsv1.Dnszone, *core.DetailedResponse, error) {
+	return &dnssvcsv1.Dnszone{ID: opt.DnszoneID}, &core.DetailedResponse{StatusCode: http.StatusOK}, nil
+}
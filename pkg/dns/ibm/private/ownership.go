@@ -0,0 +1,143 @@
+package private
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/IBM/networking-go-sdk/dnssvcsv1"
+	configv1 "github.com/openshift/api/config/v1"
+	iov1 "github.com/openshift/api/operatoringress/v1"
+
+	"github.com/openshift/cluster-ingress-operator/pkg/dns"
+)
+
+var _ dns.OwnershipRegistry = &Provider{}
+
+// txtRecordType is the type of the companion TXT records that record the
+// owners of published records.
+const txtRecordType = "TXT"
+
+// GetOwner returns the owner that the companion TXT record of the given record
+// specifies, or the empty string if there is no companion TXT record.
+func (p *Provider) GetOwner(ctx context.Context, record *iov1.DNSRecord, zone configv1.DNSZone) (string, error) {
+	current, err := p.listResourceRecords(ctx, zone, dns.OwnershipRecordName(record))
+	if err != nil {
+		return "", fmt.Errorf("GetOwner: %w", err)
+	}
+	for _, resourceRecord := range current {
+		if *resourceRecord.Type != txtRecordType {
+			continue
+		}
+		value, err := resourceRecordTarget(resourceRecord)
+		if err != nil {
+			return "", fmt.Errorf("GetOwner: %w", err)
+		}
+		if owner, ok := dns.ParseOwnershipRecordValue(value); ok {
+			return owner, nil
+		}
+	}
+	return "", nil
+}
+
+// SetOwner creates or updates the companion TXT record of the given record so
+// that it specifies the given owner.
+func (p *Provider) SetOwner(ctx context.Context, record *iov1.DNSRecord, zone configv1.DNSZone, owner string) error {
+	name := dns.OwnershipRecordName(record)
+	current, err := p.listResourceRecords(ctx, zone, name)
+	if err != nil {
+		return fmt.Errorf("SetOwner: %w", err)
+	}
+	ttl := p.PublishedTTL(ctx, record, zone)
+	value := dns.OwnershipRecordValue(owner)
+	for _, resourceRecord := range current {
+		if *resourceRecord.Type != txtRecordType {
+			continue
+		}
+		if err := p.updateDNSRecord(ctx, zone, resourceRecord, value, ttl); err != nil {
+			return fmt.Errorf("SetOwner: %w", err)
+		}
+		log.Info("updated companion TXT record", "zone", zone, "name", name, "owner", owner)
+		return nil
+	}
+	createOpt := p.dnsService.NewCreateResourceRecordOptions(p.config.InstanceID, zone.ID)
+	createOpt.SetName(strings.TrimSuffix(name, "."))
+	createOpt.SetType(txtRecordType)
+	inputRData, err := p.newInputRdata(txtRecordType, value)
+	if err != nil {
+		return fmt.Errorf("SetOwner: %w", err)
+	}
+	createOpt.SetRdata(inputRData)
+	createOpt.SetTTL(ttl)
+	if _, _, err := p.dnsService.CreateResourceRecordWithContext(ctx, createOpt); err != nil {
+		return fmt.Errorf("SetOwner: failed to create the companion TXT record: %w", err)
+	}
+	log.Info("created companion TXT record", "zone", zone, "name", name, "owner", owner)
+	return nil
+}
+
+// DeleteOwner deletes the companion TXT record of the given record, if it
+// exists.
+func (p *Provider) DeleteOwner(ctx context.Context, record *iov1.DNSRecord, zone configv1.DNSZone) error {
+	name := dns.OwnershipRecordName(record)
+	current, err := p.listResourceRecords(ctx, zone, name)
+	if err != nil {
+		return fmt.Errorf("DeleteOwner: %w", err)
+	}
+	for _, resourceRecord := range current {
+		if *resourceRecord.Type != txtRecordType {
+			continue
+		}
+		delOpt := p.dnsService.NewDeleteResourceRecordOptions(p.config.InstanceID, zone.ID, *resourceRecord.ID)
+		if delResponse, err := p.dnsService.DeleteResourceRecordWithContext(ctx, delOpt); err != nil {
+			if delResponse == nil || delResponse.StatusCode != http.StatusNotFound {
+				return fmt.Errorf("DeleteOwner: failed to delete the companion TXT record: %w", err)
+			}
+		}
+		log.Info("deleted companion TXT record", "zone", zone, "name", name)
+	}
+	return nil
+}
+
+// RecordExists returns a Boolean value indicating whether the given zone has a
+// record with the name of the given record that publishing the record would
+// overwrite, namely one with the same type, or replace, namely one with a
+// conflicting type.
+func (p *Provider) RecordExists(ctx context.Context, record *iov1.DNSRecord, zone configv1.DNSZone) (bool, error) {
+	current, err := p.listResourceRecords(ctx, zone, record.Spec.DNSName)
+	if err != nil {
+		return false, fmt.Errorf("RecordExists: %w", err)
+	}
+	recordType := string(record.Spec.RecordType)
+	for _, resourceRecord := range current {
+		if *resourceRecord.Type == recordType || dns.RecordTypesConflict(*resourceRecord.Type, recordType) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// listResourceRecords returns the records in the given zone that have the
+// given name and a type.
+func (p *Provider) listResourceRecords(ctx context.Context, zone configv1.DNSZone, name string) ([]dnssvcsv1.ResourceRecord, error) {
+	listOpt := p.dnsService.NewListResourceRecordsOptions(p.config.InstanceID, zone.ID)
+	result, response, err := p.dnsService.ListResourceRecordsWithContext(ctx, listOpt)
+	if err != nil {
+		if response == nil || response.StatusCode != http.StatusNotFound {
+			return nil, fmt.Errorf("failed to list the dns records: %w", err)
+		}
+	}
+	if result == nil {
+		return nil, fmt.Errorf("ListResourceRecords returned nil as result")
+	}
+	dnsName := strings.TrimSuffix(name, ".")
+	var records []dnssvcsv1.ResourceRecord
+	for _, resourceRecord := range result.ResourceRecords {
+		if resourceRecord.Name == nil || *resourceRecord.Name != dnsName || resourceRecord.Type == nil {
+			continue
+		}
+		records = append(records, resourceRecord)
+	}
+	return records, nil
+}
@@ -1,6 +1,7 @@
 package public
 
 import (
+	"context"
 	"fmt"
 	"net/http"
 	"strings"
@@ -16,11 +17,13 @@ import (
 	iov1 "github.com/openshift/api/operatoringress/v1"
 	logf "github.com/openshift/cluster-ingress-operator/pkg/log"
 	kerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/apimachinery/pkg/util/sets"
 )
 
 var (
-	_   dns.Provider = &Provider{}
-	log              = logf.Logger.WithName("dns")
+	_   dns.Provider    = &Provider{}
+	_   dns.TTLAdjuster = &Provider{}
+	log                 = logf.Logger.WithName("dns")
 )
 
 // defaultCISRecordTTL is the default TTL used when a DNS record
@@ -71,27 +74,34 @@ func NewProvider(config common.Config) (*Provider, error) {
 // validateDNSServices validates that provider clients can communicate with
 // associated API endpoints by having each client make a get DNS records call.
 func validateDNSServices(provider *Provider) error {
+	ctx := context.TODO()
 	var errs []error
 	maxItems := int64(1)
 	for _, dnsService := range provider.dnsServices {
 		opt := dnsService.NewListAllDnsRecordsOptions()
 		opt.PerPage = &maxItems
-		if _, _, err := dnsService.ListAllDnsRecords(opt); err != nil {
+		if _, _, err := dnsService.ListAllDnsRecordsWithContext(ctx, opt); err != nil {
 			errs = append(errs, fmt.Errorf("failed to get dns records: %w", err))
 		}
 	}
 	return kerrors.NewAggregate(errs)
 }
 
-func (p *Provider) Ensure(record *iov1.DNSRecord, zone configv1.DNSZone) error {
-	return p.createOrUpdateDNSRecord(record, zone)
+func (p *Provider) Ensure(ctx context.Context, record *iov1.DNSRecord, zone configv1.DNSZone) error {
+	return p.createOrUpdateDNSRecord(ctx, record, zone)
 }
 
-func (p *Provider) Replace(record *iov1.DNSRecord, zone configv1.DNSZone) error {
-	return p.createOrUpdateDNSRecord(record, zone)
+func (p *Provider) Replace(ctx context.Context, record *iov1.DNSRecord, zone configv1.DNSZone) error {
+	if err := p.replaceConflictingDNSRecords(ctx, record, zone); err != nil {
+		return err
+	}
+	if err := p.createOrUpdateDNSRecord(ctx, record, zone); err != nil {
+		return err
+	}
+	return p.deleteStaleDNSRecords(ctx, record, zone)
 }
 
-func (p *Provider) Delete(record *iov1.DNSRecord, zone configv1.DNSZone) error {
+func (p *Provider) Delete(ctx context.Context, record *iov1.DNSRecord, zone configv1.DNSZone) error {
 	if err := common.ValidateInputDNSData(record, zone); err != nil {
 		return fmt.Errorf("delete: invalid dns input data: %w", err)
 	}
@@ -108,7 +118,7 @@ func (p *Provider) Delete(record *iov1.DNSRecord, zone configv1.DNSZone) error {
 	opt.SetName(dnsName)
 	for _, target := range record.Spec.Targets {
 		opt.SetContent(target)
-		result, response, err := dnsService.ListAllDnsRecords(opt)
+		result, response, err := dnsService.ListAllDnsRecordsWithContext(ctx, opt)
 		if err != nil {
 			if response == nil || response.StatusCode != http.StatusNotFound {
 				return fmt.Errorf("delete: failed to list the dns record: %w", err)
@@ -123,7 +133,7 @@ func (p *Provider) Delete(record *iov1.DNSRecord, zone configv1.DNSZone) error {
 				return fmt.Errorf("delete: record id is nil")
 			}
 			delOpt := dnsService.NewDeleteDnsRecordOptions(*resultData.ID)
-			_, delResponse, err := dnsService.DeleteDnsRecord(delOpt)
+			_, delResponse, err := dnsService.DeleteDnsRecordWithContext(ctx, delOpt)
 			if err != nil {
 				if delResponse == nil || delResponse.StatusCode != http.StatusNotFound {
 					return fmt.Errorf("delete: failed to delete the dns record: %w", err)
@@ -137,7 +147,117 @@ func (p *Provider) Delete(record *iov1.DNSRecord, zone configv1.DNSZone) error {
 	return nil
 }
 
-func (p *Provider) createOrUpdateDNSRecord(record *iov1.DNSRecord, zone configv1.DNSZone) error {
+// PublishedTTL returns the record's TTL if it is permitted by CIS, or
+// defaultCISRecordTTL otherwise.  TTL must be between 120 and 2,147,483,647
+// seconds, or 1 for Automatic.
+func (p *Provider) PublishedTTL(ctx context.Context, record *iov1.DNSRecord, zone configv1.DNSZone) int64 {
+	if (record.Spec.RecordTTL > 1 && record.Spec.RecordTTL < 120) || record.Spec.RecordTTL == 0 {
+		return defaultCISRecordTTL
+	}
+	return record.Spec.RecordTTL
+}
+
+// replaceConflictingDNSRecords changes the records that have the same name as
+// the given record and a conflicting type into records of the given record's
+// type.  CIS can change the type of a record, so the first conflicting record
+// is updated in place, which replaces it atomically, and any other conflicting
+// records, such as those for additional targets, are deleted.
+func (p *Provider) replaceConflictingDNSRecords(ctx context.Context, record *iov1.DNSRecord, zone configv1.DNSZone) error {
+	if err := common.ValidateInputDNSData(record, zone); err != nil {
+		return fmt.Errorf("replaceConflictingDNSRecords: invalid dns input data: %w", err)
+	}
+	dnsService, ok := p.dnsServices[zone.ID]
+	if !ok {
+		return fmt.Errorf("replaceConflictingDNSRecords: unknown zone: %v", zone.ID)
+	}
+
+	listOpt := dnsService.NewListAllDnsRecordsOptions()
+	listOpt.SetName(strings.TrimSuffix(record.Spec.DNSName, "."))
+	result, response, err := dnsService.ListAllDnsRecordsWithContext(ctx, listOpt)
+	if err != nil {
+		if response == nil || response.StatusCode != http.StatusNotFound {
+			return fmt.Errorf("replaceConflictingDNSRecords: failed to list the dns record: %w", err)
+		}
+		return nil
+	}
+	if result == nil || result.Result == nil {
+		return fmt.Errorf("replaceConflictingDNSRecords: ListAllDnsRecords returned nil as result")
+	}
+
+	updated := false
+	for _, resultData := range result.Result {
+		if resultData.ID == nil || resultData.Type == nil {
+			return fmt.Errorf("replaceConflictingDNSRecords: record id or type is nil")
+		}
+		if !dns.RecordTypesConflict(*resultData.Type, string(record.Spec.RecordType)) {
+			continue
+		}
+		if !updated {
+			updateOpt := dnsService.NewUpdateDnsRecordOptions(*resultData.ID)
+			updateOpt.SetName(record.Spec.DNSName)
+			updateOpt.SetType(string(record.Spec.RecordType))
+			updateOpt.SetContent(record.Spec.Targets[0])
+			updateOpt.SetTTL(p.PublishedTTL(ctx, record, zone))
+			if _, _, err := dnsService.UpdateDnsRecordWithContext(ctx, updateOpt); err != nil {
+				return fmt.Errorf("replaceConflictingDNSRecords: failed to update the dns record: %w", err)
+			}
+			updated = true
+			log.Info("replaced conflicting DNS record", "record", record.Spec, "zone", zone, "type", *resultData.Type)
+			continue
+		}
+		delOpt := dnsService.NewDeleteDnsRecordOptions(*resultData.ID)
+		if _, delResponse, err := dnsService.DeleteDnsRecordWithContext(ctx, delOpt); err != nil {
+			if delResponse == nil || delResponse.StatusCode != http.StatusNotFound {
+				return fmt.Errorf("replaceConflictingDNSRecords: failed to delete the dns record: %w", err)
+			}
+		}
+		log.Info("deleted conflicting DNS record", "record", record.Spec, "zone", zone, "type", *resultData.Type)
+	}
+	return nil
+}
+
+// deleteStaleDNSRecords deletes the records that have the same name and type as
+// the given record but a target that the given record no longer has.
+func (p *Provider) deleteStaleDNSRecords(ctx context.Context, record *iov1.DNSRecord, zone configv1.DNSZone) error {
+	dnsService, ok := p.dnsServices[zone.ID]
+	if !ok {
+		return fmt.Errorf("deleteStaleDNSRecords: unknown zone: %v", zone.ID)
+	}
+
+	listOpt := dnsService.NewListAllDnsRecordsOptions()
+	listOpt.SetType(string(record.Spec.RecordType))
+	listOpt.SetName(strings.TrimSuffix(record.Spec.DNSName, "."))
+	result, response, err := dnsService.ListAllDnsRecordsWithContext(ctx, listOpt)
+	if err != nil {
+		if response == nil || response.StatusCode != http.StatusNotFound {
+			return fmt.Errorf("deleteStaleDNSRecords: failed to list the dns record: %w", err)
+		}
+		return nil
+	}
+	if result == nil || result.Result == nil {
+		return fmt.Errorf("deleteStaleDNSRecords: ListAllDnsRecords returned nil as result")
+	}
+
+	targets := sets.NewString(record.Spec.Targets...)
+	for _, resultData := range result.Result {
+		if resultData.ID == nil || resultData.Content == nil {
+			return fmt.Errorf("deleteStaleDNSRecords: record id or content is nil")
+		}
+		if targets.Has(*resultData.Content) {
+			continue
+		}
+		delOpt := dnsService.NewDeleteDnsRecordOptions(*resultData.ID)
+		if _, delResponse, err := dnsService.DeleteDnsRecordWithContext(ctx, delOpt); err != nil {
+			if delResponse == nil || delResponse.StatusCode != http.StatusNotFound {
+				return fmt.Errorf("deleteStaleDNSRecords: failed to delete the dns record: %w", err)
+			}
+		}
+		log.Info("deleted stale DNS record", "record", record.Spec, "zone", zone, "target", *resultData.Content)
+	}
+	return nil
+}
+
+func (p *Provider) createOrUpdateDNSRecord(ctx context.Context, record *iov1.DNSRecord, zone configv1.DNSZone) error {
 	if err := common.ValidateInputDNSData(record, zone); err != nil {
 		return fmt.Errorf("createOrUpdateDNSRecord: invalid dns input data: %w", err)
 	}
@@ -146,10 +266,9 @@ func (p *Provider) createOrUpdateDNSRecord(record *iov1.DNSRecord, zone configv1
 		return fmt.Errorf("createOrUpdateDNSRecord: unknown zone: %v", zone.ID)
 	}
 
-	// TTL must be between 120 and 2,147,483,647 seconds, or 1 for Automatic.
-	if (record.Spec.RecordTTL > 1 && record.Spec.RecordTTL < 120) || record.Spec.RecordTTL == 0 {
+	ttl := p.PublishedTTL(ctx, record, zone)
+	if ttl != record.Spec.RecordTTL {
 		log.Info("Warning: TTL must be between 120 and 2,147,483,647 seconds, or 1 for Automatic. RecordTTL set to default", "default CIS record TTL", defaultCISRecordTTL)
-		record.Spec.RecordTTL = defaultCISRecordTTL
 	}
 
 	listOpt := dnsService.NewListAllDnsRecordsOptions()
@@ -161,7 +280,7 @@ func (p *Provider) createOrUpdateDNSRecord(record *iov1.DNSRecord, zone configv1
 	listOpt.SetName(dnsName)
 	for _, target := range record.Spec.Targets {
 		listOpt.SetContent(target)
-		result, response, err := dnsService.ListAllDnsRecords(listOpt)
+		result, response, err := dnsService.ListAllDnsRecordsWithContext(ctx, listOpt)
 		if err != nil {
 			if response != nil && response.StatusCode != http.StatusNotFound {
 				return fmt.Errorf("createOrUpdateDNSRecord: failed to list the dns record: %w", err)
@@ -176,8 +295,8 @@ func (p *Provider) createOrUpdateDNSRecord(record *iov1.DNSRecord, zone configv1
 			createOpt.SetName(record.Spec.DNSName)
 			createOpt.SetType(string(record.Spec.RecordType))
 			createOpt.SetContent(target)
-			createOpt.SetTTL(record.Spec.RecordTTL)
-			_, _, err := dnsService.CreateDnsRecord(createOpt)
+			createOpt.SetTTL(ttl)
+			_, _, err := dnsService.CreateDnsRecordWithContext(ctx, createOpt)
 			if err != nil {
 				return fmt.Errorf("createOrUpdateDNSRecord: failed to create the dns record: %w", err)
 			}
@@ -187,8 +306,8 @@ func (p *Provider) createOrUpdateDNSRecord(record *iov1.DNSRecord, zone configv1
 			updateOpt.SetName(record.Spec.DNSName)
 			updateOpt.SetType(string(record.Spec.RecordType))
 			updateOpt.SetContent(target)
-			updateOpt.SetTTL(record.Spec.RecordTTL)
-			_, _, err := dnsService.UpdateDnsRecord(updateOpt)
+			updateOpt.SetTTL(ttl)
+			_, _, err := dnsService.UpdateDnsRecordWithContext(ctx, updateOpt)
 			if err != nil {
 				return fmt.Errorf("createOrUpdateDNSRecord: failed to update the dns record: %w", err)
 			}
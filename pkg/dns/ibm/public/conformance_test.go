@@ -0,0 +1,56 @@
+package public
+
+import (
+	"strings"
+	"testing"
+
+	configv1 "github.com/openshift/api/config/v1"
+	iov1 "github.com/openshift/api/operatoringress/v1"
+	"github.com/openshift/cluster-ingress-operator/pkg/dns/conformance"
+	dnsclient "github.com/openshift/cluster-ingress-operator/pkg/dns/ibm/public/client"
+)
+
+// conformanceBackend is a conformance.Backend for a fakeCIS.
+type conformanceBackend struct {
+	fake *fakeCIS
+}
+
+// Records returns the records with the given name, combining the records with
+// the same type, one for each target, into a single record.
+func (b *conformanceBackend) Records(dnsName string) []conformance.Record {
+	name := strings.TrimSuffix(dnsName, ".")
+	var records []conformance.Record
+	indexes := map[string]int{}
+	for _, record := range b.fake.records {
+		if *record.Name != name {
+			continue
+		}
+		i, ok := indexes[*record.Type]
+		if !ok {
+			i = len(records)
+			indexes[*record.Type] = i
+			records = append(records, conformance.Record{Type: iov1.DNSRecordType(*record.Type), TTL: *record.TTL})
+		}
+		records[i].Targets = append(records[i].Targets, *record.Content)
+	}
+	return records
+}
+
+func (b *conformanceBackend) FailWrites(recordType iov1.DNSRecordType) {
+	b.fake.failWrites = string(recordType)
+}
+
+func TestConformance(t *testing.T) {
+	conformance.Run(t, func(t *testing.T) *conformance.Harness {
+		fake := newFakeCIS()
+		zone := configv1.DNSZone{ID: "zoneID"}
+		return &conformance.Harness{
+			Provider:     &Provider{dnsServices: map[string]dnsclient.DnsClient{zone.ID: fake}},
+			Backend:      &conformanceBackend{fake: fake},
+			Zone:         zone,
+			Domain:       "example.com",
+			CNAMETarget:  "lb.example.com",
+			InvalidZones: []configv1.DNSZone{{}, {ID: "otherZoneID"}},
+		}
+	})
+}
@@ -0,0 +1,113 @@
+package public
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/networking-go-sdk/dnsrecordsv1"
+)
+
+// fakeCIS is a DnsClient that stores DNS records in memory.
+type fakeCIS struct {
+	nextID  int
+	records []dnsrecordsv1.DnsrecordDetails
+	// failWrites makes creating or updating a record of the given type
+	// fail.
+	failWrites string
+}
+
+func newFakeCIS() *fakeCIS {
+	return &fakeCIS{}
+}
+
+func (f *fakeCIS) ListAllDnsRecordsWithContext(ctx context.Context, opt *dnsrecordsv1.ListAllDnsRecordsOptions) (*dnsrecordsv1.ListDnsrecordsResp, *core.DetailedResponse, error) {
+	result := &dnsrecordsv1.ListDnsrecordsResp{Result: []dnsrecordsv1.DnsrecordDetails{}}
+	for _, record := range f.records {
+		if opt.Name != nil && *record.Name != *opt.Name {
+			continue
+		}
+		if opt.Type != nil && *record.Type != *opt.Type {
+			continue
+		}
+		if opt.Content != nil && *record.Content != *opt.Content {
+			continue
+		}
+		result.Result = append(result.Result, record)
+	}
+	return result, &core.DetailedResponse{StatusCode: http.StatusOK}, nil
+}
+
+// CreateDnsRecordWithContext creates a record.  Like CIS, it rejects a CNAME
+// record with the same name as another record, and vice versa.
+func (f *fakeCIS) CreateDnsRecordWithContext(ctx context.Context, opt *dnsrecordsv1.CreateDnsRecordOptions) (*dnsrecordsv1.DnsrecordResp, *core.DetailedResponse, error) {
+	name := strings.TrimSuffix(*opt.Name, ".")
+	if err := f.checkWrite("", name, *opt.Type); err != nil {
+		return nil, &core.DetailedResponse{StatusCode: http.StatusBadRequest}, err
+	}
+	f.nextID++
+	id := fmt.Sprintf("record-%d", f.nextID)
+	record := dnsrecordsv1.DnsrecordDetails{ID: &id, Name: &name, Type: opt.Type, Content: opt.Content, TTL: opt.TTL}
+	f.records = append(f.records, record)
+	return &dnsrecordsv1.DnsrecordResp{Result: &record}, &core.DetailedResponse{StatusCode: http.StatusOK}, nil
+}
+
+func (f *fakeCIS) DeleteDnsRecordWithContext(ctx context.Context, opt *dnsrecordsv1.DeleteDnsRecordOptions) (*dnsrecordsv1.DeleteDnsrecordResp, *core.DetailedResponse, error) {
+	for i, record := range f.records {
+		if *record.ID == *opt.DnsrecordIdentifier {
+			f.records = append(f.records[:i], f.records[i+1:]...)
+			return &dnsrecordsv1.DeleteDnsrecordResp{}, &core.DetailedResponse{StatusCode: http.StatusOK}, nil
+		}
+	}
+	return nil, &core.DetailedResponse{StatusCode: http.StatusNotFound}, fmt.Errorf("record %s not found", *opt.DnsrecordIdentifier)
+}
+
+func (f *fakeCIS) UpdateDnsRecordWithContext(ctx context.Context, opt *dnsrecordsv1.UpdateDnsRecordOptions) (*dnsrecordsv1.DnsrecordResp, *core.DetailedResponse, error) {
+	for i, record := range f.records {
+		if *record.ID != *opt.DnsrecordIdentifier {
+			continue
+		}
+		name := strings.TrimSuffix(*opt.Name, ".")
+		if err := f.checkWrite(*record.ID, name, *opt.Type); err != nil {
+			return nil, &core.DetailedResponse{StatusCode: http.StatusBadRequest}, err
+		}
+		f.records[i] = dnsrecordsv1.DnsrecordDetails{ID: record.ID, Name: &name, Type: opt.Type, Content: opt.Content, TTL: opt.TTL}
+		return &dnsrecordsv1.DnsrecordResp{Result: &f.records[i]}, &core.DetailedResponse{StatusCode: http.StatusOK}, nil
+	}
+	return nil, &core.DetailedResponse{StatusCode: http.StatusNotFound}, fmt.Errorf("record %s not found", *opt.DnsrecordIdentifier)
+}
+
+// checkWrite returns an error if a record with the given name and type cannot
+// be written in place of the record with the given ID, if any.
+func (f *fakeCIS) checkWrite(id, name, recordType string) error {
+	if len(f.failWrites) != 0 && recordType == f.failWrites {
+		return fmt.Errorf("injected failure")
+	}
+	for _, record := range f.records {
+		if *record.ID == id || *record.Name != name || *record.Type == recordType {
+			continue
+		}
+		if *record.Type == "CNAME" || recordType == "CNAME" {
+			return fmt.Errorf("a %s record with name %s conflicts with an existing %s record", recordType, name, *record.Type)
+		}
+	}
+	return nil
+}
+
+func (f *fakeCIS) NewCreateDnsRecordOptions() *dnsrecordsv1.CreateDnsRecordOptions {
+	return &dnsrecordsv1.CreateDnsRecordOptions{}
+}
+
+func (f *fakeCIS) NewDeleteDnsRecordOptions(dnsrecordIdentifier string) *dnsrecordsv1.DeleteDnsRecordOptions {
+	return &dnsrecordsv1.DeleteDnsRecordOptions{DnsrecordIdentifier: &dnsrecordIdentifier}
+}
+
+func (f *fakeCIS) NewListAllDnsRecordsOptions() *dnsrecordsv1.ListAllDnsRecordsOptions {
+	return &dnsrecordsv1.ListAllDnsRecordsOptions{}
+}
+
+func (f *fakeCIS) NewUpdateDnsRecordOptions(dnsrecordIdentifier string) *dnsrecordsv1.UpdateDnsRecordOptions {
+	return &dnsrecordsv1.UpdateDnsRecordOptions{DnsrecordIdentifier: &dnsrecordIdentifier}
+}
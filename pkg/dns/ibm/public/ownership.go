@@ -0,0 +1,152 @@
+package public
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/IBM/networking-go-sdk/dnsrecordsv1"
+	configv1 "github.com/openshift/api/config/v1"
+	iov1 "github.com/openshift/api/operatoringress/v1"
+
+	"github.com/openshift/cluster-ingress-operator/pkg/dns"
+	dnsclient "github.com/openshift/cluster-ingress-operator/pkg/dns/ibm/public/client"
+)
+
+var _ dns.OwnershipRegistry = &Provider{}
+
+// GetOwner returns the owner that the companion TXT record of the given record
+// specifies, or the empty string if there is no companion TXT record.
+func (p *Provider) GetOwner(ctx context.Context, record *iov1.DNSRecord, zone configv1.DNSZone) (string, error) {
+	dnsService, ok := p.dnsServices[zone.ID]
+	if !ok {
+		return "", fmt.Errorf("GetOwner: unknown zone: %v", zone.ID)
+	}
+	current, err := listDNSRecords(ctx, dnsService, dns.OwnershipRecordName(record), "TXT")
+	if err != nil {
+		return "", fmt.Errorf("GetOwner: %w", err)
+	}
+	for _, resultData := range current {
+		if resultData.Content == nil {
+			continue
+		}
+		if owner, ok := dns.ParseOwnershipRecordValue(*resultData.Content); ok {
+			return owner, nil
+		}
+	}
+	return "", nil
+}
+
+// SetOwner creates or updates the companion TXT record of the given record so
+// that it specifies the given owner.
+func (p *Provider) SetOwner(ctx context.Context, record *iov1.DNSRecord, zone configv1.DNSZone, owner string) error {
+	dnsService, ok := p.dnsServices[zone.ID]
+	if !ok {
+		return fmt.Errorf("SetOwner: unknown zone: %v", zone.ID)
+	}
+	name := dns.OwnershipRecordName(record)
+	current, err := listDNSRecords(ctx, dnsService, name, "TXT")
+	if err != nil {
+		return fmt.Errorf("SetOwner: %w", err)
+	}
+	ttl := p.PublishedTTL(ctx, record, zone)
+	value := dns.OwnershipRecordValue(owner)
+	if len(current) == 0 {
+		createOpt := dnsService.NewCreateDnsRecordOptions()
+		createOpt.SetName(name)
+		createOpt.SetType("TXT")
+		createOpt.SetContent(value)
+		createOpt.SetTTL(ttl)
+		if _, _, err := dnsService.CreateDnsRecordWithContext(ctx, createOpt); err != nil {
+			return fmt.Errorf("SetOwner: failed to create the companion TXT record: %w", err)
+		}
+	} else {
+		if current[0].ID == nil {
+			return fmt.Errorf("SetOwner: record id is nil")
+		}
+		updateOpt := dnsService.NewUpdateDnsRecordOptions(*current[0].ID)
+		updateOpt.SetName(name)
+		updateOpt.SetType("TXT")
+		updateOpt.SetContent(value)
+		updateOpt.SetTTL(ttl)
+		if _, _, err := dnsService.UpdateDnsRecordWithContext(ctx, updateOpt); err != nil {
+			return fmt.Errorf("SetOwner: failed to update the companion TXT record: %w", err)
+		}
+	}
+	log.Info("updated companion TXT record", "zone", zone, "name", name, "owner", owner)
+	return nil
+}
+
+// DeleteOwner deletes the companion TXT record of the given record, if it
+// exists.
+func (p *Provider) DeleteOwner(ctx context.Context, record *iov1.DNSRecord, zone configv1.DNSZone) error {
+	dnsService, ok := p.dnsServices[zone.ID]
+	if !ok {
+		return fmt.Errorf("DeleteOwner: unknown zone: %v", zone.ID)
+	}
+	name := dns.OwnershipRecordName(record)
+	current, err := listDNSRecords(ctx, dnsService, name, "TXT")
+	if err != nil {
+		return fmt.Errorf("DeleteOwner: %w", err)
+	}
+	for _, resultData := range current {
+		if resultData.ID == nil {
+			return fmt.Errorf("DeleteOwner: record id is nil")
+		}
+		delOpt := dnsService.NewDeleteDnsRecordOptions(*resultData.ID)
+		if _, delResponse, err := dnsService.DeleteDnsRecordWithContext(ctx, delOpt); err != nil {
+			if delResponse == nil || delResponse.StatusCode != http.StatusNotFound {
+				return fmt.Errorf("DeleteOwner: failed to delete the companion TXT record: %w", err)
+			}
+		}
+		log.Info("deleted companion TXT record", "zone", zone, "name", name)
+	}
+	return nil
+}
+
+// RecordExists returns a Boolean value indicating whether the given zone has a
+// record with the name of the given record that publishing the record would
+// overwrite, namely one with the same type, or replace, namely one with a
+// conflicting type.
+func (p *Provider) RecordExists(ctx context.Context, record *iov1.DNSRecord, zone configv1.DNSZone) (bool, error) {
+	dnsService, ok := p.dnsServices[zone.ID]
+	if !ok {
+		return false, fmt.Errorf("RecordExists: unknown zone: %v", zone.ID)
+	}
+	current, err := listDNSRecords(ctx, dnsService, record.Spec.DNSName, "")
+	if err != nil {
+		return false, fmt.Errorf("RecordExists: %w", err)
+	}
+	recordType := string(record.Spec.RecordType)
+	for _, resultData := range current {
+		if resultData.Type == nil {
+			continue
+		}
+		if *resultData.Type == recordType || dns.RecordTypesConflict(*resultData.Type, recordType) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// listDNSRecords returns the records with the given name and, if recordType is
+// not empty, the given type.
+func listDNSRecords(ctx context.Context, dnsService dnsclient.DnsClient, name, recordType string) ([]dnsrecordsv1.DnsrecordDetails, error) {
+	listOpt := dnsService.NewListAllDnsRecordsOptions()
+	if len(recordType) != 0 {
+		listOpt.SetType(recordType)
+	}
+	listOpt.SetName(strings.TrimSuffix(name, "."))
+	result, response, err := dnsService.ListAllDnsRecordsWithContext(ctx, listOpt)
+	if err != nil {
+		if response == nil || response.StatusCode != http.StatusNotFound {
+			return nil, fmt.Errorf("failed to list the dns records: %w", err)
+		}
+		return nil, nil
+	}
+	if result == nil || result.Result == nil {
+		return nil, fmt.Errorf("ListAllDnsRecords returned nil as result")
+	}
+	return result.Result, nil
+}
@@ -0,0 +1,43 @@
+package dns
+
+import (
+	logf "github.com/openshift/cluster-ingress-operator/pkg/log"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var (
+	log = logf.Logger.WithName("dns")
+
+	// dnsProviderQueueDepth reports the number of changes that are queued
+	// for each zone and have not yet been passed to the DNS provider.
+	dnsProviderQueueDepth = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "ingress_operator_dns_provider_queue_depth",
+		Help: "Report the number of DNS record changes that are queued for each zone.",
+	}, []string{"zone"})
+
+	// dnsProviderAPIErrors counts the errors that the DNS provider
+	// returned, by reason: "Throttled" if the provider's API throttled the
+	// request, and "Error" otherwise.
+	dnsProviderAPIErrors = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "ingress_operator_dns_provider_api_errors_total",
+		Help: "Count the errors that the DNS provider returned for DNS record changes.",
+	}, []string{"reason"})
+
+	// metricsList is a list of metrics for this package.
+	metricsList = []prometheus.Collector{
+		dnsProviderQueueDepth,
+		dnsProviderAPIErrors,
+	}
+)
+
+// RegisterMetrics calls prometheus.Register on each metric in metricsList, and
+// returns on errors.
+func RegisterMetrics() error {
+	for _, metric := range metricsList {
+		if err := prometheus.Register(metric); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,140 @@
+package dns
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	iov1 "github.com/openshift/api/operatoringress/v1"
+
+	configv1 "github.com/openshift/api/config/v1"
+)
+
+// ownershipRecordPrefix is the prefix of the name of the companion TXT record
+// that identifies the owner of a record.  The companion TXT record has a
+// different name from the record that it describes because a TXT record cannot
+// have the same name as a CNAME record.
+const ownershipRecordPrefix = "_ingress-owner-"
+
+// AdoptUnownedRecordAnnotation is the annotation on a DNSRecord that allows the
+// operator to take over a record with the same name that already exists in a
+// zone without a companion TXT record.  Without the annotation, the operator
+// refuses to overwrite such a record unless it published the record itself, as
+// the DNSRecord's status indicates.
+const AdoptUnownedRecordAnnotation = "ingress.operator.openshift.io/adopt-unowned-dns-record"
+
+// ownershipRecordHeritage is the first field of the value of a companion TXT
+// record, which distinguishes the operator's companion TXT records from other
+// TXT records.
+const ownershipRecordHeritage = "heritage=openshift-ingress-operator"
+
+// OwnershipRegistry is implemented by a Provider that can store the owner of a
+// record in a companion TXT record and look it up, which allows the operator
+// to avoid overwriting or deleting records that belong to other clusters,
+// other ingresscontrollers, or other DNS management tools that use the same
+// convention.
+type OwnershipRegistry interface {
+	// GetOwner returns the owner that the companion TXT record of the
+	// given record in the given zone specifies, or the empty string if
+	// the record has no companion TXT record.
+	GetOwner(ctx context.Context, record *iov1.DNSRecord, zone configv1.DNSZone) (string, error)
+
+	// SetOwner creates or updates the companion TXT record of the given
+	// record in the given zone so that it specifies the given owner.
+	SetOwner(ctx context.Context, record *iov1.DNSRecord, zone configv1.DNSZone, owner string) error
+
+	// DeleteOwner deletes the companion TXT record of the given record in
+	// the given zone, if it exists.
+	DeleteOwner(ctx context.Context, record *iov1.DNSRecord, zone configv1.DNSZone) error
+
+	// RecordExists returns a Boolean value indicating whether the given
+	// zone has a record that publishing the given record would overwrite
+	// or delete, which tells a record without a companion TXT record
+	// apart from a record that has not been published.
+	RecordExists(ctx context.Context, record *iov1.DNSRecord, zone configv1.DNSZone) (bool, error)
+}
+
+// AtomicOwnershipRegistry is implemented by an OwnershipRegistry that can write
+// the companion TXT record of a record in the same atomic change that publishes
+// the record, so that neither is published without the other.
+type AtomicOwnershipRegistry interface {
+	OwnershipRegistry
+
+	// EnsureWithOwner is like Provider.Ensure but also creates or updates
+	// the companion TXT record of the given record so that it specifies
+	// the given owner.
+	EnsureWithOwner(ctx context.Context, record *iov1.DNSRecord, zone configv1.DNSZone, owner string) error
+
+	// ReplaceWithOwner is like Provider.Replace but also creates or
+	// updates the companion TXT record of the given record so that it
+	// specifies the given owner.
+	ReplaceWithOwner(ctx context.Context, record *iov1.DNSRecord, zone configv1.DNSZone, owner string) error
+}
+
+// AdoptsUnownedRecord returns a Boolean value indicating whether the given
+// record has the annotation that allows the operator to take over an existing
+// record without a companion TXT record.
+func AdoptsUnownedRecord(record *iov1.DNSRecord) bool {
+	return record.Annotations[AdoptUnownedRecordAnnotation] == "true"
+}
+
+// RecordOwner returns the owner that identifies records that are published for
+// the ingresscontroller with the given name in the cluster with the given
+// infrastructure ID.
+func RecordOwner(infraID, ingressControllerName string) string {
+	return fmt.Sprintf("infra-id=%s,ingresscontroller=%s", infraID, ingressControllerName)
+}
+
+// DNSRecordOwner returns the owner that identifies the record that is
+// published for the dnsrecord with the given namespace and name, which no
+// ingresscontroller owns, in the cluster with the given infrastructure ID.
+func DNSRecordOwner(infraID, namespace, name string) string {
+	return fmt.Sprintf("infra-id=%s,dnsrecord=%s/%s", infraID, namespace, name)
+}
+
+// OwnershipRecordName returns the name of the companion TXT record of the given
+// record.  The name includes the record type so that a record and a record of
+// another type that replaces it have distinct companion records.  For a
+// wildcard record, the wildcard label is replaced by the prefix; for other
+// records, the prefix is prepended as a new label.
+func OwnershipRecordName(record *iov1.DNSRecord) string {
+	prefix := ownershipRecordPrefix + strings.ToLower(string(record.Spec.RecordType))
+	if strings.HasPrefix(record.Spec.DNSName, "*.") {
+		return prefix + strings.TrimPrefix(record.Spec.DNSName, "*")
+	}
+	return prefix + "." + record.Spec.DNSName
+}
+
+// ReplacedRecords returns copies of the given record with each of the record
+// types that conflict with its type.  Replace deletes records of these types,
+// so the copies identify the companion TXT records, by OwnershipRecordName,
+// that become stale when the given record replaces a record of another type.
+func ReplacedRecords(record *iov1.DNSRecord) []*iov1.DNSRecord {
+	var replaced []*iov1.DNSRecord
+	for _, recordType := range []iov1.DNSRecordType{iov1.ARecordType, iov1.CNAMERecordType} {
+		if !RecordTypesConflict(string(recordType), string(record.Spec.RecordType)) {
+			continue
+		}
+		replacedRecord := record.DeepCopy()
+		replacedRecord.Spec.RecordType = recordType
+		replaced = append(replaced, replacedRecord)
+	}
+	return replaced
+}
+
+// OwnershipRecordValue returns the unquoted value of the companion TXT record
+// that specifies the given owner.
+func OwnershipRecordValue(owner string) string {
+	return ownershipRecordHeritage + "," + owner
+}
+
+// ParseOwnershipRecordValue returns the owner that the given value of a
+// companion TXT record specifies and a Boolean value indicating whether the
+// value is the value of a companion TXT record.  The value may be quoted.
+func ParseOwnershipRecordValue(value string) (string, bool) {
+	value = strings.Trim(value, `"`)
+	if !strings.HasPrefix(value, ownershipRecordHeritage+",") {
+		return "", false
+	}
+	return strings.TrimPrefix(value, ownershipRecordHeritage+","), true
+}
@@ -0,0 +1,76 @@
+package dns
+
+import (
+	"testing"
+
+	iov1 "github.com/openshift/api/operatoringress/v1"
+)
+
+// TestOwnershipRecordName verifies that OwnershipRecordName returns distinct
+// names for records of different types and replaces the wildcard label.
+func TestOwnershipRecordName(t *testing.T) {
+	testCases := []struct {
+		name       string
+		recordType iov1.DNSRecordType
+		expected   string
+	}{
+		{"*.apps.example.com.", iov1.ARecordType, "_ingress-owner-a.apps.example.com."},
+		{"api.example.com.", iov1.CNAMERecordType, "_ingress-owner-cname.api.example.com."},
+	}
+	for _, tc := range testCases {
+		record := &iov1.DNSRecord{Spec: iov1.DNSRecordSpec{DNSName: tc.name, RecordType: tc.recordType}}
+		if actual := OwnershipRecordName(record); actual != tc.expected {
+			t.Errorf("expected %q for %s record %q, got %q", tc.expected, tc.recordType, tc.name, actual)
+		}
+	}
+}
+
+// TestReplacedRecords verifies that ReplacedRecords returns a copy of the given
+// record with the other of the A and CNAME types, whose companion TXT record
+// has a distinct name.
+func TestReplacedRecords(t *testing.T) {
+	testCases := []struct {
+		recordType iov1.DNSRecordType
+		expected   iov1.DNSRecordType
+	}{
+		{iov1.ARecordType, iov1.CNAMERecordType},
+		{iov1.CNAMERecordType, iov1.ARecordType},
+	}
+	for _, tc := range testCases {
+		record := &iov1.DNSRecord{Spec: iov1.DNSRecordSpec{DNSName: "*.apps.example.com.", RecordType: tc.recordType}}
+		replaced := ReplacedRecords(record)
+		if len(replaced) != 1 || replaced[0].Spec.RecordType != tc.expected || replaced[0].Spec.DNSName != record.Spec.DNSName {
+			t.Errorf("expected one %s record replaced by %s record, got %+v", tc.expected, tc.recordType, replaced)
+			continue
+		}
+		if OwnershipRecordName(replaced[0]) == OwnershipRecordName(record) {
+			t.Errorf("expected distinct companion TXT record names for %s and %s records", tc.recordType, tc.expected)
+		}
+		if record.Spec.RecordType != tc.recordType {
+			t.Errorf("expected ReplacedRecords not to modify the given record, got type %s", record.Spec.RecordType)
+		}
+	}
+}
+
+// TestParseOwnershipRecordValue verifies that ParseOwnershipRecordValue parses
+// the values that OwnershipRecordValue returns, quoted or not, and ignores
+// other values.
+func TestParseOwnershipRecordValue(t *testing.T) {
+	owner := RecordOwner("cluster-abcde", "default")
+	testCases := []struct {
+		value       string
+		expectOwner string
+		expectOK    bool
+	}{
+		{OwnershipRecordValue(owner), owner, true},
+		{`"` + OwnershipRecordValue(owner) + `"`, owner, true},
+		{`"heritage=external-dns,external-dns/owner=default"`, "", false},
+		{"v=spf1 -all", "", false},
+	}
+	for _, tc := range testCases {
+		owner, ok := ParseOwnershipRecordValue(tc.value)
+		if owner != tc.expectOwner || ok != tc.expectOK {
+			t.Errorf("expected (%q, %t) for %q, got (%q, %t)", tc.expectOwner, tc.expectOK, tc.value, owner, ok)
+		}
+	}
+}
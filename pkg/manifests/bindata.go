@@ -15,10 +15,10 @@
 // assets/router/service-account.yaml (213B)
 // assets/router/service-cloud.yaml (631B)
 // assets/router/service-internal.yaml (432B)
-// manifests/00-cluster-role.yaml (3.181kB)
+// manifests/00-cluster-role.yaml (4.509kB)
 // manifests/00-custom-resource-definition-internal.yaml (7.756kB)
 // manifests/00-custom-resource-definition.yaml (101.564kB)
-// manifests/00-ingress-credentials-request.yaml (4.861kB)
+// manifests/00-ingress-credentials-request.yaml (4.9kB)
 // manifests/00-namespace.yaml (508B)
 // manifests/0000_90_ingress-operator_00_prometheusrole.yaml (446B)
 // manifests/0000_90_ingress-operator_01_prometheusrolebinding.yaml (514B)
@@ -30,9 +30,11 @@
 // manifests/01-service-account.yaml (405B)
 // manifests/01-service.yaml (538B)
 // manifests/01-trusted-ca-configmap.yaml (517B)
-// manifests/02-deployment-ibm-cloud-managed.yaml (3.856kB)
-// manifests/02-deployment.yaml (4.288kB)
+// manifests/01-webhook-service.yaml (546B)
+// manifests/02-deployment-ibm-cloud-managed.yaml (4.175kB)
+// manifests/02-deployment.yaml (4.623kB)
 // manifests/03-cluster-operator.yaml (1.047kB)
+// manifests/04-validating-webhook-configuration.yaml (1.767kB)
 // manifests/image-references (435B)
 
 package manifests
@@ -102,7 +104,7 @@ func (fi bindataFileInfo) Sys() interface{} {
 	return nil
 }
 
-var _assetsCanaryDaemonsetYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x54\xc1\x6e\x1b\x47\x0c\xbd\xeb\x2b\x08\x19\x45\x5a\xa0\x52\x94\x1e\x0a\x63\x6e\x81\xed\xa2\x06\x1c\x45\x88\x9c\x5e\x8a\x1e\xe8\xd9\xb7\xd2\x40\xb3\xc3\x29\x87\x23\x5b\x28\xfa\xef\xc5\xae\xa4\x48\x72\xd3\x22\xa7\x9d\x25\xf9\x1e\x39\x8f\xe4\x5c\xd1\xaf\x88\x51\xe8\x63\x46\x2a\xeb\xd0\x1a\xdd\xa7\x95\xa2\x14\xba\xe1\xc4\xba\xa3\x86\xd1\x49\x2a\xb0\xd1\x15\x2d\x33\x7c\x68\x83\xa7\x2d\xc7\x8a\x42\xac\xa0\x02\x23\x36\xd2\x9a\x2c\x74\x18\x6d\x42\x6a\x1c\xdd\x0e\xa0\x25\x6c\xc4\x39\xfc\x06\x2d\x41\x92\x23\xce\xb9\xbc\xdd\xbe\x1b\x5d\x51\xe2\x0e\xc4\xa9\x19\x0e\x25\xb3\xc7\x57\xb8\xa6\xa3\x92\xe1\xdd\x88\x28\xab\x0c\x35\xdd\x82\x9b\x18\x12\x96\xf0\x92\x9a\xe2\xe8\xe7\xd9\x6c\x44\x64\xe8\x72\x64\x43\x1f\x4a\xd4\xc1\xb8\x61\xe3\xfd\x1f\x11\xa7\x24\xc6\x16\x24\x95\xa3\x89\xc8\x58\x57\xb0\xe9\xb3\xe8\x26\x0a\x37\x53\x39\x5e\x7f\x1a\xe4\x6d\xc7\x89\x57\xe8\x90\xcc\xd1\x9b\xbf\xc6\x68\x5b\x78\x1b\x3b\x1a\x2f\x14\x2d\x54\xd1\xdc\x56\x0d\x69\xb5\xf4\x6b\x34\x35\x86\xb4\x1a\xff\xfd\x66\xa0\x3e\x16\x3c\x9c\xe1\xab\x06\xdb\xdd\x48\x32\xbc\xd8\x29\xb7\xd6\xf4\xbe\xcc\x25\x7d\x12\x31\x47\xa6\x15\x5f\x5c\x05\xde\x4b\x97\x17\x2a\x6d\x88\x38\x41\x88\x6c\x97\xe1\xe8\xd3\x5e\x99\x5b\xb4\x5c\xa3\x1d\xdc\x59\x83\x0c\x89\x22\x97\x32\xe7\x0e\x8e\xca\xae\x18\xba\x89\x8f\xb5\x18\x74\xe2\x35\x58\xf0\x1c\x0f\x00\x2f\xc9\x38\x24\xe8\x99\x20\x93\xa1\x15\x8e\x0a\x74\x8b\xc9\x1a\x1c\x6d\xed\xd7\xf0\x9b\x89\x1f\xe6\xe0\xac\x94\xff\xbc\xd8\xa0\x76\x8c\xf2\xbc\xd0\xb0\x0d\x11\x2b\xdc\x15\xcf\x71\xd0\xde\x51\xcb\xb1\xe0\x22\xd6\x73\xe6\xa7\x10\x83\x05\x94\x4b\x16\xa2\x46\x25\x3b\xfa\x7d\xfc\xfe\xe1\x61\xfc\xc7\x99\xef\x8a\xee\x3b\x5e\xed\x87\xc7\x4b\xd7\xf5\xdf\xaf\x8c\xe1\x09\x10\xfa\xf0\x45\x8d\x71\x21\x31\xf8\x9d\xa3\xfb\x76\x2e\xb6\x50\x14\x24\x3b\x17\x18\xda\x85\x34\xd4\xfa\x01\xa5\xf4\xa0\x03\xe0\x17\x8e\xf1\x89\xfd\xe6\x51\x1e\x64\x55\x3e\xa6\x3b\x55\xd1\x33\x64\x16\xb5\x8b\xfa\x27\x27\x85\x17\xa2\xe6\xe8\x7a\x76\x3d\xbb\xb8\x5f\x56\x31\xf1\x12\x1d\x3d\xde\x2c\xfe\x17\x79\x7d\x7d\xfd\x4d\x48\x45\x91\xaa\xfe\xb5\x90\x8a\x3f\x2b\x8a\xfd\x4b\x5e\x9f\xab\xa3\x77\xb3\xee\x95\xb9\x43\x27\xba\x73\xf4\xd3\xec\x43\x38\xb8\x92\x34\x58\x22\xc2\x9b\xe8\x89\x65\x53\x9f\xa0\x09\x86\xd2\x2f\x8c\x14\x47\x31\xa4\xfa\x72\xf0\x9b\x44\xe8\xeb\x95\x9b\xd0\x7e\x91\x1c\xcd\xe5\xb0\x39\xe7\x7d\xda\x60\xe7\x86\x64\x13\x95\x88\xe9\x65\x82\x90\x5a\xe5\xb3\x60\xc9\x3d\xbf\xa8\xa3\xbb\x97\x50\xac\x8c\x88\x6a\x6e\xd8\xb0\x34\x65\xc3\x6a\xb7\x4f\x7b\x58\x1a\x89\xfd\x8e\x7e\x1e\x02\x06\xbb\x9e\x5b\x8e\x15\x5e\xd1\x5c\x0c\x8e\x1e\xd7\x38\x3c\x7a\x43\x33\xfa\x58\x28\xa9\xd4\xd4\x14\xb2\x35\x28\x43\x3d\x92\xf5\x53\x58\xf3\x17\xf0\xf7\x35\xc5\xb0\xc1\x10\xd1\x20\x47\xd9\xf5\x0f\xc8\x19\xc5\x8f\xf4\xbc\x0e\x7e\x7d\x64\x6a\xe4\x39\xfd\x30\x3d\xc0\x3b\x7e\xf9\x9c\x78\xcb\x21\xf2\x53\x44\xdf\x99\xef\x46\xff\x04\x00\x00\xff\xff\x13\xdc\x4e\x79\x9a\x05\x00\x00")
+var _assetsCanaryDaemonsetYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x54\x51\x6b\x23\x47\x0c\x7e\xf7\xaf\x10\x0e\xe5\x5a\xa8\x7d\xbe\x3e\x94\x30\x6f\x47\x92\xd2\x40\xce\x67\xce\xb9\xbe\x94\x3e\x28\xb3\xdf\xda\x83\x67\x47\x53\x8d\xc6\x89\x29\xfd\xef\x65\xd7\xeb\xc4\x09\xc7\x51\x66\xc1\x5e\xe9\xfb\xa4\x4f\x1a\x69\x2f\xe8\x77\xc4\x28\xf4\x39\x23\x95\x6d\x68\x8d\x6e\xd3\x46\x51\x0a\x5d\x71\x62\x3d\x50\xc3\xe8\x24\x15\xd8\xe4\x82\xd6\x19\x3e\xb4\xc1\xd3\x9e\x63\x45\x21\x56\x50\x81\x11\x1b\x69\x4d\x16\x3a\x4c\x76\x21\x35\x8e\xae\x07\xd2\x1a\x36\xe1\x1c\xfe\x80\x96\x20\xc9\x11\xe7\x5c\xde\xef\x3f\x4c\x2e\x28\x71\x07\xe2\xd4\x0c\x7f\x4a\x66\x8f\x6f\xc4\x9a\x4f\x4a\x86\x77\x13\xa2\xac\x32\x68\xba\x06\x37\x31\x24\xac\xe1\x25\x35\xc5\xd1\xaf\x8b\xc5\x84\xc8\xd0\xe5\xc8\x86\x1e\x4a\xd4\xc1\xb8\x61\xe3\xe3\x1b\x11\xa7\x24\xc6\x16\x24\x95\x93\x89\xc8\x58\x37\xb0\xf9\xa3\xe8\x2e\x0a\x37\x73\x39\x95\x3f\x0f\xf2\xbe\xe3\xc4\x1b\x74\x48\xe6\xe8\xdd\x3f\x53\xb4\x2d\xbc\x4d\x1d\x4d\x57\x8a\x16\xaa\x68\xae\xab\x86\xb4\x59\xfb\x2d\x9a\x1a\x43\xda\x4c\xff\x7d\x37\x84\x3e\x09\xee\x4f\x81\xaf\x1a\xec\x70\x25\xc9\xf0\x64\x2f\xb9\xb5\xa6\x8f\x65\x29\xe9\x8b\x88\x39\x32\xad\x78\x76\x15\x78\x2f\x5d\x5e\xa9\xb4\x21\x8e\xf5\x8c\x82\x0f\x19\x8e\xbe\x1c\xbb\x7c\x8d\x96\x6b\xb4\xd1\x9d\x35\xc8\x90\x28\x72\x29\x4b\xee\xe0\xa8\x1c\x8a\xa1\x9b\xf9\x58\x8b\x41\x67\x5e\x83\x05\xcf\x71\x24\x78\x49\xc6\x21\x41\xcf\x1a\x32\x1b\xae\xc2\x51\x81\xee\x31\xdb\x82\xa3\x6d\xfd\x16\x7e\x37\xf3\xc3\x1c\x3c\x03\xbf\x53\x58\xff\x70\x8c\xf2\xb8\xd2\xb0\x0f\x11\x1b\xdc\x14\xcf\x71\xe8\xbd\xa3\x96\x63\x79\xa9\xb4\x3f\x9e\x33\x3f\x84\x18\x2c\xe0\x4c\xc9\xf1\x69\x54\xb2\xa3\x3f\xa7\x1f\xef\xee\xa6\x7f\x9d\xf9\x2e\xe8\xb6\xe3\xcd\x71\x78\xbc\x74\x5d\xff\xfb\x8d\x31\x3c\xc1\x89\x42\x0f\x5f\xd5\x18\x57\x12\x83\x3f\x38\xba\x6d\x97\x62\x2b\x45\x41\x3a\x75\xb0\x3f\x06\xed\x42\x1a\xb4\x7e\x42\x29\x3d\x69\x24\xfc\xc6\x31\x3e\xb0\xdf\xdd\xcb\x9d\x6c\xca\xe7\x74\xa3\x2a\x7a\xc6\xcc\xa2\xf6\x4a\xff\xec\xa5\xc3\x2b\x51\x73\x74\xb9\xb8\x5c\x9c\xf9\x87\x81\x36\xf1\x12\x1d\xdd\x5f\xad\xbe\xcb\xbc\xbc\xbc\xfc\x5f\x4c\x45\x91\xaa\xfe\x6d\x23\x15\x7f\x57\x94\xd7\xf2\xfa\xe3\x73\x75\xf4\x61\xd1\xbd\x31\x77\xe8\x44\x0f\x8e\x7e\x59\x7c\x0a\xa3\x2b\x49\x83\x35\x22\xbc\x89\xbe\x44\xd9\xd5\x07\x68\x82\xa1\xf4\x0b\x23\xc5\x51\x0c\xa9\x3e\x8d\x7e\x93\x08\x7d\xbb\x72\x33\x3a\x2e\x92\xa3\xa5\x8c\x9b\x73\x7e\x4f\x3b\x1c\xdc\x90\x6c\xa6\x12\x31\x7f\x9d\x20\xa4\x56\xf9\x0c\x2c\xb9\x8f\x2f\xea\xe8\xe6\x29\x14\x2b\x13\xa2\x9a\x1b\x36\xac\x4d\xd9\xb0\x39\x1c\xd3\x8e\x4b\x23\xb1\xdf\xd1\xaf\x03\x60\xb0\xeb\xb9\xe5\xa4\xf0\x82\x96\x62\x70\x74\xbf\xc5\xf8\xd1\x1b\x2e\xa3\xc7\x42\x49\xa5\xa6\xa6\x90\x6d\x41\x19\xea\x91\xac\x9f\xc2\x9a\x9f\xc9\x3f\xd6\x14\xc3\x0e\x03\xa2\x41\x8e\x72\xe8\x3f\x20\x67\x21\x7e\xa6\xc7\x6d\xf0\xdb\x53\xa4\x46\x1e\xd3\x4f\xf3\x91\xde\xf1\xd3\xd7\xc4\x7b\x0e\x91\x1f\x22\x1c\x7d\x58\xfc\x30\xf9\x6f\x00\x13\xdc\x4e\x79\x9a\x05\x00\x00")
 
 func assetsCanaryDaemonsetYamlBytes() ([]byte, error) {
 	return bindataRead(
@@ -122,7 +124,7 @@ func assetsCanaryDaemonsetYaml() (*asset, error) {
 	return a, nil
 }
 
-var _assetsCanaryNamespaceYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x54\xcc\x31\x4e\x03\x31\x10\x05\xd0\xde\xa7\xf8\x5a\xea\x0d\xa2\xf5\x21\xa0\xa3\xff\xac\x7f\x16\x2b\xf6\xcc\xca\x1e\x12\x71\x7b\x14\x24\x84\xd2\x3f\xbd\x4b\xb5\x92\xf1\xca\xae\x79\x70\x53\xe2\x51\xdf\x35\x66\x75\xcb\xb8\xbe\xa4\xae\x60\x61\x30\x27\xc0\xd8\x95\xe1\x87\x6c\x7e\xd6\x73\xac\xd5\xf6\xa1\x39\xd7\x8d\xc6\xf1\x9d\x00\x9a\x79\x30\xaa\xdb\xbc\x7b\xfc\xdb\x53\xf5\x67\xf3\xa2\x75\xaa\x69\x0b\x1f\x19\xcb\x82\x27\xbc\x5d\x35\x46\x2d\xc2\xde\xfc\x83\x0d\x45\x67\x7e\xb5\xc0\xdd\xe2\xcf\xfe\x56\x37\x1f\x97\xe6\x2c\xa7\x87\x93\xad\xf9\x4d\x25\x63\xe9\x34\xee\xea\xb2\x58\xd2\x4f\x00\x00\x00\xff\xff\xfc\xc4\xd0\x2a\xd4\x00\x00\x00")
+var _assetsCanaryNamespaceYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x54\xcc\x31\x4e\x03\x31\x10\x05\xd0\xde\xa7\xf8\x5a\xea\x0d\xa2\xf5\x21\xa0\xa3\xff\xac\x7f\x16\x2b\xf6\xcc\xca\x1e\x12\x71\x7b\x14\x24\x84\xd2\x3f\xbd\x4b\xb5\x92\xf1\xca\xae\x79\x70\x53\xe2\x51\xdf\x35\x66\x75\xcb\xb8\xbe\xa4\xae\x60\x61\x30\x27\xc0\xd8\x95\xe1\x87\x6c\x7e\xd6\x73\xac\xd5\xf6\xa1\x39\xd7\x8d\xc6\xf1\x9d\x00\x9a\x79\x30\xaa\xdb\xbc\x7b\xfc\xdb\x53\xf5\x67\xf3\xa2\x75\xaa\x69\x0b\x1f\x19\xcb\x82\x27\xbc\x5d\x35\x46\x2d\xc2\xde\xfc\x83\x0d\x45\x67\x7e\xb5\xc0\xdd\xe2\xcf\xfe\x56\x37\x1f\x97\xe6\x2c\xa7\x87\x93\xad\xf9\x4d\x25\x63\xe9\x34\xee\xea\xb2\x58\xd2\xcf\x00\xfc\xc4\xd0\x2a\xd4\x00\x00\x00")
 
 func assetsCanaryNamespaceYamlBytes() ([]byte, error) {
 	return bindataRead(
@@ -142,7 +144,7 @@ func assetsCanaryNamespaceYaml() (*asset, error) {
 	return a, nil
 }
 
-var _assetsCanaryRouteYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x54\x90\x41\x4f\xc3\x30\x0c\x85\xef\xf9\x15\xd6\x7a\xa6\x74\xb7\xa9\x57\x84\x04\x17\x98\x36\xc4\xdd\x4b\xbc\xce\x22\x75\x22\xc7\xdd\xd8\xbf\x47\x4d\x99\x06\xb7\xbe\xfa\xf9\x7b\x7e\x69\xe0\x85\x62\x4c\xf0\x9e\x49\xca\x89\x8f\x06\xaf\x32\x28\x95\x02\x4f\x28\xa8\x57\xd0\x34\x19\xb9\x06\xf6\x99\x3c\x1f\xd9\xc3\x19\xe3\x44\x05\x50\x09\x30\xe7\xc8\x14\x00\x0d\x74\x12\xe3\x91\xdc\x17\x4b\xe8\x61\x57\x97\x30\xf3\x27\x69\xe1\x24\xfd\x82\x69\xd3\x2d\xa5\xe5\xf4\x78\x5e\xbb\x06\x04\x47\x02\x94\x50\x3f\x4a\x46\x4f\x95\x5c\xc8\xfe\x50\x5b\x37\x92\x61\x40\xc3\xde\x01\xa0\x48\x32\x34\x4e\x52\x66\x09\x70\xc2\xac\xe9\xfb\xda\xd6\x0c\xfd\x1f\x72\xc0\x88\xe2\xa9\x87\x95\xa6\x49\x82\xa6\x03\xcb\xca\x95\x4c\x7e\xde\xcd\x49\x6d\x61\x18\xea\x40\xb6\x9d\x35\x6c\xba\x4d\xf7\x60\x3e\x3b\x00\x4b\xcb\x78\xa9\xb5\x27\x3d\xb3\xa7\xfa\xa7\xb9\xa9\xa5\xc2\xfd\x60\xa8\xef\x30\x5b\x2e\xc4\xc3\xc9\x7a\x58\x77\xdd\x8c\x8a\xbf\xe7\x1a\xe9\xc8\x52\x0b\xf4\x40\x61\x58\xcc\x2c\x85\xfc\xa4\xf4\x1c\x06\xfa\xb8\x3b\xb6\x29\xb2\xbf\xf6\xb0\xa3\xc0\x4a\xde\x1c\xc0\x85\x63\xf0\xa8\xe1\x36\x7a\x4b\x42\xee\x27\x00\x00\xff\xff\xa2\x4f\x56\xb1\xc8\x01\x00\x00")
+var _assetsCanaryRouteYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x54\x90\x41\x4f\xc3\x30\x0c\x85\xef\xf9\x15\xd6\x7a\xa6\x74\xb7\xa9\x57\x84\x04\x17\x98\x36\xc4\xdd\x4b\xbc\xce\x22\x75\x22\xc7\xdd\xd8\xbf\x47\x4d\x99\x18\xa7\xe4\xc5\xf6\xf7\x9e\xd3\xc0\x0b\xc5\x98\xe0\x3d\x93\x94\x13\x1f\x0d\x5e\x65\x50\x2a\x05\x9e\x50\x50\xaf\xa0\x69\x32\x72\x0d\xec\x33\x79\x3e\xb2\x87\x33\xc6\x89\x0a\xa0\x12\x60\xce\x91\x29\x00\x1a\xe8\x24\xc6\x23\xb9\x2f\x96\xd0\xc3\xae\x0e\x61\xe6\x4f\xd2\xc2\x49\xfa\x05\xd3\xa6\x9b\x4b\xcb\xe9\xf1\xbc\x76\x0d\x08\x8e\x04\x28\xa1\x5e\x4a\x46\x4f\x95\x5c\xc8\xee\xa8\xad\x1b\xc9\x30\xa0\x61\xef\x00\x50\x24\x19\x1a\x27\x29\xb3\x04\x38\x61\xd6\xf4\x7d\x6d\xab\x87\xfe\x37\x39\x60\x44\xf1\xd4\xc3\x4a\xd3\x24\x41\xd3\x81\x65\xe5\x4a\x26\x3f\xcf\xe6\xa4\x36\x9f\x00\x86\x3a\x90\x6d\x67\x0d\x9b\x6e\xd3\x3d\x98\xcf\x0e\xc0\xd2\x52\x5e\xd6\xda\x93\x9e\xd9\x53\x7d\x69\x6e\xaa\x26\xbf\x0b\x0c\xf5\x1f\xe6\x96\x0b\xf1\x70\xb2\x1e\xd6\x5d\x37\xa3\xe2\x6f\x5c\x23\x1d\x59\xea\x02\x3d\x50\x18\x16\x1e\x4b\x21\x3f\x29\x3d\x87\x81\x3e\xfe\x3a\xb6\x29\xb2\xbf\xf6\xb0\xa3\xc0\x4a\xde\x1c\xc0\x85\x63\xf0\xa8\xe1\x56\x7a\x4b\x42\xee\x67\x00\xa2\x4f\x56\xb1\xc8\x01\x00\x00")
 
 func assetsCanaryRouteYamlBytes() ([]byte, error) {
 	return bindataRead(
@@ -162,7 +164,7 @@ func assetsCanaryRouteYaml() (*asset, error) {
 	return a, nil
 }
 
-var _assetsCanaryServiceYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\xcc\xb1\x6a\x33\x31\x10\x04\xe0\x5e\x4f\x31\x70\xb5\x7f\xfc\x77\x42\xed\x35\x71\x95\x03\x87\xf4\x8b\x6e\xed\x88\xc8\xd2\xb2\xbb\x77\xe0\xb7\x0f\xbe\x0b\xc1\xa4\x4a\x27\xcd\xcc\x7e\x03\x5e\xb8\xd6\x8e\x57\xe1\x66\x1f\xe5\xe2\x38\xb5\xab\xb2\x19\x46\x6a\xa4\x77\x18\xeb\x5a\x32\x87\x01\x67\xe1\x5c\x2e\x25\x63\xa5\xba\xb0\x81\x94\x41\x22\xb5\xf0\x0c\x72\xe8\xd2\xbc\xdc\x38\x7c\x96\x36\x27\x9c\xbf\xcf\x48\xca\x3b\xab\x95\xde\x12\xd6\xff\x61\x40\xa3\x1b\x83\xda\xbc\x3d\x4c\x28\xf3\x06\x19\xfb\x13\xf2\x2f\x98\x70\x4e\x01\xf0\xbb\x70\xc2\x58\x17\x73\xd6\xd3\x14\x00\xe9\xea\xf6\xa8\x0e\x1b\x91\x10\x8f\xf1\x78\xf0\x2c\x01\xd8\xdb\x3d\xda\xbf\xda\xbd\xe7\x5e\x13\xde\xc6\x69\x4b\x9c\xf4\xca\x3e\x3d\xcf\x7e\xa0\x18\xe3\x6f\x28\xc6\xf8\x17\xe8\x31\xfb\x0a\x00\x00\xff\xff\x41\xbe\x5b\x7c\x4b\x01\x00\x00")
+var _assetsCanaryServiceYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\xcc\x31\x6b\x03\x31\x0c\x05\xe0\xdd\xbf\xe2\xc1\xcd\x29\xe9\x26\xbc\xde\xd2\x4c\x0d\xa4\x74\x17\x3e\x25\x35\x75\x6c\x23\xe9\x0e\xf2\xef\x4b\xee\x4a\x09\x9d\xb2\x49\x7a\x7a\xdf\x80\x37\x29\xa5\xe1\xbd\x4b\xb5\xaf\x7c\x76\x1c\xea\x45\xc5\x0c\x23\x57\xd6\x1b\x4c\x74\xc9\x49\xc2\x80\x53\x97\x94\xcf\x39\x61\xe1\x32\x8b\x81\x55\xc0\xbd\x97\x2c\x13\xd8\xa1\x73\xf5\x7c\x95\xf0\x9d\xeb\x14\x71\xfa\xad\x71\xcf\x9f\xa2\x96\x5b\x8d\x58\x5e\xc3\x80\xca\x57\x01\xd7\x69\x1d\xac\x73\x92\x15\x32\xf1\x07\xe4\x25\x58\x97\x14\x03\xe0\xb7\x2e\x11\x63\x99\xcd\x45\x0f\xc7\x00\xf4\xa6\x6e\xf7\x68\xb7\x12\x11\xb4\xa7\xfd\xce\x53\x0f\xc0\x96\x6e\xa7\x6d\xd5\xe6\x2d\xb5\x12\xf1\x31\xde\xcb\x80\xb3\x5e\xc4\x8f\x8f\x6f\x7f\x10\x11\xfd\x87\x88\xe8\x19\x88\x88\xc2\xcf\x00\x41\xbe\x5b\x7c\x4b\x01\x00\x00")
 
 func assetsCanaryServiceYamlBytes() ([]byte, error) {
 	return bindataRead(
@@ -182,7 +184,7 @@ func assetsCanaryServiceYaml() (*asset, error) {
 	return a, nil
 }
 
-var _assetsRouterClusterRoleBindingYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x84\x8f\x31\x4e\xc4\x40\x0c\x45\xfb\x39\x85\x25\xea\x0c\xa2\x43\xd3\x01\x37\x58\x24\x7a\xef\xc4\xbb\x31\x49\xec\xc8\xf6\xa4\xe0\xf4\x28\x4a\x44\xc3\x4a\x29\x2d\xf9\xbf\xff\xfe\x13\xbc\xb3\xf4\x0e\x31\x10\x98\xb6\x20\x03\xd3\x89\x20\x14\x38\x1c\x3e\xc9\x56\xae\x04\x6f\xb5\x6a\x93\xc8\x69\x64\xe9\x0b\x7c\x4c\xcd\x83\xec\xa2\x13\x6d\x71\x96\x7b\xc2\x85\xbf\xc8\x9c\x55\x0a\xd8\x15\x6b\xc6\x16\x83\x1a\xff\x60\xb0\x4a\x1e\x5f\x3d\xb3\x3e\xaf\x2f\x69\xa6\xc0\x1e\x03\x4b\x02\x10\x9c\xa9\x80\x2e\x24\x3e\xf0\x2d\x3a\x96\xbb\x91\x7b\xb7\x9b\x24\x6f\xd7\x6f\xaa\xe1\x25\x75\xb0\x17\x1f\x3e\x87\xce\x1f\xe1\xf8\xdf\x4f\x5f\xb0\x3e\xa2\xa6\x6d\xd8\x85\x6e\x5b\xf1\xbf\x19\xe7\x32\x27\xf0\xdf\x00\x00\x00\xff\xff\x83\x13\xa9\xa6\x49\x01\x00\x00")
+var _assetsRouterClusterRoleBindingYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x84\x8f\x31\x4e\xc4\x40\x0c\x45\xfb\x39\x85\x25\xea\x0c\xa2\x43\xd3\x01\x37\x58\x24\x7a\xef\xc4\xbb\x31\x49\xec\xc8\xf6\xa4\xe0\xf4\x28\x4a\x44\xc3\x4a\x29\x2d\xf9\xbf\xff\xfe\x13\xbc\xb3\xf4\x0e\x31\x10\x98\xb6\x20\x03\xd3\x89\x20\x14\x38\x1c\x3e\xc9\x56\xae\x04\x6f\xb5\x6a\x93\xc8\x69\x64\xe9\x0b\x7c\x4c\xcd\x83\xec\xa2\x13\x6d\x71\x96\x7b\xc2\x85\xbf\xc8\x9c\x55\x0a\xd8\x15\x6b\xc6\x16\x83\x1a\xff\x60\xb0\x4a\x1e\x5f\x3d\xb3\x3e\xaf\x2f\x69\xa6\xc0\x1e\x03\x4b\x02\x10\x9c\xa9\x80\x2e\x24\x3e\xf0\x2d\x3a\x96\xbb\x91\x7b\xb7\x9b\x24\x6f\xd7\x6f\xaa\xe1\x25\x75\xb0\x17\x1f\x3e\x87\xce\x1f\xe1\xf8\xdf\x4f\x5f\xb0\x3e\xa2\xa6\x6d\xd8\x85\x6e\x5b\xf1\xbf\x19\xe7\x32\x27\xf0\xdf\x01\x00\x83\x13\xa9\xa6\x49\x01\x00\x00")
 
 func assetsRouterClusterRoleBindingYamlBytes() ([]byte, error) {
 	return bindataRead(
@@ -202,7 +204,7 @@ func assetsRouterClusterRoleBindingYaml() (*asset, error) {
 	return a, nil
 }
 
-var _assetsRouterClusterRoleYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x94\x92\x31\x6f\xdb\x40\x0c\x85\x77\xfd\x0a\x22\x9d\xad\xa0\x5b\xa1\xb5\x43\xb7\x0e\x45\xd1\x9d\x3a\xbd\x54\xac\x2f\xc7\x03\xc9\x93\x9b\xfe\xfa\x42\xb2\x13\x18\xb6\x83\x24\x9b\xa8\x3b\x7e\xef\xdd\x23\x3f\xd1\xd7\xdc\x3c\x60\xe4\x49\x2b\x26\x32\xcd\xa0\x07\x35\x32\x6d\x01\xf3\x9e\x7e\xce\xe2\xe4\xb3\xb6\x3c\xd1\x08\x62\x27\x83\x87\x49\x0a\x59\xb6\xb2\xaa\xbb\x8c\x19\x7d\xb7\x97\x32\x0d\xcf\xc4\x1f\x9a\xd1\x71\x95\x5f\x30\x17\x2d\x03\xd9\xc8\xa9\xe7\x16\xb3\x9a\xfc\xe3\x10\x2d\xfd\xfe\x8b\xf7\xa2\xf7\xcb\xe7\xee\x11\xc1\x13\x07\x0f\x1d\x51\xe1\x47\x0c\xa4\x15\xc5\x67\x79\x88\x9d\x94\xdf\x06\xf7\xdd\xd1\x52\x67\x2d\xc3\x87\x6e\x47\x5c\xe5\x9b\x69\xab\xbe\x36\xed\xe8\xee\xae\xa3\xd5\x9b\x36\x4b\x38\xfd\x43\x99\xaa\x4a\x09\xdf\xaa\x15\xec\x95\x13\x8e\xa5\xc3\x16\x39\x16\x0b\x6c\x3c\xb5\x64\xf1\xd8\x3e\x0e\x1c\x69\xee\xae\x75\xd6\x27\xa0\x84\xa4\xf3\x37\x5c\x4b\x87\xee\x51\x0c\x8b\xe0\x70\xa1\x90\x0c\x1c\x78\x85\x7c\x19\xce\x35\xd8\xdb\xf8\x07\x29\x38\x25\xb8\x7f\x4c\x60\x4b\xb0\x7f\x49\xf6\x26\x7e\xbb\xf3\xd1\x4c\xde\x0f\xbe\xf7\xe0\x68\x17\xfc\x56\xa7\xdb\x86\x1d\xa9\x99\xc4\xd3\x1b\xe8\xe7\x6b\x49\x4b\xe0\x6f\x24\x2d\x1e\xc6\xa7\xb9\x9f\xeb\x38\xce\x9a\xbf\xaf\xeb\x70\x3c\x98\xd5\xa3\x20\x0e\x6a\xfb\x1b\x2e\x26\xf1\xa4\x0b\xec\xe9\xd5\x99\xbc\xec\x59\x7e\x73\xa1\xfe\x07\x00\x00\xff\xff\x45\xf5\x79\x0d\x73\x03\x00\x00")
+var _assetsRouterClusterRoleYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x94\x92\x31\x6f\xdb\x40\x0c\x85\x77\xfd\x0a\x22\x9d\xad\xa0\x5b\xa1\xb5\x43\xb7\x0e\x45\xd1\x9d\x3a\xbd\x54\xac\x2f\xc7\x03\xc9\x93\x9b\xfe\xfa\x42\xb2\x13\x18\xb6\x83\x24\x9b\x28\x90\xdf\x7b\xf7\xc8\x4f\xf4\x35\x37\x0f\x18\x79\xd2\x8a\x89\x4c\x33\xe8\x41\x8d\x4c\x5b\xc0\xbc\xa7\x9f\xb3\x38\xf9\xac\x2d\x4f\x34\x82\xd8\xc9\xe0\x61\x92\x42\x96\xad\xac\xea\x2e\x63\x46\xdf\xed\xa5\x4c\xc3\x33\xf1\x87\x66\x74\x5c\xe5\x17\xcc\x45\xcb\x40\x36\x72\xea\xb9\xc5\xac\x26\xff\x38\x44\x4b\xbf\xff\xe2\xbd\xe8\xfd\xf2\xb9\x7b\x44\xf0\xc4\xc1\x43\x47\x54\xf8\x11\x03\x69\x45\xf1\x59\x1e\x62\x27\xe5\xb7\xc1\x7d\x77\xb4\xd4\x59\xcb\xf0\xa1\xdb\x11\x57\xf9\x66\xda\xaa\xaf\x43\x3b\xba\xbb\xeb\x68\xf5\xa6\xcd\x12\x4e\xff\x50\xa6\xaa\x52\xc2\xb7\x8e\x15\xec\x95\x13\x8e\xa5\xc3\x16\x39\x16\x0b\x6c\x3c\x8d\x64\xf1\xd8\x3e\x0e\x1c\x69\xee\xae\x75\xd6\x27\xa0\x84\xa4\xf3\x37\x5c\x4b\x87\xee\x51\x0c\x8b\xe0\x70\xa1\x90\x0c\x1c\x78\x85\x7c\x19\xce\x35\xd8\xdb\xf8\x07\x29\x38\x25\xb8\x7f\x4c\x60\x4b\xb0\x7f\x49\xf6\x26\x7e\xeb\xf9\x68\x26\xef\x07\xdf\x7b\x70\xb4\x0b\x7e\xab\xd3\x6d\xc3\x8e\xd4\x4c\xe2\xe9\x0d\xf4\x73\x5b\xd2\x12\xf8\x1b\x49\x8b\x87\xf1\x69\xef\xe7\x3a\x8e\xb3\xe1\xef\xeb\x39\x1c\x75\x66\xf5\x28\x88\x83\xda\xfe\x86\x8b\x49\x3c\xe9\x02\x7b\x7a\x75\x27\x2f\x77\x96\xdf\x3c\xa8\xff\x03\x00\x45\xf5\x79\x0d\x73\x03\x00\x00")
 
 func assetsRouterClusterRoleYamlBytes() ([]byte, error) {
 	return bindataRead(
@@ -222,7 +224,7 @@ func assetsRouterClusterRoleYaml() (*asset, error) {
 	return a, nil
 }
 
-var _assetsRouterDeploymentYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x55\x51\x6f\xe3\x36\x0c\x7e\xcf\xaf\x20\xda\x87\x7b\x4a\x9c\x76\xb7\x1b\xe6\xb7\x20\x71\x6f\x01\x2e\x6d\xd0\xe4\xee\x35\x60\x65\xda\x16\x2a\x4b\x1a\x45\xa5\xcb\x0d\xfb\xef\x83\x9d\xb4\xb5\xdd\x5e\xaf\xc0\xb0\x3c\x29\xe2\x27\xf2\x23\xf9\x91\x3e\x87\x05\x79\xe3\x0e\x35\x59\x81\x07\x2d\x15\xe4\x54\x60\x34\x02\x7b\x34\x91\xc2\xe8\x1c\x96\xb6\x64\x0a\x01\xe6\xce\x0a\x3b\x63\x88\x21\x78\x52\xba\xd0\xea\x04\x02\x64\x02\xf4\xde\x68\xca\x01\x05\x38\x5a\xd1\x35\x4d\x46\xf7\xda\xe6\x69\x27\xc2\x08\xbd\xfe\x46\x1c\xb4\xb3\x69\xf3\x20\x24\xfb\x8b\xd1\x39\x58\xac\x09\xd0\xe6\xed\x21\x78\x54\xd4\x7a\x0c\x24\x3d\x6f\x4d\xd4\x74\x04\xe0\xd9\xb5\x8c\x16\x84\xb9\xd1\x96\x36\xa4\x9c\xcd\x43\x0a\x9f\xa6\xd3\x11\x80\x50\xed\x0d\x0a\x35\x50\x80\x9a\x04\x73\x14\x3c\xfe\x03\x40\x6b\x9d\xa0\x68\x67\xc3\xe3\x15\x80\x20\x97\x24\x93\x07\xc7\xf7\xc6\x61\x3e\x71\x9e\x6c\xa8\x74\x21\x13\xed\x92\x1a\x2d\x96\xd4\xd0\x4f\xe1\xc3\xdf\x67\x54\x14\xa4\xe4\x2c\x85\xb3\x35\x53\x41\xcc\x94\x2f\x22\x6b\x5b\x6e\x54\x45\x79\x34\xda\x96\x67\xff\x7c\x68\x5d\x3f\x12\x6e\xcf\xc4\x7b\xad\x68\xa6\x94\x8b\x56\xae\xb1\xa6\x14\xd8\x45\x21\x3e\x01\xce\xc1\xba\x9c\x36\x64\x48\x89\x63\xd0\xe1\x45\xfe\x47\x9c\x67\xed\x58\xcb\x61\x6e\x30\x84\xa3\x9f\x70\x08\x42\xf5\x58\x99\x18\x84\x78\xac\x58\x8b\x56\x68\x4e\x0f\x94\xb3\x82\xda\x12\x77\x12\x1e\xb7\xa5\x1e\x30\x38\xb2\xd0\x35\x96\xf4\xe3\xf0\xcd\xaf\x85\xac\xa3\x31\x6b\x67\xb4\x3a\xa4\xb0\x2c\xae\x9d\xac\x99\x42\xd3\xe3\x67\x5c\x20\x15\x5b\xaa\xce\x0a\xfd\x25\x69\xc7\xd4\x44\xda\x10\x41\x25\xe2\x43\x9a\x24\x77\xb1\xfc\xae\x8d\xc1\x09\x53\x5e\xa1\x4c\x94\xab\x93\xcb\xe9\xf4\xb7\xcb\x8f\x9f\x7a\xaf\xd0\x18\xf7\xb0\x66\xbd\xd7\x86\x4a\xca\x82\x42\xd3\x36\x33\x05\xe1\x48\x1d\xa8\x10\xd7\xda\xb6\xb6\x15\x85\xd0\x10\x3e\x91\xbd\x42\x63\xee\x50\xdd\x6f\xdd\x17\x57\x86\x1b\x9b\x31\xbb\x7e\x09\x56\xc4\xe5\x40\xc9\xcf\x66\xb2\xfb\x6e\x22\x8f\x95\xbc\xbd\xf9\xba\xcd\x6e\x77\x9b\xec\xf6\xdb\x72\x9e\xed\xae\x67\xab\x6c\xb3\x9e\xcd\xb3\x1e\xfb\x76\x5c\x52\x78\x92\xd7\x58\x1f\x67\xeb\x15\x7f\x8b\xec\x6a\xf6\xf5\xcb\x76\x37\xcf\x6e\xb7\xcb\xab\xe5\x7c\xb6\xcd\x76\x8b\xe5\xed\x6b\xee\x12\x12\x95\xf8\x7b\x9d\x88\x09\x89\x67\xbd\x47\xa1\x37\x3c\x2e\xb2\xcd\x76\x79\x3d\xdb\x2e\x6f\xae\x77\xf3\xd9\x6e\x3d\xdb\xfe\xf1\xaa\xd7\x3d\x72\xc2\xd1\x26\xca\xd9\x42\x97\x35\xfa\x90\x9c\x44\x3c\x56\xd8\x39\x4e\x14\x77\x9b\x6e\xf4\x9e\x2c\x85\xb0\x66\x77\x47\xfd\x96\x37\xcd\xfe\x4c\x03\x1d\x00\x78\x94\x2a\x85\xa4\x22\x34\x52\x7d\x1f\x1a\x1d\x4b\x0a\x17\xbf\xff\xd2\xd7\x41\xa7\xbd\x9f\x19\x15\xad\x89\xb5\xcb\x9f\x36\xc1\xc5\xb4\x83\x66\xc2\x5c\xff\x17\x4a\x49\xe3\xe1\xf0\x1e\x62\x41\x90\x25\xfa\x57\x02\x15\xa8\x4d\x64\xda\x56\x4c\xa1\x72\x26\x4f\xe1\xe2\x72\xfa\x7f\x52\x01\xf0\x83\x9a\xf4\x4a\x12\x5c\x64\x45\xa1\x1f\x8d\xe9\xcf\x48\x41\xc2\x90\x83\xf2\xb1\xa9\xe9\xb4\x1e\xdc\xd7\x54\x3b\x3e\xa4\x70\xf9\xeb\xa7\x95\xee\xd8\xf6\xce\xc4\x9a\x56\xcd\xa6\x0b\xfd\x61\xa9\x9b\xbb\xf5\x31\xa5\xb7\x65\x0b\x27\xd9\x9e\xbe\x47\x63\x45\x2c\xcd\x37\x67\x88\x6a\x0a\x72\x63\xcd\xe1\xc5\x06\xe8\x07\x7b\x53\xcd\xaf\x84\x7d\x36\x8e\xef\xa2\xcd\xcd\x3b\x82\x1e\xb3\x7e\x4a\x78\xfc\x8e\x04\x02\x29\xee\xb7\xfc\x84\x5e\xb9\x9c\x52\xf8\xd8\x93\x48\xb3\x31\x1b\x78\xb3\xf4\xfb\x0b\x7a\xdc\x5d\x52\xe3\x9f\x66\x70\xac\xc1\x0a\x7d\x37\xb0\x16\xaa\x07\xcd\xba\xa7\x43\xd7\xcd\x60\xd0\x1f\xa5\xf9\x43\xc0\xcf\x0b\xe9\x7c\x33\xc0\x68\x52\x28\xd0\x84\x67\xcb\x8b\x22\xfc\x1b\x00\x00\xff\xff\x43\xe3\xb8\x07\xa7\x08\x00\x00")
+var _assetsRouterDeploymentYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x55\x4b\x6f\xe3\x36\x10\xbe\xfb\x57\x0c\xe2\xc3\x9e\x64\x39\xe9\x76\x8b\xea\x66\xd8\xca\xd6\xc0\x3a\x31\x62\xef\x5e\x8d\x09\x35\x96\x89\x50\x24\x3b\x1c\x3a\xf5\x16\xfd\xef\x05\xfd\xc8\x4a\xce\x63\x03\x14\xc5\xf8\x20\x73\x3e\x7d\xf3\xfa\x38\xea\xc3\x84\xbc\x71\xbb\x86\xac\xc0\xa3\x96\x0d\x54\xb4\xc6\x68\x04\xb6\x68\x22\x85\x5e\x1f\xa6\xb6\x66\x0a\x01\xc6\xce\x0a\x3b\x63\x88\x21\x78\x52\x7a\xad\xd5\x11\x04\xc8\x04\xe8\xbd\xd1\x54\x01\x0a\x70\xb4\xa2\x1b\x1a\xf4\x1e\xb4\xad\x8a\x56\x84\x1e\x7a\xfd\x8d\x38\x68\x67\x8b\xf4\x42\xc8\xb7\x97\xbd\x3e\x58\x6c\x08\xd0\x56\xfb\x87\xe0\x51\xd1\x9e\x31\x90\x74\xd8\x52\xd4\xa2\x07\xe0\xd9\xed\x33\x9a\x10\x56\x46\x5b\x5a\x90\x72\xb6\x0a\x05\x7c\x1a\x0e\x7b\x00\x42\x8d\x37\x28\x94\xa0\x00\x0d\x09\x56\x28\x78\xf8\x07\x80\xd6\x3a\x41\xd1\xce\x86\xd3\x11\x80\x20\xd7\x24\x83\x47\xc7\x0f\xc6\x61\x35\x70\x9e\x6c\xd8\xe8\xb5\x0c\xb4\xcb\x1b\xb4\x58\x53\x6a\x50\x01\x1f\xfe\xbe\xa0\xf5\x9a\x94\x5c\x14\x70\x31\x67\x5a\x13\x33\x55\x93\xc8\xda\xd6\x0b\xb5\xa1\x2a\x1a\x6d\xeb\x8b\x7f\x3e\xec\xa9\x4f\x09\x27\x0b\xc4\x5b\xad\x68\xa4\x94\x8b\x56\x6e\xb0\xa1\x02\xd8\x45\x21\x3e\x02\xfa\x60\x5d\x45\x0b\x32\xa4\xc4\x31\xe8\xf0\xac\xfe\x3d\x0c\x3c\x6b\xc7\x5a\x76\x63\x83\x21\x1c\x78\xc2\x2e\x08\x35\x99\x32\x31\x08\x71\xa6\x58\x8b\x56\x68\x8e\x2f\x28\x67\x05\xb5\x25\x6e\x15\x9c\x81\x7d\x9e\x41\xb2\x3e\xe8\x06\x6b\x7a\x3d\x7c\xb2\x3d\x64\x1e\x8d\x99\x3b\xa3\xd5\xae\x80\xe9\xfa\xc6\xc9\x9c\x29\xa4\x19\x9f\x50\xa9\x66\x15\xf7\xa9\x3a\x2b\xf4\x97\xfc\x08\x9f\xac\x0f\x0b\x22\xd8\x88\xf8\x50\xe4\xf9\x7d\xac\xbf\x6b\x63\x70\xc0\x54\x6d\x50\x06\xca\x35\xf9\xd5\x70\xf8\xdb\xd5\xc7\x4f\x9d\xb7\xd0\x18\xf7\x38\x67\xbd\xd5\x86\x6a\x2a\x83\x42\xb3\x1f\x66\x01\xc2\x91\x5a\x50\x21\x6e\xb4\xdd\xfb\x66\x14\x42\x4a\xf8\x98\xec\x35\x1a\x73\x8f\xea\x61\xe9\xbe\xb8\x3a\xdc\xda\x92\xd9\x75\x5b\x30\x23\xae\xcf\x94\x7c\x72\x02\x90\xdd\xb6\x0b\x39\x75\xf2\xee\xf6\xeb\xb2\xbc\x5b\x2d\xca\xbb\x6f\xd3\x71\xb9\xba\x19\xcd\xca\xc5\x7c\x34\x2e\x5b\x50\x38\x5c\x97\x02\x9e\xe4\x95\xe9\xc3\xdd\x7a\x81\x6f\x52\x5e\x8f\xbe\x7e\x59\xae\xc6\xe5\xdd\x72\x7a\x3d\x1d\x8f\x96\xe5\x6a\x32\xbd\x7b\x89\x2e\x27\x51\xb9\x7f\xd0\xb9\x98\x90\x7b\xd6\x5b\x14\x7a\x83\x71\x52\x2e\x96\xd3\x9b\xd1\x72\x7a\x7b\xb3\x1a\x8f\x56\xf3\xd1\xf2\x8f\x17\x59\xb7\xc8\x39\x47\x9b\x2b\x67\xd7\xba\x6e\xd0\x87\xfc\x28\xe2\x4c\x61\xeb\x71\xa0\xb8\x3d\x74\xa3\xb7\x64\x29\x84\x39\xbb\xfb\xe3\x1d\x3c\x59\x1a\xf6\x67\x3a\xd3\x01\x80\x47\xd9\x14\x90\x6f\x08\x8d\x6c\xbe\x9f\x3b\x1d\x4b\x01\x97\xbf\xff\xd2\xd5\x41\x6b\xbc\x9f\x19\x15\xcd\x89\xb5\xab\x9e\x36\xc1\xe5\xb0\x85\x66\xc2\x4a\xff\x97\x94\xf2\xc4\xb0\x7b\x4f\x62\x41\x90\x25\xfa\x17\x02\xad\x51\x9b\xc8\xb4\xdc\x30\x85\x8d\x33\x55\x01\x97\x57\xc3\xff\x33\x15\x00\x7f\xd6\x93\x96\x93\x29\xb8\xc8\x8a\x5a\x2b\x21\xfd\x98\xfe\x8c\x14\xe4\xec\x14\x40\xf9\x58\xc0\xe5\x70\xd8\x9c\x9d\x37\xd4\x38\xde\x15\x70\xf5\xeb\xa7\x99\x6e\xf9\xb6\xce\xc4\x86\x66\x69\xd3\x75\xb8\x32\x68\xd2\xd9\xfc\x50\xd2\xdb\xb2\x85\xa3\x6c\x8f\xdf\xa3\x4c\x11\x4b\xfa\xe6\x9c\xa3\x52\x43\x6e\xad\xd9\x3d\xdb\x00\xdd\x60\x6f\xaa\xb9\x43\x78\x08\xfb\xc3\x99\xdd\x47\x5b\x99\x77\x04\x3d\x54\xfd\x54\x70\xf6\x8e\x02\x02\x29\xee\x8e\xfc\x88\x9e\xb9\x8a\x0a\xf8\xd8\x91\x48\xda\x98\x09\x9e\x96\x7e\x77\x41\x67\xed\x25\x95\xfd\xb4\x82\x43\x0f\x66\xe8\xdb\x81\xb5\x50\x73\x36\xac\x07\xda\x15\xf0\xea\x45\x3f\x49\xf3\x55\xc0\xcf\x1b\xe9\x7c\xda\xcf\x68\x0a\x58\xa3\x09\xd4\x7b\xb5\x09\xff\x0e\x00\x43\xe3\xb8\x07\xa7\x08\x00\x00")
 
 func assetsRouterDeploymentYamlBytes() ([]byte, error) {
 	return bindataRead(
@@ -242,7 +244,7 @@ func assetsRouterDeploymentYaml() (*asset, error) {
 	return a, nil
 }
 
-var _assetsRouterMetricsClusterRoleBindingYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x7c\x8f\xc1\x4a\xc4\x40\x0c\x86\xef\xf3\x14\x79\x81\x56\xbc\x2d\x73\x53\x0f\xde\x57\xf0\x9e\x9d\xa6\x36\xb6\x93\x0c\x49\xa6\x07\x9f\x5e\x8a\x22\xc2\x42\xaf\x81\x7c\xdf\xff\xad\x2c\x53\x86\x97\xad\x7b\x90\x5d\x75\xa3\x67\x96\x89\xe5\x23\x61\xe3\x77\x32\x67\x95\x0c\x76\xc3\x32\x62\x8f\x45\x8d\xbf\x30\x58\x65\x5c\x2f\x3e\xb2\x3e\xec\x8f\xa9\x52\xe0\x84\x81\x39\x01\x08\x56\xca\x60\xda\x83\x6c\xa8\x2a\x1c\x6a\x07\xcc\xfb\xed\x93\x4a\x78\x4e\x03\xfc\x18\xdf\xc8\x76\x2e\xf4\x54\x8a\x76\x89\xbf\xd7\x66\x5a\x29\x16\xea\x3e\xac\x17\xff\x3d\x7b\xc3\x42\x19\xb4\x91\xf8\xc2\x73\xfc\x27\x9b\x6e\x74\xa5\xf9\x90\xdf\xa5\x9c\x0c\x02\xc0\xc6\xaf\xa6\xbd\x9d\xd4\xa5\xef\x00\x00\x00\xff\xff\x7f\xc0\x4a\x40\x1d\x01\x00\x00")
+var _assetsRouterMetricsClusterRoleBindingYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x7c\x8f\xc1\x4a\xc4\x40\x0c\x86\xef\xf3\x14\x79\x81\x56\xbc\x2d\x73\x53\x0f\xde\x57\xf0\x9e\x9d\xa6\x36\xb6\x93\x0c\x49\xa6\x07\x9f\x5e\x8a\x22\xc2\x42\xaf\x81\x7c\xdf\xff\xad\x2c\x53\x86\x97\xad\x7b\x90\x5d\x75\xa3\x67\x96\x89\xe5\x23\x61\xe3\x77\x32\x67\x95\x0c\x76\xc3\x32\x62\x8f\x45\x8d\xbf\x30\x58\x65\x5c\x2f\x3e\xb2\x3e\xec\x8f\xa9\x52\xe0\x84\x81\x39\x01\x08\x56\xca\x60\xda\x83\x6c\xa8\x2a\x1c\x6a\x07\xcc\xfb\xed\x93\x4a\x78\x4e\x03\xfc\x18\xdf\xc8\x76\x2e\xf4\x54\x8a\x76\x89\xbf\xd7\x66\x5a\x29\x16\xea\x3e\xac\x17\xff\x3d\x7b\xc3\x42\x19\xb4\x91\xf8\xc2\x73\xfc\x27\x9b\x6e\x74\xa5\xf9\x90\xdf\xa5\x9c\x0c\x02\xc0\xc6\xaf\xa6\xbd\x9d\xd4\xa5\xef\x01\x00\x7f\xc0\x4a\x40\x1d\x01\x00\x00")
 
 func assetsRouterMetricsClusterRoleBindingYamlBytes() ([]byte, error) {
 	return bindataRead(
@@ -262,7 +264,7 @@ func assetsRouterMetricsClusterRoleBindingYaml() (*asset, error) {
 	return a, nil
 }
 
-var _assetsRouterMetricsClusterRoleYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x4c\xce\xb1\x4e\xc4\x40\x0c\x84\xe1\x7e\x9f\xc2\x12\x75\x72\xa2\x43\x69\x29\xe8\x29\xe8\x9d\xec\x70\xb1\x2e\x6b\xaf\x6c\xef\x49\xf0\xf4\xe8\x44\x90\xa8\xe7\x93\xfe\x79\xa2\xd7\x63\x44\xc2\xc9\xed\x40\x90\x02\x15\x95\xd6\x2f\xea\x6e\x0d\xb9\x63\x04\xa5\x51\x6c\xce\x1d\xe4\x36\x1e\xb6\x21\x5d\xb6\x20\x68\xed\x26\x9a\x85\xbb\x7c\xc0\x43\x4c\x17\xf2\x95\xb7\x99\x47\xee\xe6\xf2\xcd\x29\xa6\xf3\xed\x25\x66\xb1\xcb\xfd\xb9\xdc\x44\xeb\xf2\xd7\x7c\xb7\x03\xa5\x21\xb9\x72\xf2\x52\x88\x94\x1b\x96\x33\x32\x35\x53\x49\x73\xd1\x6b\xf1\x71\x20\x96\x32\x11\x77\x79\x73\x1b\x3d\x1e\x7a\xfa\x95\xb3\x75\x68\xec\xf2\x99\xb3\x58\x21\x72\x84\x0d\xdf\xf0\xdf\x78\x5c\xce\xcf\x85\xe8\x0e\x5f\xcf\xf1\x8a\x2c\x3f\x01\x00\x00\xff\xff\x4f\xd5\xdf\xe0\x03\x01\x00\x00")
+var _assetsRouterMetricsClusterRoleYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x4c\xce\x31\x4b\x03\x41\x10\x86\xe1\x7e\x7f\xc5\x07\xd6\x77\xc1\x4e\xb6\xb5\xb0\xb7\xb0\xdf\xdc\x7d\xe6\x86\xdc\xcd\x2c\x33\xb3\x01\xfd\xf5\x12\x8c\x60\xff\xc0\xfb\x3e\xe1\x75\x1f\x91\x74\xb8\xed\x0c\x28\xb9\x72\xc5\xf9\x0b\xdd\xed\x60\x6e\x1c\x81\x34\xc4\xe2\xad\x13\x6e\xe3\x6e\x0f\xa6\xcb\x12\xa0\xae\xdd\x44\xb3\xb4\x2e\x1f\xf4\x10\xd3\x0a\x3f\xb7\x65\x6e\x23\x37\x73\xf9\x6e\x29\xa6\xf3\xf5\x25\x66\xb1\xd3\xed\xb9\x5c\x45\xd7\xfa\xd7\x7c\xb7\x9d\xe5\x60\xb6\xb5\x65\xab\x05\xd0\x76\xb0\x3e\x22\xd3\x61\x2a\x69\x2e\x7a\x29\x3e\x76\x46\x2d\x13\x5a\x97\x37\xb7\xd1\xe3\xae\xa7\x5f\x39\x5b\xa7\xc6\x26\x9f\x39\x8b\x15\xc0\x19\x36\x7c\xe1\x7f\xe3\x71\x7a\x3c\x17\xe0\x46\x3f\x47\x2d\xc0\x84\x0b\xb3\xfc\x0c\x00\x4f\xd5\xdf\xe0\x03\x01\x00\x00")
 
 func assetsRouterMetricsClusterRoleYamlBytes() ([]byte, error) {
 	return bindataRead(
@@ -282,7 +284,7 @@ func assetsRouterMetricsClusterRoleYaml() (*asset, error) {
 	return a, nil
 }
 
-var _assetsRouterMetricsRoleBindingYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x94\xce\x31\x4e\xc5\x40\x0c\x04\xd0\x7e\x4f\xe1\x0b\x24\x88\xee\x6b\x3b\x68\xe8\x3f\x12\xbd\xb3\x71\x12\x93\xac\xbd\xb2\xbd\x29\x38\x3d\x42\x8a\x44\x05\xd2\x6f\x47\x33\x9a\x87\x8d\x3f\xc8\x9c\x55\x32\xd8\x84\x65\xc4\x1e\x9b\x1a\x7f\x61\xb0\xca\xb8\xdf\x7c\x64\x7d\x3a\x9f\xd3\xce\x32\x67\xb8\xeb\x41\xaf\x2c\x33\xcb\x9a\x2a\x05\xce\x18\x98\x13\x80\x60\xa5\x0c\xcd\xb4\x52\x6c\xd4\x7d\xd8\x6f\x7e\xc5\xde\xb0\x50\x06\x6d\x24\xbe\xf1\x12\x03\xcb\x6a\xe4\x9e\x4c\x0f\xba\xd3\xf2\x33\xc7\xc6\x6f\xa6\xbd\xfd\x63\x48\x00\xbf\x84\xbf\x1e\xbd\x4f\x9f\x54\xc2\x73\x1a\xae\xf6\x3b\xd9\xc9\x85\x5e\x4a\xd1\x2e\xf1\xa0\xb4\xaa\x70\xa8\xb1\xac\x90\xbe\x03\x00\x00\xff\xff\x15\x9f\x30\x56\x29\x01\x00\x00")
+var _assetsRouterMetricsRoleBindingYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x94\xce\x31\x4e\xc5\x40\x0c\x04\xd0\x7e\x4f\xe1\x0b\x24\x88\xee\x6b\x3b\x68\xe8\x3f\x12\xbd\xb3\x71\x12\x93\xac\xbd\xb2\xbd\x29\x38\x3d\x42\x8a\x44\x05\xd2\x6f\x47\x33\x9a\x87\x8d\x3f\xc8\x9c\x55\x32\xd8\x84\x65\xc4\x1e\x9b\x1a\x7f\x61\xb0\xca\xb8\xdf\x7c\x64\x7d\x3a\x9f\xd3\xce\x32\x67\xb8\xeb\x41\xaf\x2c\x33\xcb\x9a\x2a\x05\xce\x18\x98\x13\x80\x60\xa5\x0c\xcd\xb4\x52\x6c\xd4\x7d\xd8\x6f\x7e\xc5\xde\xb0\x50\x06\x6d\x24\xbe\xf1\x12\x03\xcb\x6a\xe4\x9e\x4c\x0f\xba\xd3\xf2\x33\xc7\xc6\x6f\xa6\xbd\xfd\x63\x48\x00\xbf\x84\xbf\x1e\xbd\x4f\x9f\x54\xc2\x73\x1a\xae\xf6\x3b\xd9\xc9\x85\x5e\x4a\xd1\x2e\xf1\xa0\xb4\xaa\x70\xa8\xb1\xac\x90\xbe\x07\x00\x15\x9f\x30\x56\x29\x01\x00\x00")
 
 func assetsRouterMetricsRoleBindingYamlBytes() ([]byte, error) {
 	return bindataRead(
@@ -302,7 +304,7 @@ func assetsRouterMetricsRoleBindingYaml() (*asset, error) {
 	return a, nil
 }
 
-var _assetsRouterMetricsRoleYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x4c\x8e\xb1\x6e\xeb\x30\x0c\x45\x77\x7d\x05\x91\x37\x3b\x0f\xdd\x02\xfd\x40\xf7\x0e\xdd\x19\xe9\x36\x26\x62\x8b\x02\x49\xb9\x68\xbf\xbe\x88\xe3\x02\x9d\x78\xef\x01\xc1\xc3\x7f\xf4\xa6\x0b\x9c\x1a\x50\x51\xe9\xfa\x45\xdd\x74\x45\xcc\x18\x4e\xa1\xe4\xc5\xb8\x83\x4c\x47\xc0\x68\x45\x98\x14\x27\xb4\xda\x55\x5a\x24\xee\xf2\x0e\x73\xd1\x96\xc9\xae\x5c\xce\x3c\x62\x56\x93\x6f\x0e\xd1\x76\xbe\x5f\xfc\x2c\xfa\x7f\x7b\x49\x77\x69\x35\xef\xae\xb4\x22\xb8\x72\x70\x4e\x44\x8d\x57\xe4\x3f\xca\xe9\x7e\xf1\x03\x7b\xe7\x82\x4c\xda\xd1\x7c\x96\x8f\x98\xa4\xdd\x0c\xee\xc9\xc6\x02\xcf\x69\x22\xee\xf2\x6a\x3a\xba\x3f\x2e\x4d\x74\x3a\x25\x22\x83\xeb\xb0\x82\x83\x39\x6c\x93\x02\xdf\xcb\xef\xd7\xcf\xd6\xb5\x3e\xc2\x06\xbb\x1e\xcb\x37\xc4\x3e\x17\xf1\x67\xf8\xe4\x28\x73\xfa\x09\x00\x00\xff\xff\x67\x78\x6f\x08\x23\x01\x00\x00")
+var _assetsRouterMetricsRoleYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x4c\x8e\xb1\x6e\xeb\x30\x0c\x45\x77\x7d\x05\x91\x37\x3b\x0f\xdd\x02\xfd\x40\xf7\x0e\xdd\x19\xe9\x36\x26\x62\x8b\x02\x49\xb9\x68\xbf\xbe\x70\x62\x14\x9d\x78\x79\x41\x9c\xc3\x7f\xf4\xa6\x0b\x9c\x1a\x50\x51\xe9\xfa\x45\xdd\x74\x45\xcc\x18\x4e\xa1\xe4\xc5\xb8\x83\x4c\x47\xc0\x68\x45\x98\x14\x27\xb4\xda\x55\x5a\x24\xee\xf2\x0e\x73\xd1\x96\xc9\xae\x5c\xce\x3c\x62\x56\x93\x6f\x0e\xd1\x76\xbe\x5f\xfc\x2c\xfa\x7f\x7b\x49\x77\x69\x35\x3f\x5c\x69\x45\x70\xe5\xe0\x9c\x88\x1a\xaf\xc8\x7f\x94\xd3\xfd\xe2\x47\xed\x9d\x0b\x32\x69\x47\xf3\x59\x3e\x62\x92\x76\x33\xb8\x27\x1b\x0b\x3c\xa7\x89\xb8\xcb\xab\xe9\xe8\xbe\x93\x26\x3a\x9d\x12\x91\xc1\x75\x58\xc1\xd1\x39\x6c\x93\x82\x9d\x39\xfd\x7e\xfd\xdc\xba\xd6\x3d\x6c\xb0\xeb\x71\x7c\x43\x3c\xe6\x22\xfe\x0c\x9f\x1c\x65\x4e\x3f\x03\x00\x67\x78\x6f\x08\x23\x01\x00\x00")
 
 func assetsRouterMetricsRoleYamlBytes() ([]byte, error) {
 	return bindataRead(
@@ -322,7 +324,7 @@ func assetsRouterMetricsRoleYaml() (*asset, error) {
 	return a, nil
 }
 
-var _assetsRouterNamespaceYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x94\x91\x41\x8f\xd3\x40\x0c\x85\xef\xfd\x15\x56\xf6\xba\x0d\xe2\x9a\x5f\xc0\x85\x15\x02\x89\xbb\x3b\xe3\x24\xa6\x13\x7b\x34\x76\x1a\xf5\xdf\xa3\x99\x44\x6c\x2b\x40\x88\x53\xa4\xf1\xf3\xf3\x7b\x5f\xae\x2c\x71\x80\x37\x5c\xc8\x32\x06\x3a\x61\xe6\xef\x54\x8c\x55\x06\xb8\x7d\x3c\x2d\xe4\x18\xd1\x71\x38\x01\x08\x2e\x34\x80\x66\x12\x9b\x79\xf4\x33\xcb\x54\xc8\xec\x04\x80\x22\xea\xe8\xac\x62\x55\x08\xef\xa2\x9e\xf5\x83\x68\xa4\xb3\x51\xa2\xe0\x5a\x06\xe8\xba\x26\xd9\xb4\x5c\x93\x62\xec\x9f\xb4\x98\x92\x6e\x14\x07\xe8\x16\x14\x9c\x68\x21\xf1\xaa\x4f\x78\xa1\x74\x98\xbf\x40\x53\x3d\x24\x59\x54\xd8\xb5\xb0\x4c\xe0\x0a\x49\xf5\x0a\xa3\x16\xf8\x46\xe5\xc6\x81\x3e\xef\x53\xd0\xcb\x0f\x0a\x6e\xc0\x02\x3e\xb3\xb5\x3e\x7b\xe9\xdf\x22\x87\xb4\x9a\x53\x79\x30\x1e\xa0\xf3\xb2\xd2\x9e\xfd\xef\x24\x6a\x3a\x4d\x11\x50\x22\x08\x6d\x35\xc6\x62\xa0\x23\xf8\x4c\x7b\x89\x96\x6c\x41\x0f\x73\x8d\xbb\xb1\xcf\xf0\x46\x5e\x69\x7c\xd1\xc4\xe1\xbe\x1f\xd8\x5f\x9e\xd9\xe4\x36\x3f\x4f\x45\xd7\x3c\xc0\xe3\xcd\xc7\x49\xff\xc7\xdd\x43\xfd\x8b\xfe\x0b\x7c\xd5\xd5\xa9\x40\xa4\x9c\xf4\x5e\x31\x83\x10\x45\xab\x00\x77\xbc\xb9\xf0\x8d\x13\x4d\x04\x64\x01\x53\xfb\xbd\xaf\x80\x06\x1b\xa5\x54\xbf\xb3\x9a\x1f\x66\xc7\xcd\x56\xbb\x3e\x43\xd6\xe2\xd6\xaa\xd6\xe2\xdd\x27\x35\x3f\x5a\x76\x40\x12\xb3\xb2\x38\xe4\xf5\x92\xd8\x1a\x07\xf3\x82\x4e\xd3\xfd\xf5\x30\xdc\x66\x0e\x33\xb0\xb5\xf5\x48\x23\xae\xc9\x9b\x9d\xca\x39\x17\x5a\xd8\x08\x72\x42\x6f\x7c\xfb\x03\x42\x3c\x1b\x85\xb5\xb0\xdf\xfb\xeb\x7a\xa1\x22\xe4\x64\xb5\x3d\xc9\xa8\x25\xd0\xf0\xde\x29\xfe\x6b\x05\xd7\xc8\xfe\x3f\x0b\x1b\x16\x79\xd2\xff\x0c\x00\x00\xff\xff\xc2\xce\xfd\x7a\x5a\x03\x00\x00")
+var _assetsRouterNamespaceYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x94\x91\x41\x8f\xd3\x40\x0c\x85\xef\xfd\x15\x56\xf6\xba\x0d\xe2\x9a\x5f\xc0\x85\x15\x02\x89\xbb\x3b\xe3\x24\xa6\x13\x7b\x34\x76\x1a\xf5\xdf\xa3\x99\x44\x6c\x2b\x10\x88\x53\xa4\xf1\xf3\xf3\x7b\x5f\xae\x2c\x71\x80\x37\x5c\xc8\x32\x06\x3a\x61\xe6\xef\x54\x8c\x55\x06\xb8\x7d\x3c\x2d\xe4\x18\xd1\x71\x38\x01\x08\x2e\x34\x80\x66\x12\x9b\x79\xf4\x33\xcb\x54\xc8\xec\x04\x80\x22\xea\xe8\xac\x62\x55\x08\xef\xa2\x9e\xf5\x83\x68\xa4\xb3\x51\xa2\xe0\x5a\x06\xe8\xba\x26\xd9\xb4\x5c\x93\x62\xec\x9f\xb4\x98\x92\x6e\x14\x07\xe8\x16\x14\x9c\x68\x21\xf1\xaa\x4f\x78\xa1\x74\x98\xbf\x40\x53\x3d\x24\x59\x54\xd8\xb5\xb0\x4c\xe0\x0a\x49\xf5\x0a\xa3\x16\xf8\x46\xe5\xc6\x81\x3e\xef\x53\xd0\xcb\x0f\x0a\x6e\xc0\x02\x3e\xb3\xb5\x3e\x7b\xe9\xdf\x22\x87\xb4\x9a\x53\x79\x30\x1e\xa0\xf3\xb2\x52\xcd\xf2\x37\x12\x00\x2f\xa0\x29\x02\x4a\x04\xa1\xad\xc6\x58\x0c\x74\x04\x9f\x69\x2f\x51\x9f\x60\x41\x0f\x73\x8d\xbb\xb1\xcf\xf0\x46\x5e\x69\x7c\xd1\xc4\xe1\xbe\x1f\xd8\x5f\x9e\xd9\xe4\x36\x3f\x4f\x45\xd7\x3c\xc0\xe3\xcd\xc7\x49\xff\xc7\xdd\x43\xfd\x8b\xfe\x0b\x7c\xd5\xd5\xa9\x40\xa4\x9c\xf4\x5e\x31\x83\x10\x45\xab\x00\x77\xbc\xb9\xf0\x8d\x13\x4d\x04\x64\x01\x53\xfb\xbd\xaf\x80\x06\x1b\xa5\x54\xbf\xb3\x9a\x1f\x66\xc7\xcd\x56\xbb\x3e\x43\xd6\xe2\xd6\xaa\xd6\xe2\xdd\x27\x35\x3f\x5a\x76\x40\x12\xb3\xb2\x38\xe4\xf5\x92\xd8\x1a\x07\xf3\x82\x4e\xd3\xfd\xf5\x30\xdc\x66\x0e\x33\xb0\x35\x6e\x91\x46\x5c\x93\x37\x3b\x95\x73\x2e\xb4\xb0\x11\xe4\x84\xde\xf8\xf6\x07\x84\x78\x36\x0a\x6b\x61\xbf\xf7\xd7\xf5\x42\x45\xc8\xc9\x2a\x39\x92\x51\x4b\xa0\xe1\xbd\x53\xfc\xd7\x0a\xae\x91\xfd\x7f\x16\x36\x2c\xf2\xa4\xff\x39\x00\xc2\xce\xfd\x7a\x5a\x03\x00\x00")
 
 func assetsRouterNamespaceYamlBytes() ([]byte, error) {
 	return bindataRead(
@@ -342,7 +344,7 @@ func assetsRouterNamespaceYaml() (*asset, error) {
 	return a, nil
 }
 
-var _assetsRouterServiceAccountYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x2c\xce\xb1\x4e\xc4\x30\x10\x84\xe1\xde\x4f\x31\xd2\xd5\x9c\x44\xeb\x8e\x92\x16\x24\x7a\xb3\x99\xbb\x5b\x91\x78\xcd\xee\x3a\x88\xb7\x47\x41\x29\xa7\x98\x5f\xdf\x05\x2f\x22\x36\x7b\xe2\x66\x0e\xb7\x99\xf4\x80\x38\x5b\x72\xc1\xe7\x2f\xf2\x41\xd8\xa0\xb7\x34\xbf\xe2\x35\xf1\xa3\xeb\x0a\xe7\xf7\x54\x27\x64\x9d\x91\x74\x84\xd8\xe0\x52\x2e\x18\xf4\x4d\x23\xd4\x7a\xc0\xb9\xfe\x57\xd2\xf0\x76\x84\x31\xdc\x84\x11\xda\xef\xd7\xf2\xa5\x7d\xa9\x78\xa7\xef\x2a\x3c\x0d\xa5\x0d\xfd\xa0\x1f\xef\x8a\xfd\xb9\x6c\xcc\xb6\xb4\x6c\xb5\x00\xbd\x6d\xac\x27\xf0\x9c\x31\x9a\xb0\x1e\xba\x1e\x0f\xbd\xe5\x93\xf6\xbb\x33\xa2\xfc\x05\x00\x00\xff\xff\x33\xdc\xda\x8c\xd5\x00\x00\x00")
+var _assetsRouterServiceAccountYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x2c\xce\xb1\x4e\xc4\x30\x10\x84\xe1\xde\x4f\x31\xd2\xd5\x9c\x44\xeb\x8e\x92\x16\x24\x7a\xb3\x99\xbb\x5b\x91\x78\xcd\xee\x3a\x88\xb7\x47\x41\x29\xa7\x98\x5f\xdf\x05\x2f\x22\x36\x7b\xe2\x66\x0e\xb7\x99\xf4\x80\x38\x5b\x72\xc1\xe7\x2f\xf2\x41\xd8\xa0\xb7\x34\xbf\xe2\x35\xf1\xa3\xeb\x0a\xe7\xf7\x54\x27\x64\x9d\x91\x74\x84\xd8\xe0\x52\x2e\x18\xf4\x4d\x23\xd4\x7a\xc0\xb9\xfe\x57\xd2\xf0\x76\x84\x31\xdc\x84\x11\xda\xef\xd7\xf2\xa5\x7d\xa9\x78\xa7\xef\x2a\x3c\x0d\xa5\x0d\xfd\xa0\x1f\xef\x8a\xfd\xb9\x6c\xcc\xb6\xb4\x6c\xb5\x00\xbd\x6d\xac\x27\xf0\x9c\x31\x9a\xb0\x1e\xba\x1e\x0f\xbd\xe5\x93\xf6\xbb\x33\xa2\xfc\x0d\x00\x33\xdc\xda\x8c\xd5\x00\x00\x00")
 
 func assetsRouterServiceAccountYamlBytes() ([]byte, error) {
 	return bindataRead(
@@ -362,7 +364,7 @@ func assetsRouterServiceAccountYaml() (*asset, error) {
 	return a, nil
 }
 
-var _assetsRouterServiceCloudYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x7c\x90\x41\x6b\x14\x41\x10\x85\xef\xfd\x2b\x1e\xec\x39\x41\x31\x07\x99\x63\x72\x12\x82\x2c\xb8\x78\xaf\xf4\xd4\xec\x34\xe9\xa9\x6a\xaa\x6a\x56\xf7\xdf\xcb\xf4\xec\x82\xa2\x78\xec\x07\xf5\xfa\x7b\xdf\x01\xaf\x4a\x23\x9e\xa9\x92\x64\x36\x7c\x63\xbb\x94\xcc\x08\x45\xab\x94\x19\x45\x30\x99\x4a\x40\x27\xc4\xcc\x30\x5d\x83\x6d\x8b\x73\xd5\x75\x04\xcb\xa5\x98\xca\xc2\x12\xfe\x98\x0e\xf8\x22\x67\x63\x77\xbc\xa8\x84\x69\xad\x6c\xf0\xc6\xb9\x4c\x25\xe3\x42\x75\x65\x07\x19\x83\x5a\xab\x85\x47\x50\xc0\x56\x89\xb2\xf0\x63\x7a\x2f\x32\x0e\x77\x82\x44\xad\x7c\x67\xf3\xa2\x32\xe0\xf2\x31\x2d\x1c\x34\x52\xd0\x90\x80\x03\xbe\xd2\xc2\x28\x0e\xe7\xf8\xa3\x02\x10\x5a\xd8\x1b\x65\x1e\xa0\x8d\xc5\xe7\x32\xc5\x43\xd9\xa1\x12\x50\xe9\x8d\xab\x6f\x25\xd8\x18\x86\xdb\x9e\xb4\x31\x6e\x69\x5c\x1b\x0f\xdd\xc9\x5d\x49\x02\x9c\x2b\xe7\x50\xfb\xfb\x6c\x63\x39\xcd\xc5\x41\xd5\x15\x33\x79\x77\xc4\xd3\xc4\xb9\x1b\x5b\xc8\xde\x8b\x9c\xf1\xfa\x8c\xa6\x5a\x11\x64\x67\x0e\x07\x39\x56\x99\x99\x6a\xcc\x57\xfc\x98\x59\x20\xda\xcb\x6e\x7a\x9b\x8e\xbb\xa7\x66\xec\xbc\xd9\x17\x10\x44\x47\xc6\x1b\xcf\x45\xc6\xfe\x8f\xef\xaa\xb6\xd9\xfc\x33\xd8\x84\xea\xc9\x68\x9a\x4a\x3e\x6a\x2d\xf9\xba\x0d\xc9\x54\x13\xd0\xd4\xa2\xaf\x7e\xe8\x82\x06\xcc\x11\xad\xaf\x69\xa6\xa1\x59\xeb\x80\xd3\xcb\x71\x4f\xd4\x62\xc0\xe7\x0f\xfd\xb1\x03\x1f\x7b\x74\xbb\xf9\xbd\xc2\xff\xdb\xf1\xf4\xf4\xe9\x9f\x25\x9e\x7e\x05\x00\x00\xff\xff\x56\xdc\x0d\xe9\x77\x02\x00\x00")
+var _assetsRouterServiceCloudYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x7c\x90\x41\x6b\x14\x41\x10\x85\xef\xfd\x2b\x1e\xec\x39\x8b\x62\x0e\x32\xc7\xe4\x24\x04\x59\x70\xf1\x5e\xe9\xa9\xd9\x69\xd2\x53\xd5\x54\xd5\xac\xee\xbf\x97\xe9\xd9\x80\xa2\x78\xec\x07\xf5\xfa\x7b\xdf\x01\x2f\x4a\x23\x9e\xa8\x92\x64\x36\x7c\x63\xbb\x96\xcc\x08\x45\xab\x94\x19\x45\x30\x99\x4a\x40\x27\xc4\xcc\x30\x5d\x83\x6d\x8b\x73\xd5\x75\x04\xcb\xb5\x98\xca\xc2\x12\x7e\x4c\x07\x7c\x91\x8b\xb1\x3b\x9e\x55\xc2\xb4\x56\x36\x78\xe3\x5c\xa6\x92\x71\xa5\xba\xb2\x83\x8c\x41\xad\xd5\xc2\x23\x28\x60\xab\x44\x59\xf8\x98\xde\x8a\x8c\xc3\x3b\x41\xa2\x56\xbe\xb3\x79\x51\x19\x70\xfd\x98\x16\x0e\x1a\x29\x68\x48\xc0\x01\x5f\x69\x61\x14\x87\x73\xfc\x51\x01\x08\x2d\xec\x8d\x32\x0f\xd0\xc6\xe2\x73\x99\xe2\xa1\xec\x50\x09\xa8\xf4\xca\xd5\xb7\x12\x6c\x0c\xc3\x7d\x4f\xda\x18\xb7\x34\x6e\x8d\x87\xee\xe4\x5d\x49\x02\x9c\x2b\xe7\x50\xfb\xfb\x6c\x63\x39\xcf\xc5\x41\xd5\x15\x33\x79\x77\xc4\xd3\xc4\xb9\x1b\x5b\xc8\xde\x8a\x5c\xf0\xf2\x84\xa6\x5a\x11\x64\x17\x0e\x07\x39\x56\x99\x99\x6a\xcc\x37\xfc\x98\x59\x20\xda\x87\xdd\xf5\x36\x1d\x77\x4f\xcd\xd8\x79\xb3\x2f\x20\x88\x8e\x8c\x57\x9e\x8b\x8c\xfd\x1f\xdf\x55\x1d\x13\xc0\x3f\x83\x4d\xa8\x9e\x8d\xa6\xa9\xe4\x93\xd6\x92\x6f\xdb\x90\x4c\x35\x01\x4d\x2d\xfa\xea\x87\x2e\x68\xc0\x1c\xd1\xfa\x9a\x66\x1a\x9a\xb5\x0e\x38\x3f\x9f\xf6\x44\x2d\x06\x7c\xfe\xd0\x1f\x3b\xf0\xa9\x47\xf7\x9b\xdf\x2b\xfc\xbf\x1d\x8f\x8f\x9f\xfe\x59\xe2\xe9\xd7\x00\x56\xdc\x0d\xe9\x77\x02\x00\x00")
 
 func assetsRouterServiceCloudYamlBytes() ([]byte, error) {
 	return bindataRead(
@@ -382,7 +384,7 @@ func assetsRouterServiceCloudYaml() (*asset, error) {
 	return a, nil
 }
 
-var _assetsRouterServiceInternalYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\xcf\x31\x6b\xfb\x30\x10\x05\xf0\x5d\x9f\xe2\x41\xd6\xff\xbf\x34\x24\x94\x56\x6b\xa6\x6c\x86\x96\xee\x87\x7c\x49\x8e\xca\x92\xb8\x3b\xbb\xf4\xdb\x97\x38\x35\xb8\x74\xc9\x22\x90\x4e\xef\xf7\xb8\x0d\x0e\x79\x34\x67\xc5\x2b\xeb\x24\x89\xf1\x29\x7e\x41\xcf\x27\x1a\xb3\x63\xa2\x3c\xb2\x85\x0d\x8e\xe5\xac\x6c\x86\x43\x2d\xae\x35\x67\x56\x58\xe3\x24\x27\x49\xa0\x52\xaa\x93\x4b\x2d\x06\x52\x06\xb5\x96\x85\x7b\x90\x43\xc7\xe2\x32\xf0\x43\xf8\x90\xd2\xc7\xa5\x23\x50\x93\x77\x56\x93\x5a\x22\xa6\x6d\xd8\xa0\xd0\xc0\xff\xe6\xd3\x1a\x25\x06\x95\xfe\x0f\x6b\xec\xbf\xc8\x6b\x7f\x0c\x80\x7f\x35\x8e\xcb\x1a\xc7\x2e\x00\xad\xaa\xdb\x75\xf4\x7f\x26\x23\x2e\xee\x2d\x00\xb7\x49\xc4\xf3\xe3\xed\xa2\xd5\x6b\xaa\x39\xe2\xed\xd0\xcd\x2f\x4e\x7a\x66\xef\xe6\x4f\x3f\x99\x35\x61\x2b\x63\xbf\xdf\xdd\x89\xd8\x4a\x19\xd8\x55\xd2\xda\xd9\xbe\xec\x9e\xee\x80\x96\xe0\x77\x00\x00\x00\xff\xff\x4c\x3f\xad\x47\xb0\x01\x00\x00")
+var _assetsRouterServiceInternalYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\xcf\xb1\x6a\x33\x31\x10\x04\xe0\x5e\x4f\x31\x70\xed\xff\x87\x18\x9b\x90\xa8\xbd\xca\x9d\x21\x21\xfd\xa2\x5b\xdb\x4b\x74\x92\xd8\xdd\xbb\x90\xb7\x0f\x67\xe3\x70\x21\x8d\x1b\x81\x18\xe6\x1b\xb6\x43\x9f\x27\x73\x56\xbc\xb2\xce\x92\x18\x9f\xe2\x67\x0c\x7c\xa4\x29\x3b\x66\xca\x13\x5b\xe8\xb0\x2f\x27\x65\x33\xf4\xb5\xb8\xd6\x9c\x59\x61\x8d\x93\x1c\x25\x81\x4a\xa9\x4e\x2e\xb5\x18\x48\x19\xd4\x5a\x16\x1e\x40\x0e\x9d\x8a\xcb\xc8\x0f\xe1\x43\xca\x10\x6f\x1b\x81\x9a\xbc\xb3\x9a\xd4\x12\x31\x6f\x42\x87\x42\x23\xff\xbb\xbc\xd6\x28\x31\xa8\x0c\x7f\x58\x63\xff\x45\x2e\xfb\x31\x00\xfe\xd5\x38\xde\xce\xd8\x1f\x02\xd0\xaa\xba\x2d\xd1\xff\x0b\x19\x71\x76\x6f\x01\xb8\x26\x11\xcf\x8f\xd7\x8f\x56\xaf\xa9\xe6\x88\xb7\x7e\xa9\x01\x4e\x7a\x62\x3f\x54\xf5\x9f\xce\x9a\xb0\x95\xb1\xdb\x6d\xef\x44\x6c\xa5\x8c\xec\x2a\x69\xed\x6c\x5e\xb6\x4f\x77\x40\x23\xbb\x4a\xb2\xf0\x3d\x00\x4c\x3f\xad\x47\xb0\x01\x00\x00")
 
 func assetsRouterServiceInternalYamlBytes() ([]byte, error) {
 	return bindataRead(
@@ -402,7 +404,7 @@ func assetsRouterServiceInternalYaml() (*asset, error) {
 	return a, nil
 }
 
-var _manifests00ClusterRoleYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x56\x4d\x8f\xe3\x36\x0c\xbd\xfb\x57\x08\x33\x87\x05\x16\xb0\x83\xde\x8a\xdc\x8a\x16\xe8\xa9\x5d\xa0\x28\x7a\x67\x24\x26\x66\x47\x16\x0d\x92\xca\xac\xfb\xeb\x0b\xf9\x63\xb2\x93\xef\xd9\xcd\x29\x91\x4c\x3e\x3e\xf2\x91\x84\x9e\xdd\xaf\x31\xab\xa1\x38\xe1\x88\x6e\xcb\xe2\xac\x45\xc7\x3d\x0a\x18\x8b\x23\x53\x8c\xdb\xa6\x7a\x76\x7f\x7f\xf9\xed\xcb\xda\xfd\xe2\x22\x9b\xe3\x6d\xb1\x52\x74\xda\x72\x8e\xc1\x6d\xd0\x09\xf6\x11\x3c\x06\xb7\x19\x46\x28\x75\x94\x46\xa8\x04\x1d\x6a\x0f\x1e\x75\x44\x7f\x6d\xc9\xb7\xd5\xf3\xfb\x28\xe0\x2d\x43\x8c\x83\x4b\x88\x41\x1d\x78\x8f\xaa\x4d\xf5\x42\x29\xac\x17\x82\x7f\x71\xc4\x0a\x7a\xfa\x07\x45\x89\xd3\xda\xc9\x06\x7c\x03\xd9\x5a\x16\xfa\x0f\x8c\x38\x35\x2f\x3f\x6b\x43\xbc\xda\xff\x54\x75\x68\x10\xc0\x60\x5d\xb9\x91\xc1\xba\x04\x4b\xda\xd2\xd6\x6a\x4a\x3b\x41\xd5\x7a\x09\x5f\x39\x07\x29\xb1\x8d\x18\x5a\x3c\x9c\xa3\xe4\x63\x0e\xd8\x08\x46\x04\xc5\xe6\xcd\xbb\xe0\xd3\xa6\xab\x7d\xe4\x1c\xea\x0e\x12\xec\x30\xac\xdd\x93\x49\xc6\xa7\xdb\xae\xa5\x9a\x8b\x57\xdd\xd2\xae\xad\x61\x0f\x14\x61\x43\x91\x6c\xf8\x00\x0e\xa5\x5d\xc4\x3a\x71\xc0\x3a\xe0\x1e\x63\x49\xe6\xcd\x5d\x72\x44\x5d\x57\xb5\x83\x9e\x7e\x17\xce\xfd\x98\x55\xed\x9e\x0a\xb2\xa0\x72\x16\x8f\xf3\x9d\xe7\xb4\xa5\x5d\x07\xbd\x8e\xc7\x83\x5c\xe3\x51\x51\xf6\xe4\x11\xbc\xe7\x9c\x6c\xba\xc3\x14\x7a\xa6\xe5\x34\x5b\x2c\x07\x2f\x38\x7f\xe8\x39\xcc\xf6\x7b\x9c\x8c\xf7\x28\x9b\x85\xc9\xe7\xa7\xea\x3e\x7e\x05\x66\x85\x7b\xf2\x45\x9d\x23\x10\x2f\x08\x86\xf7\x22\x95\x62\x1d\xd1\x88\xa4\x76\xc6\x1b\xfa\xb1\x1a\x47\xfe\x01\xfb\xc8\x43\x87\x4b\xe6\x01\xb0\xe3\xa4\x78\x5f\x6e\x3d\x47\xf2\xc3\xd9\xfc\x02\xa9\xe4\xbe\xe4\xb7\xc9\x61\x77\x27\x5e\xc7\x89\x8c\x85\xd2\xae\xf1\x2c\xc8\xda\x78\xee\x4e\xe1\x67\x79\x66\xeb\x23\xe4\xa9\x7e\xe3\xdf\x1d\xda\xf8\x9b\xfb\x50\xae\x4e\xe3\x5d\x1c\xb7\x33\x2d\x35\x4d\xec\xb8\x06\x8e\x2f\x36\x94\x02\xa5\xdd\x74\x7f\xb0\x38\xfa\x74\x9d\xe3\xa8\x5a\xf9\xf3\x0a\xe6\xdb\xeb\xb4\x97\x21\x7f\x37\x3e\xa7\x94\xe7\x9d\xe0\x39\x99\x70\x8c\x28\x7a\xe1\x7a\xa5\x06\x96\xef\x52\x68\x76\x6e\xee\xa4\x10\x92\x0a\x7a\x96\x79\x6a\x0e\xc7\x0f\x84\x9c\x86\xf9\x66\xae\x5b\x01\x35\xc9\xde\xb2\xe0\xbb\x44\xf1\x2d\xf6\xfc\x0f\x7a\x2a\x1d\xb4\xd4\x23\xa1\xbd\xb2\xbc\x1c\x71\x29\xba\x7c\x27\x97\x43\xa4\x5b\xac\x8e\xa7\xf6\xa0\xff\x77\x86\x9e\x9b\x72\x51\xe7\xc3\x6d\xf7\xa0\xb0\x67\xd5\xbd\xd8\xce\x77\x0a\x3c\x97\xed\x2c\x76\x7f\x81\xfd\xac\x6d\x59\x28\x97\x06\x7b\x19\x87\x08\xa7\xa2\x7c\xfa\xfc\xa9\xaa\x9e\xdd\x1f\x24\xc2\x82\xc1\x6d\x85\x3b\x57\xec\x4c\x57\xc2\xd9\x50\x56\x1d\x9a\x90\xd7\xd5\x5c\x82\xba\x0c\x7d\x33\x40\x17\xcf\x6c\x9b\xe2\x71\x23\xcd\x09\x55\x17\xd8\x33\x3d\x79\x9d\xce\x1d\x34\xca\xbe\xc3\x64\xe4\xaf\x2f\x3c\xe3\x17\x4c\x82\x7b\xc2\xd7\xf3\x6d\xf4\x18\x26\xb7\x37\xaf\xe6\xcd\xbf\xe8\x6d\x7a\x40\x3d\x94\xd0\xb3\x83\x14\x1c\x7e\xed\x21\x85\xe2\x33\x3f\x14\x3d\x24\x90\xa1\x3e\x2c\xc8\xe6\x07\xb4\xfc\x78\x47\x3d\xb2\x93\xae\x4f\xe2\x0f\xf3\x50\xf4\x59\xc8\x86\x1b\x54\x16\xb3\x52\x51\xfc\x6a\x9e\x93\x9a\x00\x9d\x3c\xa0\xb2\xe2\x37\xce\x7f\x96\x57\xdb\xf4\xa1\x65\xb5\x79\x94\x1f\xc0\x3a\x90\x7a\xde\xa3\x0c\x17\x5b\xee\xed\x35\x18\xe7\x57\xe0\xe5\x45\xfd\x7f\x00\x00\x00\xff\xff\x82\x99\xe5\x95\x6d\x0c\x00\x00")
+var _manifests00ClusterRoleYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xe4\x57\x4d\x8f\xdb\x46\x0f\xbe\xeb\x57\x10\xeb\x43\x80\x00\x92\xf1\xde\x5e\x18\xe8\x21\x68\x80\x9e\x9a\x00\x6d\xd1\x3b\x3d\x43\x4b\xd3\x1d\x0d\x55\x92\xf2\xc6\xf9\xf5\xc5\xc8\x92\xed\x95\xd7\x6b\x67\xd7\xe8\xa5\x27\x5b\x23\xf2\xe1\xc3\xaf\x11\xb9\x80\x9f\x63\xaf\x46\x02\xc2\x91\x60\xc3\x02\xd6\x10\x70\x47\x82\xc6\x02\xc1\x94\xe2\xa6\x2a\x16\xf0\xc7\xd7\xcf\x5f\x57\xf0\x09\x22\x1b\xf0\x26\x4b\x29\x81\x36\xdc\x47\x0f\x6b\x02\xa1\x2e\xa2\x23\x0f\xeb\xdd\x00\xa5\x10\x52\x16\x82\x84\x2d\x69\x87\x8e\x74\x40\x7f\x6a\x82\x6b\x8a\xc5\x73\x2b\xe8\xac\xc7\x18\x77\x90\x88\xbc\x02\x3a\x47\xaa\x55\xf1\x18\x92\x5f\x4d\x04\x7f\xe3\x48\x05\x76\xe1\x4f\x12\x0d\x9c\x56\x20\x6b\x74\x15\xf6\xd6\xb0\x84\xef\x68\x81\x53\xf5\xf8\x7f\xad\x02\x2f\xb7\xff\x2b\x5a\x32\xf4\x68\xb8\x2a\x60\x60\xb0\xca\xc6\x92\x36\x61\x63\x65\x48\xb5\x90\x6a\x39\x99\x2f\x00\x30\x25\xb6\x01\x43\xb3\x06\x40\x48\x2e\xf6\x9e\x2a\xa1\x48\xa8\x54\x1d\xb4\x33\x7e\x58\xb7\xa5\x8b\xdc\xfb\xb2\xc5\x84\x35\xf9\x15\x3c\x98\xf4\xf4\x70\x5d\x35\x47\x73\xd2\x2a\x9b\x50\x37\x25\x6e\x31\x44\x5c\x87\x18\x6c\xf7\x03\x38\x21\xd5\x91\xca\xc4\x9e\x4a\x4f\x5b\x8a\xd9\x99\x83\xba\xf4\x91\x74\x55\x94\x80\x5d\xf8\x45\xb8\xef\x06\xaf\x4a\x78\xc8\x0c\x85\x94\x7b\x71\x34\x9e\x39\x4e\x9b\x50\xb7\xd8\xe9\x20\x72\x4c\xd7\xf0\xa8\x24\xdb\xe0\x08\x9d\xe3\x3e\xd9\x5e\x84\x92\xef\x38\x24\x7b\x26\x31\x3d\x38\xa1\xf1\x45\xc7\x7e\x94\xdf\xd2\x5e\x78\x4b\xb2\x9e\x98\x7c\x7c\x28\x6e\xe3\x97\x61\x96\xb4\x0d\x2e\x67\x67\x06\xe2\x84\xd0\xe8\x56\xa4\x1c\xac\x19\x8d\x18\xd4\x5e\xd0\xc6\xae\xd3\x73\x7d\x4f\x5d\xe4\x5d\x3b\x3a\x53\x82\x47\x6a\x39\x29\xdd\xe6\x5b\xc7\x31\xb8\xdd\x39\x6a\xc7\xde\x07\x95\xbe\xcb\xfe\xad\x7b\x5f\xdf\x88\xd7\x72\x0a\xc6\x12\x52\x5d\x39\x16\x62\xad\x1c\xb7\xe7\xf0\x63\x7a\x46\xe9\x19\xf2\x3e\x7e\xc3\xdf\x9a\x6c\xf8\xed\x3b\x8f\x46\x2f\xd8\xbb\xd8\x6e\xe7\x36\xdd\xbe\x63\x87\x6b\x60\x7e\xb0\x0e\xc9\x87\x54\x67\x22\x25\x1c\x25\x66\xaf\x5e\xe7\x38\x64\x2d\xff\x79\x42\x73\xcd\xeb\xb4\xa7\x26\x7f\xd6\x3e\xe7\x94\xc7\x3b\xc1\x71\x32\xe1\x18\x49\xf4\xc2\xf1\x52\x0d\xad\xbf\x29\x43\xa3\x72\x75\x23\x05\x9f\x54\xc8\xb1\x78\x9d\x3d\xfe\x80\xc9\x7d\x33\x5f\xf5\x75\x23\xa8\x26\xbd\xb3\x5e\x48\x4f\xb9\x8e\x4f\x3e\x4d\xff\xb0\x0b\xb9\x82\xa6\x78\x24\xb2\x27\x96\xc7\x19\x97\x9c\x97\x37\x72\x39\x5a\xba\xc6\xea\xc4\xde\x2c\xff\x6f\x34\x3d\x16\xe5\x94\x9d\x1f\x2e\xbb\x3b\x99\x7d\x31\xbb\x17\xcb\xf9\x26\x13\x87\xb0\xbd\x88\xdd\x5d\x60\x3f\xe6\x36\x5f\x28\x97\x1a\x7b\x04\x76\x11\xcf\x93\xf2\xe1\xe3\x87\x77\x81\xce\xf1\xde\xd2\xec\x35\x1a\x3d\xe1\xae\xba\xc1\xea\x28\x7a\x74\xe5\x70\x74\x87\x42\x78\x33\x8f\x63\xc2\x0e\x6f\x5e\x2f\x90\x05\xfc\x1a\x44\x58\xc8\xc3\x46\xb8\x85\x8c\x62\xba\x14\xee\x8d\x64\xd9\x92\x49\x70\xba\x1c\x6b\xae\xcc\xb7\x6c\xb5\xc3\x36\x9e\x53\x1e\x34\xae\xd4\xd5\x20\x23\x3a\xc1\x3e\x67\x94\x83\x73\x85\xce\x0d\x34\xf2\x3c\x47\xc9\x82\x7b\xfd\x0b\x63\xfc\x48\x49\x68\x1b\xe8\xe9\xe5\x74\xdd\x87\xc9\xf5\x4f\x9d\xf6\xeb\xbf\xc8\xd9\x7e\x62\xbd\x2b\xa1\x05\x60\xf2\x40\xdf\x3a\x4c\x9e\xfc\x61\x32\x77\x98\x50\x76\xe5\xf1\x8b\x54\xbd\x23\x97\x33\xaa\x43\x0b\xbf\x3b\x70\xb7\x5b\x7f\x47\x65\xdf\xc0\x43\xc9\xf5\x12\x6c\x77\x85\xca\x24\x96\x23\x4a\xdf\xcc\x71\x52\x13\x1c\xc7\xdb\x53\x5e\x4a\x27\xca\x5f\xf2\x98\xbc\xf7\xa5\x61\xb5\xb1\xd1\xef\xc0\xda\x07\x75\xbc\x25\xd9\x5d\x2c\xb9\xc3\xf8\x1d\xc7\xb1\xfb\x84\xe4\xec\x66\x2a\xcb\xb2\x98\x6d\x78\xd6\xa0\x01\xc6\xc8\x4f\x0a\x6c\x0d\x09\x38\x6e\x3b\x4e\x79\xaa\x1d\x2a\xae\x57\x12\x05\x63\x10\xfa\xbb\x27\x35\xf8\xfc\xe5\x77\x98\x06\x93\xc5\xb4\xd5\x8d\x9e\x7c\xd0\xe1\xf5\x77\x4e\xa4\x79\xf3\x1b\x06\xca\x90\xea\x93\xe9\xa5\x02\xf8\x34\x89\x03\xfa\x36\xa4\x90\x03\x6c\x2c\xc5\x02\x1c\x26\xc8\x63\x1f\x58\x13\x74\x18\x07\xb3\x01\x3c\x2e\x8d\x99\xc8\xc0\xf6\x04\x31\x9f\xad\x69\x6c\x2c\x9f\xbb\x42\x68\xd8\x50\x4f\x57\xca\xae\x5f\xc7\xa0\x0d\xe9\xb8\xa9\x9e\xa8\x73\x8a\x3b\x08\x9b\xe7\xdb\x29\x34\x38\x88\x16\x8b\xd7\xe7\xb6\xe5\xc0\xa6\x3c\xc2\xfd\x94\x37\x3f\x88\xb8\xa6\xf8\xaf\x6d\xac\x47\xf3\x25\xf9\xf0\x9f\x5a\x61\x5f\x4d\xce\x79\xb3\x1c\xf3\xf4\xbc\x51\x2e\x7c\xca\x4f\x2e\xec\x93\xbb\xe8\x38\x35\xe5\x7f\x9e\x22\x19\xdd\x91\xd9\x8b\xd7\x60\x4d\x56\xfc\x33\x00\xca\x81\x0d\x8d\x9d\x11\x00\x00")
 
 func manifests00ClusterRoleYamlBytes() ([]byte, error) {
 	return bindataRead(
@@ -417,12 +419,12 @@ func manifests00ClusterRoleYaml() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "manifests/00-cluster-role.yaml", size: 3181, mode: os.FileMode(420), modTime: time.Unix(1, 0)}
-	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xbc, 0x19, 0xbd, 0x6e, 0x68, 0x84, 0x61, 0xc8, 0x1a, 0x23, 0xe9, 0x91, 0xfb, 0x1f, 0xb2, 0xd9, 0xc2, 0x59, 0x46, 0xc7, 0x74, 0x9b, 0x46, 0x32, 0xe2, 0x96, 0x94, 0xe3, 0xe1, 0xc5, 0x91, 0x53}}
+	info := bindataFileInfo{name: "manifests/00-cluster-role.yaml", size: 4509, mode: os.FileMode(420), modTime: time.Unix(1, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x7c, 0x42, 0xe8, 0xfa, 0xe4, 0x87, 0x9b, 0xa3, 0x3e, 0xaa, 0x62, 0x1a, 0xa8, 0xf6, 0x95, 0xa0, 0x2, 0x6a, 0x92, 0x6d, 0xd5, 0x5, 0xbc, 0xe1, 0xbd, 0x8b, 0x0, 0x4, 0xe9, 0xe7, 0xa5, 0x52}}
 	return a, nil
 }
 
-var _manifests00CustomResourceDefinitionInternalYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xbc\x59\x6d\x8f\x1b\xb7\x11\xfe\x7e\xbf\x62\x20\x7f\x48\x0b\xdc\xae\x72\x76\x52\x04\x02\x8a\xe2\x70\x4e\x82\x43\x6d\xf7\xe0\xbb\x3a\x40\x7c\x06\x42\x2d\x47\xbb\x63\x73\xc9\x0d\xc9\xd5\x59\x57\xf4\xbf\x17\x43\x72\x5f\x24\xad\xa4\x4b\xed\x76\x3f\x49\xe4\x70\x38\xf3\xf0\x99\x17\xee\x8a\x86\xde\xa1\x75\x64\xf4\x02\x44\x43\xf8\xd9\xa3\xe6\x7f\x2e\xff\xf4\x83\xcb\xc9\xcc\xd7\x17\x67\x9f\x48\xcb\x05\x5c\xb5\xce\x9b\xfa\x2d\x3a\xd3\xda\x02\x5f\xe2\x8a\x34\x79\x32\xfa\xac\x46\x2f\xa4\xf0\x62\x71\x06\xa0\x45\x8d\x0b\x90\xda\x59\x2c\x8c\x95\x2e\x27\x5d\x5a\x74\x2e\x37\x0d\x5a\xe1\x8d\xe5\x1f\xda\x55\xb4\xf2\x39\x99\x33\x00\xa1\xb5\xf1\x82\xf5\x38\x5e\x0f\x6c\x44\x26\x9a\xc6\x9a\x35\xca\x2d\xe1\x05\x54\xde\x37\x6e\x31\x9f\x97\xe4\xab\x76\x99\x17\xa6\x9e\xf7\x02\x73\xd1\xd0\xbc\x69\x95\x9a\x7f\xff\xc3\x77\x41\x11\xe9\x42\xb5\x12\x73\x8b\x0a\x85\xc3\x2d\x5d\x73\x5a\xd6\x59\xa1\x4c\x2b\xb3\x5a\x68\x51\xa2\x5c\xc0\xcc\xdb\x16\x67\xa7\x97\x3a\x54\xab\x6e\x55\x56\x51\x59\x65\x62\x2d\x48\x89\x25\x29\xf2\x9b\x3f\xa0\x87\x74\xa9\x30\xd3\x46\x62\x26\x71\x8d\x8a\x21\xea\x97\xbb\x06\x0b\x06\xa4\xb4\xa6\x6d\x16\x70\x0a\x46\xc6\x3d\x01\x18\x4f\xeb\xe5\x9b\xdb\xb7\xe1\x08\xc2\x98\x22\xe7\xff\xbe\x3d\xfe\x8a\x9c\x0f\x73\x8d\x6a\xad\x50\xe3\x43\x0b\xc3\x6c\x5e\xab\x84\x1d\x4d\x9c\x01\xb8\xc2\x34\xb8\x80\x37\xbc\x5d\x23\x0a\xe4\xb1\x75\xe4\x4f\xda\x3e\x4b\x1c\x58\x5f\x84\xbf\x00\x0e\xed\x9a\xf1\x65\xbf\xba\x21\x6f\xac\x28\x71\x7b\xac\x5d\xda\xc4\xad\xa4\x29\x4a\x0a\xdf\xba\x05\xfc\xeb\xdf\x9d\x58\x51\x61\x2d\x06\x01\xc6\xe1\xf2\xe6\xfa\xdd\x8b\xdb\x9d\x09\x00\x89\xae\xb0\xd4\xf8\xc0\xed\x59\xef\x38\x90\x03\xc1\x38\x40\xf4\x0a\xd2\x59\x02\x69\xf0\x15\xc2\xa3\xd1\xe8\x40\x32\xbf\x51\xc2\x72\xc3\xfe\xe7\x85\xd1\x2b\x2a\xb7\x0f\xb0\x50\xad\xf3\x68\x21\xe7\xb3\xca\x9b\x76\xa9\xa8\xf8\xd5\x68\x04\xa1\x65\x37\x68\x69\x2d\x3c\xf2\x68\x0e\xf7\x1a\xae\xd2\x12\x21\x6b\xd2\xbc\x31\x35\xad\x0a\xec\x07\xb3\x02\x5f\x91\x83\x0e\x04\x36\x53\x1b\x0f\xae\x6d\x1a\x63\x3d\xca\x1c\xee\x76\xe7\x8d\x56\x1b\x58\x19\x0b\xa4\x3d\x5a\x2d\x14\x14\xa6\xae\x5b\x4d\x45\xaf\xf3\x1f\x0d\xea\x5b\xb6\x18\x3a\xea\xb8\x60\xc9\xf5\x8a\x21\x78\x1d\x5c\xaf\x51\xfb\x1b\xa3\xa8\xd8\xb0\xd2\xfb\xd9\x3f\x75\x82\xe4\x7e\x76\x1e\x20\xe9\x96\xc2\x03\x29\x15\xac\x5a\x22\x1b\xd2\x18\xed\x68\xa9\x30\xd8\x10\xd6\x90\x2e\xc3\x8a\x01\x5e\xb6\x32\x0c\x85\x70\x03\x0e\x6c\x92\x68\x23\x1c\xa6\x6e\x84\xa7\x18\x39\xa0\x38\x08\xe0\x62\x01\xb7\x5e\xb0\xd2\x07\xf2\x15\x69\x10\x50\x8b\x8f\xc6\x42\x0a\xa2\xb0\x97\x80\x9a\x34\xd5\x6d\xcd\x2e\x5e\x3c\x87\xda\x68\x5f\x39\x30\x16\x5e\xf0\xcc\x20\xed\xe0\x4f\x0f\x15\x15\x15\xae\xd1\xb2\x73\xca\xe8\x12\xed\x9f\xf3\xd9\x88\x27\x7e\xc3\x94\x36\xcb\x8f\x58\xf8\xd1\x70\x63\xd9\x6d\x4f\x63\x3a\xa6\x04\xd5\x65\xcc\xad\xf1\x1d\xc2\x7d\xc3\xac\x8c\x72\x89\x4c\x2e\xc0\x90\xa2\x05\x65\xa2\xf2\xe8\xe0\x1b\x8b\x0e\xb5\xef\xcf\x4e\xe8\x64\x55\x0e\xb7\x1c\x44\xd6\x81\xab\x4c\xab\x24\x14\x46\xaf\xd1\xfa\x00\x71\xa9\xe9\xb1\xd7\xe6\xc0\x9b\xb0\x8d\x12\x1e\x9d\x1f\x88\xb1\x16\xaa\xc5\xf3\x40\xcd\x5a\x6c\xc0\x22\xeb\x85\x56\x8f\x34\x04\x11\x97\xc3\x6b\x63\x11\x48\xaf\xb6\x33\x6e\x57\x0f\x12\xc3\xfc\x66\x5e\x18\xed\x2d\x2d\x5b\xe6\xd4\x3c\x64\xb0\xb9\xa3\x32\x13\xb6\xa8\xc8\x63\xe1\x5b\x8b\x9c\x95\xb3\x60\xac\x0e\x29\x3e\xaf\xe5\xb3\x3e\xca\xbf\xd9\x81\x2f\x9e\x83\xf3\x96\x74\xb9\x35\x15\x32\xda\x51\xac\x39\xb7\xc5\xb8\x8e\xcb\xa3\x2f\x03\xa4\x1d\x2d\xdf\xfe\x78\x7b\x37\x44\x50\x80\x3d\x22\x3c\x88\xba\x01\x6c\x06\x8a\xf4\x0a\x6d\x94\x5c\x59\x53\x07\x2d\xa8\x65\x63\x48\xfb\x44\x6b\x42\xcd\x61\xba\xac\xc9\xf3\x29\xfe\xde\xa2\xf3\x7c\x0e\x39\x5c\x85\xea\xc6\xc1\xd2\x36\x52\x84\x18\xbe\xd6\x70\x25\x6a\x54\x57\x5c\x0f\xfe\xd7\x50\x33\xa2\x2e\x63\xf8\x9e\x0e\xf6\xb8\x9a\xef\x2f\xd8\x8b\x12\x80\xae\x52\x1d\x3c\x1d\x16\xe0\xc3\x61\xb4\xf8\x37\xad\x46\xf9\x89\x07\x25\x3a\xb2\x9c\x6b\xb1\x12\x6b\x32\xb6\x1f\xd7\x2e\xa6\xec\xfc\xa9\xb6\x40\xc0\x9f\x95\xed\x5a\xc4\x85\x49\x6a\xb7\x9b\xf0\xa6\xa5\xb8\xbc\x4d\xcc\xc4\x84\x76\x77\xf7\xea\xf0\xdc\xa6\x99\x5a\xe8\x85\x2d\xd1\xbb\x9d\x99\x43\x09\x26\x00\xb8\x6f\xea\xbe\xd0\x6e\x89\x9b\x58\x04\x12\xb5\xf1\x29\xf5\x14\xad\xb5\xcc\xd5\x26\x4e\x89\xa6\x51\x84\xb2\xcb\xcf\x43\xca\xce\xe1\x6d\x4a\xdd\xbe\x12\x1e\x2a\xb1\xc6\x6e\x8d\x43\x0f\x62\xa7\x46\x80\x60\x12\x97\xda\xd8\x58\x2f\x59\x59\xea\x57\xfa\xca\x91\x43\xac\x5e\x35\x0a\x9d\xd4\x6e\xef\x39\x5d\x25\xba\x22\xd8\x95\xe8\xa4\xbd\xd3\x1a\xf3\x19\x8f\xdc\xcf\x6e\xb8\xfe\xba\x2a\x18\x14\xbb\x06\xce\x92\x32\xb4\xa8\xb1\x6e\x0d\x61\xc8\x49\x92\x5d\xf8\xa4\xcd\x83\xee\xe5\xcf\xb9\xe7\xe1\xc2\xea\x79\x5b\xee\x84\x39\x73\xaa\x4d\xb7\x7b\x0e\x97\x7a\x03\xf8\x99\x5c\xc8\x27\x47\xed\x2e\x84\xe6\xfd\x24\x2a\xe4\xfd\x92\xbb\x92\x5c\x61\x71\x4c\xfd\x62\xdc\x10\x84\x9a\x18\x60\x5a\x11\x2a\xc9\x65\x43\xb4\x2a\xe4\x12\x78\xdd\xd9\xf0\x4e\x28\xea\x72\x75\x40\xfe\x7e\xf6\x7a\x38\x09\x2d\xb7\xcf\x66\xab\xd0\x75\xcf\xc1\xd8\xef\x48\x15\xb6\x5d\x74\x7b\x4e\x88\xa0\x6e\xeb\x29\x3e\x32\xd9\x0f\xaf\xe2\xd9\xde\xb6\x29\xca\x73\xdc\x9d\xa4\x79\x92\xeb\x32\x4a\x65\x9c\xe7\x8e\xb3\x43\x74\xa0\xd4\x1f\xf7\xbc\x26\xfd\x0a\x75\xe9\xab\x05\x5c\xec\x4d\xf7\xd1\x7f\xd2\xc2\x5e\xb2\xb3\x31\x51\x25\x8c\x68\x70\xc8\xc4\x74\x39\xf7\x60\x8f\x68\xcd\x79\x4a\x82\x01\x75\x5e\xf2\xe2\xdb\x2e\x02\x79\xc5\xb8\xe7\x6a\x5d\xec\x53\x2f\x7f\x61\x27\x4b\xce\xf3\x70\xa9\x88\xcb\x7e\x4c\x31\xe7\xb0\x6c\xfd\x40\xf7\x24\x7e\xf5\xe6\xf2\xf5\x8f\x83\x48\x83\x36\x68\xb8\xbc\xb9\x86\x50\x59\x44\xe1\x77\x53\xec\x80\x16\xb7\x10\x25\xda\x89\xf9\x95\xb1\xb5\xf0\x41\xe2\x2f\xdf\x4d\xc3\xc9\x3d\xda\x02\xbe\x3d\x04\x26\x6f\xf0\x44\x34\x37\x4d\x7f\xe4\xa3\xac\xc1\x26\xe6\xf0\x93\xb1\x80\x9f\x45\xdd\x28\x3c\x87\xd9\xe5\x8c\x1b\xc1\x59\x70\x7a\x76\xd8\xaf\x83\x2c\x38\x46\xee\xa0\xf4\xc0\xdc\xe5\xde\x78\x42\xfc\xa4\x8b\x49\x2e\x84\x73\xe7\x58\x1c\x3a\x6c\xbe\xb0\x56\xec\x97\xaf\x00\xfa\xb5\xc7\xda\x4d\x51\x18\x80\xc2\xd4\xa4\x03\x07\x51\x49\x77\xb0\xa3\x25\x3e\x26\xdc\x74\x3e\xb5\x71\xa1\x35\x45\xed\xd5\x06\xcc\x32\xde\x00\x3b\xa1\x2f\x28\xee\xc7\x2a\x66\xb7\xcd\xcf\xa8\xb9\x38\x4c\xb4\xe7\x7b\x56\xef\x2f\x39\xe1\x41\x39\x08\x0e\xd9\x26\x79\x01\xf0\x4b\x85\x7a\x7b\x90\xd5\xa5\x92\x13\x83\x3c\x44\x9b\x51\x0a\x6d\x1a\x4f\x85\xd9\xd8\x78\x9b\x92\xa3\xc2\x42\x1a\x50\x14\x55\x5f\xfb\x1e\xc3\x25\x92\x93\x86\xd0\x69\x75\xba\x0b\x35\xc2\x7a\x2a\xf8\xb2\x1e\x84\x60\x25\x48\xb9\xf3\x58\x65\xf9\x77\x6b\x43\xe8\x44\xbd\xc3\x45\x77\xaf\x4a\xb2\xb6\xee\x06\x0c\xce\x0c\x65\x7a\x64\x36\x17\x36\x89\x1e\x6d\x4d\x1a\xa3\x04\x79\xd0\x88\x32\x94\x29\x8b\xde\xc6\x1a\x3d\xb2\x30\x48\x75\x9d\x5f\xf4\xe3\xab\x67\x9b\x70\x6b\x3f\x79\xe2\xf1\x6e\xcf\x61\x36\x02\x20\x1d\xe5\x0e\xec\xc7\xcd\x3c\x14\x7d\x47\xe2\x6b\xcb\x90\x97\x6f\x6e\x7f\x35\x1a\x6f\xb7\xe2\x66\xb0\x47\x74\xd6\xf4\x77\xe0\x93\xf0\x1d\x0d\x9c\xf8\x1c\x0b\x9f\xf8\xf4\x5c\x38\x28\xb1\xdb\x73\x0e\x2b\x02\xac\x42\x6f\x60\x3c\xe4\x9c\x29\x28\xb4\x5c\xec\xc9\x0e\xce\x1d\xd7\xba\x17\x12\x4d\x6c\xe1\xba\xcb\x5a\x12\x74\x6d\x51\x30\xbd\xce\x27\x1a\xbd\xfd\x0e\x8f\x1b\xd4\xb0\x55\x02\xf3\x7e\x76\x67\x5b\x4c\xad\x51\xdb\x30\xcb\xbb\x88\xf0\x5b\x8b\x42\x4b\xf8\x93\x50\x2e\x08\x2b\xa3\xcb\xb1\xc9\xc2\x19\x1d\x2f\xcf\xe8\x9c\x28\x31\xa1\xb0\xec\x6c\x2d\x44\xeb\xfa\x16\x24\xed\x30\xd9\x7b\x3d\x85\x44\xf1\x39\x42\xa5\x89\x83\x48\x84\xba\xea\x01\x21\x07\x1f\x5b\xe7\x3b\x62\x69\x29\xac\x1c\xc7\x3a\x77\x98\x93\xe5\x65\xdb\xc6\x23\x74\x8a\xcf\xe1\x0b\xd7\xf8\xc9\xd2\x79\x9c\x10\xf2\x53\xf7\xa7\xe1\x39\x4d\xe0\xf8\x28\xe1\xfc\x9d\x15\xda\x05\x5f\xef\x68\xba\xab\x1c\x3f\x27\x5a\x82\xf1\xd3\xe5\x21\x4e\x6f\x99\xa7\x89\x9b\xe2\xf8\x49\x7c\xf9\x6a\xfb\x47\x2a\x7e\x35\x75\xd3\xb5\xfd\xbf\x56\x77\xa2\x85\xde\xd3\xf9\x7f\xdd\x57\x6a\xc7\x21\xf2\xc4\xcc\x96\xa4\xbb\xdc\x1c\x6a\xe2\x43\x85\xa9\x74\xd8\xbe\xbe\x37\x5d\x3a\x3a\x1c\x4b\x4f\x8a\xa4\xa7\x71\x9b\x4e\xc4\xd9\x76\x72\x26\xd9\x99\x4f\x12\xb5\xa7\x15\x61\xaa\xc6\xe9\x7e\x1a\x2e\x08\xde\xc0\x8a\xd2\x3d\x9a\x5b\x6b\xbe\x51\xf5\xfd\xc6\xbd\xe6\x1b\x2e\xdf\x16\x02\x02\x69\xdd\x0a\x7d\x51\xa1\x84\xd6\x71\x02\xfc\xed\xfa\xe5\x6f\x20\x1c\x50\xc8\xea\xef\x2f\x3e\x84\x25\x8f\x9c\x64\x4f\x2f\x12\xd0\x58\xcc\xfa\x96\x42\x86\xcf\x07\x41\xcf\xf3\x0f\xe7\xac\xe8\xe7\xab\x9b\x2f\x52\xf3\xe2\x43\xf0\xe2\xfd\xc5\x87\xe1\x25\x9b\x34\x85\xcb\xc5\x83\xcb\x45\x2d\x1e\x8d\x0e\x9f\x92\x0a\x45\xf3\xf8\xd6\x74\x6e\x71\x85\x16\x75\x81\x73\x6b\x5a\x8f\xdf\xbf\x98\x97\xe8\xb3\x88\x4b\x16\x70\xa9\x7c\xad\x9e\x99\x26\x56\xb9\xf7\xcf\x77\x55\xd7\x54\x58\xe3\xcc\xca\x07\xcd\xa8\xb3\xd6\x05\xfd\x82\x41\x99\x6b\xf4\x0f\xc6\x7e\x9a\x4b\xed\xe6\xac\xed\x6f\x6b\xc2\x87\xbf\x86\xb9\xac\x50\x94\x45\x2b\x9e\x89\xc7\x2c\x49\x66\x52\xbb\xb0\x6f\xe6\x2a\xf3\xc0\x2e\x0d\xfb\x85\x17\x0f\x79\x69\x4c\xa9\x30\xec\xc6\x5a\xd9\x88\x91\x17\xeb\x8b\x79\xea\x22\x99\xd2\x8e\xbd\x39\x5c\x9b\xe0\xe9\x81\xe7\x45\x79\x22\x77\x6c\xf3\x91\xe5\x77\xa9\xf7\x7b\x8b\xa9\x63\x3c\xcc\xbd\xf3\xfe\x9d\x6d\xf8\x1c\xe6\xbc\x28\x4b\xd2\xa5\x68\x28\xb0\x6d\x97\xcc\x4c\x10\x10\x91\x34\x89\x25\x77\xa2\x74\x81\x27\x5e\x94\xd9\x8a\x94\x47\xeb\xce\xbf\x80\x16\x07\xcc\x09\x3c\xe9\x5f\x70\x6f\xb1\xe4\x29\x80\x9f\x2c\xb6\x00\x42\xc6\x02\x2e\xd4\xcd\x13\x8b\xe1\xce\x69\x0e\x19\x5f\x14\x05\x36\x1e\xe5\x9b\xdd\x6f\x87\xb3\xd9\xd6\x87\xc1\xf0\x77\xd4\x19\xc2\xfb\x0f\x67\xf1\x1b\x1e\xca\x77\xdd\xb7\x3f\x1e\xfc\x4f\x00\x00\x00\xff\xff\xf6\xc8\x1e\x71\x4c\x1e\x00\x00")
+var _manifests00CustomResourceDefinitionInternalYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x59\x5f\x6f\xe3\x36\x12\x7f\xcf\xa7\x18\x78\x1f\x7a\x07\x44\x72\xb3\x69\x0f\x85\x81\xc3\x21\xc8\xb6\x45\x70\xbb\x7b\xc1\x26\xb7\x05\x2e\x09\x50\x5a\x1c\x4b\xb3\x4b\x91\x2a\x87\x72\xd6\x39\xdc\x77\x3f\x0c\x45\x59\xb2\x63\xc7\x49\xbb\x8d\xf3\x60\x93\xc3\xe1\xcc\x8f\xbf\xf9\x43\x49\x35\xf4\x11\x3d\x93\xb3\x33\x50\x0d\xe1\x97\x80\x56\x7e\x71\xfe\xf9\x07\xce\xc9\x4d\x97\x27\x47\x9f\xc9\xea\x19\x9c\xb7\x1c\x5c\xfd\x01\xd9\xb5\xbe\xc0\x37\xb8\x20\x4b\x81\x9c\x3d\xaa\x31\x28\xad\x82\x9a\x1d\x01\x58\x55\xe3\x0c\xb4\x65\x8f\x85\xf3\x9a\x73\xb2\xa5\x47\xe6\xdc\x35\xe8\x55\x70\x5e\xbe\x58\xae\x68\x11\x72\x72\x47\x00\xca\x5a\x17\x94\xe8\x61\x59\x0f\x62\x44\xa6\x9a\xc6\xbb\x25\xea\x0d\xe1\x19\x54\x21\x34\x3c\x9b\x4e\x4b\x0a\x55\x3b\xcf\x0b\x57\x4f\xd7\x02\x53\xd5\xd0\xb4\x69\x8d\x99\x7e\xff\xc3\x77\x51\x11\xd9\xc2\xb4\x1a\x73\x8f\x06\x15\xe3\x86\xae\x29\xcd\xeb\xac\x30\xae\xd5\x59\xad\xac\x2a\x51\xcf\x60\x12\x7c\x8b\x93\xc3\x4b\x19\xcd\xa2\x5f\x95\x55\x54\x56\x99\x5a\x2a\x32\x6a\x4e\x86\xc2\xea\x05\x7a\xc8\x96\x06\x33\xeb\x34\x66\x1a\x97\x68\x04\xa2\xf5\x72\x6e\xb0\x10\x40\x4a\xef\xda\x66\x06\x87\x60\x14\xdc\x13\x80\xdd\x69\xbd\x79\x7f\xf5\x21\x1e\x41\x1c\x33\xc4\xe1\x9f\x9b\xe3\x6f\x89\x43\x9c\x6b\x4c\xeb\x95\x19\x1f\x5a\x1c\x66\xb2\x65\x6b\x94\x1f\x4d\x1c\x01\x70\xe1\x1a\x9c\xc1\x7b\xd9\xae\x51\x05\xea\x23\x80\x65\xc7\x9f\xb4\x7d\x96\x38\xb0\x3c\x89\x3f\x01\x18\xfd\x52\xf0\x15\x78\xfb\xa1\xe0\xbc\x2a\x71\x73\xac\x9d\xfb\xc4\xad\xa4\x49\xfe\x39\xa8\xd0\xf2\x0c\xfe\xfb\xbf\x5e\xac\xa8\xb0\x56\x83\x80\x9c\xea\xd9\xe5\xc5\xc7\xd3\xab\xad\x09\x00\x8d\x5c\x78\x6a\x84\x5b\x33\x98\xac\x1d\x07\x62\x50\x82\x03\x74\x1c\x85\x74\x96\x40\x16\x42\x85\xf0\xe0\x2c\x32\x68\xe1\x37\x6a\x98\xaf\xc4\xff\xbc\x70\x76\x41\xe5\x06\xea\xd3\xc2\xb4\x1c\xd0\x43\x2e\x67\x95\x37\xed\xdc\x50\xf1\x1f\x67\x11\x94\xd5\xfd\xa0\xa7\xa5\x0a\x28\xa3\x39\xdc\x5a\x38\x4f\x4b\x94\xae\xc9\xca\xc6\xd4\xb4\x26\xb2\x1f\xdc\x02\x42\x45\x0c\x3d\x08\x62\xa6\x75\x01\xb8\x6d\x1a\xe7\x03\xea\x1c\xae\xb7\xe7\x9d\x35\x2b\x58\x38\x0f\x64\x03\x7a\xab\x0c\x14\xae\xae\x5b\x4b\xc5\x5a\xe7\xbf\x1a\xb4\x57\x62\x31\xf4\xd4\xe1\x68\xc9\xc5\x42\x20\x78\x17\x5d\xaf\xd1\x86\x4b\x67\xa8\x58\x89\xd2\xdb\xc9\xbf\x6d\x82\xe4\x76\x72\x1c\x21\xe9\x97\xc2\x3d\x19\x13\xad\x9a\xa3\x18\xda\x38\xcb\x34\x37\x18\x6d\x88\x6b\xc8\x96\x71\xc5\x00\xaf\x58\x19\x87\x62\xb8\x81\x04\x36\x69\xf4\xd1\x88\x73\x57\x37\x2a\x50\x17\x39\x60\x24\x08\xe0\x64\x06\x57\x41\x89\xd2\x7b\x0a\x15\x59\x50\x50\xab\x4f\xce\x43\x0a\xa2\xb8\x97\x82\x9a\x2c\xd5\x6d\x2d\xb0\x9d\xbc\x86\xda\xd9\x50\x31\x38\x0f\xa7\x32\x33\x48\x33\xfc\xe5\xbe\xa2\xa2\xc2\x25\x7a\x71\xce\x38\x5b\xa2\xff\x6b\x3e\x19\xf1\x24\xac\x84\xd2\x6e\xfe\x09\x8b\x30\x1a\x6e\xbc\xb8\x1d\xa8\x8f\xab\xfe\x6f\x94\x31\x37\xc6\xb7\x08\xf7\x8d\xb0\xb2\x93\x4b\x64\xe2\x08\x43\x8a\x16\xd4\x89\xca\xa3\x83\x6f\x3c\x32\xda\xb0\x3e\x3b\x65\x93\x55\x39\x5c\x49\x10\x79\x06\xae\x5c\x6b\x34\x14\xce\x2e\xd1\x87\xc8\xe0\xd2\xd2\xc3\x5a\x1b\x43\x70\x71\x1b\xa3\x02\x72\x18\x88\xb1\x54\xa6\xc5\xe3\x48\xcd\x5a\xad\xc0\xa3\x78\x0b\xad\x1d\x69\x88\x22\x9c\xc3\x3b\xe7\x11\xc8\x2e\x36\x33\x6e\x5f\x0f\x12\xc3\xc2\x6a\x5a\x38\x1b\x3c\xcd\xdb\xe0\x3c\x4f\x63\x06\x9b\x32\x95\x99\xf2\x45\x45\x01\x8b\xd0\x7a\x94\xac\x9c\x45\x63\xad\x38\xc5\x79\xad\x5f\xf5\x04\xe6\x6f\xb6\xe0\xeb\xce\x81\x83\x27\x5b\x6e\x4c\xc5\x8c\xf6\x24\xd6\x92\xdb\xe4\x78\x55\x5a\xde\xf9\x32\x40\xda\xd3\xf2\xc3\x8f\x57\xd7\x43\x04\x45\xd8\x3b\x84\x07\x51\x1e\xc0\x16\xa0\xc8\x2e\xd0\x77\x91\xb9\xf0\xae\x8e\xd8\xa2\xd5\x8d\x23\x1b\x12\xad\x09\xad\x84\xe9\xbc\xa6\x20\xe1\xf9\x5b\x8b\x1c\xe4\x1c\x72\x38\x8f\xd5\x0d\xe6\x08\x6d\xa3\x55\x8c\xe1\x0b\x0b\xe7\xaa\x46\x73\x2e\x25\xe9\xcf\x86\x5a\x10\xe5\x4c\xe0\x7b\x3e\xd8\xe3\x6a\x0e\x70\x30\x4a\x00\xfa\x4a\xb5\xf7\x74\x44\x40\x0e\x47\xd0\x92\xef\xb4\x18\xe5\x27\x19\xd4\xc8\xe4\x25\xd7\x62\xa5\x96\xe4\xfc\x7a\xdc\x72\x57\xab\xf2\xe7\xda\x02\x11\x7f\x51\xb6\x6d\x11\x40\x26\x89\x7c\x3b\xe1\xed\x96\x92\xf2\xb6\x63\x46\x62\xc5\xeb\xeb\xeb\xb7\xfb\xe7\x56\xcd\xae\x85\x41\xf9\x12\x03\x6f\xcd\xec\x4b\x30\xf2\xd9\x61\xea\x63\xa1\x2d\x9c\x27\x3b\x16\x81\x46\xeb\x02\x76\xe0\x17\xad\xf7\xc2\xd5\xa6\x9b\x52\x4d\x63\x08\x75\x9f\x9f\x87\x94\x9d\xc3\x87\x94\xba\x43\xa5\x02\x54\x6a\x89\xfd\x1a\xc6\x00\x6a\xab\x46\x80\x92\x7c\x51\x5a\x17\xcf\x70\x15\xb7\x4a\xfd\xca\xba\xe8\xe4\xd0\x55\xaf\x1a\x95\x4d\x6a\x37\xf7\xdc\x5d\x25\xfa\x22\x98\xf6\xea\xb5\xf7\x5a\xbb\x7c\x26\x23\xb7\x93\x4b\xa9\xbf\x5c\x45\x83\xba\xae\x41\xb2\xa4\x8e\x2d\x6a\x57\xb7\x86\x30\x94\x24\x29\x2e\x7c\xb6\xee\xde\xae\xe5\x8f\x81\xc9\x4a\x61\x0d\xb2\xad\x74\xc2\x52\x52\xcd\xaa\xdf\x3d\x87\x33\xbb\x02\xfc\x42\x1c\xf3\xc9\x93\x76\x17\xca\x4a\xd8\x6b\x34\x18\x50\x43\x72\x57\x13\x17\x1e\xc7\xd4\xef\x7b\x88\xd8\x10\xc4\x9a\x18\x61\x5a\x10\x1a\x2d\x65\x43\xb5\x26\xe6\x12\x78\xd7\xdb\xf0\x51\x19\xea\x73\x75\x44\xfe\x76\x92\xe6\x6e\x27\x11\x8e\x8d\xb3\xc9\x27\x3b\x58\xb3\x37\xf6\x7b\x52\xc5\x6d\x67\xfd\x9e\x3b\x44\xd0\xb6\xf5\x2e\x3e\x0a\xd9\xf7\xaf\x92\xd9\xb5\x6d\x8f\xe6\x53\xdc\x1d\xa4\x79\x92\xeb\x33\x4a\xe5\x38\x48\xc7\xd9\x23\x3a\x50\xea\xe5\x9e\xd7\x64\xdf\xa2\x2d\x43\x35\x83\x93\xa3\x8d\x19\x80\xa4\xf4\xfa\xfa\xed\x41\x0b\xd7\x92\xbd\x8d\x89\x2a\x71\xc4\x02\xa3\x10\x93\x73\xb8\x58\xc0\x03\x7a\xd7\xf5\x58\x09\x75\x59\x72\xfa\x6d\x1f\x81\xb2\x62\xdc\x73\xb5\xdc\xf5\xa9\x67\xbf\x88\x93\xa5\xe4\x79\x38\x33\xa4\xb8\x4f\x31\xc7\x30\x6f\xc3\x40\xf7\x24\x7e\xfe\xfe\xec\xdd\x8f\x83\x48\x83\x3e\x6a\x38\xbb\xbc\x90\x18\x09\x5e\x15\x21\xdf\x8b\x96\xb4\x10\x25\xfa\x1d\xf3\x0b\xe7\x6b\x15\xa2\xc4\xdf\xbe\xdb\x31\x9f\x7a\xb4\x19\x7c\xbb\x0f\x4c\x39\x8e\x67\xa2\xb9\x6a\xb0\x87\x73\x94\x35\xc4\xc4\x1c\x7e\x72\x1e\xf0\x8b\xaa\x1b\x83\xc7\x30\x39\x9b\x48\x23\x38\x89\x4e\x4f\xf2\x97\xb3\xe0\x29\x72\x47\xa5\x7b\xe6\xce\x1e\x8d\x27\xc4\x0f\xba\x98\xe4\x62\x38\xf7\x8e\x75\x43\xfb\xcd\x57\xde\xab\xc7\xe5\x2b\x82\x7e\x11\xb0\xe6\x5d\x14\x06\xa0\x38\xb5\x63\xe2\x09\x54\xd2\x1d\xec\xe8\x09\x07\x52\xc2\x4d\xe7\x53\x3b\x8e\xad\x29\xda\x60\x56\xe0\xe6\xdd\x0d\xb0\x17\x4a\x71\xfa\x7b\x8a\xfb\x53\x15\xb3\xdf\xe6\x67\xb4\x52\x1c\x76\xb4\xe7\x8f\xac\x7e\xbc\xe4\x80\x07\xe5\x20\x38\x64\x9b\xe4\x05\xc0\x2f\x15\xae\x2b\xe9\x70\xd5\x4c\x25\xa7\x0b\xf2\x18\x6d\xce\x18\xf4\x69\x3c\x15\x66\xe7\xbb\xdb\x94\x1e\x15\x16\xb2\x80\xaa\xa8\xd6\xb5\x4f\xee\xa5\x39\x48\xd2\x50\x36\xad\x4e\x77\xa1\x46\xf9\x40\x85\x5c\xd6\xe3\xe5\x15\x16\x8a\x0c\xcb\x86\x2a\xc4\xef\xad\xd4\x67\x4e\x7a\x87\x8b\xee\xa3\x2a\x29\xda\xfa\x1b\x30\xb0\x1b\xca\xf4\xc8\x6c\x29\x6c\x1a\x03\xfa\x9a\xac\x74\xd0\x2a\x48\xbd\xb4\x88\x3a\x96\x29\x8f\xc1\x77\x35\x7a\x64\x61\x94\xea\x3b\xbf\x68\xe2\xd7\xcf\x36\xa2\x95\x0f\x9e\x78\x94\x8a\x61\x36\x02\x20\x1d\xe5\x16\xec\x4f\x9b\xb9\x2f\xfa\x9e\x88\xaf\x0d\x43\xde\xbc\xbf\x92\x87\x02\x57\x1b\x71\x33\xd8\xa3\x7a\x12\xac\xef\xc0\x07\xe1\x7b\x32\x70\x0e\x87\x4f\xf7\x59\x73\x61\xaf\xc4\x96\x1f\x93\x61\x45\x84\x55\xd9\xd5\x48\x09\x28\x66\x57\x50\x6c\xb9\xc4\x93\x2d\x9c\x7b\xae\xf5\x0f\x24\xe2\x23\x14\xae\xfa\xcb\x5a\x12\xe4\xb6\x28\x84\x5e\xc7\x3b\x1a\xbd\xc7\x1d\x9e\x34\xa8\x71\xab\x04\xe6\xed\xe4\xda\xb7\x98\x5a\xa3\xb6\x71\x76\x88\x88\xa1\x4e\xca\xa2\xd8\x12\xfe\xa4\x0c\x47\x61\x79\x4e\x30\x36\x59\xb1\xb3\x51\x45\x8d\xcc\xaa\xc4\x84\xc2\xbc\xb7\xb5\x50\x2d\xaf\x5b\x90\xb4\xc3\xce\xde\xeb\x39\x24\x3a\x48\xa5\xfd\x84\x3a\x5f\x03\x42\x0c\x9f\x5a\x0e\x3d\xb1\xac\x56\x5e\x8f\xf0\x8a\x1d\x26\xe7\x4f\xa8\x3f\x48\xa7\x43\x17\xae\xf1\x5f\x96\x82\xed\x80\x50\xd8\x75\x7f\x7a\x09\x81\xbb\x8f\x51\x1c\xae\xbd\xb2\x1c\x7d\xbd\xa6\xdd\x5d\xe5\xb3\x8a\xdf\xfe\x3c\x24\xe9\x2d\x0b\xb4\xe3\xa6\x38\xfe\x24\xbe\x7c\xb5\xfd\x3b\x2a\x7e\x35\x75\xbb\x6b\xfb\xef\x56\x77\xa0\x85\x1e\x7f\xc2\x9e\xde\xef\xcf\xdb\x57\x5b\x96\x10\x99\x1d\x3d\x2b\xa0\x92\x74\x9f\x9b\x25\x4f\xc1\x7d\x85\x1e\xc7\xb9\x89\xb8\x4f\x5a\xf8\xa8\x8f\x79\x61\x24\x3d\x8f\xdb\x74\x20\xce\x36\x5c\x98\x90\xee\xcd\x27\x8d\x36\xd0\x82\x30\x55\xe3\x74\x3f\x8d\xf7\x89\xe0\x60\x41\xe9\x1e\x2d\xad\xb5\xdc\xa8\xd6\xfd\xc6\xad\x95\x1b\xae\xdc\x16\x22\x02\x69\xdd\x02\x43\x51\xa1\x86\x56\xde\x12\xc0\xaf\x17\x6f\x7e\x95\xa7\x02\xb2\x9d\x85\x9b\x93\xbb\xb8\xe4\x41\xda\x8e\xc3\x8b\x14\x34\x1e\xb3\x75\x4b\xa1\xe3\xeb\x83\xa8\xe7\xf5\xdd\xb1\x28\xfa\xf9\xfc\xf2\x0f\xa9\x39\xbd\x8b\xf5\xe5\xe6\xe4\x6e\x78\xc8\xa6\x5d\xc1\xb9\xba\xe7\x5c\xd5\xea\xc1\xd9\xf8\x2a\xa9\x30\x34\xed\x9e\x9a\x4e\x3d\x2e\xd0\xa3\x2d\x70\xea\x5d\x1b\xf0\xfb\xd3\x69\x89\x21\xeb\x70\xc9\xc4\x96\xbc\x0a\xb5\x79\xe5\x22\xce\x0c\x37\xaf\xb7\x55\xd7\x54\x78\xc7\x6e\x11\xa2\x66\xb4\x59\xcb\x51\xbf\x12\x50\xa6\x16\xc3\xbd\xf3\x9f\xa7\xda\xf2\x54\xb4\xfd\x63\x49\x78\xff\xf7\x38\x97\x15\x86\xb2\xce\x8a\x57\xea\x21\x4b\x92\x99\xb6\x1c\xf7\xcd\xb8\x72\xf7\x70\x73\x3a\xda\x2f\x3e\x78\xc8\x4b\xe7\x4a\x83\x71\x37\xd1\x2a\xfe\x8d\xbc\x58\x9e\x4c\x53\x17\x29\x01\xc0\xe2\xcd\xe4\xe8\x2b\x04\x5e\x50\x25\xbf\x84\x8f\x22\xbf\x4d\xbd\xdf\x5a\xf4\xab\x03\xdc\x3b\x5e\x3f\xb3\x8d\xaf\xc3\x38\xa8\xb2\x24\x5b\xaa\x86\x22\xdb\xb6\x34\x46\x9e\x81\xea\x48\x93\x58\x72\xad\x4a\x8e\x3c\x09\xaa\xcc\x16\x64\x02\x7a\x3e\xfe\x03\xb4\xd8\x63\x8e\x20\x9b\xf5\xb6\xf2\x06\x4b\x9e\x03\xf8\xc1\x62\x0b\xa0\x74\x57\xdf\x95\xb9\x7c\x66\x31\xdc\x3a\xcd\x21\xe3\xab\xa2\xc0\x26\xa0\x7e\xbf\xfd\xee\x70\x32\xd9\x78\x31\x18\x7f\xae\x3b\x07\x9e\xc1\xcd\x9d\xbc\x09\x0c\xf2\xbc\x2f\xbd\xe1\xe0\x19\xdc\xdc\x1d\xfd\x7f\x00\xf6\xc8\x1e\x71\x4c\x1e\x00\x00")
 
 func manifests00CustomResourceDefinitionInternalYamlBytes() ([]byte, error) {
 	return bindataRead(
@@ -442,7 +444,7 @@ func manifests00CustomResourceDefinitionInternalYaml() (*asset, error) {
 	return a, nil
 }
 
-var _manifests00CustomResourceDefinitionYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\xfd\x6f\x7b\xdb\x36\xd2\x28\x0e\xbf\xdf\x4f\x81\x47\xdd\x3e\xb1\x52\x89\xb2\xfc\x27\x4d\xbd\xdb\xed\xe3\x38\x69\x9b\xeb\x4e\x13\x3f\xb1\xb7\xdd\xdd\x28\xcd\x0f\x22\x21\x09\x6b\x0a\xd0\x12\xa0\x6d\xb5\xe9\xf9\x56\xe7\xdd\x79\x75\x3e\xc0\xf9\x4c\xbf\x0b\x33\x00\x08\x52\x24\x45\x39\xce\x7d\x77\xcf\x1d\xf7\xba\x1a\x9b\x04\xc0\xc1\x60\x30\x98\x19\xcc\x1f\xba\xe2\x3f\xb2\x4c\x71\x29\x4e\x08\x5d\x71\x76\xab\x99\x30\x7f\xa9\xe8\xea\xb1\x8a\xb8\x1c\x5d\x8f\xff\x70\xc5\x45\x72\x42\xce\x72\xa5\xe5\xf2\x35\x53\x32\xcf\x62\xf6\x94\xcd\xb8\xe0\x9a\x4b\xf1\x87\x25\xd3\x34\xa1\x9a\x9e\xfc\x81\x10\x2a\x84\xd4\xd4\x3c\x56\xe6\x4f\x62\xc6\x1c\xd2\xd5\x2a\x93\xd7\x2c\x89\xe4\x8a\x09\xb5\xe0\x33\x1d\x71\x79\x42\x16\x5a\xaf\xd4\xc9\x68\x34\xe7\x7a\x91\x4f\xa3\x58\x2e\x47\xbe\xc1\x88\xae\xf8\x68\x95\xa7\xe9\xe8\xd1\xf8\x11\x0c\xc4\x45\x9c\xe6\x09\x8b\x32\x96\x32\xaa\x58\x69\xac\x11\x9f\x2e\x87\x71\x2a\xf3\x64\xb8\xa4\x82\xce\x59\x72\x42\x7a\x3a\xcb\x59\x6f\x7b\x57\xc5\xd2\x99\xeb\x35\x5c\xf0\xf9\x62\x48\xaf\x29\x4f\xe9\x94\xa7\x5c\xaf\x77\x18\x87\x8b\x79\xca\x86\x42\x26\x6c\x98\xb0\x6b\x96\xca\x15\xcb\x82\xee\x82\x2e\xd9\x09\xe1\x62\x9e\x31\xa5\x62\x29\x74\x26\xd3\x94\x65\xca\x8c\x92\x51\x2d\xb3\xd2\x70\x7f\x50\x2b\x16\x1b\x0c\xce\x33\x99\xaf\x4e\x48\x7d\x23\x1c\xd5\x62\x1a\x57\xe9\x39\x7e\xe0\xcc\x7f\x00\xde\xa5\x5c\xe9\xff\xa8\x7f\xff\x82\x2b\x0d\x6d\x56\x69\x9e\xd1\xb4\x0e\x44\x78\x6d\xe6\x97\xa7\x34\xab\x69\xf0\x07\x42\x54\x2c\x57\xec\x84\xbc\x34\xe0\xac\x68\xcc\x92\x3f\x10\x72\x8d\x74\x65\xc1\x1b\x5a\x0c\x5c\x8f\xe1\x4f\xd3\x65\xc1\x96\xf4\xc4\xfe\x45\xcc\x14\xc5\xe9\xf9\xf3\x1f\x0f\x2f\x2a\x2f\x08\x49\x98\x8a\x33\xbe\xd2\x40\xa5\xbd\x8d\x29\xd8\xf7\x53\xa6\x08\x25\x76\x29\x1d\x94\xa4\x00\x93\xcc\x64\x46\xf4\x82\x91\x38\xcd\x95\x66\x59\x44\x2e\xcd\x1f\xc5\xfb\x98\x0a\xa2\x58\x76\xcd\x63\x46\x5e\xad\x98\xb8\x30\x78\x26\xaf\x65\xae\x19\xa1\x22\x21\xff\x91\x4f\x59\x26\x98\x66\xca\xa1\x91\x64\x76\x3b\xa8\x88\x4c\x04\xf9\x69\xc1\x04\xa1\x62\x13\xc9\x84\x2b\x12\x67\x8c\x6a\x96\x0c\x08\x25\x82\xdd\xd4\xc1\x97\xb0\x55\x2a\xd7\x4b\x26\x74\xd0\x9e\x68\x49\x68\x9a\xca\x1b\x62\xf6\x66\x26\x68\x4a\x74\x46\x67\x33\x1e\x9b\x37\x19\xa3\xf1\x02\x26\x65\x01\x57\x44\x2f\xa8\x26\xec\x76\x25\x15\xf3\x60\xca\xcc\x4e\x23\x80\xf7\xaf\xab\x84\x6a\x2e\xe6\x44\x2f\x78\x31\x11\xb2\xa4\x6b\x92\x32\x0a\xdf\x4d\xb8\xca\x72\x40\x3b\xe0\x6e\x95\x4f\x53\x1e\x93\x19\x8d\x4d\x37\xc1\xf4\x8d\xcc\xae\xcc\x04\x04\x8b\x61\xcb\x13\xaa\x9a\x67\x97\xb1\x6b\x6e\xe8\x01\xbe\x30\x65\x04\x1e\x27\x44\xe6\x1a\x70\xe7\xb8\xc1\x95\x47\xb2\xd9\x55\x89\x8c\xd5\x28\x96\x22\x66\x2b\xad\x46\x6e\x8e\x43\xfb\x6d\x2e\xe6\x23\xfb\xa5\x61\x40\xaf\x6e\x29\xd8\x35\xcb\xc8\x4a\x2a\xc5\xa7\x29\x1b\x10\x65\x38\xdb\x34\x65\x24\x61\x33\x9a\xa7\x5a\x79\x82\x58\xa5\x54\xcf\x64\xb6\x24\x34\x63\x24\x57\x2c\x89\xc8\x05\x63\x04\x70\x3b\xe3\x2c\x4d\xa0\xe5\x52\x66\xa6\xaf\xa6\x3c\xc5\xe5\x3e\x93\xcb\x15\xd5\x1c\x39\x05\x49\xcd\xa6\x27\xe3\x13\x72\xa1\xa9\xf9\xca\x0d\xd7\x0b\x2e\x80\x22\xff\x29\xcd\xf4\x81\x69\xc0\x48\x94\x2c\xb9\xe0\xcb\x7c\x49\xe4\x8c\x8c\x0f\xc8\x52\x0a\xbd\x80\x55\x3a\x34\x6f\x8a\xd6\x8a\xec\xdd\x2c\x78\xbc\x80\x99\x70\x45\x52\x29\xe6\x2c\xeb\x47\xbd\x60\x73\xac\x32\xc3\x1c\x34\x77\x7c\xc0\xfd\x04\x9c\xbd\xf4\xbc\xb2\x9d\x1e\x98\x3d\x87\xed\x0c\x62\xb8\x00\x12\x62\x6e\xf7\xb2\xc4\x6e\x54\x03\xaa\xa5\x94\x55\xc6\x14\x13\xc8\xe6\xcd\x63\x2a\x88\x9c\xfe\x93\xc5\xda\xa0\x2d\x33\x1d\x89\x5a\xc8\x3c\x4d\xcc\xf2\x5f\xb3\x4c\x93\x8c\xc5\x72\x2e\xf8\x2f\x7e\x34\x65\xc8\xcb\x7c\x26\xa5\x9a\x29\x4d\xb8\xb0\xb4\x7d\x4d\xd3\x9c\x0d\x60\xbb\x19\x3a\xc9\x98\x19\x97\xe4\x22\x18\x01\x9a\xa8\x88\xfc\x60\xd6\x83\x8b\x59\xf9\x28\x71\xe7\x56\x2c\x97\xcb\x5c\x70\xbd\x1e\x01\x65\xf0\x69\xae\x65\xa6\x46\xc0\x9a\x47\x8a\xcf\x87\x34\x8b\x17\x5c\xb3\x58\xe7\x19\x33\xc7\xcd\x10\x80\x15\x40\xc8\xd1\x32\xf9\xcc\x6f\x95\x07\x15\xf4\xe9\xb5\x61\x75\x4a\x67\x5c\xcc\x4b\xaf\x80\x03\xb7\xe2\xda\xf0\x60\xb3\x8e\xd4\x76\xc7\xb9\x14\x28\xc5\xed\xc8\xc8\xeb\x67\x17\x97\xc5\x96\x04\xb4\x23\x86\x8b\xa6\xaa\x40\xb6\x41\x14\x17\x33\x96\x61\xcb\x59\x26\x97\x30\x0a\x13\xc9\x4a\x72\xa1\x2d\xd3\xe3\x86\xb1\xa8\x7c\xba\xe4\xda\xac\xe2\xbf\x72\xa6\xb4\x59\x87\x88\x9c\xc1\xb1\x6d\x36\x65\x6e\x98\x82\xa1\xff\xe7\x82\x9c\xd1\x25\x4b\xcf\xcc\x41\xf7\xb1\x51\x6d\x30\xaa\x86\x06\x7d\xdd\x91\x1d\x4a\x1d\x9b\x1d\x10\x5b\xa5\x57\xee\x44\x6d\x5c\x1d\xd3\xc0\x2c\x0e\x70\xd3\x15\x8b\xf9\x8c\xc7\x9e\xc6\xcd\xc3\x84\x29\x9e\xb1\x84\x4c\xd9\x82\x5e\x73\x99\xb9\xe7\x1b\xec\x3e\xaa\x7c\xa6\x69\x8b\x9a\x1f\x5c\x97\xcb\x17\x17\x9b\xaf\x2a\xf0\xf9\x96\x0e\x3a\xa6\x88\x62\xda\x10\x0d\xb2\x32\xbb\xa6\x86\x88\xcc\xfe\xb9\x66\x19\x9f\xad\xcd\x5f\x76\xed\x63\x03\x03\x4c\x8a\xa9\x01\x01\xc6\x02\x67\xde\x14\x99\x9e\xd9\x92\x4c\x00\xef\x5a\xe6\x3a\xa7\x29\x31\xdf\x32\x03\xb3\x64\xce\x86\x9a\x65\x4b\x2e\xe0\x48\x32\xa3\x67\x8c\x89\x38\x5b\xaf\x34\xc9\xcc\xc9\xa2\xaa\x73\xde\x36\x6f\xf3\x03\xe7\x1a\x4b\x2e\x72\x58\xad\x73\xaa\x0d\x13\x68\x68\x5b\x41\x46\x7d\xd7\x00\x33\x14\x84\x1e\xb3\x44\x19\x03\xc1\xc5\x9c\x89\x66\x99\xe0\xa0\x82\x43\xd2\x32\xa9\xa9\x39\xf4\x74\xbc\x30\x13\x9b\x53\x2e\x14\x6e\x98\x84\x03\x2a\x73\xae\xcc\x1b\x23\xbc\x10\x69\xd8\xf9\x35\x4d\x79\x52\x83\x52\x83\xbe\x19\x4f\x35\xf3\x0b\xa1\x22\x02\x22\x46\x1d\x00\xcb\x5c\x69\x83\x75\x72\x7e\xf6\xfa\x19\x51\x6b\xa1\xe9\x6d\x44\xc8\x73\xcb\x64\x01\x76\xae\x08\x5b\xae\xf4\x7a\x40\x84\x1b\xda\xac\x26\x57\x64\xc5\x32\x73\x5e\x99\x8d\x8a\x5d\x98\xef\x21\xa4\xb0\x9d\x34\x48\x23\xda\x9c\xe6\x06\x11\x82\x91\x15\xa2\x09\x3f\x0e\x73\x26\xb4\x66\x26\x0f\x54\xed\xe4\x33\xc2\x52\xc5\xe0\x63\xb5\xc7\xbb\x59\x08\xdc\x3d\x21\x56\x0c\xad\x24\x4c\x70\x7b\xac\x14\xd2\x42\x1d\xc1\x98\x1f\xae\xd9\xb2\x91\x06\x5a\x18\x42\xb5\x09\xcd\x32\xba\x6e\x68\x71\x3b\x2c\xc4\x8c\xa1\xc1\xdc\xd0\xf6\xd1\x72\xc9\xe3\xda\x4e\x88\xa6\xb3\xd3\x4e\xd4\xe9\x1a\x97\xe8\x31\x96\x62\xc6\xe7\x4b\xba\x02\xb4\x51\x2e\x1c\xb7\x3f\x7f\xf6\xc3\x90\x89\x58\x26\x2c\x21\x67\xa7\x25\xec\x4d\x73\x91\xa4\xac\x4a\xae\x6e\xb7\xe2\x0e\xf7\x6b\xf8\x40\x85\x7d\x2d\xf1\xd1\xc4\xc8\x1a\x4a\x83\xfa\x80\x2b\x8f\x92\x25\x12\x5a\x01\x14\x17\x00\x8c\x57\x30\x86\xf8\x0a\x55\x0c\x23\xd3\x37\x2d\xd8\xb6\x5d\x6e\x7e\x40\xf6\x6f\x7c\x5b\xc1\x1e\x90\x9b\x65\xc4\x8e\xcd\x47\x48\x83\x48\xeb\x19\x9b\xb1\x8c\x89\x98\x25\x16\x7e\xb2\xa4\xab\x96\xd1\x3b\xd0\x8c\xd9\xb2\x86\xb9\x37\x03\x89\x0a\x4c\x2b\xc9\xd5\x9c\x3a\xee\xc7\x52\x44\xb1\x3c\xe7\x32\xe5\xf1\xba\x13\x35\xf5\x1a\x3a\x07\xd4\x75\xb3\x60\x7a\xc1\xb2\xe6\xcd\x09\xb3\x53\x16\x0c\x90\xbe\x8c\x4e\xce\x93\xd2\x66\x45\x86\x65\x44\x08\x10\x7a\x69\x0c\x62\x37\x8a\x83\x20\x74\x91\x49\xef\xb5\x45\xd4\xa4\x67\x38\xc2\xa4\xf7\x0a\x80\xa4\xe9\xa4\x07\x32\xf1\x4b\xa9\x2d\xb9\x36\x80\x22\x45\xba\x26\xf1\x82\xc5\x57\xaa\xee\x5c\xea\x70\xde\x98\x53\x09\xcf\x9c\x3f\x11\xae\xcd\x09\x66\x84\x17\x18\x72\x73\xa8\x38\x65\x34\xd3\xec\x56\x93\xef\x2f\x2f\xcf\x0d\xc8\x2b\xaa\x94\x5e\x64\x32\x9f\x2f\x82\xa1\x4a\x22\x75\xf8\xc3\x44\xbe\x6c\x23\x8a\x5e\x53\x47\xf3\xd2\x21\xab\xa5\x89\xc3\x5f\x2b\x61\x35\x92\x6e\x3b\xd9\x0e\x3d\x23\x6a\x7f\x5d\x25\xac\x9a\xd6\xad\x04\x6e\x15\xaa\x60\x9c\xad\xc2\x4c\x6f\xb3\x0f\x0a\xc6\x7e\x6f\x83\xe2\x4b\x14\x8b\x33\xa6\xab\x1c\xd3\x76\x2e\xb1\x4a\xa3\x1c\x1a\xe1\x6c\xdd\x40\x79\x11\xaa\xe7\xa0\x07\x2b\x92\x48\xf1\x40\xdb\x0d\x04\x3d\x78\x46\xe4\x8d\x08\x47\x1c\xd4\xcc\xcb\xc0\x88\x0a\xe2\x44\x00\x7b\xb5\xf0\x21\x5f\x45\x20\xe1\xfb\x33\x69\x04\x14\x03\xef\x15\x5b\x2b\x3c\x05\x8d\xb4\x6a\xfa\xe9\x54\x45\x71\xa6\x4f\x4a\xe0\xcf\x78\x8a\xc6\x08\xdc\x9f\xa9\x8a\xae\xd8\xfa\xc4\xf4\xae\xbc\x9a\x08\x73\xe4\xe7\x42\x31\x3d\x20\x94\xdc\xf0\x34\x89\x69\x96\x94\x06\x33\x98\xcc\xb5\x5c\x52\xcd\x63\x9a\xa6\x6b\x32\x67\x82\x65\x7e\x2f\xe1\x0c\x2e\x2b\x27\x35\x57\x56\xb6\x71\x6a\x71\x9d\x89\x42\x2e\xcd\x0c\xf7\xcc\x28\x2a\x9f\xe2\x9f\xaa\x0f\xa3\x9a\x2e\xc5\x77\xca\xf2\xc4\xd9\xa9\x01\x34\x35\x87\x57\x19\x30\xa3\xf8\xcd\xb1\x87\x51\x98\x43\xfb\xcc\x03\x45\x74\x66\xf0\xaa\xb4\xcc\x58\x64\x27\xde\x3c\x63\x38\x15\x01\xb0\x05\xcd\x90\x12\x96\x79\xaa\xf9\x2a\x65\xb0\xf9\x47\x07\x56\xbc\x4d\xec\x9e\xb7\xca\x35\xe1\xcb\x55\x6a\x98\xe8\xe9\x8b\xf3\x97\x7d\x14\x9b\x1c\x9f\xdc\xe3\x11\x8b\x8c\xfc\x65\x74\xfa\x35\x99\x66\xf2\x46\xb1\xcc\x4c\x38\x63\x20\x5b\xf1\x29\xcb\xf4\x1a\x4d\x31\x46\x9c\x33\xc7\x67\x68\x12\x89\x90\xa1\x2e\x19\x15\x2a\x10\xb6\xa8\xb0\xed\x69\xd0\x18\x28\x5e\x48\x60\xe4\x99\xb7\x3a\x01\x33\x05\x91\xf0\x8a\xa5\xf0\xa5\x19\xe5\xa9\x1d\xd7\xaa\x22\x39\x58\x07\x10\xf9\x06\xab\x57\xc2\xd0\x32\x55\xe1\xe0\xb1\xa4\x29\x53\x31\x17\x73\x4f\xbc\x5c\x0c\x0d\x0c\x21\x1e\xf7\xdc\x49\x52\xac\xa4\xcc\x0c\x6a\xb3\xa1\x3b\x6d\x92\x7e\xc7\xb5\xf4\x36\xb4\x07\x8a\x4c\x73\x9e\xea\x21\x17\xe4\xd5\x69\xae\x17\xb8\x59\xb3\x5a\xa6\xbb\x4d\x9c\x68\x13\x25\xca\xda\xf6\xcb\x4d\x91\x41\xd7\xea\xb2\x6d\x36\x27\x79\xcd\xb2\x6b\xce\x6e\x46\xd6\xe0\x34\x34\x53\x1b\x22\x23\x54\x23\x10\x8e\x46\x9f\xc1\x3f\xe4\xf2\xd5\xd3\x57\x27\xe4\x34\x49\x08\xae\x61\xae\xd8\x2c\x4f\xf1\x30\x55\x51\x60\x90\x19\x80\xa9\x60\x40\x72\x9e\x7c\x53\x55\x78\xcb\x0c\xb7\x91\xf1\x6f\x11\x38\x4a\x02\xee\x92\xae\xda\xe5\x5b\xdc\xc6\x1d\x78\x36\xee\x7e\xe0\xd3\x4f\x5f\x5e\xa0\xa0\x66\x8d\x72\x2d\x9c\x17\xc8\xd8\x6d\x50\x2d\xad\xcc\x96\x67\xac\xd8\x9f\x33\x46\x75\x9e\x31\x05\xfc\xf1\x21\xf9\xd6\x72\xa0\x17\x92\x26\x4f\x68\x4a\x45\xcc\xb2\x0b\x6b\x99\xf5\x56\x0d\x30\x47\xaa\x85\xe1\xb1\x20\xde\xb2\xf9\x7a\x40\x0a\x10\x37\xbf\x66\x40\xce\x58\x2c\xb3\x44\x59\x03\x9f\x1d\xea\xdc\x8f\x74\x61\x07\x8a\x10\x0e\x38\x31\x72\x05\x1a\x75\xb0\x23\x6a\x8e\x9f\xc1\x86\xe2\xe3\xf6\x48\xc1\x53\x2d\x6c\x80\x0d\xad\x02\xfe\x89\xd0\x6c\x9e\x36\x16\x8a\x4b\x27\x7f\x81\xea\x87\xb0\xe2\xdc\xb8\x48\xf8\x35\x4f\x8c\xae\x8e\xf6\x5d\xa5\xa9\xce\x15\x53\x44\x49\x6b\x08\x16\x89\xd9\xe3\x99\x02\x9e\x60\xe4\xc4\x0c\x4e\x56\x4d\xb3\x39\xd3\x85\x4d\xb9\x84\x9b\x89\x70\xb0\xc2\xb9\x66\x34\x0e\xc1\xff\x95\x33\x42\x97\xd2\xa0\x22\x4d\x37\x6d\x1e\x0a\x8d\x76\xf1\xa6\x29\x09\xf9\xb6\x55\x4a\xbd\xed\x15\xa0\x87\x31\x22\x5c\xa1\xf2\x75\x8a\x3d\x03\x48\x64\xb8\x4e\x84\xd0\xd4\xb2\x8b\xd6\x7d\xd2\xbc\xc0\xdb\x89\xbd\xb9\x6f\x48\x5d\x76\x39\x9a\x28\xdf\x0d\x02\xf3\x45\xae\x60\x6d\xd7\x6a\xe0\x8c\x2d\xa9\xa4\x09\x99\x5a\x2a\xf7\x4c\xd4\x1c\x1e\x03\xc2\x74\x1c\x95\x4f\xfc\x50\xfc\x31\x07\x00\x35\x80\x48\x61\x38\x5a\x46\x95\xce\x72\xb0\xb2\x6d\xc1\x29\x50\x49\xe4\xcc\xde\xb0\xe9\x4e\x7f\xba\x38\x29\x50\x51\xb7\xf1\xf6\x80\xa9\x3f\x73\x14\x03\x57\x3e\x7d\x72\xfa\x4b\x9e\xb1\x93\xdd\xfb\x7d\x77\x76\x7e\xa7\xef\x3d\x7f\xf2\xc3\x59\x2a\xf3\xe4\x64\x57\x38\x53\x3e\xa5\x53\x6a\xfb\x76\xef\xf7\x82\x4f\xaf\xb9\x11\xd6\xe0\xe7\x7b\xa9\xf4\x4b\x7b\xf1\x61\x90\x26\xd6\x76\x55\xfd\x15\x02\xd8\x32\xc9\x1e\xde\x15\x1a\xc6\xf1\x52\x0a\xd6\xf7\x4b\xa6\x65\x38\x06\xac\x6d\x0b\xa1\x6d\xee\xa6\xbb\x9c\x98\x8b\xe2\x83\x9d\x0e\xce\xa0\x3d\x59\xc8\x34\x51\x64\x45\x33\xba\x64\xda\xb0\x11\x27\x1c\x86\x98\x68\x63\xc9\x11\x39\x47\x83\x35\x6a\x7e\x7c\x06\x18\x32\xa4\x1b\xe2\xe1\x03\x4c\x0b\xe6\x10\x3f\x97\x99\x6e\x37\x2f\x00\xf6\x4f\xc8\xe3\xfd\xae\x46\x08\x37\xac\x33\x44\xac\xcc\xef\x12\x85\x7b\x83\x20\x6b\x3f\xdd\x34\xca\xa4\x5c\x69\x86\x17\x66\xa0\x72\x16\xc6\xc0\x40\xb5\x2e\xba\x29\x66\x86\x62\x02\x3f\xf0\x78\x1f\x4e\xd7\x34\x63\x34\x31\x02\x95\x19\x35\x0a\x0e\x00\xdb\x0f\x14\x5e\xc9\x45\x6c\x14\x78\x2f\x37\xbf\x94\x09\x03\x90\x33\x2a\xe6\x5e\xf2\xf1\xb7\x9d\x70\x98\xe9\xf0\x2c\xd9\x37\xb2\x1d\xd8\x0c\xb5\xb7\x25\x98\xb3\xa9\xc4\xa5\x1f\xef\x37\xad\x8e\xf9\x31\x34\x4f\xf5\x89\x61\x5b\x87\x07\x2d\xed\x96\xf4\x96\x2f\xf3\xe5\x09\x79\x74\x7c\x7c\x78\xdc\xd6\x10\x6f\xc6\x4e\x48\xdb\x4a\x21\xd3\x07\x56\x69\x6f\xb8\xeb\x7e\x40\xbc\xeb\x4c\x1a\x47\x47\x87\xbb\xd0\x86\xba\x0f\xe2\xb8\xd8\x8d\x3a\x8e\x8e\x0e\x7f\x77\xe4\x71\x74\x74\xf8\xef\x4a\x1f\xab\x4c\x6a\x19\xcb\xb4\xb3\x61\xb2\xe7\x7a\x34\x58\xde\x36\xef\xfd\xd9\xed\x0a\xac\xe2\x5c\xc4\x72\x09\x57\x31\xc1\x6d\xb9\x96\x70\x03\xb0\x4a\x8d\xa4\x75\x79\x06\xd6\xa9\x6e\xc3\x9d\xbf\x7e\xf5\xb7\xbf\x7b\xf8\xe1\x14\x29\x3f\x2a\xdd\xeb\x00\x05\x94\xa4\x0c\x77\x01\x92\xaf\x80\xae\xb8\x46\x41\x19\xae\xf1\xf0\x2e\x63\xc1\x88\xbd\x7d\xa4\x49\x62\xa0\x60\xca\x50\x8c\xd3\x5f\x83\x59\x00\x79\xce\x64\x76\x43\xb3\x04\xed\x33\x52\xb1\xea\x3c\xeb\xaf\xc9\x08\xf9\x2b\xc8\xd6\x15\xd8\x51\x2e\x52\x0d\x38\x00\x55\x1b\xc0\xc6\x2f\x6d\x80\xc9\x85\xd2\x8c\x26\x78\xf5\x63\x1a\x3a\xab\x51\x09\x05\x0f\x94\xeb\x62\xf6\x11\x70\xea\x05\xa3\x89\xc1\x8d\x91\x63\x53\x39\x57\x11\x09\xcc\x99\x00\x54\x0d\xb0\x76\xd7\x6f\xc2\x09\xf2\x7f\x4c\xf3\x4d\x64\x18\xfd\xdd\x1c\x84\x6b\x99\x83\x1d\xc1\xec\x2f\xa7\x64\x94\x85\x41\xf8\x32\xc8\xf3\x95\xaf\x9a\x51\x10\xe5\x5d\x31\x6d\x34\x0c\xc3\xb7\x4e\x46\xa3\x9b\x9b\x9b\x68\x41\x57\x99\xbc\x5d\x47\x32\x9b\x8f\x12\x79\x23\xcc\x77\x47\x07\xd1\x81\xd1\x7e\x47\xf0\x6a\xe8\xc9\x4b\xdf\x6a\xe0\x58\x46\x69\x36\xbb\x99\x4b\x41\xe8\x54\xe6\xba\x8e\x0e\x2f\x4b\xc6\x2f\x6b\x36\x36\xb3\x0c\x6d\x4b\x8e\xd3\x9d\x14\x0a\x0e\xe8\x07\x56\x8e\x8f\xc8\x43\x32\xe9\x5d\x9e\x9d\x4f\x7a\xf8\x2b\x7c\xc7\x5a\x98\xab\x8d\x83\xbd\x18\xc8\xc6\xee\x7e\x93\x2b\xd8\x59\x66\x0b\xd4\x01\x5c\xb1\x57\x07\x82\xb5\xc2\x9b\x45\xd8\x18\x0b\xc3\x30\x1b\x2d\xc4\x64\xab\x95\x98\x6c\xb3\x14\x63\x83\xcb\xb3\xf3\x2d\x2d\x00\xfc\x0f\xbb\xe8\x20\xa0\x23\x76\x3f\x16\xc7\x5f\x1d\x3e\xea\xca\x1f\xfd\xc8\x2d\xe7\x22\xe8\x9f\x0b\xd4\x54\x03\x5f\x05\xb0\x77\x65\x40\x28\x5e\xc3\xbd\xaf\x93\xed\x39\xb8\xa8\x78\x55\xb7\xa2\x6f\xa9\xc2\x42\x90\xb4\xee\x2b\xae\x36\x37\xd6\x60\x93\xb1\x38\x50\x73\x65\xaf\xd8\x00\x09\x86\xee\x17\x8c\xa6\x7a\x61\x2f\x3f\x70\x72\xe5\x9e\xe8\x80\x26\x12\x64\x47\xab\x4c\x4e\x0d\xd7\x15\xc1\x30\x70\x03\x3d\xe7\xd7\x4c\x10\x21\x13\x36\x28\x70\xb0\xa2\x7a\x41\x46\xf8\x89\x5f\x46\x28\x20\x68\x49\x12\x86\xf7\x28\x0c\x24\xef\x7a\x25\x15\x9c\x7a\x6c\xfb\x8c\xc5\x8c\x5f\x33\xef\x63\x66\x17\xcf\x7c\x2c\x02\xb3\x0c\x8a\xe4\xd6\x21\x91\xdb\xd7\xe5\x69\x80\xd1\xc0\xac\x94\x43\x65\xe0\xb0\x46\x61\x28\x92\x0b\xcd\x53\x24\x8b\x00\x29\x96\x5f\x5b\x70\xea\x30\x64\x71\x4b\x53\x25\x89\xd2\x72\x15\x1e\x3c\xde\xa5\x25\xf0\xbc\xba\x75\x5e\x56\x47\xc7\x44\xb1\x58\x8a\x44\x11\x3a\x33\x94\x56\x41\x95\xd2\x14\x3f\xec\x0e\x0c\x21\xf5\xd0\x82\x71\x9e\xc9\xa9\x79\xc4\xae\x59\xb6\x26\xc7\x66\x1a\xe3\x7d\x37\x9c\x5d\x02\x4a\x8e\x87\xf8\x84\x68\xbe\x64\x86\xd7\x98\x63\xc4\xbe\xd3\x8b\x8c\x29\xa3\x4a\x01\x6d\xde\x48\xa2\xf2\x38\x66\x4a\xcd\xf2\xd4\x1c\xfa\xe6\x40\x60\x49\xe8\x93\x43\xa6\x2c\x96\x4b\x87\x9d\x35\xd8\x60\x85\xfb\x23\x63\x86\xe5\x69\x7e\xcd\xd2\xf5\x00\xb6\xcc\x0d\x4b\xd3\xa1\x66\x4a\x07\xee\x51\x77\x94\xe7\xcc\x7e\xff\xf7\x14\xe8\xb6\xde\xc1\xa6\x81\xd2\xdf\x49\x11\x0e\x3b\x34\x6b\xc2\x25\x0a\x6d\x56\x77\x6b\x4c\x0e\x1f\xa2\xf6\x3a\x07\x18\x90\x7e\x5e\x1b\xbe\xd7\xd2\xb8\x2a\xc7\xd6\x74\x0e\x5d\x15\x04\x8e\xee\x1c\x68\x9e\x9f\x7b\x51\x29\xc3\xb6\x5a\xda\xe3\x95\x02\x19\x7b\x67\xbe\xba\xbd\x3a\x05\xaf\x53\x9d\xf1\x18\xac\x81\xe4\x19\x8d\x17\x96\x51\x3b\xeb\x62\x41\x8c\x28\x06\x9d\x3d\x7f\xfa\x9a\x38\x47\x72\xb2\xc7\xa2\x79\x44\x26\xbd\xf1\x7e\x04\xff\x8d\x1e\xbb\xbb\xe7\x59\xb2\xbf\x7f\x72\x62\xfe\xee\x03\x97\x17\xd2\x0e\xcc\x8b\xe9\x24\x03\x32\xe9\xb9\x9e\xfb\x93\x1e\x2c\xdb\xf3\xf3\xeb\x23\xd8\x9f\x93\xde\xc9\x49\xf8\xf4\x91\x77\x01\x25\xd3\x75\x55\x96\x00\xa4\x28\x30\x7f\x56\x85\x4e\x94\x4c\x24\xf8\xc6\xa6\x5c\x1b\x11\x7a\xc9\xad\x19\x0f\x0f\x39\x46\xb3\x94\xb3\xcc\x7b\x45\x1b\xc4\x16\x4e\xc6\x20\x81\x24\x1c\x0f\xb8\x05\xbd\xc6\x33\xb2\x6e\x99\x40\x6e\x1a\x80\xec\xb8\xa4\x6b\x50\x10\x03\xf7\xdf\x68\xca\x34\x8d\xca\xf7\x19\x66\x55\x86\x6e\x55\x86\x08\xf9\xd0\x2e\x64\xe1\xaf\xef\x38\xfd\xa4\x87\x47\xf1\xf0\xcf\x1b\x7e\xde\x60\xef\xff\xcb\xa4\xe7\x9d\xa4\xb9\xeb\x52\x38\x8d\xd8\x4e\x93\x5e\xe1\x37\x32\xb0\xd7\x58\xbc\xf4\x39\x10\x8f\x35\xbd\x62\x84\xcd\x66\x46\xd0\xe2\xb3\xda\xf9\x3a\x27\x28\x03\x5f\x81\xaf\xa3\x68\x7c\xd0\x2a\x90\x6d\xf1\x22\xda\xd8\x12\x40\x72\x00\xe1\x06\xb9\x9b\x69\x56\x28\x12\x9c\x14\x6e\xe9\x72\x95\xb2\x01\x09\x08\x13\xe8\xd2\x93\x65\xaf\xdf\xc6\x48\x89\x73\xc7\x3a\x21\x7b\x3f\xef\xed\xbd\xd9\x1f\x7e\xf5\xf6\x3d\xfc\x1f\x7f\x1d\x07\xbf\x1f\xbc\xd9\x1f\x1e\xb9\xdf\x8f\xdf\xec\x0f\x8f\xdf\xf6\x27\x51\xff\xd7\xc3\xdf\x76\xef\x37\x72\x5d\xc6\x07\xf6\xcd\xe1\x9b\xfd\xe1\xc1\xdb\xfe\x1f\xfb\xef\xf7\x7e\x56\x0f\xf7\x10\x96\xd3\xe1\xb7\x74\x38\x7b\xfb\xeb\x78\x70\xf4\xdb\x49\xff\xd7\x2f\x7f\xdb\x78\xfa\xfe\xa4\xdf\x7f\x5f\xdb\xf8\xd1\x6f\x7b\x27\x1b\xad\xf7\xf6\x2c\x04\x16\xaa\xe4\xfd\x38\x49\xde\xbf\x19\x0f\xbf\x7a\xfb\x4d\xd2\xdf\x8b\x5a\x5f\x9b\xa9\xf6\x9b\x3f\x78\xfc\xdb\xde\xde\xe6\x27\xfb\xbf\x8e\x07\x07\xa6\xdb\xc7\xfc\xf4\x51\xe3\xa7\x4d\xb7\xba\x57\xdf\xdc\x03\x3c\x2d\x00\x1d\x36\x02\x74\xd4\x00\xd0\xaf\xfb\x83\x83\xdf\x3e\x2e\x50\x07\x8d\x40\x1d\x37\x03\x75\xf8\x91\x81\x1a\x37\x02\xf5\xa8\x19\xa8\xa3\x7b\x04\xea\xa4\xe9\xfb\x5f\x36\x7f\xff\xf8\xde\xbe\xdf\xdf\xfb\x3c\xfa\xa2\xff\x8d\x7a\xb8\x37\x19\xed\x8d\x4d\xdb\xc7\xc8\x3d\xc6\x6f\x1d\x87\xf0\x8c\x04\xfe\xdf\xef\xff\xb1\xdf\xca\xd0\x3a\xa9\x9f\x84\x88\x3c\x4d\xe9\x34\x65\x27\x44\x67\x79\x93\x47\x1d\xe9\xe4\xc8\x49\x48\x22\xd4\x0f\x10\xf2\xb3\x64\x42\xb7\x3b\xd5\x91\x50\xaf\xc5\x4e\xcd\x9e\x59\x55\x67\x82\x9a\xef\xc0\xf5\x2b\xba\x9a\x71\xe7\x84\x3b\x63\xf1\x3a\x4e\xbd\x0e\xea\x7d\x55\x8a\xfb\x55\x42\x95\x92\x31\x2f\xbb\xbc\x54\x24\x27\x7b\xbe\xba\x2b\x64\x17\xd3\x54\xb9\x61\xf7\x41\x61\xe4\x79\x59\x86\xb7\x73\x8b\xc8\x8f\x60\x73\x29\x8c\x30\x7e\xda\x70\x24\xff\x55\xd8\x81\xa3\x26\x27\x04\xd2\xd1\xb4\xb1\x1d\x99\xa6\x95\xff\xde\x87\x5a\x30\xac\xeb\x62\x76\xee\x85\xf1\x9d\x4c\xb8\x95\xbe\x56\xb2\x77\xde\xfd\xd5\xeb\xd8\xc2\xe6\xe5\x82\x02\x9c\xb8\x9b\x8b\x84\x65\x29\x78\xd8\x97\x6f\x5f\x3d\x80\x4d\x97\xdf\xde\x7f\x66\xb5\x4a\xb9\x0b\x37\xf2\xc3\xd7\x80\x88\x3e\x24\xe8\x45\x80\x11\x48\xd6\x02\x87\x9e\x6b\x6e\xe0\x56\x91\xa8\x8b\x56\x61\x7e\xe8\xcd\x96\x06\x1b\xda\xc4\x8d\x72\x30\x17\xc6\x14\x8b\x32\x17\x9f\x00\x02\xae\x91\xad\x43\x24\x9e\xfe\x74\x51\xb1\x4b\xdf\x0d\x61\x06\x80\xfb\xc0\xd0\x2e\x58\x22\xe0\xd4\x4b\x95\xe2\xf1\x8b\x0e\x7a\x65\x23\xf6\x6a\xc6\xd8\x20\xcd\x32\x4e\x2b\x2a\x28\x85\xbb\x7b\x37\x4e\x57\x6d\xf4\x0c\x9b\xb7\x0b\xa8\xbb\x63\x04\xb0\xe2\x0d\x67\xcf\x93\x94\x5d\xa2\x35\xa4\x5b\xd7\x2a\x6e\xea\x46\xaa\xd8\x7b\x9d\x8d\x47\xf3\x25\x23\x2b\x96\x71\x69\x5d\xe6\x4a\x9e\x75\x36\xe6\x90\x27\x29\x23\x53\x36\x93\xd6\x08\x59\x31\xbf\xa5\x52\x6d\x86\x29\x90\xc0\x0a\xe9\x14\xd6\x15\xcd\x14\x03\xe7\x0d\x88\x78\x84\x6f\x27\x6e\x7d\xa0\xe9\x9f\x88\x62\x8c\xfc\xd9\x79\x98\xad\xae\xe6\xd1\x5c\x46\x09\xbb\x1e\x99\xc6\x9f\x9d\x9b\x01\x9e\xda\x1e\x7f\x89\x08\x39\x25\x82\x83\x2d\xe8\x17\x96\x49\xf7\x35\x70\x1a\x14\x92\xc8\x15\x17\xe0\x36\xc6\x85\x0f\xdb\x01\xdf\x41\x67\xaf\xf6\x26\x1e\xf4\xec\x04\x88\xcb\xef\xca\x76\x77\xd3\xf4\xd1\xbe\x77\xf7\x6e\x35\x7b\x77\x5c\x38\x67\x17\x72\x68\xe8\xd8\xad\xa3\xc8\x50\x6e\xde\x62\xe3\x09\x7f\xac\xa7\xcd\x07\x6d\xcf\x9a\x31\xee\xb4\x3d\x5d\xb4\x6c\xc7\xed\xf9\xf2\xc5\x93\x2e\x88\xdf\x09\x1d\xd0\x78\xd7\xf9\xf7\x1c\x44\x66\x57\xc0\xef\x72\xb6\xc9\xb7\xd1\x9b\x4b\x69\x2a\x34\x07\x67\x66\x9c\xf8\x86\x05\x01\xd8\xfb\xa6\x50\x02\x97\x40\x93\x9e\xe5\x49\x93\xde\x09\x39\x75\x0c\x0a\xcc\x66\xe4\x49\xe9\x42\x6c\x49\xaf\x98\x82\x1b\x03\x73\xf4\x26\x2c\xe6\x68\x51\xa1\x9a\x30\xee\x6f\x4f\x75\x46\x85\x02\xa3\x79\x4a\xd7\x2c\x23\x7b\x97\x67\xe7\xa3\x8b\x8b\x17\x7d\x62\xed\x76\x70\x96\xd8\x08\x3f\xdb\x04\xdc\x84\xe1\x62\xbe\x8f\x47\x4c\xd9\x95\x1b\xa6\x95\x24\x1c\x3d\xf6\xdd\x39\x73\x12\x86\x2f\x27\x32\x56\x11\xbd\x51\x11\x5d\xd2\x5f\xa4\x80\xac\x06\xa7\xf0\xeb\xb3\xb3\x8b\x11\x46\xbb\x8e\x7c\x7e\x80\x79\xce\x13\x56\x31\xd0\x80\x03\x51\xb4\xd0\xcb\xf4\xb3\x38\x9d\x3a\xdc\xbc\x7c\xf1\x04\xf1\xe2\x7c\x6e\x76\xc3\x4b\x2b\x42\x7e\x0f\x53\x15\xe9\x74\xdb\xc9\x4c\x3a\x09\xa3\xee\x67\xe8\x28\xa8\x63\xeb\x97\x2f\x9e\x74\xde\x70\x9d\xd8\xd5\xf6\x88\xa2\xe2\xe3\x66\xd8\x2d\xcd\x3a\x6f\xf5\x79\xbc\xda\x4d\x7c\x9b\xc7\xab\xbb\x89\x6f\xdf\x9d\x9d\xdf\x8b\xf8\x66\x00\xf8\xaf\x11\xdf\x38\x13\xfa\x14\x4c\xd8\xbb\x33\xc6\xb0\x77\x90\x81\x62\x21\x6f\x9c\x97\x84\x35\x8e\x63\x76\x05\x6b\x00\xb7\x17\xe9\x75\x57\x91\xaa\x81\x3b\x9a\xed\xff\x5d\x2a\xa7\x34\x35\x1c\xe0\x02\x43\x56\x30\xc8\xb7\x61\x24\xd4\x2b\xb1\x4f\x05\x18\x6b\xc3\x76\xf1\x0d\x60\x9a\xa6\x62\x4d\x32\x36\x47\xab\x2c\x5c\x9d\x19\x5e\xf0\xe3\xf9\x59\xd5\x2b\xa4\x5e\x5d\x2d\xa5\x6f\x80\x2c\x2c\xd1\x5c\xca\x79\xca\x80\x23\x04\x7e\xe8\x4c\xcc\xb9\x60\xe8\x5f\xbf\x90\x37\x43\x2d\x47\x0e\xfe\x61\xc0\x18\xb8\x98\x7f\x36\x07\xd8\xdf\x59\xa0\x2d\x0f\x7c\x21\xe3\x5d\x71\x00\x5d\x2a\x28\x40\xb1\x0a\xe3\xd0\x2c\x1a\x82\x69\x2b\xba\x64\x0e\x1b\x10\xe6\xf2\xe3\xf9\x59\xdf\x88\x79\xe6\xe5\x06\xc5\xc3\x95\x6d\x17\x14\x85\x7e\x06\x5c\xb9\x03\xd5\x09\x5e\x2e\xa2\xbc\x1d\x95\x65\x1c\x21\x1e\xdd\xe4\x3f\xc3\xa9\x58\x8c\xdd\x37\x2b\x45\x52\xea\xd8\x18\x70\x7e\xbf\xbc\xb4\x33\xf3\xeb\x22\xe3\x94\xb6\x71\x28\xde\x6c\xd7\xe7\x9b\xee\xfc\x4e\xf1\xe2\x22\x74\x77\xe9\x9d\xfe\x74\xd1\x1b\x90\x1e\xf8\x47\x9b\x5f\x9e\xd0\x8c\xfd\xc0\x34\x4d\xcd\x1f\xdf\x9d\x9d\x9b\x7f\x5e\xe6\x9a\x0a\x7e\x6b\x7e\x85\xbb\x0d\x4d\xe3\xab\x1e\xde\x97\xf4\x7e\xbc\x58\x2d\x58\xc6\x7a\xdb\xe4\xc0\x6e\xeb\x38\x34\x42\x5b\x97\x56\x06\xdc\x0e\xed\xfc\x6c\x3a\xb4\xfd\x6e\x8b\x5f\x0b\xb6\xb2\xb8\xe8\xd0\xd2\xa3\xaa\x43\x5b\x8b\xc5\x0e\x2d\x9f\x3f\xf9\xa1\x13\x15\x6e\x25\xd8\x6e\x07\xff\xd6\x43\xbf\x13\xcd\x63\xde\xa5\xce\xfe\x39\xa6\x75\x60\xb5\x04\x7e\x07\xcf\xa8\x73\x59\xdd\xd4\x8a\xb9\xb2\xd9\x84\x92\x88\x9c\xdb\x64\x3a\x25\x42\x77\x1e\xf3\x3d\xa4\xdb\xe7\x96\x23\xb5\x12\x6e\x17\xa3\xa2\x1b\x68\x4b\x33\xf7\xf9\x0f\x33\x2a\x76\x89\xff\xae\x31\x01\xb7\xb4\x06\xc4\xde\xd5\x55\x41\x58\x8f\xa6\x4e\x6e\x0a\xae\x71\xb3\x8b\x82\x73\x90\xea\x12\x43\xd5\xa2\x94\x96\x47\xf9\x10\xef\x85\x4f\xae\xb7\x9f\x5c\x6f\x3f\xb9\xde\x7e\x72\xbd\xfd\x6f\xe5\x7a\xbb\x95\xeb\xaf\x32\x7e\xdd\x90\x3c\x81\x54\x19\xa2\x6d\xdb\xcc\xf3\xcf\x6d\x83\xbb\xf1\x7a\xdb\xfb\x13\x8f\xff\xc4\xe3\x3f\xf1\xf8\x4f\x3c\xfe\x13\x8f\x27\xf7\xc4\xe3\xdb\x2c\x14\xcd\x37\x2f\x35\xbc\xdb\xf2\xb7\x3a\x27\x0f\x58\xf5\xba\x10\x67\xc3\xc8\x6c\x74\x85\x6a\x8a\x06\x70\x5b\x25\xc8\x76\x1b\x0e\x45\x9c\xf4\x0f\xa6\x5e\x11\x66\x11\xb3\x56\xe3\x41\x63\xf2\x98\x22\xbf\x2d\x6c\x3a\x9b\x2f\xa7\x88\x89\x07\xda\x3c\xad\xfd\x5c\x25\x23\x6e\x18\x76\x5f\x0c\x0b\x30\x5d\x30\xd6\x2d\xb3\x47\x5d\x36\x59\xfb\x6c\xf4\x99\xd9\xb0\x9e\x4f\xa0\x51\xbb\x48\x2d\x51\x4d\xbd\x13\x38\xf7\x54\x5d\x76\x0c\xac\x70\xfa\xda\x2d\x50\x37\xb7\x07\xaa\x08\x4e\x11\x74\xc9\xa2\x30\x1b\x03\x6c\x6e\x37\x1a\x9e\xd2\x02\xde\xff\x22\x05\x53\x36\x65\x2b\x7a\x2b\x8b\x2e\xa9\x14\x30\x89\xef\x3f\xa4\xc0\xec\x32\xf6\x21\x9e\xf6\xe6\x29\x26\x32\x0e\x67\xb6\xf4\x3a\x2f\x2c\x42\x9e\x65\x4c\xe8\x74\xed\x8e\x37\x07\x96\xe5\xd4\xa7\x3f\x5d\x0c\xd0\x8a\x84\x76\xac\xef\xce\xce\x7d\x70\xbc\xb2\x09\x2d\x2a\x51\xf4\x5b\x49\x52\x62\xb4\x0b\xc1\xe0\x90\x22\x86\xa8\x3e\x96\x05\xe9\xc1\x65\x9e\xf8\x20\x0a\x85\x98\xa5\x82\x3c\x06\x64\x2a\x73\x01\x8b\x1a\xc0\xf3\x78\x1f\x26\x7a\x74\x74\x88\x11\x2b\xb9\x72\x31\x35\x6a\x25\x6d\xca\x61\x48\x45\x66\x41\xb0\x66\xeb\x86\x78\xa4\xed\x39\x25\xae\x39\xf5\x31\x39\x08\x82\x45\xab\x93\xf8\x26\x82\x3c\x95\x0c\xe3\x3c\xda\xc7\xfa\x48\x9b\x78\xe0\x32\xbe\x18\x08\xd8\xed\x2a\xe5\x31\x37\x14\x53\x89\xec\x02\x44\xd9\x5c\x94\x22\x87\x14\x42\x1d\xc0\x7d\xb8\x61\xcd\xb8\x23\x57\xf3\x51\x63\x1f\x9f\xa3\x55\x3f\xd5\x91\x9b\x5d\x06\x99\x68\x43\x8a\x33\x2c\x21\x59\x0b\xba\xb4\x89\x97\x68\x9a\xca\x18\x06\x9b\xae\x0b\x27\xf9\x3f\x91\x85\xbc\x61\xd7\x10\xa4\x26\xbd\x30\xaa\x34\xd5\x3c\xc6\x68\x32\xdb\x0f\x53\x8d\xc0\x6a\xe0\x59\xec\x25\x1d\xff\x4d\xeb\x36\x62\xbd\x1a\x1d\x3b\xda\x98\x16\x70\x3f\x48\x50\x9c\x5d\x37\x24\xab\x20\x1d\x72\xea\xd5\x1c\x5d\x2d\xad\x03\x6e\xd2\xd2\xca\x6e\x8e\x96\x16\x15\xb2\x6a\x3d\xe0\xef\x9c\x90\xaf\xc1\xe8\xdb\x2a\x36\x98\xc3\xec\x4c\x2e\x5d\xf2\xd8\xad\xa9\x6b\x2a\xed\x7d\x5a\x6f\x4a\x56\xe8\xb7\xea\x73\x53\xb8\xc0\xbc\xb8\x68\x1d\x91\x27\x41\xe0\x8b\x06\x5e\x0b\x3b\x19\x7b\x84\x2d\x6b\xe0\xd8\xa6\x17\x2e\xf9\x92\x5d\xae\x57\xcd\x6a\x63\x59\x04\xf2\xcd\x31\xe7\x94\x0b\x48\xfa\xe1\xf9\x0f\xcf\x6c\xa2\x95\x30\x33\x2a\xc4\xcd\x04\x00\x16\xd7\xcd\x97\x3e\xa7\xae\x55\xdc\xec\x05\x75\xd9\x9f\xaa\x31\xe7\x9d\xe5\xa5\x66\xb8\x75\x19\x57\x36\xe3\xe5\x89\xf9\x3f\x04\x06\x05\xa0\x4d\x99\x60\x33\xae\xf1\x8e\x35\xe8\x35\x20\xd3\x5c\x93\xef\x4f\xcf\x8d\x1a\x80\x5b\x46\x69\xf3\x7f\xa3\xcb\xf8\xac\xe3\xb0\x05\xb3\xb5\xd5\x1c\x57\xa8\x49\xcd\x40\xfb\xca\xf2\x18\x19\x47\x44\xc8\x77\x3e\xe7\x9b\x5a\x31\x8a\xcc\x17\xd2\x5d\xee\x2d\xf4\x32\x1d\x90\x58\xa9\x01\xf9\xa7\xcd\x24\xd4\xb7\x5e\x5a\xdb\x60\x73\xad\xfc\xad\xbf\xcb\x38\xe1\x5a\xb2\x84\xec\xf1\x25\x9d\x9b\x33\x3e\x4f\xb8\x1c\x90\x6b\x9e\x30\xe9\xbe\xe2\x46\x4f\xb9\xd6\x29\xc4\xcf\xb0\x5b\xe4\x2c\xde\x3a\x01\x2e\x73\x90\x30\x6a\x95\x1b\xd0\xc1\x04\x51\x40\x62\x78\xf5\x9c\x72\x11\x79\xc5\xca\x48\x73\xff\x94\x6c\x21\x85\x96\x22\x5a\xb2\x84\xe7\x4b\xb8\x15\xd5\x0b\x36\x9c\xff\xc2\x57\xc3\x15\x13\x34\xd5\xeb\x61\x72\x38\x9e\x26\x8f\xbe\xfa\x72\x36\xa6\x07\x4d\x1c\x68\x4b\x78\x50\x99\x0a\x83\xbd\x64\x96\xd7\x10\x64\x29\x55\x3e\xa2\x0b\x32\xe1\x13\x2c\x03\x52\x90\x41\x44\x9e\x61\xf4\x9a\x59\x94\x51\xac\xd4\x9f\x0c\x8f\xcd\x14\xd3\x5f\xe7\x7a\x36\x7c\x3c\xe9\x0d\xdc\x4b\xb3\x60\xc1\x9f\x0f\xf1\x77\x40\xf3\x48\x5d\xcf\xbf\xb8\x75\xaf\x03\xc7\xa5\x91\x8c\x35\xd3\x43\xa5\x33\x46\x97\xf8\xf6\x6f\xc3\x18\x2a\xb5\x8c\xf0\x1f\x95\x4f\xcd\x73\x97\x48\xea\xb2\x80\xd7\xee\x1a\xd4\x26\x61\x22\x67\x98\x63\x72\x58\xcc\x10\xfc\x7f\xcc\x02\xbe\xfe\xf6\x8c\x8c\x0f\x8f\xc6\x27\xe5\x46\x27\x5f\xa3\xc5\x68\xd2\x1b\x4d\x7a\x46\xa9\x83\xbf\x1e\xbe\x99\xf4\xfe\x34\xe9\x15\x56\xa9\xb7\x46\xfd\x72\x0e\x64\x5c\x94\xc7\xb0\x5b\xd2\x48\xa4\x72\x76\x12\xba\x65\x79\xea\xb2\xb4\xb6\x64\x4a\xe1\x2f\x90\x37\x8e\x66\x1a\x89\xdd\x93\x1f\xd4\x56\xc0\x79\xe3\xb7\x56\x19\x8b\x59\x82\x07\xa3\x41\xcd\x04\x2f\xa9\x70\xce\xf8\x98\x12\x2d\xaf\x18\x64\x4b\xf4\xc2\x34\x4b\xe5\x4d\xe4\x60\x86\xb7\x61\xd2\x7e\xb3\xd0\x61\x5a\x6d\xb3\xa0\x34\xd6\xe6\xa0\x35\x83\x63\x8c\xba\xcf\x57\x7a\xb3\xe0\xda\x1c\xe3\x10\x09\x67\xd9\x4a\xd1\x45\x21\xd0\x62\xed\xa3\xd6\xfd\x1b\x17\x5e\xa7\x41\x04\xa0\x29\xe8\x1d\x1e\xaa\xf0\xa1\xfb\x9c\xaa\x8e\xb0\xd7\xff\xf3\x5f\xfe\x7f\x83\x3f\x9d\x4c\x26\x93\xde\xe8\xcd\xdb\x6f\xa2\xaf\x6d\x77\xb7\x52\xd5\xb5\x80\xec\x32\x4a\x3a\xa4\xb8\xaf\x49\x9b\xa4\xb6\x58\xd1\x91\x0a\xcc\x10\x3a\x18\x11\x64\x13\x8b\x47\xaa\x4e\x2c\xfa\x26\xbd\xaf\x27\x3d\xb2\x87\x7f\x8c\xc8\xbf\x72\xa9\x59\x32\x44\x7c\xf6\xed\x47\x4a\x0f\x07\xe1\x7a\x58\xfa\x7b\x7c\x70\x30\x40\xdb\x41\x96\x19\x39\xdc\xaa\x3d\x32\x37\x12\x36\xf4\x56\x2e\x07\x9d\xcf\xc5\x1a\x60\x9f\xac\xd2\x5c\x01\xaa\x3d\x86\xc8\xb3\xbf\x9d\x3d\x3b\xbf\x24\x93\x89\xd9\x39\xb8\x7c\x67\xaf\x21\x9e\x22\x86\x28\x58\x25\xfd\x48\xa6\xa3\xdd\xdd\xa7\x17\x67\xcf\x9f\x07\xa3\x50\xac\xcc\x61\xfe\xe5\x98\xb6\x5d\xc5\x74\x65\xa9\x6e\xd2\x62\xd9\xf0\x11\x80\x3f\xef\x7d\xc3\xfb\x7b\xb7\xc3\x37\x3f\xbf\x7d\x43\xf6\xfa\x93\x09\xae\x5b\x6f\xf4\x4d\xf4\xf5\xe4\x76\x7f\x7f\x38\xb9\x1d\x7f\x3b\xb9\xfd\xf2\xdb\xb7\x5f\xbc\x0f\x36\xc8\x7b\xd8\x1f\xef\x61\x7b\xbc\xb7\xbb\xe3\xbd\xdf\x1c\xef\xcd\xde\x78\x0f\x5b\xa3\x3f\xea\x30\xf2\xde\x9f\xc8\xc3\x0e\xcd\xbe\xde\xeb\x02\x65\x6f\x6f\x32\x79\x63\x1f\x7e\xf9\xed\xdb\xf7\x6f\x7e\x9e\xdc\xee\x3f\xed\x4d\x26\x6f\xfb\x0f\x7b\xfd\xfe\xc3\x3f\x36\x22\xe5\xa3\x66\x78\x57\xac\xce\x2c\xb3\x55\xfc\x7a\x66\xe4\x85\xd7\x36\x3e\xbf\x39\xca\xc8\x47\x17\xbd\x06\xdd\xaf\x2e\xd0\xa5\x7c\xb4\x34\x8c\x5d\xf1\x27\xb3\x92\x57\x61\x88\x2c\x42\xab\x17\x54\x24\xa9\xd9\x22\xb3\x8a\xdf\x3a\x9c\xb0\x8a\xc8\x5c\x3b\x87\x77\xea\x12\x0c\xa0\x72\x0a\x79\x1e\x92\x0d\xdf\x95\x8a\xa3\xb8\xd9\xce\x93\x9e\x9d\x8e\xe5\x9e\x93\xde\xf3\xb9\x90\x19\x9b\xf4\x8a\xf2\x03\xde\xad\x1c\x02\x92\x65\xd8\xa7\x51\x7b\x52\x0c\xd2\x31\x58\xf3\xf0\xd1\x3e\xe4\x27\x38\xda\x7f\xec\x34\x67\x36\x00\x3b\x71\x75\x62\x7b\x7c\xe6\x5c\xb9\x52\x39\x9f\xdb\x92\x08\x36\xb5\x6f\xdf\xa6\xa1\x94\xb9\xd0\x1b\x3d\x2d\x43\x85\x7a\x64\xab\x0c\x3c\x95\x97\x4c\x67\x3c\x56\x6d\x13\x71\x93\x6d\x9c\x47\x6d\xdc\x80\xb7\x89\x9a\x59\xa2\xee\x59\x9a\xd6\xdc\x31\xce\xa2\x0b\x1c\x05\x5c\xc4\x19\x18\x5b\xcc\xfb\x02\xba\x4d\x8f\x7e\xae\x42\x24\xe3\xe1\xbe\x5e\xa1\x46\x08\xa0\x56\x2c\xe3\x90\x4f\x02\xe4\xbd\xf2\x5d\xc5\x03\x97\x82\xc3\xe5\x1b\xc9\xc8\x4f\x6c\xea\x53\x1d\x3f\x00\x0d\x34\x4f\xa9\xe6\xd7\xe5\x01\xf7\x26\x3d\x73\xbc\xe2\x93\x49\xaf\xef\x79\xef\x94\x11\x45\x67\x2c\x5d\x13\x0e\xa8\x4b\x22\x42\xbe\xf7\xaa\x28\x00\xe6\x53\x5d\x2c\xe9\x1a\xd9\xec\x94\xa1\x35\x1f\x98\xa6\xf3\x96\x67\x59\x26\x5d\x66\x51\x25\x9d\x3b\x6a\x48\x9f\xa5\x15\xc2\x4a\x38\xcb\x15\x4b\xa0\x50\x93\x5d\x08\xc8\xbd\xcd\xe9\x5c\x48\xc5\xe1\x96\xc5\x4c\x34\x65\x4b\x58\x74\xe1\xbd\x9c\x6b\x41\x2b\x41\x85\xfa\x73\x4c\x8d\xb6\x5c\xd6\x1c\xdc\x7a\xa2\xe1\xbc\x3c\x39\x0e\xe7\x97\x05\x07\x5c\xcb\x74\x96\xa3\x7a\xa2\xb5\xe9\x50\xef\xd4\xda\xac\x23\x0f\x5b\xb8\x0b\xf8\xcd\x00\x36\x1a\xb9\x5c\x03\x63\x05\x4e\x64\xd0\x7d\x66\xd4\x60\xda\x90\x4e\x62\x43\xcf\x2c\x77\x69\xa8\x77\x01\x37\x64\x56\x2c\x83\x25\x25\x2b\xd3\x3a\xba\xef\x02\x15\xa8\xe7\x15\x3d\x42\xbd\x10\xd2\xb0\xfb\xe4\xec\x20\x00\xf7\x00\x96\xa1\x81\x65\xf8\x67\x84\x2b\x96\x09\xfb\x4b\x64\x26\xd6\x1b\x58\x43\x63\xf8\xc6\x66\x08\x00\x7e\x55\x3c\xc6\xc4\x38\x45\x3e\x80\x60\xd8\xe3\xfd\x43\x1c\xad\x50\xc2\x45\x80\x82\x42\x13\x3f\xde\x3f\xf4\xfc\x41\x45\xe4\xcc\x5b\x59\xc1\xb6\x1a\x20\x0d\xba\x98\xd6\x60\x73\xdc\x3f\x2a\x7a\xb9\xbd\x87\x88\xe6\xbf\x98\x7d\x07\xc9\x36\x2c\xbf\x10\x8e\xdd\x94\xb1\x33\xb5\xae\xff\x79\x9a\x06\x1c\xaa\xc8\x5f\x1a\xde\xdd\x45\xe4\xd9\x7c\xe8\xd5\xb1\x8c\xde\x44\x58\xbc\x31\x57\x2c\xb3\x09\xea\x2b\x75\x1c\x31\x99\xc4\x68\x46\x13\x3a\x3d\x3a\xfe\xf2\xe8\x4b\xfa\xd5\xf4\x70\x3f\x8e\x0f\x67\xfb\xf4\x68\x7a\x34\xa6\xc9\xc1\xe3\x2f\xc7\xb3\xaf\x8e\x1f\x1f\x7c\x49\xbf\x3a\x1c\x81\x28\xa3\x5c\x37\x7b\x8d\x36\x32\x40\x8f\x6a\xd0\xea\xeb\xe5\x78\xae\x5d\xd4\xbe\xa9\xb7\x02\x96\xaf\xb1\x4a\xd4\x78\x07\x73\x46\xe7\x5c\xe4\xf7\x5a\xd2\xe4\x03\x4d\x50\x0d\x65\x4c\xb6\xca\x40\xdf\x23\x0d\x6c\xcf\x9c\x1c\x34\xf6\x64\x5f\xb5\x3c\x79\x82\xc2\xbb\x95\xc6\x45\x2c\x9d\x79\xaa\x28\xd0\x77\x97\x3c\xb8\xf6\x4a\x97\x25\x08\xdc\x2e\x15\x58\x6a\xbb\x96\xdd\x14\xf0\xa0\x59\x58\x55\x7a\xf3\xc2\x5a\x31\x2b\x90\x7c\xeb\xc6\x1a\x90\xbf\x0d\xfd\x1f\xe6\xb7\xf2\x83\xef\xa5\xd2\xe5\x27\xe7\x32\xab\x3e\xc9\xa4\x96\x78\x3e\x6e\x3c\x1e\xba\xb2\x7e\x65\x84\x87\xe1\x8c\x78\xc2\xa1\xe6\x5d\x0e\x00\xf2\x41\x59\xa7\xab\x15\x43\x29\xce\xe6\x34\x0d\xae\x83\xed\x9d\xd6\xe6\x5c\x29\x74\x52\x2e\xc1\x57\x02\x7a\xae\xb5\x0b\xc3\x39\x79\x8b\xf5\xa5\x4a\x64\xf0\x10\xe4\x99\x55\x4a\x63\xb6\xe3\xe7\x3c\x6a\xfd\xb7\x32\x18\x07\x6f\x5a\xd6\xc5\xe7\x3c\x86\x8c\x94\x13\x22\xec\xe1\x06\x24\xcf\x67\x2f\xa5\xf8\x50\x40\xac\x58\xbe\xf6\x7e\x08\xce\x90\x06\x9a\xbc\x0b\xed\x32\x82\xd1\x8e\x1f\xc2\xda\x96\x9b\xf3\x0e\x70\x5c\x9a\x78\x38\xbb\x8a\x7d\xd7\x6d\x4d\x90\x27\xdd\x6a\xdf\xd9\x74\x8f\x03\xb4\x34\xb0\x2b\xdc\xd2\x02\x31\xdf\x66\xa8\x37\x93\xbf\x1b\x6f\x24\x16\x13\x2f\xe9\x92\x9d\x51\xc5\x4e\x93\x7f\xe6\x4a\x1b\x71\xbb\xa3\x45\xba\xb1\x7b\xb0\x6e\x18\x9c\x1b\xbc\x82\x85\xb4\xa7\xb3\xb5\x49\x43\x22\xf0\x62\x5f\xa2\x10\xe3\xb2\x63\x15\x7d\x4b\x99\xac\x20\xd8\x58\x6c\x74\x2b\x62\x4e\x5c\x0a\x83\x98\xae\xb8\xa6\x29\xff\x85\xda\x08\xe6\x92\x7c\xa2\x8a\xe8\x99\x49\xaf\xc2\x81\x26\xbd\x92\x7b\xb8\xa5\xbf\x49\xef\x76\xe8\x39\xa0\xf9\x6d\xd2\x2b\x41\x51\x48\x12\x08\x39\x4e\xcf\xa7\xb1\x2a\x26\x50\x05\x0c\x0d\x92\x15\x6c\x99\xbd\x02\x52\x4f\x80\x2a\x5f\xc6\x69\x50\x2d\x0e\x35\xb0\xd5\xa1\x86\xe5\x72\x84\xf8\x18\x86\xb9\x29\x8a\x38\x40\x7c\xe7\x18\xbe\xfb\x6d\x51\x30\xb1\xd8\x3e\xba\x16\x18\x07\x07\x8c\x06\x85\x16\xb1\x7c\x0c\xe0\xc7\xcf\xd2\xb9\xfc\xa0\xe8\x52\xbe\x82\x5f\x8c\x87\x38\xe8\xd0\xd0\xc6\xd7\x3a\xcb\x59\x90\x0b\xcb\x2e\x90\x13\xbe\xba\x82\x83\x85\x81\x5d\x92\x71\x2f\x3a\xb6\x1c\xa8\x42\x56\xa7\x8c\x03\xda\x45\x6b\xdc\xf4\xbb\x58\xcb\x37\x78\x95\x81\xef\xfb\x86\x5d\xe3\x44\x22\x27\x09\x55\xa8\xbb\x92\x67\xab\x42\xab\xbd\xbe\x93\x69\x1b\xe9\x7e\x33\x72\xdf\xd5\x6f\xdc\xd8\x44\x9b\xe6\xc6\x83\x47\xe3\x47\x44\x59\x25\xf2\x28\x3a\x68\x8e\x67\x58\xd2\xdb\x17\x4c\xcc\xf5\xe2\x84\x8c\xf7\x0f\x8e\x9a\xdb\x71\xe1\xda\x35\x27\x3b\x2c\x6c\x82\x7f\x7c\xff\xf3\x9b\xe1\xff\xe7\xb3\x3f\x7e\xfe\xff\x7d\xf0\xf0\x8b\x08\x72\x01\xfd\xe3\xe7\x77\xff\x0f\x1d\xfe\xf2\xfe\x7f\xbc\xfd\xe2\x83\x0c\x68\x9d\x52\xf0\x6c\xb3\xb2\x61\x51\x90\xe7\x8d\x31\x13\x65\xce\xe9\x5a\x07\xb6\xad\x72\xb0\xe9\x2c\xb4\xde\x87\xeb\xd3\x56\x4e\xce\xb2\x1e\x2e\xc0\x9b\x8c\x0b\x08\x44\xb7\xce\xa0\xd5\xf4\xfb\xa1\x75\x84\x2b\x37\xfa\x46\x02\x58\xd8\xd1\xd4\xd2\x8d\xab\xbe\x64\x0b\xa0\xd8\x7b\xf1\x70\x64\x4b\x66\x76\xb4\xd0\x31\x74\xba\x0e\x2f\x33\x94\xb5\xee\xa4\x79\x82\x34\x56\xd8\xb0\xac\xdb\xa2\x4f\x1d\xa8\x33\x94\x5d\x82\xf2\x2e\x95\xb9\xb4\x6f\x72\x95\xc7\x8b\x60\x7a\x88\x1c\xf8\xa8\x96\xc5\x20\x4d\xbb\xbd\x8b\x6f\xaf\xcd\xf6\xd0\xd5\xb3\xf7\x81\xbb\x72\x2a\xf9\x12\xda\x87\x45\xb9\x31\x0b\x66\xb0\xf6\x0f\x1e\x28\x5c\x88\x52\x7e\xfc\x05\x85\x0b\x69\x9b\x38\x30\x17\xa9\xc1\xa3\x53\xb1\xfc\x69\x63\xd9\x2a\xac\x17\x5e\xb6\x0e\xb1\x5a\x4a\x9d\xdb\x90\x61\x31\x4b\x57\xd4\xda\x12\x88\x85\xcf\x2b\x8d\x10\x1a\x8a\xa5\x5a\x5d\x40\x28\x80\x9a\xca\xb9\x6d\x5b\x63\x46\x6c\xd2\x65\xcc\xbb\xde\xe7\xbf\x7e\xf1\xb7\xdf\xe4\x64\x42\x3e\x8f\xf9\xc9\xe7\xf1\xea\xdd\xe7\x33\x7e\xf2\xf9\x6c\xf5\xee\xf3\x4b\xf5\xee\xf3\x4c\x9f\x7c\xbe\xe2\x49\x0f\x73\x89\xa0\xf2\x9e\x21\xa7\x47\xcd\xdc\x5e\x21\x27\x32\xce\x3d\xec\x27\xce\x0d\x35\x9e\x1a\x85\xdc\x2a\xe8\x70\x08\xe1\x01\x35\x4c\x62\x29\xae\x47\x07\xd1\xfe\xa8\xb4\xff\x30\x0e\xff\x71\x74\x10\x1d\xb6\xa5\x83\xea\xca\xeb\x3a\x72\xbb\xd2\x1d\xc8\xe7\x7b\x9f\xbf\xdf\x9b\xfc\xfa\x66\xf8\xc5\xdb\x6f\xde\xfc\xff\xff\xf6\xec\xed\xde\xa0\xf8\xbd\xff\x70\xf2\x5b\xff\x9b\xbd\x37\xa7\xc3\x7f\xd0\xe1\x2f\x6f\xbf\x78\x3f\x79\xf3\xc6\x32\xc5\x77\xf0\x60\x6f\xb2\xf7\xe6\xe7\xfe\xdb\x2f\x26\xfd\xfe\x37\x7b\x83\xe6\x77\xfd\x87\x93\xb7\xfd\xfe\xfb\x37\x3f\x7f\xfe\xe6\x24\x16\x3a\x4b\x4f\xde\xbe\x6d\xbb\x8f\xe8\x18\xf8\xb7\x7b\x89\xd3\xf2\x6e\x08\xad\x01\x2d\x87\x20\x32\xa2\x21\x4f\x7a\xfd\x5d\x19\x63\x85\x87\xe0\x5d\xb0\xa1\x53\xb7\xc9\xee\xe5\x94\x6c\xdc\x02\x42\xd6\xf0\xa4\xb6\x20\xc1\x8f\x48\x6a\x77\x3d\x5a\xef\xc3\x07\xb8\xf5\xb5\xb5\x24\x6f\x35\xb2\x38\x8b\xb3\xb7\xaf\x94\x03\x40\x6e\xca\x05\x83\x4d\x6b\x96\xa0\x41\x33\xa8\x32\x5d\x5d\x21\x9f\xd4\x1b\xbc\x12\xe7\x28\x1d\xba\x9a\x89\xd3\x5c\x97\x8e\x2c\xb8\xe8\xe5\x0a\x5e\xde\xa9\xf8\x77\x6b\xde\x85\x4a\x86\xb1\xba\x2c\x0b\xba\xa8\x6a\xef\x2d\xee\xd5\x29\xb7\x9e\x94\x9b\x97\x48\x7e\x3e\x1f\x72\x36\x26\x50\x83\x1d\x99\x71\x57\x7e\x10\xf4\x31\x70\xa0\xe5\x39\xc4\xf6\x5c\xb6\xed\x94\xae\x59\x2f\xbc\x7f\xe2\x4e\x31\xf2\x85\x57\x63\x63\xb0\xd1\x99\x6f\x52\x10\xa6\x9f\x51\x4b\x16\x32\xd7\x6d\x5b\x90\x7b\xe7\x04\x00\x6a\xad\x52\x59\xbb\x7d\x1a\xa7\x87\x5d\xea\xe7\x46\xdd\x5b\x17\x45\x15\x91\xc6\xc9\x5c\x40\xcb\x6d\x33\x91\x82\xbd\x9a\x75\x89\xd7\xdf\x35\xed\x9a\x0d\xb7\xe9\x9a\x68\xcd\xe7\x71\x5f\x5d\xb7\x71\xd6\xff\x7c\x68\xda\xaa\x4d\x90\x1d\x33\xbc\xec\x00\x45\x89\x24\x7c\xe8\x92\x8d\x92\x2a\x32\x42\xdb\x03\xba\x42\x15\x78\x12\xdb\x3b\x75\xd8\xb1\xce\x57\xa9\xf6\x5a\xa1\xfa\xb3\x53\x12\x34\xab\x27\xb4\x66\x39\xad\x9d\x54\xcf\xf5\xac\x08\x1e\x76\x2e\xfe\xad\x9c\x95\x27\xb0\xcd\x44\xef\x3b\x82\x05\x31\x95\x31\x4d\xc7\x2d\x16\xc4\xf0\x67\x97\x1c\x24\x57\x2c\xeb\x96\x54\x6e\x08\xae\xcc\x1d\x9b\x2e\x29\xef\x9a\xd7\x24\xa1\x6c\xd9\x31\xaf\xdd\x90\xd0\x5c\x2f\x3a\x36\x45\xfc\x77\x6c\x9c\xae\xba\xce\x4c\xb0\x1b\xd5\x15\x5f\x79\xdc\x56\x3e\x3f\x6c\x1a\x67\x3b\xa1\xa0\xad\x42\x44\xd8\x76\xa6\xbb\x02\x20\x3a\xb7\xa4\x79\xc2\xb7\x67\xc5\xb3\x6d\x53\x96\x75\x6d\x6b\x50\xd0\x75\x5e\xb0\x1b\xda\x44\xd2\x8d\xc6\xe3\x5d\x1a\xef\x04\x46\x5b\x79\xbb\x8d\xc6\xdb\xcf\x84\xa0\x71\x5b\x79\x8f\x8d\xc6\xdb\xf8\x7b\xa9\xf1\x97\xf7\xcd\x3d\x0b\x9d\xa2\x13\xfb\x74\x45\x90\xda\xd5\x8f\xa2\x7d\xc9\x79\xdd\x7d\xca\x5f\xfc\xda\x54\xa5\x29\x3e\x2d\x1f\x25\x96\xdd\x6e\x63\xb6\xa5\x41\xbd\x8b\x92\x81\xaf\x23\xcb\xed\x5a\xbf\x25\x40\x18\x96\x67\x39\xda\xff\xaa\xcb\xd2\xf9\x72\x2e\x47\xad\xb5\x36\xdd\x4f\xb7\x4a\x7d\xee\x67\xb5\xa5\x6a\x95\xfb\x29\x47\xca\x07\x05\xa9\xfe\xfa\xf4\x1c\xff\x16\xf9\x72\xca\xb2\x8f\x73\x9c\xdf\x19\xc5\xdb\x4a\xe5\xf8\x0e\x0e\xc7\x5d\x98\xc5\x2e\x18\xde\x25\x67\xa1\x95\x88\xba\xc8\x8e\x72\x2b\x6f\xfd\x78\x09\xbe\x6a\x13\x98\x86\xea\x96\x11\xf3\x6d\x38\xfa\x73\xed\x2d\x20\x2d\x77\xe2\x85\xae\xe3\xcb\xe8\x97\xf3\xb1\x17\xf6\x64\x2b\x60\xf1\x84\xc5\x34\x0b\xa3\xc3\xe8\x92\x25\x20\x2c\xcd\xd5\xa4\xe7\x42\x27\x6b\xac\x38\x2b\x99\x58\x7f\xc6\xd2\x88\x35\x2d\xb5\x24\x37\x19\xd7\xcc\xdb\x93\x83\x2f\x5b\x5b\x8f\x57\xdb\x35\x78\x2b\x5c\x53\x9e\xba\x1c\xc6\x71\xa8\xbf\x39\xdb\x10\xa6\x43\xf0\x4a\xa9\xb7\x36\x95\x3d\xb6\x02\xd0\xbc\xf5\xde\x29\x81\x4a\xa6\xb9\x2d\x12\x06\x1b\x8a\x26\x56\x9d\xb5\x75\xdf\x0c\x0f\xf3\x20\x16\xb1\xe2\x78\x59\x57\x86\xc9\xa6\xed\x83\xf7\xf8\x37\x3a\x2f\x24\x99\x5c\xad\x0a\x07\xd8\x8c\x6a\x66\x25\x58\x45\xd8\x6d\xcc\x58\xe2\x3d\x33\xed\x68\x59\x2e\x34\x5f\xb2\x07\xca\x25\x82\x6d\x00\xfa\x81\x22\x31\x5d\xd1\x98\x6b\x57\x0e\x1e\x35\x3c\xf3\xfb\x0b\x87\x4a\xd0\x04\xa1\xae\xd9\xa6\xa2\xd8\xe0\xdd\x66\xef\x5f\xc1\x13\xac\xc4\x70\x62\x2a\x7c\xfd\xb5\xf2\xa9\xb0\xdb\x8a\x2c\x68\xe9\x4a\xc3\xaf\x8a\x1d\x13\x13\xf5\xc6\xed\x11\xf7\x64\x87\xcc\x72\x7e\x43\x74\x68\x7b\xd1\x45\xd8\xfd\x1d\x26\x5a\x83\x98\x3a\xba\xd2\x79\xc6\xce\xa4\xbc\xda\xa2\x7d\x6e\x86\xe3\x95\xba\x06\x0a\x98\x75\xed\xc6\xc7\xba\x6c\xb5\x8b\xb1\x53\xf5\xb2\xa8\xc5\x80\x27\xa4\x1f\xcb\x10\xa7\x1b\xa0\xed\xd0\xda\xb5\x50\xd2\xc6\xfd\xae\x9d\x9a\x99\x08\x4e\x2f\x30\xd3\xb9\xfb\x5c\x04\xaa\x69\x7e\x5b\xaa\x24\x75\xd6\xf7\x97\x54\xc7\x8b\xcb\x4e\x59\xae\x4b\x53\xf2\xfd\x2a\x8a\xb1\x3b\x2d\xe0\x3d\x16\xe5\x23\x2b\x96\x99\xe3\x1d\x6b\xee\x23\x5f\x81\xa9\x61\xdc\x7e\x6d\x22\xc8\x67\xb7\x34\xd6\x3d\x97\x13\x9b\x99\xbf\x5c\x24\x11\x8e\x0c\x69\xf3\xce\x33\x36\xe3\xb7\x3d\x67\x75\xc2\xf7\x2b\x78\x88\xcd\x70\xd9\xdd\x68\xe5\x0a\x67\x14\xad\xf3\x9b\xf5\xd4\xec\x05\x39\xbc\x05\x62\xb1\xa3\xb8\xaf\x71\x5f\xb9\x01\x46\x71\xdf\x6b\x1b\x07\xbb\xfa\xd1\x9a\x5c\x4b\x0a\xa4\xfa\x8f\x41\x70\x54\x31\x42\x6f\x26\x65\xcf\xe5\x71\x01\x4a\x00\xa7\x61\x8f\xce\xc4\xb6\x80\x92\x5a\x52\x4e\x69\xd6\x03\x23\xb4\x90\x18\x76\x65\xdb\x98\xe7\xfe\x32\x23\x83\x60\x72\x1d\x2f\xf0\x00\x81\xb1\xb8\xea\x48\x69\x64\x07\x8b\xc4\x90\xc0\x42\x74\x6a\x89\xf3\xdd\xda\x74\x07\x3d\x66\x07\x2d\xa6\x42\xe9\x4e\x7b\x08\x1d\xb5\x1b\xf4\x12\x24\x41\xd8\xb3\x2e\xab\x05\x1a\xd5\x7d\xf2\x17\xbc\xcc\x56\xd5\x7d\x30\x70\x7f\xc0\x2e\x70\x9e\x38\x6c\x58\x04\x4c\x25\x2c\xe5\x4b\x0e\xc5\x59\xdd\x6d\x11\x9e\x9d\x3a\x5b\x07\xa7\x36\x57\x16\x26\xd4\x7d\xf0\x8e\xca\xc1\xa2\xb3\x5c\x60\xd0\x3b\x17\x7e\x04\x7b\x5e\x6e\x64\x9e\xa9\x11\x98\x6c\xac\x80\x84\x42\x0a\x8a\xad\x28\x88\x0e\x98\xe0\xc1\xee\x6d\x2d\x35\x4d\x03\xb4\x94\xd2\x18\x41\x85\x51\xe7\x03\xd0\x45\xef\xb4\x22\x7b\x17\x55\x72\x07\x01\x7f\x17\xf1\x7e\xdb\x15\x25\xfe\xb4\x5d\x54\xd2\x0a\xbf\x7b\xae\x5b\xaf\x0e\x83\xc6\x35\x57\x87\x8f\x0e\x1e\x1d\x07\x57\x87\xe3\x4e\x58\xec\x78\x23\x68\xdb\x77\xbb\x17\xc4\x9f\xe2\x76\xb0\xf9\x6a\xb0\xf5\x9a\x18\x7f\x76\xd8\xc6\x05\x33\xbc\xc3\xaa\x58\x2e\xda\xb0\x36\x96\x95\x7f\x5a\xa2\xba\x9f\xce\x4b\xd4\x55\x1f\x1e\x16\xc7\x5d\x87\x96\x16\x41\xdb\xf5\xdc\xed\x0a\xf1\x92\xde\x3e\x07\x01\xae\x95\x53\xdc\x6b\xc1\xb5\x40\x9c\x6d\x89\x08\x70\x3f\x9b\x91\x01\xe5\xbe\xfe\x02\xbb\xc4\x5b\xef\x43\x10\x0e\x3d\x1c\xfd\xf9\xef\x12\x36\x04\xa9\xd8\x31\xb6\x39\xf4\x38\x55\x25\x8f\xd3\xcd\xe8\x4b\x8a\x29\x80\x14\x8b\x8d\xcc\xb2\xf1\x1a\xef\xdf\x5d\x32\xe5\x3a\x55\xdd\x39\xaf\x2a\x62\x9d\x56\x21\xac\xd1\xba\x9a\x6d\x78\xb8\x12\xf0\x0d\x75\xee\xad\xc1\x97\xfa\x11\x21\x0e\x8d\x31\xb8\x93\x96\x90\x65\xe0\xb8\x7c\x71\x41\x56\x54\x29\xbd\xc8\x64\x3e\x5f\x84\xbd\xef\xa5\x56\x98\x3d\xff\x76\xb3\xc8\x38\x1f\xd4\x30\x7c\xc2\xa0\x2b\xf4\x4f\x29\x48\x41\xba\x19\xdd\xc5\xb5\xd5\x2f\xfc\x36\x5d\xb7\x83\x1a\xb4\x31\x91\x36\x55\x08\xd7\xa5\x46\x15\x0a\x7d\x1a\x77\x55\x85\xc8\xce\xd5\xb8\x76\x32\x77\xdf\x45\x58\x74\x0e\x00\x76\x5a\xd6\x57\x0f\x4a\xee\x97\x9e\xfd\xdf\x29\xd5\x91\x5d\x8d\xb1\xbb\x1a\xbc\xbb\xca\x6c\x64\xab\xdc\x16\x78\x71\x6d\x17\x0a\xee\xcb\x31\x3a\xfc\xe9\x72\x76\xb7\x7a\x5e\x15\x3f\x3b\xdd\xf8\x74\xb7\x69\x77\x3f\x9d\x5d\xeb\x86\x50\xba\x3a\x68\x3b\x15\xe9\xea\x7c\x50\x93\x8e\x87\x35\x01\x04\x60\x6c\xc0\xae\x4c\xda\x46\x23\x34\x70\xe9\x72\xac\xc2\x4e\x6c\xba\xd2\xf5\x13\x9f\xfe\xc4\xa7\x3f\xf1\xe9\x4f\x7c\xfa\xbf\x37\x9f\xee\x7c\x0b\xf1\x42\xce\xbf\xdd\x2d\xfa\xa2\x57\xea\x56\x1f\x84\x21\x67\xd5\x5d\xec\x0c\xd6\xe5\x58\x97\x16\xde\x1e\xde\x57\x41\x2e\x3b\xd8\xfa\xa5\xc0\x8a\x07\x45\xad\xd1\xcd\xc8\x89\x6f\x65\xe6\x42\x1a\x9a\xdb\x0d\x7c\x2c\xc4\x47\x8b\x7e\xa8\xd1\x11\x1d\x92\xfe\x3d\x75\xc4\xe7\x3a\xc8\x61\x88\x31\x33\x6e\xad\x83\x10\x9c\xbb\xaa\x8a\x9d\x36\x79\x2a\xe7\xa5\x34\x47\x9d\x4a\xb4\xbf\x68\xbd\x28\xac\xba\xc3\x97\xc6\x0f\x88\x1c\x0a\xf2\x05\xa8\x97\x2e\x73\x4b\xa0\x32\x06\x09\x91\x6a\x5c\xc8\x37\xf3\xea\x54\xf2\xbc\xdc\x7f\x6a\x9d\x20\xb3\x4e\xaf\xdf\x94\x6d\xa6\x3e\x5f\x4d\x2e\x20\x1c\xd2\xb0\xa7\x0f\xce\xbb\xd3\x39\xcb\x0d\x86\xbc\xcd\xf2\x14\x2b\x38\xda\x6c\x3b\x36\xc3\x11\x8e\xf6\xbb\xc9\xb6\xb3\x71\x4b\x57\x93\xf2\xaa\xf7\x42\xce\x5d\x3d\x2b\x48\xa7\xd3\x6b\xca\xc1\x04\x2d\x3f\xb4\xd4\x55\x3b\xa5\xb7\x26\xf5\x71\x3f\xf7\x55\xe3\xaa\xf0\x8c\x69\x68\xf5\x21\xd1\x2d\x3e\x55\xcf\x05\x4b\x59\xac\x65\x6d\x1c\x42\xf9\xec\xda\xe8\xe2\xca\x5c\x43\xcc\x24\x4f\xb5\xad\xe6\xa1\x18\x1c\x62\xbe\xb9\x22\x36\x97\x3b\x10\x53\x53\x3e\xe9\x4b\x5b\x8c\x31\x20\x5e\x7f\x62\x81\x2f\xc8\x82\x66\x89\x77\x81\xce\x05\x64\x7e\xd7\xe5\x52\x06\xc2\xc1\xc1\xc5\xfc\x4e\x79\x49\xc0\x76\xfc\xec\xd6\xe5\x18\xed\x16\x13\x53\xed\x54\xce\x90\x9b\xd2\x29\x4b\x89\x72\x28\xb3\x2b\x0f\x31\xe4\x98\x75\x29\x7c\x02\x14\x7f\xfa\xf2\x69\xb3\x5a\xb3\x4b\x00\xf8\x69\xcb\xc7\x6d\x1a\x4d\xf7\x06\xbd\x5e\x5c\xee\x4a\xdc\x8d\x03\x42\xc9\x15\x5b\xe3\xad\x21\x15\x85\x53\x95\x75\x21\x4a\x7d\xe5\xba\x2b\xb6\x86\x46\xd8\xaf\x79\x0b\x76\xd3\xc4\xae\xd8\x16\x8f\xfa\xd2\x24\xcd\xb7\xad\x4f\x19\xce\xd6\x3c\xf0\xfa\x8e\x9f\x60\x21\x2b\xb4\xcb\xde\x1d\x05\x65\x87\x8b\x1d\x00\xf5\xe8\xcb\xd8\x0a\xe3\x56\x14\x22\xf8\x81\x42\x64\x1a\xda\x59\xf0\x95\xf5\x61\xc2\x4d\x64\x31\x6a\x2b\x4b\xb8\x21\x90\x4e\x9e\x8b\x81\x91\x8e\xcc\x3f\xcf\x6e\xb9\xe1\xbb\x66\x0d\x9e\x4a\xa6\x5e\x4a\x0d\x4f\xee\x65\xaa\x08\xc2\x0e\x13\xb5\xbc\x1c\x13\x74\x81\xb0\x6d\x66\x62\x2f\xb1\xdd\x84\xec\x5d\xb3\x47\x0a\x57\xe6\x4c\x92\x99\x9b\x91\xd7\x73\x95\x1d\xc2\x69\x5d\x42\x8a\x21\x1c\x37\xb5\x63\x58\x44\xc8\xac\x84\x87\x96\xe1\xec\x50\xc0\x7d\xf0\x0d\x48\x20\x90\x19\x25\x21\x89\xad\x11\xe0\x4a\x7d\xf0\x98\x2c\x59\x36\x67\x46\x35\x8b\x17\xed\xe8\xed\x64\x1f\xe9\xac\x97\x75\x51\x5d\xba\xe8\x6d\x43\x43\x71\xad\xef\x1d\x3a\x1b\x1b\x75\xd0\x8f\xb6\x41\x0b\x2c\xf3\x85\xd9\xae\x8d\x18\x2a\x4a\x9e\x9f\x77\xe0\x1a\x1d\xf0\xb8\xc9\xb3\x11\x00\xe4\x84\x4b\xba\x32\x54\xfa\xab\x61\x78\x40\x28\xbf\x91\x15\xe5\x46\x56\x3a\x75\xb9\x66\xc3\x77\xd6\xd4\x12\x0e\x03\x89\xf1\x14\x31\x6b\x70\x4d\x53\xe7\x8c\x28\x08\xc3\x33\xcc\xbb\x2f\x05\x27\xc5\x80\xdc\x40\x65\x27\xc3\xb2\xbc\x0e\xd7\xbb\x62\x6b\x9b\xe1\x32\xa4\xec\xde\x73\x61\xd3\xe2\x6e\xd0\xb2\xe7\xdb\xa0\x15\xf5\xe0\x5d\xef\x2e\x87\xcb\x87\x08\x14\x95\x44\xaf\x4b\xba\xb2\x79\x5e\xa9\x96\xcb\x9a\xda\xef\x50\x2a\xd3\xec\x32\x03\x5b\x07\xe1\x23\x6c\xee\x0b\x67\xb9\x8a\x16\x3e\x9b\xb3\xbc\x76\x42\x48\xbc\x60\x49\x9e\xda\x24\xd1\x4d\x42\x47\x49\x98\xf0\x35\xd2\x7d\x6a\x33\x48\x79\xfe\xb2\xf4\x65\x23\x97\x2c\x25\x64\x56\x86\xda\xe8\x77\x12\x33\xcc\x64\xda\xe4\xae\xfa\xe9\x87\x62\x97\x2f\x09\x51\x39\xe0\x40\x16\x6b\x2d\x8f\xe1\x45\x28\x2f\x40\x15\xbe\x65\xaa\x2a\xd8\x61\x42\x1f\x60\x86\xa5\x6c\x7d\x2d\x62\x58\xc2\x30\xe1\x98\x35\x1d\xa2\x74\x6e\x97\xc0\xb6\x09\xb0\x89\x44\x6f\xfd\xf7\x6c\x75\x9c\xba\xca\x39\xd7\xe3\x91\x9d\x95\x11\x27\x35\xd5\x39\xce\xe3\xa7\x05\x13\x9b\xc3\x72\x45\x7e\x92\xd9\x95\xcb\xdc\x13\x88\x88\xe0\x38\x5e\x2e\x45\x24\xd5\x09\x49\xb9\xc8\x6f\x01\xa1\xc3\x4c\xa6\x2c\x2a\xb7\xb8\x81\xb1\x4e\xc8\x83\x07\xad\x9f\xb4\x56\xed\xf3\x94\x0a\x76\x2f\xdf\x5d\x52\x83\x0f\xf7\x5d\x4c\xcf\x54\x22\xd2\xcd\xd2\x5d\x65\x8b\x09\x7a\x6f\x87\xb4\x13\xd5\x09\xab\x42\xea\xa2\x84\x50\x44\xc8\x2b\xc3\x46\x36\x7b\x79\x3e\xe7\x94\x4c\x50\xc8\xad\xd8\x6e\x04\xde\x25\xb7\x0e\xd1\x76\xb9\x83\x0a\x2f\xa7\xe7\xcf\x4f\x6c\x8a\xb3\x04\x08\x2e\xb0\x83\x40\xd2\xfb\x58\x1a\x61\xff\xd6\xec\x68\x0f\x9a\xd9\x6b\x25\x22\xff\xb0\x4c\x29\x5d\x85\xfb\x8d\xdd\xf7\x9f\x2a\xe0\x93\x3b\x78\x02\xff\xee\x04\xfd\xae\x4b\x82\x3f\x5b\x05\xfe\x8d\x09\xdf\xb3\xd0\x4f\x76\xb3\x90\x77\x13\xfe\x37\x80\xfe\xdd\x29\x00\x3b\x4e\xbb\x8b\x22\xb0\x31\xe9\x4f\xca\x80\xff\xe9\x78\x61\xba\xe3\x65\x4d\xf7\xbb\xe7\xae\x0e\x74\xed\x0a\x02\xe9\xa6\x24\x90\xee\xd7\x38\x5d\x66\xd0\x41\x61\x20\x3b\x2b\x0d\xa4\x3b\xae\x3f\x29\x0f\x9d\x0e\xae\xce\xaa\x61\x6b\x93\xdd\x14\x09\x18\x54\xa6\x36\x5f\x4e\xc7\x34\x36\x41\x87\xf2\x69\x1e\xbe\xd8\x4d\xa4\xbe\x2c\xdb\x22\xcd\xe2\x81\x55\xdc\x8c\xec\xca\x61\x76\xaa\x86\x59\xba\x0d\x1b\x99\xc5\xd1\x43\x2a\x92\x61\x01\xda\xe8\x3e\xd2\x43\x5e\x5a\x69\x0c\xec\xed\x97\x7e\x6c\x80\x5d\x6b\x6a\xf4\x27\x08\x5e\xc4\x37\x0c\xeb\xb5\x00\x34\x78\xd2\x02\x3d\xba\xf8\x9c\x8c\xaf\x52\x46\xfe\xec\x89\x7c\x80\x19\xe1\xfe\x62\x45\x50\x4f\xee\xa0\x8f\x39\xc2\xfc\xb3\xfb\xed\x2f\x1f\x6a\x32\xc4\xaf\xed\x20\x31\x3d\xc3\xcb\xb7\x30\xc3\x2a\xb3\x93\xb3\xb9\xec\xb4\x74\xc1\x3e\x70\x9d\x65\x83\x1e\x6d\x98\x50\x9a\x96\x1a\xab\x08\xb5\x82\x30\x0e\x68\x33\xfc\xe8\xa5\xbc\x40\xa5\x94\x0d\x20\x10\x85\x65\xc5\x13\xd8\x9b\x2f\xe5\xb3\x5b\x16\xe7\xcd\xb5\xbe\xf1\xa7\x23\xcb\xda\xcd\x8c\xfa\x1f\x85\x44\x85\x33\x2b\x49\x54\x05\xe9\x85\x32\x55\x2b\x66\xae\xd8\xba\x38\xd9\xad\xbc\x56\x4a\x6e\xe5\x82\x30\xa7\xcc\x1e\xd4\x7f\x72\x65\x06\x96\x53\x17\x11\x6c\x23\x4d\x65\x30\xba\x43\xa8\x11\x55\xd3\x14\x3f\xf3\x5f\x64\xd1\x7d\xb5\x83\x40\xe7\xf9\x6e\xbd\x28\x17\xc8\x6f\xcf\xfe\x95\xd3\x34\x22\x4f\x9d\xa2\xa7\xa5\x7b\x64\x1b\x6d\x9c\x13\xbe\xfa\xad\x51\x98\x6c\xa4\x8f\x92\xb6\x66\x1c\xec\xf1\x98\x0a\xbf\x91\x8b\x35\x52\x58\x28\x6d\x45\x33\xcd\xe3\x3c\xa5\x19\x31\x7b\x61\x2e\xb3\xf5\xbd\x60\xb4\x20\x9a\x0b\x16\x4b\x91\xec\xa2\xd1\x5c\x56\xfb\x86\x38\x06\x3d\x92\x65\x5c\x62\x19\x4c\xbe\x64\x55\x22\xdd\xc3\x3b\x4a\x1f\x42\x3e\x73\xbb\xda\x6f\xb1\x01\x91\x7a\xc1\xb2\x1b\xae\x58\xc5\x87\xc2\x56\x4a\xe9\x07\x9c\xcf\xef\x8a\x72\x49\x46\xae\xbd\xf6\xcc\x7c\x1d\x6e\x47\xb2\x16\xd9\xc5\x86\x9a\xc9\x0c\x52\x85\xef\x25\x12\xab\xb1\x5e\xf3\x58\xf7\x23\xf2\x0f\x96\x49\x0c\xc8\x63\x73\xbc\x7f\xb6\x24\x5e\x38\x78\x61\x79\x52\xaa\xc8\x3e\xd9\x83\x6e\x84\x2f\x97\x2c\xe1\x54\xb3\x74\xdd\x77\xb7\x6a\x6a\xad\x34\x5b\xb6\x2f\x5d\x90\x10\xe1\x51\x7b\x44\x48\x57\xaf\x2b\x00\x76\x87\x95\xfd\xd1\x5d\xd7\x16\x98\x71\xf9\x5d\x4b\x4b\xe8\x8f\x17\xd9\x22\xdb\x87\xfe\x70\x85\xb7\x80\x63\x33\x7e\x81\xff\x69\xe8\x80\x92\x8c\xcd\x81\xca\x6d\xed\xf5\x0f\xa7\xf1\x0f\xb6\x84\xb7\x0e\x60\x14\x0f\x1e\xd3\x0e\x65\x1e\x5c\x4b\x87\x56\x97\x85\xb9\x48\xad\x51\x23\xbf\xb8\x4e\xd1\xef\xc2\x90\x77\x19\x7e\x22\x9c\x8f\xd1\x83\x31\x59\x6c\x15\x10\x4a\x62\x23\x00\x8b\xf2\xc7\x9f\x8b\x59\x46\xb1\x32\x67\x9e\x31\xac\x21\x37\xab\xb5\xd7\xd9\x9c\x2d\x9b\x66\xbb\xd2\xb0\x6e\x17\xc6\x41\xb3\x4b\xb9\x92\xa9\x9c\xa3\xd6\xc9\xc3\xc1\xac\xd9\xb1\x79\x18\x5e\x02\xaf\x18\xe8\xb5\x9b\x32\x34\x84\xb4\x10\x53\xe6\x46\x1d\x1b\x1d\xf6\xc0\xa2\xe1\x66\xc1\x0c\x61\x6f\x7c\xc2\xa7\x0e\xe5\x8a\x5c\x80\xd2\x61\xc7\x34\x9d\xbf\xe7\xf3\x45\xba\x3e\x75\x89\x26\x06\xe0\xfb\xcb\x62\xc3\x6e\xd2\x75\xd4\xd9\xbc\x58\x27\x77\x6e\xcb\xb1\xd2\xce\x48\x20\x47\xfb\x69\xb2\xe4\xdd\x0a\xea\xf6\xca\xed\xeb\x0b\xea\x42\x25\x36\xb0\x7c\xb2\x1b\xfc\x00\x89\x53\xca\x97\xaa\x92\xc1\x15\xd3\xb6\xcb\x1b\x83\xa0\x84\x89\xb5\x6b\x45\xe3\x4c\xda\x34\xc6\xe8\xc0\xd0\x77\xf6\x6e\xcb\x59\x3c\x96\xdc\xaa\xfa\xf2\xb6\x46\xc6\xf7\x75\xa2\x2b\xb0\xc2\x2a\xa1\x55\xd3\x5e\x1e\x10\x3a\x95\x39\x48\x58\x3c\xf3\x83\xde\xed\x46\xc1\xc1\xfa\xea\x46\xb0\xcc\x08\x1d\x1d\xef\x15\x36\xfa\x55\x52\x7b\x62\xdd\x75\xba\x64\x8d\xc8\xd9\x2c\x81\x07\xd8\xfa\xb1\xe4\x2b\xeb\x4a\x8a\x4e\x04\x19\x92\x0b\x9d\xf1\x58\x9f\x90\xa7\x32\x30\x00\xdb\x4c\xfe\x5c\x90\x84\xcf\xa0\x02\x8f\x0e\x3f\x02\x3e\x7e\x94\x2f\x89\x4f\xff\x6c\x20\x8b\x70\xc0\xe7\x42\x63\x4e\x7b\x68\x6c\x7d\x8a\x4e\xd0\xb9\xc8\x97\x08\x70\x03\x14\xc3\xaf\xa8\x5e\x14\x79\x04\xdd\x98\xd6\x87\xb7\x3a\xcd\x0a\x09\x54\x3c\x4f\x70\x4a\x77\xae\xd5\x51\x3b\x81\x96\xf6\xf8\xb9\xd6\x23\xa7\xe5\xe8\x72\xf2\xe2\x2e\x65\x77\xca\x7d\x2a\x44\x62\x51\x0c\xd5\x2f\xbc\x30\x0a\x1b\x92\xd7\xd1\x47\x90\x67\x7c\xf3\x4a\xee\xa7\xf2\x87\xec\x2b\x74\x63\x35\x47\x03\x7e\xea\xcd\xf8\x2d\x61\xb7\x2b\xa9\xda\x9c\x8a\xca\xe7\x06\xf4\x7c\xa0\x2a\x00\x22\xf3\x33\xc3\x39\xd5\xdc\x3a\xaa\x96\x8b\x67\xd1\x15\x1f\x4d\x53\x39\xb5\x77\x32\x58\x15\xcb\x1c\x66\x50\x60\x3a\x9a\xcb\xa2\xfe\xf4\x5f\x57\x09\x05\x8f\xa5\xca\x4c\xc0\x2b\xd1\x3d\x53\xce\xf1\xcd\x9c\x19\xee\xd9\x53\xae\x9c\xda\x68\x73\x31\xe4\x0a\x33\xca\x68\x23\xff\x85\x58\xa6\xd5\x69\x18\xdc\x5c\xe4\xd3\x44\x2e\xa9\x39\x08\x25\x51\x5a\xae\x88\x2f\x7c\x8f\xdc\xdd\x8e\xe0\x36\x65\x6e\x20\xb5\xe5\x2b\xea\xc6\x7b\x69\x76\x2d\x26\xdb\xc8\x98\x07\xa3\xc5\x87\x6b\x22\xaa\x93\xb6\xd7\x4a\x6a\x73\xe2\x46\xe2\xad\x9b\xb9\xb3\x09\xd7\x33\x5c\xc8\xb1\x56\xd3\xed\x03\xea\xe4\x54\x21\xeb\xd2\xb4\xf8\xf0\xdd\xf6\x60\xbb\xdc\x67\x56\xa9\xbb\x5b\x5e\xa9\x79\xbb\x4b\xde\x6b\x5c\xff\x4f\xee\x78\x9f\xdc\xf1\xba\xa0\x1f\x7f\x3e\xb9\xe3\x7d\x72\xc7\xfb\xbf\xe0\x06\xee\x93\x3b\xde\x27\x77\xbc\x7f\xb3\x1b\xb5\xff\x4c\x77\x3c\x9d\xaa\x0b\x16\xe7\x19\xd7\xeb\xf3\x4c\xce\x78\x5a\x6b\xd3\xab\x5c\xa2\x6d\xf4\x09\x82\x7d\x6c\x85\x68\xe5\x23\x9a\xaa\x41\x57\x56\xea\x28\x84\x8e\x2d\xd2\x44\x49\x98\xa7\x2b\x6e\xa4\x18\xd3\xa9\xce\xca\xe4\x2c\x50\x19\x53\x32\xcf\xe2\xaa\x5f\x54\x50\x87\xce\x0c\xf6\x2a\x4d\x06\xa8\x82\x59\xa3\x2a\x2e\xec\x0f\x32\x61\x99\x30\xa7\x24\x4c\x0d\x24\x7d\x84\x0a\x8d\xc9\xfc\x9a\xf9\x97\xe5\xfa\x59\x5c\x6d\xda\x4e\xc8\x94\xe9\x1b\xc6\x84\x39\x5c\x18\x85\xfa\x70\xa5\x84\x6d\x73\x7e\xcd\x84\x61\x6d\xd6\x7a\x80\x23\x69\xe9\xe3\xf7\x42\x00\xfd\x77\xf1\x12\x12\xf1\x62\x07\x26\x7f\x8b\xfe\x1e\xfd\xc3\x4c\x81\xe4\xab\x79\x46\x13\x90\xe1\x4b\x2f\xbf\x18\x83\x17\x97\xd5\x2f\xc0\x4c\x52\x3f\x11\x14\xfe\x83\x7b\xd0\x7a\x71\x11\xcc\x49\x79\x6a\xc3\x7c\x08\x25\xe6\xb1\xcc\xeb\xf5\xe1\x6d\x52\x07\xa6\xc8\xec\xa6\x95\xda\x74\x9a\xc0\x32\x72\xc5\xb2\xa1\x8b\xbb\x35\x04\xa7\x2c\x6d\xba\xb9\x45\xe4\x09\x23\xec\x56\x9b\xfd\x97\x9a\xd9\x67\x20\xda\x22\x1d\xf8\xd4\x9c\x0e\x11\x54\x11\x2e\x30\xc0\xb7\x84\x93\xa2\xb8\x33\xd5\x54\xe9\x4c\xae\x16\x3c\x8e\xc8\xa9\x70\x4b\x59\x1d\x28\x95\xf2\x4a\x91\x94\x5f\xe1\x45\x03\xd8\x40\x62\xbe\x5a\xb0\x4c\x9d\x90\x21\x79\x76\xf6\xf4\xfb\x67\xc3\x67\x67\x4f\x2f\x4e\x87\x67\xdf\x9f\x9e\x7d\x7f\x7a\xb0\x3f\x3c\x7f\xf5\xe2\xef\xe3\xc3\xfd\x63\xff\xfe\xf5\xd6\xb7\xa7\xcf\x2e\xc6\x07\x8f\x87\xdf\x9d\xfd\x30\xbc\xf8\xfe\xf4\xe0\xf8\x51\x65\xec\xcd\xf7\x4b\x2e\x2e\x5f\x5c\xd8\xd2\xb4\x27\x06\x65\xd7\xe3\x68\xdc\xa4\x46\x75\x0a\xb4\xed\x22\x51\xba\xb9\x77\xf5\xb6\xe8\xd9\x0e\xa1\x6a\xe3\x12\xb7\x82\x0d\x14\x5e\x13\x9a\xce\x65\xc6\xf5\x62\x69\x73\x16\x41\x9d\x57\x36\x97\x9a\x83\x8e\x6b\x45\x07\xd3\xc1\xd0\xc6\x82\x8a\x44\x2d\xe8\x15\x8b\x88\xbf\xc6\x43\xd7\xc6\x8c\x2d\xe5\x35\x83\x04\x78\x36\x30\x97\x67\xc8\xf9\x45\xa2\x88\xbd\xbb\xb1\x6a\x6d\x35\xe9\xa2\xdd\xad\x4f\x9f\x5d\x0c\xcf\x9e\x9c\x1d\x1a\x44\x13\xb2\xb7\xa6\xcb\xb4\x5f\x5d\xf6\xb0\x4d\x5b\x4c\x67\x07\xb1\xa5\xeb\x75\x5c\x17\x37\x9b\x12\x49\x74\x5e\xa1\x52\xb7\xa6\x75\x82\xa8\x7f\x9a\x92\x6b\xdb\xcc\xda\xdd\x20\xd8\x35\x93\x5a\xc6\x32\xf5\x35\xfe\x3a\x2d\x5c\x1d\xea\x4d\x13\xfb\x01\x45\xc6\xd1\x78\x40\xc6\xd1\x01\x70\xf3\x71\x74\x18\x2e\x45\x3d\xf1\xc3\x31\xf1\xea\xf2\xd9\x09\x89\x7d\xd9\x77\xc8\xd5\xc0\xe7\x0b\xa6\x74\xb9\x97\xbf\xe3\xe7\x8a\xd8\x47\x97\x2f\x2e\xc6\x07\x6d\xcb\xd9\x25\x04\x31\x18\xab\x3d\x0f\x5b\xa9\x69\x7b\x2e\x85\x52\xd3\xf6\x4c\xe8\xa5\xa6\x6d\x95\x0a\x3e\xbc\xfe\x16\x81\x6b\x01\x77\xa8\x75\x63\xf9\x61\x0f\x64\xfc\x75\xbc\xde\x4b\x0b\xb0\xd8\xce\xb4\x77\xc3\xaf\x78\xb4\x94\xbf\xf0\x34\xa5\x91\xcc\xe6\x23\x27\xbe\x8c\x2e\x40\x98\x78\x77\xc1\x13\xf6\xee\xf2\xc5\xc5\x67\xe1\x61\xfb\x2e\x96\xcb\x15\xd5\x7c\x0a\xb5\x55\xde\x45\x07\x8f\x33\x16\xcb\xe5\x92\x89\x84\x25\xd1\xc1\x57\xe6\x0b\x86\xc2\x2a\x8c\xbe\x61\xe3\x5f\xbe\xb8\x78\x77\xfa\xec\xe2\xdd\xf8\xe0\xf1\xbb\xef\xce\x7e\x78\xe7\x99\xb5\x7b\x71\x70\xfc\xc8\xbd\x38\x7c\x7c\x64\x5f\x38\xde\xff\xce\xf1\xfe\x77\x1d\x99\xfc\x8e\x47\xc4\xc1\xf1\x23\xf7\x1e\x3f\x5e\xea\xdd\xf0\xf6\xae\x87\x57\x1b\x5c\xcd\x5f\xad\xdd\xb9\x8d\x9b\x6e\x87\xf2\xaa\x2d\x64\xba\x04\x51\xb0\x1b\x81\x62\xdb\x8f\x47\x9a\x28\x96\x96\x89\xf2\xbf\x94\x04\x6b\x17\xe4\xb0\x60\xa5\x67\x9e\x95\xe6\xa2\x08\x33\xf8\xb8\x0b\x26\xd3\x8e\xb5\x70\x25\x6a\x79\x1f\x67\xa9\x5e\xa5\xc9\xbb\x29\x8d\xaf\x6e\x68\x96\xfc\x8e\x16\xec\xbf\x1f\xcf\x28\xde\x35\x7f\xb1\x84\x8d\x66\x4c\xb4\xe1\xd0\xc8\x7b\xf5\x5d\xea\xb1\xd6\x8c\xb1\x5a\x6c\x15\xef\x1a\xba\x6c\xe2\xa7\x09\x37\xfe\x79\x1d\x1e\xeb\xf0\x57\x1d\xaf\x61\x1c\x04\xa2\x0c\x51\x28\x0d\xd7\xf2\x8a\xfd\x8f\xcb\x0b\xda\x0a\x95\xd4\x17\x28\xb1\x25\x47\x6a\xec\x02\xd6\x26\x20\x33\x72\x06\x5a\x5e\x64\xff\x75\xb9\xdb\xd1\xf2\x4d\xed\x26\x0f\x24\xe0\xa0\x80\x74\x2d\xa7\x29\xaa\x1d\x46\x9b\xdf\x0d\xcd\x11\x46\xaf\xa9\x1b\x41\x7d\x28\xb3\x7a\x5d\x08\x35\xef\x2a\x1a\xaf\xf5\xcf\xf1\x5f\x32\x30\x18\x39\x4c\x58\xd7\x1c\xeb\x6e\xc8\xd6\x68\x56\x40\x7b\x07\x86\x62\x72\xcc\x87\x25\xd8\x8d\x63\x68\x58\xae\x94\x5d\xb3\x54\xae\xec\x3d\x20\xbb\xe5\x0a\x8c\x07\x61\x93\x19\x64\x20\x43\x0b\x04\x17\x98\xbe\x27\x22\xe4\x29\xb8\x25\x81\x1f\xb1\x20\xab\x8c\xc5\x5c\x19\x6d\xde\x26\x25\x09\xfa\x17\x55\x55\xc0\x82\xbf\xca\x64\xcc\x94\x35\xe0\xc0\x6d\x90\x0d\x64\xcb\x58\x92\xc7\x35\x09\x69\x59\xd5\x00\xc4\x55\xa0\x15\x94\x62\xe6\xc8\x94\xa1\x2d\xa5\xf0\x08\x5c\x33\x4d\x6e\x58\x9a\x12\x9a\xc8\x95\xbd\x36\x35\xf8\x95\x82\x28\x39\xd3\x37\x06\xc2\x94\x4f\x33\x6a\xb4\xcc\x3b\xdf\x5d\xbe\x4a\xdb\xae\x2b\x43\x12\x6a\x69\x86\xd3\x6c\x69\x80\x24\xfe\x11\x6e\x3a\x75\x2e\xb8\x98\xbf\x5a\x35\x3a\xee\x53\xb1\x6e\x2a\xd1\xd9\xad\x10\xe6\x92\xde\x9e\x15\x76\xc7\x56\x17\xf2\x0e\x9a\xd9\x70\x9b\x92\x55\xaf\xaf\xdd\x37\xa8\x5d\xab\x5a\xf9\x4c\xf7\x07\xfb\xf0\xd3\xd6\xd4\xa5\xe4\x3b\xa8\x6f\x57\xe1\x93\xe1\xba\x35\x15\x3e\xa6\xc9\x3f\x73\xdc\xd5\xd6\x5f\x17\xc0\x16\x31\x6b\xf0\x47\x5c\xc9\x44\x45\xe4\x34\x4d\x9d\xa3\x94\xd9\x22\x98\x12\x9a\xa6\xc0\x26\xc0\x29\xc2\x9a\x43\x79\x16\xb8\xb0\x15\xfe\x01\x7c\xe6\xfc\x71\x2b\xbe\x58\x65\x90\x03\x57\xac\x52\x30\x37\x86\x69\x80\xe1\xda\xb5\xb9\xe1\x7a\xc1\x45\xa5\x3f\x57\x64\xce\x04\xcb\x68\x6a\x59\x41\xa8\x11\x6e\x66\x27\x72\xee\x74\x5c\x03\x3f\xc2\xcf\x2a\x5d\x31\x2c\xde\xcd\x5a\x0a\x65\x97\xbf\xe5\xe2\x92\x2f\x99\xcc\x1b\x63\x20\x2a\x26\xb5\x4a\x2f\xbf\x86\x0b\x79\x43\x52\x89\xf6\x50\x4f\x8c\xde\xcd\x6d\xc1\x52\xb8\xf5\x84\x1c\x50\x29\x23\x37\x94\x23\xaa\x5c\xf9\x26\x57\x03\xda\xe6\x15\x75\xb5\xaf\xe0\xa4\x19\x19\x49\x98\x89\x84\xc4\x69\x91\x90\xaa\xf8\x48\x8b\xf1\x5f\x5b\x20\xb9\x22\x63\xd5\xc4\x2a\xdd\xa6\x48\x2c\x3a\xef\xea\x1c\x85\x73\xb8\x03\x36\xef\x0f\x95\xb4\x8a\xc8\x6e\xb8\x39\xdc\xff\xe8\xc8\xc1\x2c\x97\x4f\xf2\xd9\x8c\x65\x4f\xd6\xba\x99\x9d\x55\xb2\x1e\x56\xbb\x55\xfc\xc7\x96\x39\x38\xce\x2f\x65\xb6\x0e\xbc\xc5\x32\x06\x84\x93\x90\x3d\x2e\xc8\xd4\x74\xeb\x03\x7a\x36\x92\xc8\x86\xf8\x55\xf6\x2e\x2f\xaa\x26\x0e\xac\xe4\xee\xd4\x24\x65\x54\x69\x32\x7e\x64\xe4\x5b\x8e\x39\x4d\x47\x07\x70\x65\x68\xcb\xac\x3b\xaa\xde\xfc\xde\x9e\x93\xae\xb4\x94\xa9\x8a\x38\xd3\x33\x90\xad\x16\x7a\x99\x8e\xb2\x59\xfc\xe5\xf1\xd1\x7e\x3f\x6a\xad\x1e\xb9\x39\xa8\xe7\x6e\xb4\x92\xda\x4c\xce\xc8\xe1\xc1\x97\x8f\x1e\x23\x0e\x4a\x3c\xaa\x3c\x7c\x23\x4b\x32\x12\xd8\xe6\x1a\x58\xd6\xe4\xad\xe5\x5a\x4a\xa2\x96\x34\x4d\x51\x32\xca\x18\xbd\x6a\x00\xd5\x30\xe3\x6e\xe3\xa5\x34\x9b\x9b\x5d\x6e\x96\x14\x45\xa4\xfa\x11\xad\xf9\x56\xf1\xb9\x80\xcb\x2f\x90\xb1\x80\x35\x5b\xb2\xd0\x0b\x2a\x88\x60\x46\x84\xa3\xd9\xba\x51\x60\xea\x72\x34\x16\xe9\x67\xcd\xda\xb7\x6e\x85\xb6\xf0\x87\x10\x01\x3f\xd0\xdb\xd7\x0c\x8a\xfe\xdd\x71\x57\x54\x06\xb8\xfb\xfe\xc8\xe4\xb2\x66\x69\x0c\x29\x87\x69\x6a\x33\xf8\x16\xdc\x7b\x89\x84\xd0\x95\x13\xa9\xef\xb4\xbd\xb8\x88\xe5\xd2\xf4\x0e\xf3\x93\x16\xbe\xd1\x58\x5f\x08\x24\xf9\xbd\x4d\xc8\x86\x6d\x78\xec\xe3\xb4\x06\x10\x54\x63\xbe\xb0\xd9\xdf\x6d\xe8\x39\x04\xc9\x64\x48\x27\x2d\x43\xde\xe7\xae\x7c\x3c\xfe\xea\xe0\xfe\x36\x65\x95\x04\xee\x6f\x7b\x76\x19\xf9\x77\xbd\x51\x5b\x0a\xde\x76\xda\xa7\xa9\x5e\x9c\x2d\x58\x7c\x05\x0a\xd1\x35\x4d\x3b\xef\xcf\x6a\xc7\xcd\xc3\xdd\x7b\x16\x67\x70\x84\x2b\x7f\xcd\xaf\x6f\xa4\xd9\x38\x46\x6f\x05\x11\xd5\xe6\x1f\x8d\xcd\x70\x10\x54\xc3\x75\xa9\x36\xa4\x95\x8e\x94\xcb\xa9\xe2\x63\x39\xdc\xed\xfb\x3c\x95\x53\xa0\x23\xaa\x02\x62\x04\x79\x21\x4d\xad\x6b\xef\x00\xca\xb2\x59\xc5\xd6\x28\xe0\x19\x4f\x12\x26\x8c\xf4\x3d\xc4\x40\x08\xeb\xf2\x89\x7f\x40\xa5\x12\xbc\xe9\x9f\xa0\x1f\x69\x56\xf6\xa3\xb0\xa9\x7a\x23\x04\x3e\x02\xe0\x23\x6e\x91\x31\xe9\x01\xd9\x3f\x83\x4a\x98\xe0\x5d\x96\x0b\x43\x17\x78\x69\x87\xc3\x5a\x37\x33\x28\xf4\x1a\xc3\xf5\x1f\xc6\x24\xa9\x01\x61\x34\x5e\xa0\x33\xb3\x17\xf2\x67\x19\x45\x36\x03\x5c\x89\xe4\x82\x1b\x25\x7b\x36\xe3\xb7\x03\xc2\xe6\x64\xd2\x3b\xdc\xdf\x5f\xaa\x49\x6f\x40\x26\xbd\x71\x74\xbc\x98\x40\x49\xba\x49\xef\x60\x71\x74\xbc\x34\xe0\xa0\x5f\x1e\x18\x1d\x4c\x67\x94\xbb\x27\x3d\x61\xfb\xe4\x6a\xd2\x23\x7b\xd0\xe5\x7f\xff\x4f\xf3\xfb\x5f\xbf\xd8\xdf\x7f\x72\x8c\x83\xfc\x9f\xff\x65\x9f\x1c\x3e\x39\xeb\x9b\xd6\xee\x4b\xf6\x9f\x25\xfe\xb3\xb0\xd3\x2e\xed\x76\x2d\x49\x6a\xd4\x19\x20\xfe\x63\x74\x11\xc0\x5d\xc4\x6e\xa1\x48\x8e\xce\xe8\xcc\xa8\x21\x49\x8e\x52\xb1\x94\x64\x06\x9c\x52\x68\x72\x79\x76\x5e\xa1\x0e\x98\x7e\x0c\x06\x63\x01\x25\xfc\x2e\xfe\xfe\x92\xac\x0c\x81\x68\xa2\xb4\xcc\x96\x98\x3a\x55\xb3\x4c\x50\x0c\xe1\x19\x38\x7f\x1b\x07\x8f\x84\x3b\x4b\x5b\xc8\x54\x81\x0b\x8d\x30\x9c\x3a\x63\x60\x25\x4a\xa9\x66\x22\x5e\x0f\x1c\x40\x4e\x36\xb7\x4e\x35\xc1\x15\xba\x04\x3a\x37\x5c\xa5\x08\x1e\x32\x44\xb6\xa0\xd7\x4c\x3c\x40\x43\xc7\x94\x31\x97\xe9\x15\xf9\x9a\xca\xe3\x05\x60\xe9\xd4\x45\xc1\xcb\x8c\xfc\xc2\x32\x59\xb7\x1b\x6d\xc8\xa4\x90\x44\xae\xb8\x70\xcb\x5f\x73\x0c\x2d\xa4\x54\x2c\x20\x7e\x5f\xfd\xae\xce\xdd\xc6\x9b\x9f\xa2\xe0\x02\x22\x94\x9c\xeb\x20\xf1\x9b\xee\x18\x59\x7a\xb9\xa7\xe5\x47\xe5\xd8\x6e\xd4\x4a\xbc\x27\x98\x4b\xf6\xbf\xd1\xe6\x60\x7c\xf4\xe5\xd1\xe3\xc3\x47\x47\x5f\x2e\x15\xd9\x3b\x38\x8a\x1e\x1f\x93\x84\xae\x21\x2d\xf4\x13\xa9\x17\xf5\x11\x57\xc1\x34\x1a\x53\x1f\xf9\x2c\xf1\x7b\xfb\xef\xf7\xde\xec\x0f\xbf\x7a\xfb\xc5\xde\x24\xc2\x5f\xfa\xdf\xec\x09\xf5\x3e\x57\xef\xff\xf7\xff\x54\xef\xff\xcf\xff\x52\xef\x97\xea\xbd\x7a\xbf\x7c\xbf\xe8\xf7\xbf\xe8\x37\xa5\x8e\xdf\xaa\x06\x74\x33\x55\x6c\xd4\x60\x0f\xfa\x78\x46\x1a\xe2\xac\x08\x57\x54\x7c\x99\xa7\x9a\x0a\x26\x73\x55\x72\x1c\xf3\xd5\x79\xa7\x8c\x30\x65\xd4\x69\xae\x16\x2c\x31\x0c\xce\xa7\x21\xb7\x96\xbe\x88\x3c\x47\x72\xf7\xbb\x02\xd7\x02\x56\x46\x21\xfb\x69\xa8\xf3\xac\xa5\x0d\x55\xc1\x93\x2d\x04\xc0\x90\xbe\x35\x0f\xc6\x12\x5d\xca\x0b\xd7\x48\x1b\x82\xeb\xdd\xce\xcc\x69\x60\xeb\x27\xab\x7c\x69\x6d\x8c\xe7\x2c\xb3\x91\x14\x85\x6d\xe0\xc4\x09\x21\xfb\x03\x32\x1c\x17\x7e\x85\x19\xd0\xc0\xc1\xfe\xfe\xfe\xd0\xda\x70\x5a\xf2\xbe\x9b\x3d\xb6\xbf\x55\x8e\xd1\x1b\xa9\x93\xe5\x8c\x1c\x9b\xa1\x71\x5f\x57\xbc\xee\x36\x29\x92\x0b\x32\xcb\x21\xb6\xb2\xf0\x64\x73\x30\xb1\x82\xde\x87\x63\x8c\x62\x74\xab\x02\x00\x24\x6b\x41\x97\x98\xc0\x1b\x32\x7e\xc1\x21\xe4\xd7\x1f\xfb\x96\xfd\xfc\xbc\x11\x37\x07\x39\x52\x39\x57\xcf\x2c\x17\xa2\x54\x9a\x3a\x22\x18\x03\x61\x1e\x0e\xc7\x64\x8f\x47\x2c\x1a\x10\x9a\x6b\xd9\xc7\x6f\x17\x6c\x90\x5a\x69\xc7\x7e\xcf\x2e\x11\x40\x93\x90\xbd\xff\x71\x0c\xa8\x36\x10\xbc\x5a\x31\x71\x61\xce\x42\xf2\x97\xaf\x8f\xa2\xf1\xbe\x8b\x6c\x55\x7d\xb7\x42\x19\x9b\x19\x0a\x01\x62\xaa\xd0\x1f\x7e\x95\x8b\x38\xcf\x42\x71\xc9\xc9\x49\x39\x64\xf6\x07\x1e\x9f\x15\xbe\x75\xd6\xc0\xec\x57\xc0\xad\x4d\xe9\xc8\xa1\x3e\x62\x1a\xbd\x65\x4a\xb2\x6f\x38\x0a\x3a\xac\x42\xb8\x06\x12\x26\xe0\x10\x01\x5b\x65\xec\x1a\xbc\x62\x83\x04\xfe\x0e\x72\xd0\x22\x94\xa6\x99\x86\xe0\x21\x5c\xdd\xb5\xcc\x2d\x0b\x36\x1c\x98\xab\x38\x63\xda\xe1\x70\x8f\x45\xf3\x68\x40\xbe\x04\x58\xfb\x05\xf9\xa2\x60\x64\xb6\x14\x57\x64\xc9\xe7\x59\x11\x6f\x24\xd8\x0d\x24\x77\x1b\x20\x1a\x1f\xc0\x01\x30\xcf\x69\x46\x85\x66\x5e\x71\xa7\xda\x37\x84\x62\xda\x3c\x61\x42\x1b\x02\xf2\xf4\x50\x88\x50\x66\xc3\xc3\xc9\x43\x28\x51\x31\x13\x34\xe3\xd2\xe7\x9c\xbb\x01\xc9\x76\x46\x79\x8a\xc1\x51\x34\xd3\x91\x9b\x95\x39\xca\xe0\x13\x36\xb8\xaa\x88\x10\xdc\xfc\x88\x9b\x6a\xd1\x46\xe7\x46\xe2\x71\x77\x23\x38\xf9\x36\x5c\xc1\xae\x30\x60\xcd\x73\x9e\x80\x3d\x14\x05\x08\xb3\x39\x87\x63\xbc\x15\x66\x3a\xd8\x4c\x53\x56\x50\x67\xb8\x81\xa8\x76\x05\xd4\x61\x89\xfe\x6e\xbe\x49\x05\x59\x4a\xc1\x21\xb9\x46\x48\x67\x46\x4c\xb4\xab\xe1\x77\x8c\x9d\xad\x0e\x0b\xeb\x93\x25\xd3\x19\x8f\x4f\xc8\x03\xdf\xec\x1d\x0e\xf4\xce\xc6\x92\xbd\x53\x4c\xbf\x03\xa5\xe7\x57\xdf\xe4\x6b\x27\x3d\x4e\x7a\x03\x1f\x31\xf9\xf5\xa4\xe7\x65\xc9\xa1\x65\xb4\x93\xde\x6f\x0f\xb6\x03\x0b\xf6\x61\x7f\x5d\x56\x21\x4e\x8c\x11\xfd\x7d\xcc\x65\x54\x0c\xec\xa1\xbb\x2b\x56\x3e\x44\x51\xda\xae\x04\x65\x2c\x95\x34\xd9\x4d\xff\x29\xf7\x29\x9f\xd8\x56\x12\x72\xaa\x00\x01\xa7\x6a\x57\x44\xc3\x2e\x8e\xd1\x5d\x8a\x28\x47\x1c\x0d\xf6\x7e\x1c\xb3\x15\xee\x6b\x3c\x52\x1a\x8f\x6a\x43\x21\x21\x9f\xa2\x71\x9c\x2f\xf3\xd4\x27\x99\xdc\x20\x8d\x81\xcd\x5a\x60\xaf\x07\x31\x11\x2c\x72\x82\xcd\x6f\x78\xe0\xcd\x67\xa0\x02\x83\x91\xe1\x99\xc0\xd2\x11\x7c\x69\x8b\x47\x98\x81\xa8\x13\x90\x1f\x28\x2f\x22\x57\x9c\xc8\x33\x99\x8b\x24\x93\x53\x2e\x6c\xd5\x09\xe0\xd4\xce\x29\x35\xaa\x8a\xeb\x09\xab\x9b\xb0\xd1\xdc\xec\x1b\xe6\x64\x74\xa2\xb8\x81\x02\x83\x35\x81\x53\x14\xa5\x59\xca\xde\xda\x90\xe5\x85\x5e\x39\xff\x74\x14\x5e\xfe\x95\xf3\xf8\xaa\x88\xf1\xdf\xa8\x61\x04\xca\x92\xd7\xd7\xe0\xed\x9f\xa0\xb2\xcb\x9f\x9d\x91\x73\x75\x35\x8f\xe6\x32\x4a\xd8\xf5\xc8\x34\xfe\xec\x9c\x66\x8a\x3d\xb5\x3d\xfe\x12\x88\xd7\x83\x12\x6d\x04\xd2\x16\xfa\x48\x8e\xd5\x60\xbb\x98\x3c\x3e\xd8\x57\x11\xf9\xc1\x49\xda\x22\xa9\x6f\xaa\xc2\x9b\xe6\x42\x22\xf1\xae\x9f\x72\x56\x1c\xde\x25\x5b\xd5\xcc\x30\x64\x37\x5b\x99\x6b\xc5\x13\x97\xd5\x42\xd9\xaa\x57\xaa\x5c\x8b\x5d\x97\x33\x53\x94\xf6\x84\x4f\x0e\x41\x57\x2b\x96\x8c\x66\xa9\x94\x99\xbd\xd3\x46\x6b\xcf\x3f\x51\x25\x82\x43\xa3\xf4\xe5\x19\x4a\xf6\x66\xba\x95\x51\x20\xe1\xc3\xc1\x3e\xa4\x43\x6a\x94\xe4\x8a\xd1\xc3\x1c\xbd\x48\x4a\x49\x25\x98\xb6\x60\x8d\x56\xaa\xc0\x74\x06\xa7\xa8\x90\x61\x13\x38\x1f\xca\x73\xd3\x2c\x4d\x55\xb3\x15\xc8\x1e\x6f\xc1\xb0\x81\x3a\x56\xdc\x93\x1f\xa3\x6a\xb4\x29\x46\x1a\xa0\x24\xd6\x90\xe7\x36\x40\xe3\xb2\x90\x69\xd9\x7f\x92\x51\xc1\x2c\xcb\xef\xca\xac\x80\x31\xe4\x55\x49\xaf\x6c\x6e\x03\xe9\x35\x10\xf7\x42\x61\x31\xb4\x3d\x38\x06\xc2\x05\x91\x53\x08\xfa\x15\xf3\x90\x8f\xd8\x70\x70\xbb\x27\x79\x46\x98\x48\x56\x92\x43\x28\x6c\x13\x8f\x71\xb4\x8a\xc4\xc2\x12\xb4\x7b\x38\x5b\x46\x6a\xc3\x53\x05\xbb\x31\x12\xbe\x4d\x5c\xe4\x03\xd7\x2d\xe1\x4e\xed\x95\x60\x42\x8c\xf8\x92\x22\x9b\xce\xa7\xca\xda\x43\x70\xe8\xdf\x93\xbe\x8b\x2c\x7f\xd7\x4b\xd6\x6a\xaf\x7b\xba\x64\xad\xdc\xa6\x56\x2f\x5b\xed\xd5\xe1\xef\xfb\x92\x15\xe7\x70\x07\x6c\xde\xe7\x25\x6b\x03\x22\x7f\x37\x97\xad\x7a\x91\x31\x9a\x9c\xc9\xbc\x3e\x3f\xfe\x26\x8a\x82\x0e\x25\x69\xad\xb0\xab\x60\x0b\xe5\x77\x66\xad\xd9\xe4\xcc\xbc\x04\xf9\xd9\xc8\x0f\xae\xcb\x07\x99\x4d\x06\x55\x9b\x09\x34\x68\xb0\x96\x60\x66\x71\x07\x56\x71\x94\xf8\x5c\x12\x39\x04\x65\x3f\x3a\x72\xb0\xdd\xf9\x4e\x67\xc3\x16\x62\x4b\x4b\x55\xb5\x70\xae\x88\xff\x98\xb3\x92\xf0\x06\x11\xa4\x64\x14\xd9\xf1\x4a\xa8\x22\xa3\x86\x2b\xe7\xd0\x51\x59\x8e\x06\x3f\x1a\xd0\x27\x35\x4f\xf9\x2f\x76\x2d\xce\xce\xff\xea\xce\xaf\x84\x65\x20\xe1\x0e\xc8\x4a\x6a\xa3\x4d\x03\x24\x46\x23\x86\xf3\x01\xb2\xa6\xe1\x20\x7c\x66\x13\x50\xa1\x31\x39\x22\xaf\x59\x92\xc7\x9b\xb0\x39\x98\x8a\x98\xc2\x86\x7c\x2c\x5a\x3a\x3f\x20\xb2\x92\x32\x4b\x3f\xf0\x7a\xc8\xb9\x37\x35\x66\xb2\xdb\x5a\x68\x74\xbb\xe2\xa4\x53\xf5\x5c\x80\x9f\xd1\x53\x96\xd2\x8e\xc9\x72\x2a\x9d\x5a\x6f\x8d\xcc\x71\xbd\x90\x69\x42\x12\xaa\x29\xa6\xed\x00\x39\xc5\xa7\x4b\x85\x76\x75\x57\x0e\x92\xa8\x85\xcc\x74\x70\xe0\x07\xc3\x9a\x81\xa8\xe1\x82\x34\xbe\x72\x47\x83\x23\xe7\x98\x65\x1a\x43\x4c\x51\xf0\x6b\xad\x60\x68\x0f\x6f\xa3\x84\xa1\xca\x43\xc9\x94\x99\x13\xb8\x80\x30\x1c\x2f\x76\xb7\xd3\xb8\x8b\x9b\x03\x7a\x11\x3f\x24\x01\x04\x81\xc5\xfd\xa3\xf3\xd3\x5c\x08\x96\xee\x74\xe8\x94\xba\xd4\x1d\x3a\xd8\x20\x3c\x7b\xf6\xb8\x88\xd3\x1c\x34\xd0\x1b\x36\x55\x32\xbe\x62\x5a\xf5\x1b\x8f\x24\xc8\x7a\x88\x83\x18\x95\x34\x49\x3b\x9e\x3f\xe3\xc5\xc7\x45\x57\xab\xdf\x66\x10\xc7\x71\x06\xd2\xe1\x2b\xbc\x6a\xac\xf7\x7c\x28\xa1\xb4\xb9\xab\x63\x69\xd6\x63\x1a\x4e\x82\xa2\x71\x45\x0c\x45\xe9\x5e\xd9\xba\xbc\xe0\x7a\xab\x4a\xe1\x25\x35\x70\x6c\xf5\x26\xdf\x25\xa0\x7e\x65\xbd\x2f\x86\xb9\xb8\x12\xf2\x46\x0c\xd1\x5d\xb0\x76\xec\xc6\x71\x31\xff\x61\x15\x67\x25\x7c\x61\x13\x97\x52\x05\xbc\x06\x33\x16\xe3\x81\x88\x42\x3d\x4b\x5c\x23\x1b\x51\xb9\x71\xe0\x55\x91\xd1\xe6\x53\xe8\x0d\xee\xaf\xbb\x66\x9f\xdc\xe8\x01\x2e\xd0\xfe\x70\xf0\x40\x16\xa6\x7c\x9f\xde\x91\xc6\xb1\xcc\x60\xab\x34\x86\x96\x07\x69\xb6\xeb\xd6\xf4\xc3\x92\x0f\xc6\x52\xe0\xd5\x4d\x87\x1c\x9b\x45\xdb\x72\x42\xa1\xe0\x79\xa1\x48\x05\x89\x2d\x7d\xce\x45\x97\x19\x76\xdb\x34\x61\x78\xdf\x89\xda\x4b\x59\x1e\xfb\xb3\x00\x9e\xb9\x21\x0a\xe1\x69\x8f\x47\x6c\x40\x22\xfb\xe9\xcd\x55\x61\xff\xca\x69\xaa\x48\x64\xf6\x57\xe4\x96\xa0\x6f\xcd\x42\x19\x43\x9f\xf9\xe2\x2a\x2b\x98\x97\x55\xeb\x6d\xae\x6c\x54\x42\x3c\xc5\xa1\xbc\x50\x33\xa3\x19\xa3\x46\x20\x42\xb4\xc4\x74\x85\x41\x11\xdc\x4a\x46\x0f\xc9\x0b\x49\x93\x27\xb6\x3e\xeb\x0f\x54\xd0\x39\x4b\xc8\x90\x5c\x66\x39\x33\x82\x47\xd9\x92\x1b\x22\x19\x5c\x4d\xf4\x09\x79\x08\xa2\x9a\x53\x59\xc9\x2a\x87\xbb\x40\xb8\xec\xc0\xec\x30\x6b\x97\x6e\x43\x59\x61\x9f\xc7\xac\x5c\x16\x36\x22\x43\xf2\x2d\x4d\x15\x7c\x91\x8a\x35\xee\x20\xa9\x58\xf5\x83\xb9\x50\x54\x73\x35\xe3\xf6\x4c\x2b\x43\xff\x9a\xd1\x64\xbd\x23\xec\xa7\x65\x48\xe0\x7e\x04\x91\x10\xd9\x99\x6d\xbc\x37\x42\xd6\xfa\x03\x40\x7e\xfa\xf2\xe2\x23\xe1\xd9\xac\xf0\x2a\xa5\x1a\x24\x3b\xcb\x83\xcd\xe7\xdc\x54\xea\xe8\x1d\x13\xd5\x61\xe6\x54\xd3\x2e\x11\xed\xa9\x3c\xfc\x15\x8c\x32\x23\x93\x5f\xa4\x30\x94\xf4\x21\xc8\xb8\xcb\xb2\x99\x4f\x97\x97\xca\x3c\x31\x22\x7c\x96\x28\xbc\x48\x02\x67\x08\x95\xc7\x46\x99\x9a\xe5\x69\x61\x07\xb9\x13\xb4\x75\xa7\x7c\x4b\x4e\x80\xda\xfc\xe4\x67\x6e\x78\x03\x3a\xe4\x42\xb6\x5b\x58\x24\x34\x4b\x8a\xaf\x5b\x97\xf7\xfa\x74\x34\xdb\x43\x18\x52\xaa\xf4\x65\x46\x85\x82\xc1\x8c\xd0\xd4\x1c\xc4\xe0\x85\x14\xaa\xd9\xd0\x88\x35\x8d\x2d\x3b\x44\x99\xdb\x52\xe7\x1f\x94\x8e\xc8\x68\x5d\x6d\x29\x0f\x3a\x0c\x51\x7f\x9a\xef\x34\x44\x5b\xa8\x5a\x17\x09\xb7\x4b\xc2\xa0\xfa\x0c\x10\xb8\x1f\xb7\x1e\x83\xc5\xb6\xc5\xfb\x18\x9d\xd3\xd4\x3f\x14\x46\x10\xab\xa3\x9e\x56\xc0\x1d\x67\x39\xf7\x8c\xe5\xc2\xf2\x95\xad\xd0\x34\x77\xad\x40\xe8\x39\x55\x33\x8c\xdb\xe8\x7b\x21\x95\x7e\x89\x45\xb9\x3b\xe9\x0e\x41\x7b\xd0\xef\x36\xe2\x63\xe0\xe2\x3d\x68\xd4\xc6\x61\x23\x72\x8e\x39\xea\x30\xa7\x94\x61\x56\x36\x54\x31\x18\xa1\x29\x8d\x54\x97\xe0\xa3\x85\xd6\xab\x73\x99\xb5\x96\xd0\xf0\xd5\xe0\x1f\xb7\x45\x12\x95\x91\x60\x87\x75\xcb\x01\x07\x83\xbd\x98\x83\x5c\xbb\x28\x5a\x14\x3e\xcd\x2e\x71\x88\x91\xae\x98\x28\xfc\x96\x9d\x57\x71\x14\x5e\x12\x14\xdd\x14\xb3\x49\x9d\xe0\x03\x8f\xf7\xf1\xee\x11\x0e\x4c\xb7\xe4\xc1\xe5\x97\xed\x27\xa4\x26\xb1\xe4\x22\xe6\x49\x70\x47\x02\xe5\x1e\xcd\x28\xe8\x7b\x63\x65\x6a\x7b\x0a\xd9\x3a\x1f\x25\x47\x97\x7d\x82\x19\xc1\x20\xee\xc8\x97\x52\xe4\xba\x94\xb4\xf4\xf1\x7e\x5b\x76\xba\x9d\x03\xba\x1e\x1d\x1f\x1f\x1e\x77\x09\xe7\x6a\x5b\xa9\x6e\x19\xfe\xe1\x16\xb0\x33\x69\x1c\x1d\xb5\x65\x0c\xd9\xa0\x0d\x75\x1f\xc4\x71\xb1\x1b\x75\x1c\x1d\x1d\xfe\xee\xc8\xe3\xe8\xe8\xf0\xdf\x95\x3e\x5c\xee\x9e\xee\xd9\x82\x7c\xb6\x9f\x22\x59\x5c\x98\xd3\x7e\xd3\x5e\xeb\x2e\x03\x7d\xa0\x41\xc9\x23\x10\x3d\x67\x56\xa9\x39\x82\x2e\xcf\xce\x0d\xba\xbb\x0d\x77\xfe\xfa\xd5\xdf\xfe\xee\xe1\x47\xdf\xbc\xd2\x23\xe7\x68\x08\x74\x07\x14\x50\x92\xc9\xad\x37\xa2\x93\x77\x39\xde\x6e\xca\xe5\x32\x17\x81\xa2\x04\xda\x99\x51\xac\x6c\xcd\x03\xa3\x32\xda\x2b\x9a\x60\x16\x40\x9e\x33\x99\xdd\x50\xab\x0f\x3b\xb9\x30\x9c\x67\xbd\x72\x4f\xc8\x5f\xc1\x5e\x5c\x81\xdd\x95\xc8\x6d\xbc\xc0\xcd\x18\x80\x8d\x5f\xda\x00\x93\x0b\xa5\x19\x4d\x6c\x35\x06\x99\xf9\x40\xce\x12\x0a\x1e\x28\xd7\xc5\xec\xa3\x20\xc2\x44\xd9\xec\x16\x73\x15\x91\xe0\xfa\x1d\x80\xaa\x01\x56\x8a\x36\x53\x3d\x1a\x37\x2b\xc8\x00\x57\x2e\x8e\x0e\x5c\xe8\xa8\xac\x7d\x2a\xb7\xb2\xea\x64\x4b\xa1\xb2\xea\x8a\xc3\x28\x88\xf2\xae\x98\x76\x25\xbc\x4e\x46\xa3\x9b\x9b\x9b\xc8\xb9\xc7\xcb\x6c\x3e\x4a\xe4\x8d\x30\xdf\x1d\x1d\x44\x07\xa3\x44\xc6\x23\x78\x35\xf4\xe4\xa5\x6f\xb5\x4d\x7b\x88\xbb\x19\x6e\xa4\x21\xfd\x7f\x0d\x1d\x5e\x96\x54\x91\x20\x86\x14\x13\xd2\xa1\xf8\xe0\x38\xdd\x09\x6a\x34\xa0\xa5\x81\x1f\xa8\xad\x6f\x42\x1e\x92\x49\xef\xf2\xec\x7c\xd2\xc3\x5f\xe1\x3b\xf6\x9a\xb9\xda\x38\xd8\x8b\xf5\x37\xfa\x66\x67\xb9\x2b\xfb\x2a\xc0\x95\xb0\xf9\x36\xef\xd1\x46\x83\x3f\xe9\x18\x82\xdd\x6b\x1b\xc0\x34\xb8\x3c\x3b\xdf\xd2\x02\xc0\xdf\xca\xf7\xb6\xe4\x53\x33\xc2\x7e\xf7\x63\x71\xfc\xd5\x61\x53\x00\x0b\xa9\xb3\xf8\x6d\x3b\x17\x59\x56\x98\x61\xac\xbb\x66\x60\xfb\x37\x84\x62\x85\x48\x17\x8e\xfc\xe1\x27\xdb\xf3\x19\x64\x4f\xbd\x05\x2f\xa6\x74\xd3\x3a\x11\xb8\x48\xb6\xed\x2b\x48\x2c\x5c\xd9\x58\x83\x4d\xc6\xe2\x40\xcd\x5d\x79\x26\x40\x02\x14\xf7\x08\x23\x25\xa2\x1a\x4b\x89\x61\xda\x8a\x89\x04\xd9\xd1\x2a\x93\x53\x66\x8b\xd8\xb8\x61\xc0\xa1\x0b\xf3\x6d\xa2\x07\xaa\xc7\xc1\x8a\xea\x05\x19\xe1\x27\x7e\x19\xa1\x80\xa0\x25\x84\x36\x64\x4b\x2e\xbc\x99\xa0\xc6\xa0\xe1\x0c\x34\xc8\x5a\x63\xc6\xaf\x99\x8f\xfa\xb0\x8b\x67\x3e\x86\x69\xeb\x50\x24\x77\xfe\xb9\xf6\x75\x79\x1a\xe0\xa1\x65\x56\xca\xa1\xd2\x0d\x86\xde\xb3\x32\x61\x81\xf7\x44\x88\x14\xcb\xaf\xbd\xbd\x68\x13\x43\x16\xb7\xe0\xf2\x06\x65\x02\x82\x83\x27\x08\xdf\x83\xb8\xf7\xc2\x3b\x5b\xce\xc8\xd1\x31\x51\xb6\x1c\x17\x9d\x19\x4a\xab\xa0\x0a\xdc\x6a\x55\x70\x60\x08\xa9\x87\x16\x8c\x73\xf0\x8d\x9b\x13\x76\xcd\xb2\x35\x39\x06\x2f\xa7\x7d\x37\xdc\xc0\x55\x35\x38\x1e\xe2\x13\x7f\xd5\x81\x71\xff\xf0\x4e\x2f\x32\xa6\xe0\xaa\xcc\xd0\xe6\x8d\x0c\x8c\x2c\xe6\xd0\x37\x07\x02\x4b\x8a\x09\x40\xe6\x90\x58\x2e\x1d\x76\xc0\x3f\x3e\x17\xee\x8f\xb0\x00\xce\x00\xb6\xcc\x0d\x4b\xd3\xa1\x66\x4a\x07\x55\x08\xee\x28\xcf\x99\xfd\xfe\xef\x29\xd0\x6d\xcd\xa6\x93\x06\x86\xcf\x4e\x8a\x70\xd8\xa1\x59\x13\xae\xd8\x65\x9b\xd4\xdd\xd0\xec\x7a\x81\x26\xdd\x0f\x51\x7b\xad\x9f\xdc\x05\x48\x3f\xaf\xc1\x17\xb5\xbb\x1c\x5b\xd3\x39\x38\x46\xa9\xcd\x0f\xe9\xee\x09\x9e\x9f\x7b\x51\x29\xc3\xb6\x5a\xda\xe3\x95\xc6\x18\x23\x26\x9b\xf9\x20\x86\xfb\x42\xd5\x17\x28\xbb\xfe\x8c\xc6\x0b\xcb\xa8\x9d\x2b\x67\x41\x8c\x28\x06\x9d\x3d\x7f\xfa\x9a\xf8\xf8\xbe\x3d\xeb\xea\x36\xde\x8f\xe0\xbf\xd1\x63\xe7\xe5\x36\x4b\xf6\xf7\x4f\x4e\xcc\xdf\x18\xb2\x2e\xa4\x1d\x98\xab\xb0\xa0\xe5\xa4\xe7\x7a\xee\x4f\x7a\x18\x28\x7c\x7e\x7d\x04\xfb\x73\xd2\x3b\x39\x09\x9f\x3e\x42\x2b\xa6\xad\xcd\x52\x91\x25\xec\x2d\x1f\x4d\xd3\x0d\xa1\x13\x25\x13\x23\xda\xc5\x3c\xe5\xda\x88\xd0\x18\x33\x00\xb6\x49\x73\xc8\x31\x9a\xa5\x9c\x65\xf5\x7e\x9e\x28\x81\x24\x1c\x0f\x38\xb0\xc5\x82\x09\xa8\x66\x99\x40\x6e\x1a\x80\xec\xb8\xa4\x6b\xf4\x72\xb0\xae\x55\x86\xa2\xa6\x4c\xd3\x4a\x2d\x7e\xb3\x2a\x43\xb7\x2a\x43\x84\x7c\x68\x17\x32\x08\xa3\xb4\xac\xdc\x79\x7e\x0f\xff\xbc\x91\xe5\x1a\x4a\x0a\xfd\x65\xd2\xf3\x37\x12\xdc\x75\xa9\xf1\x0b\x2f\xea\x0f\x39\xd7\x59\x5e\xfa\x1c\x16\x0b\x0b\x5c\x7e\xf9\xac\x76\xbe\x45\x88\x50\x18\xd4\x72\x14\x8d\x0f\x5a\x05\xb2\x5d\xeb\xd0\x03\xc9\x61\xa1\x81\x2a\xb9\x9b\x69\x56\x28\xb2\x54\x8b\x2b\x20\x4c\xa0\x4b\x4f\x96\xbd\xfe\x96\x32\xf3\xce\x1d\x70\xef\xe7\x3d\xf4\x06\x7c\x0f\xff\xc7\x5f\xc7\xc1\xef\x07\x6f\xf6\x87\x47\xee\xf7\xe3\x37\xfb\xc3\xe3\xb7\xfd\x49\xd4\xff\xf5\xf0\xb7\xdd\xfb\x8d\x5c\x97\xf1\x81\x7d\x73\xf8\x66\x7f\x78\xf0\xb6\xff\xc7\xfe\xfb\xbd\x9f\xd5\xc3\x3d\x84\xe5\x74\xf8\x2d\x1d\xce\xde\xfe\x3a\x1e\x1c\xfd\x76\xd2\xff\xf5\xcb\xdf\x36\x9e\xbe\x3f\xe9\xf7\xdf\xd7\x36\x7e\xf4\xdb\xde\xc9\x46\xeb\xbd\x3d\x0b\x81\x85\x2a\x79\x3f\x4e\x92\xf7\x6f\xc6\xc3\xaf\xde\x7e\x93\xf4\xf7\xa2\xd6\xd7\x66\xaa\xfd\xe6\x0f\x1e\xff\xb6\xb7\xb7\xf9\xc9\xfe\xaf\xe3\xc1\x81\xe9\xf6\x31\x3f\x7d\xd4\xf8\x69\xd3\xad\xee\xd5\x37\xf7\x00\x4f\x0b\x40\x87\x8d\x00\x1d\x35\x00\xf4\xeb\xfe\xe0\xe0\xb7\x8f\x0b\xd4\x41\x23\x50\xc7\xcd\x40\x1d\x7e\x64\xa0\xc6\x8d\x40\x3d\x6a\x06\xea\xe8\x1e\x81\x3a\x69\xfa\xfe\x97\xcd\xdf\x3f\xbe\xb7\xef\xf7\xf7\x3e\x8f\xbe\xe8\x7f\xa3\x1e\xee\x4d\x46\x7b\x63\xd3\xf6\x31\x72\x8f\xf1\x5b\xc7\x21\x3c\x23\x81\xff\xf7\xfb\x7f\xec\xb7\x32\xb4\x8e\xe9\xbc\x3b\xe5\x46\x24\x1d\xf3\x7e\x27\x42\xe1\xd5\xf0\x92\x09\xdd\x5e\x16\x8e\x84\x7a\xad\xbd\x4f\xee\x2a\x32\x3d\xa8\xf9\x4e\x50\x83\xdb\x2a\x57\x29\x9f\xb1\x78\x1d\xa7\x5e\x07\xf5\xa5\xc9\x8a\xbb\x56\x42\x95\x92\x31\x66\x08\xf7\xda\x5b\x45\x72\xb2\xe7\xab\xf3\xbc\xb2\x77\xb6\xd5\xa2\x57\xae\xa8\x47\x44\x9e\x97\x65\xf8\x1f\xdc\x1d\x2f\x7a\xff\x87\xc1\xba\xee\x1a\xdd\x1c\xc9\x7f\x15\xee\x32\xf8\xc1\x07\x9a\x36\xb6\x23\xd3\xb4\xf2\xdf\xfb\x50\x0b\x86\x8d\x70\xc9\xce\xbd\x30\xbe\x93\x09\xb7\xd2\xd7\x4a\xf6\xae\xf2\x6c\xc5\x3c\x10\xd8\xbc\x8a\x8c\x6c\x28\xee\x82\x43\x6a\xba\xc6\xd2\x11\xa5\xd2\xad\xee\x23\x77\x2b\xbe\x59\x03\xe2\x87\x14\xe0\x0c\xb0\xd6\xa1\x66\x16\x21\xf4\x66\x6b\xb1\xa1\x8a\x36\x71\xa3\x8a\x24\xa2\x65\x67\x37\x5f\x4a\xc5\x67\x65\x08\x91\x78\xfa\xd3\x45\xc5\x2e\x7d\x37\x84\x19\x00\xee\x03\x43\xbb\x60\x89\x40\xc2\x35\xaa\x14\x8f\x5f\x74\xd0\x2b\x1b\xb1\x57\x33\xc6\x06\x69\x96\x71\x5a\x4d\x56\x28\x00\x8f\x76\x9c\xae\xda\xe8\x19\x36\x6f\x17\x50\x77\xc7\x08\x41\xd7\x34\x6b\x38\x7b\x9e\xa4\x6c\x8b\xd3\x6a\x3b\x6e\xea\x46\xaa\xd8\x7b\x9d\x8d\x07\x5c\xc4\x6d\x69\x75\x5b\x3e\x3e\x70\x6d\xb5\x79\x65\x78\x92\x32\x32\xc5\xc0\xf7\x4d\xb6\x1b\xa7\x90\xa4\xa3\x1a\x77\x52\x13\x7b\xb8\xa2\x99\x62\xe8\xe4\xa6\xee\x25\x10\x91\x9c\x12\xc1\x53\x9f\x6a\xc4\x7e\xad\x92\x5c\x64\x60\x34\x11\x9b\x2d\x96\xd6\xe4\x75\xb2\xd5\x2b\x2c\xc4\xe5\x77\x65\xbb\xbb\x69\xfa\x68\xdf\x27\xec\x69\x35\x7b\x77\x5c\xb8\x8e\xbe\xbb\xd5\x9f\xce\x65\xcb\xc2\xe6\xad\x15\xc3\x8a\x1f\x81\xce\x05\x1f\xb4\x3d\x6b\xc6\xb8\xd3\xf6\xb4\xe3\x74\xdd\x9e\x2f\x5f\x3c\xe9\x82\xf8\x9d\xd0\xd1\xee\xa1\xd3\x30\x7f\x9f\x58\x1a\xbc\xbd\xcd\xef\x72\xb6\xc9\xb7\x0d\xb9\x70\xa1\x34\x15\x50\xfa\xc4\x4d\x7c\xc3\x82\xe0\xca\x2b\x57\x84\x12\xb8\x04\x9a\xf4\x2c\x4f\x9a\xf4\x4e\xc8\xa9\x63\x50\x60\x36\x23\x4f\x4a\x17\x62\x4b\x7a\xc5\x14\xdc\x18\x98\xa3\x37\x61\x31\x47\x8b\x0a\xd5\x84\x71\x7f\x7b\xaa\x33\x2a\x14\x18\xcd\x53\xba\x66\x19\xd9\xbb\x3c\x3b\x1f\x5d\x5c\xbc\xe8\x13\x6b\xb7\x83\xb3\xc4\x16\xab\xb2\x4d\x20\x93\x22\x5c\xcc\xf7\xf1\x88\x29\x3b\xd6\x61\x6e\x58\xef\x67\x6a\xcf\x99\x52\xd2\xea\x44\xc6\x2a\xa2\x37\x2a\xa2\x4b\xfa\x8b\x14\x50\x8b\xf7\x14\x7e\x7d\x76\x76\x31\x82\x42\x97\x7a\xe4\x52\x48\x67\xf3\x9c\x27\xac\x62\xa0\xc1\xb2\xbc\x0b\xbd\x4c\x3f\x8b\xd3\xa9\xc3\xcd\xcb\x17\x4f\x10\x2f\xce\xe7\x66\x37\xbc\xb4\x22\xe4\xf7\x30\x55\x91\x4e\xb7\x9d\xcc\xa4\x93\x30\xea\x7e\x86\x8e\x82\x3a\xb6\x7e\xf9\xe2\x49\xe7\x0d\xd7\x89\x5d\x75\xa9\xa1\xe8\x3e\x6e\x86\xdd\xd2\xac\xf3\x56\x9f\xc7\x8d\x05\xd4\xdd\x4f\x79\x87\xcf\xe3\xd5\xdd\xc4\xb7\xef\xce\xce\xef\x45\x7c\x33\x00\xfc\xd7\x88\x6f\x9c\x09\x7d\x0a\x26\xec\xdd\x19\x63\xd8\xbb\x92\xf4\xd2\x7a\x49\x58\xe3\x38\x5c\xaf\x39\x03\xb8\xbd\x48\xaf\xbb\x8a\x54\x0d\xdc\xd1\x6c\xff\xef\x20\x83\x9e\xe1\x00\x17\x45\x8c\x09\x70\xd8\xda\x4b\x4d\xd0\x2b\xb1\x4f\x05\x18\x6b\xc3\xc6\x87\xf6\xfe\x95\x8a\x35\xc9\xd8\x1c\xad\xb2\x98\x32\x7a\xc1\xc8\x8f\xe7\x67\x55\xaf\x90\x7a\x75\x35\x0a\x99\x42\x9c\xca\x3c\x89\xe6\x52\xce\x53\x06\x1c\x21\x08\x3c\x61\x62\xce\x05\x03\xbe\x31\x5a\xc8\x9b\xa1\x96\x23\x07\xff\x30\x60\x0c\x5c\xcc\x3f\xc3\x8c\x81\xef\x2c\xd0\x96\x07\xbe\x90\xf1\xae\x38\x80\x2e\x15\x14\xa0\x58\x05\x27\xae\x43\x43\x30\x6d\x28\xa6\x6f\xb1\xb1\x67\xb4\xe4\x1f\xcf\xcf\xfa\x46\xcc\x33\x2f\x37\x28\x1e\x23\xd8\x3a\xa0\xa8\x92\xd2\x97\x97\x9c\x15\xc8\x94\x2d\xe8\x35\x97\x5b\x50\x59\xc6\x11\xe2\xd1\x4d\xfe\x33\x9c\x8a\xc5\xd8\x7d\xb3\x52\x24\xa5\x8e\x8d\x01\xe7\xf7\xcb\x4b\x3b\x33\xbf\x2e\x32\x4e\x69\x1b\x87\xe2\xcd\x76\x7d\xbe\xe9\xce\xef\xb4\x9c\xe0\xc3\x30\xca\xde\xe9\x4f\x17\xbd\x01\xe9\x9d\xfe\x92\x67\xcc\xfc\xf2\x84\x66\xec\x07\xa6\x69\x6a\xfe\xf8\xee\xec\xdc\xfc\xf3\x32\xd7\x54\xf0\x5b\xf3\x2b\xdc\x6d\x68\x1a\x5f\xd9\xda\xac\xbd\x1f\x2f\x56\x0b\x96\xb1\xde\x36\x39\xb0\xdb\x3a\x0e\x8d\xd0\xd6\xa5\x95\x01\xb7\x43\x3b\x3f\x9b\x0e\x6d\xbf\xdb\xe2\xd7\x82\xad\x2c\x2e\x3a\xb4\xf4\xa8\xea\xd0\xd6\x62\xb1\x43\xcb\xe7\x4f\x7e\xe8\x44\x85\x5b\x09\xb6\xdb\xc1\xbf\xf5\xd0\xef\x44\xf3\x2a\x96\xed\x04\x5f\xf6\xcf\x31\xad\x03\xab\x25\x26\x19\x32\xcf\x4a\x49\x90\x36\x1c\x64\xd8\xed\x4a\x82\x62\x79\x2e\x95\xe2\x46\xed\x0d\x09\xfd\x99\x75\xac\xe9\x21\xdd\x3e\xb7\x1c\xa9\x95\x70\xbb\x18\x15\xdd\x40\x5b\x9a\xb9\xcf\x7f\x98\x51\x71\xfb\xa2\x0d\xeb\x4c\xcd\x2d\xad\x01\xb1\x77\x75\x55\x10\xd6\xa3\xa9\x93\x9b\x82\x6b\xdc\xec\xa2\xe0\x1c\xa4\xac\xdf\xc1\x1d\x1d\xf6\x2b\xa3\x7c\x88\xf7\xc2\x27\xd7\xdb\x4f\xae\xb7\x9f\x5c\x6f\x3f\xb9\xde\xfe\xb7\x72\xbd\xdd\xca\xf5\x57\x19\xbf\xee\x5a\x4d\xd6\xb6\x6d\xe6\xf9\xe7\xb6\xc1\xdd\x78\xbd\xed\xfd\x89\xc7\x7f\xe2\xf1\x9f\x78\xfc\x27\x1e\xff\x89\xc7\x93\x7b\xe2\xf1\xbb\x97\xf4\x04\xef\xfe\x9a\xd4\x05\xc8\xdf\xea\x9c\x3c\x36\xd2\x3c\x38\xb9\xdf\x30\x32\x1b\x5d\xd1\x98\xce\xc3\x6d\x95\xff\xf0\x86\xbb\xd2\x50\xc4\x49\xff\x60\xea\xb5\xb1\x09\x25\xab\xf1\xa0\x43\xa2\x10\xd8\x74\x3e\xdd\xb0\xbb\x1d\x03\xda\x3c\xad\xfd\x1c\xc4\x69\xd8\xd4\x6f\x5a\x3a\x7c\x58\xaa\xf2\x69\x56\x30\xd5\x14\x3b\xf1\x36\xb4\xb2\x27\x2c\x58\xcc\x62\x29\x62\xb6\xd2\x6a\x64\xbd\x6c\xd4\xb0\xf8\xba\x7b\x36\xfa\xcc\x6c\x58\xcf\x27\xd0\xa8\x5d\xca\xfb\x30\x20\xb4\xd6\xb9\xa7\xea\xb2\x63\x60\x85\xd3\xd7\x6e\x81\xba\xb9\x3d\x50\x45\x70\x8a\xa0\x4b\x16\x95\x32\x33\x40\x0a\x07\x3b\x1a\x9e\xd2\xa2\x48\x23\x61\x13\x3b\xa1\xb7\xf2\x96\x34\x14\x98\x3e\x05\x30\x17\xff\x43\x0a\x4c\xc6\x62\x1f\xe2\x69\x6f\x9e\x02\x0e\x7f\x0a\x67\xb6\xf4\x3a\x6f\x39\x3f\x6a\x91\xe0\x08\xc0\xb2\x9c\xfa\xf4\xa7\x8b\x01\x5a\x91\xd0\x8e\xf5\xdd\xd9\xb9\x4f\xb0\xe1\xf2\xa7\x84\xb1\xe3\x5d\x48\x52\x62\xb4\x0b\xc1\xe0\x90\x22\x86\xa8\x3e\x96\x05\xe9\xc1\xa5\xf0\xfa\x20\x0a\x85\x98\xa5\x82\x3c\x06\x98\x65\xd7\x2c\x6a\x00\xcf\xe3\x7d\x98\xe8\xd1\xd1\x21\x46\xac\xe4\xca\xc5\xd4\x40\x56\x46\xee\xea\x26\x3a\x10\xac\xd9\xba\x21\x1e\xa9\x42\xdc\x35\x40\x5e\x73\xea\x63\x72\x10\x04\x8b\x56\x27\xf1\x4d\x04\x79\x2a\x19\xc6\x79\xb4\x8f\xf5\x91\x36\x31\xae\xbc\x8d\x34\x61\xb7\xab\x94\xc7\xdc\x50\x4c\x25\xb2\x0b\x10\x05\x2e\x15\x4b\x2a\x72\xc8\xe5\xd7\x01\xdc\x87\x1b\xd6\x8c\x3b\x72\x35\x1f\x35\xf6\xf1\x39\x5a\xf5\x53\x1d\xb9\x99\x41\x92\xbf\x25\x0b\x28\x0e\xca\x10\x87\xe9\xf4\xd3\x54\xc6\xd4\x96\xea\xf5\x4e\xf2\x7f\x22\x0b\x79\xc3\xae\x21\x48\x4d\x7a\x61\x54\x69\xaa\x79\x8c\xd1\x64\xb6\x1f\xa6\xb9\x84\xd5\xb0\x59\xcd\x9d\xa4\xe3\xbf\x69\xdd\x46\xac\x57\xa3\x63\x47\x1b\xd3\x72\xe5\x19\x30\x6f\xd6\x9d\x6b\x03\xd7\x1c\x5d\x2d\xad\x03\x6e\xd2\xd2\xca\x6e\x8e\x96\x16\x15\xb2\x6a\x3d\xe0\x1b\x65\x80\x76\x3b\x62\xa3\xd1\xb7\x55\x6c\xf0\x91\x14\x58\x26\x44\xd6\x7a\xb1\x94\x2d\x82\xd5\x1e\x95\xa4\x22\x35\xef\xef\x9c\x5d\x04\x72\x29\x3e\xbb\x35\xab\xae\x3a\xd7\xd4\xa9\x76\x2a\x27\x26\x4b\xe9\x94\xa5\x44\x39\xd8\x2c\x4e\xcd\x9e\xb0\x61\x93\xe1\x13\xd8\x0d\xa7\x2f\x9f\xd6\x67\xce\x23\xdb\x63\x41\x4a\x80\x9d\xb6\x7c\x1c\x81\xf4\x6f\xb0\xa0\x0f\xee\x78\x57\x99\xce\x88\x05\x57\xcc\xa6\x92\xa6\xc2\xbb\xe8\x62\xe3\x8c\xa5\xde\xee\x7e\xc5\x30\xf9\x94\x0d\xd7\x6b\xd3\xa2\x3b\x5c\x68\x5f\xb1\x56\xaf\xe7\xca\x24\xcd\xb7\x2d\x41\xe0\x6c\xcd\x03\x2f\xa5\xfb\x09\xe2\x6d\xbd\x61\x06\xed\x77\x50\x1d\xef\x45\x1c\x2e\x76\x00\xd4\xa3\x2f\x63\x2b\x34\x54\x28\x44\xf0\x03\x85\xc8\x34\xb4\xb3\xe0\x2b\x8c\x2c\x55\x0c\xa8\xc7\x05\x40\xa2\x5c\xec\x86\x40\x3a\x79\x2e\x06\x46\x27\x31\xff\x3c\xbb\xe5\x4a\xa3\x36\x6a\x4e\xcb\x97\x52\xc3\x93\x7b\x99\x2a\x82\xb0\xc3\x44\xad\xf4\x8e\x01\x48\xe0\xf0\x0e\xb5\xa4\x50\x03\x73\x13\xb2\x75\x8a\x3c\x52\x20\x20\x99\xc8\xcc\xcd\xc8\x07\x7b\x2a\x3b\x84\x73\x57\x14\x52\x0c\x41\xa7\xab\x1d\xc3\x22\x42\x66\x25\x3c\xb4\x0c\x67\x87\x02\xd7\x41\x7c\x03\xd2\x0e\xe4\xf1\x87\x5c\xf7\x78\xd4\x5a\x45\x85\xc7\x64\xc9\xb2\x39\x44\x28\xc7\x8b\x76\xf4\x76\x88\xda\xda\xe1\xde\xb8\x4b\xf8\x40\x97\xcb\xba\xa1\xa1\xb8\xd6\xf7\x0e\x9d\x8d\x8d\x3a\x5c\xe6\x6d\x83\x16\x58\xe6\x0b\xb3\x5d\x1b\x31\x54\x38\x6c\x9d\x77\xe0\x1a\x1d\xf0\xb8\xc9\xb3\x11\x00\xe4\x84\x4b\xba\x32\x54\xfa\xab\x61\x78\x40\x28\xbf\x91\x15\xe5\x99\x32\x12\x8f\x11\xb7\x52\x56\x7a\x67\x1d\x2c\xc2\x61\xcc\x08\x1c\xf2\x3b\xf2\x6b\x9a\x42\xad\x14\x09\xb2\x71\x8a\x0c\x57\xce\x36\x4e\x8a\x01\xb9\x01\xbb\x94\x61\x59\xde\x97\xb5\x77\xc5\xd6\xbd\xc1\x06\x65\xf7\x9e\x8b\x5e\x51\xb8\xa3\x44\xcb\x9e\x6f\x83\xee\xd2\x83\x77\xbd\xbb\x1c\x2e\x9d\x12\x97\x75\x4b\xcc\xba\xa4\xab\xa1\xa5\x02\x2d\x97\x35\x9e\x6b\x2e\x21\xe9\x77\x90\x85\x1b\x56\x65\x9b\x24\xb0\xd9\xa5\x26\x21\xab\x4d\xeb\x8d\x61\xa0\xb6\xc3\x96\x94\xa5\xb5\x89\xab\xdb\xa3\xb4\x21\xae\xb4\xb3\x08\x53\x6a\x5d\x11\x5f\x2a\xef\x3e\x89\x2e\x9f\x44\x97\x4f\xa2\xcb\x27\xd1\xe5\x93\xe8\xb2\xf1\xf3\x49\x74\xf9\x24\xba\xfc\x2e\x44\x17\xd5\xf5\xdc\x57\xc1\x91\x4f\x2b\x27\x1a\x04\x40\x59\xce\x82\xb2\xc8\xc0\xde\x9c\xd5\x97\x12\x89\x65\x86\x46\xd8\x30\x51\x7b\xcd\xed\xdd\x65\xa9\x26\x88\x2f\x0e\x01\x63\xd8\x2c\x26\x90\x82\x3c\x48\xa7\x23\x67\x9b\xd9\xe3\x77\xce\x1f\xab\x53\x75\x8e\xc5\x37\xb7\xa2\xa5\x68\xea\x8e\xbf\xcb\x17\x17\x61\x84\x5b\xd9\x71\xdf\xd5\x57\xe5\xc2\xa6\xd7\xb8\x8b\x78\x14\xf3\xd5\xa2\x25\xb6\xb6\xe2\x0e\x8f\x8d\x5d\x10\x1a\x98\x1c\xd1\x4d\x1b\x03\xea\xe0\x75\x51\x55\x30\xac\xd3\xcd\xe6\x52\x63\x3c\xb4\x65\xc0\x6e\x7a\x0b\x2a\x12\xb5\xa0\x57\x2c\x22\x3e\x23\x34\xd6\x6a\xc9\xd8\x52\x5e\x33\xc2\x84\xce\xec\xcd\x24\xb7\x39\xa9\x44\xa2\x48\x22\x31\xc7\x11\x9a\x3c\x23\x02\x89\xab\x7c\xbe\x0e\xeb\x17\xf0\xf4\xd9\xc5\xf0\xec\xc9\xd9\xe1\xf0\xe2\xfb\x53\x42\xf6\xd6\x74\x99\xf6\xe1\xea\xcc\x4d\x9b\x0c\x4b\x6d\x9a\x8c\x99\x5b\x18\x7f\xe7\x3b\xc3\x16\xf6\xc9\xc5\xe5\x8b\x8b\x1f\x31\x71\x4c\xc7\x92\xd5\x61\x97\xa6\x35\x81\xcc\x4b\x34\x75\x29\x69\x9c\x75\xd7\x60\xbe\xb8\x09\xb7\x84\xd4\x69\x91\xea\xd0\x6c\x9a\xf8\x9c\x37\xe3\x68\x3c\x20\xe3\xe8\x00\x18\xdb\x38\x3a\x0c\xd1\x5e\x9e\xa5\xe9\x77\x3d\x8e\xc6\x50\x58\xee\xd5\xe5\xb3\x93\xe0\xe2\x09\xd2\x86\xf1\xf9\x82\x29\x5d\xee\x15\x96\x6c\xb4\x8f\x2e\x5f\x5c\x8c\x0f\xee\x6a\x87\x0e\xc6\x68\x4e\x4e\x55\x6a\xd6\x54\xad\xa7\xd2\xac\x39\x71\x56\xa9\x59\x53\x42\xda\xbb\x17\x40\x69\x78\x55\xf3\x18\xb5\xc0\x52\x8a\x06\xa5\x65\x46\xe7\xe5\xb4\x0d\x2a\x9f\xfa\x3a\x0e\x05\x2a\x55\x4c\xcb\x3c\x0d\xf8\xb8\xd3\xd8\xce\xa9\x5e\x9c\xf8\x72\x0f\x8e\xb9\x07\xad\x0d\x91\x3a\xa6\xea\x1a\x87\xa5\x1f\xc2\xa6\x30\x48\xb5\x71\x43\x21\x89\x3f\x94\x7b\x9d\x90\x5f\x7f\xfb\xc3\xff\x1b\x00\x00\xff\xff\xa4\x45\x11\x07\xbc\x8c\x01\x00")
+var _manifests00CustomResourceDefinitionYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\xbd\xfb\x7b\xdb\x36\xd2\x30\xfa\xbb\xfe\x0a\x1c\x75\x7b\x6c\xa5\x12\xe5\x5b\xd2\x54\xdd\x6e\x8f\xeb\xa4\xad\x9f\x37\x4d\x7c\x62\x6f\xdb\x77\xa3\x34\x07\x22\x21\x09\x6b\x8a\xd0\x12\xa0\x6d\xb5\xe9\xf9\xdb\xbf\x67\x06\x03\x12\xa4\x48\x89\x72\xdc\x7d\xbb\xdf\x17\xab\x4f\x23\x91\xb8\x0c\x06\x83\xb9\x61\x30\xe0\x4b\xf9\xa3\x48\xb5\x54\xc9\x88\xf1\xa5\x14\x77\x46\x24\xf0\x4b\x07\xd7\x4f\x75\x20\xd5\xf0\xe6\xb0\x73\x2d\x93\x68\xc4\xce\x32\x6d\xd4\xe2\xb5\xd0\x2a\x4b\x43\xf1\x4c\x4c\x65\x22\x8d\x54\x49\x67\x21\x0c\x8f\xb8\xe1\xa3\x0e\x63\x3c\x49\x94\xe1\xf0\x58\xc3\x4f\x06\x6d\x0e\xf8\x72\x99\xaa\x1b\x11\x05\x6a\x29\x12\x3d\x97\x53\x13\x48\x35\x62\x73\x63\x96\x7a\x34\x1c\xce\xa4\x99\x67\x93\x20\x54\x8b\x61\x5e\x60\xc8\x97\x72\xb8\xcc\xe2\x78\xf8\xe4\xf0\x09\x36\x24\x93\x30\xce\x22\x11\xa4\x22\x16\x5c\x8b\x52\x5b\x43\x39\x59\x0c\xc2\x58\x65\xd1\x60\xc1\x13\x3e\x13\xd1\x88\x75\x4d\x9a\x89\xee\xf6\xaa\x5a\xc4\x53\x57\x6b\x30\x97\xb3\xf9\x80\xdf\x70\x19\xf3\x89\x8c\xa5\x59\xed\xd0\x8e\x4c\x66\xb1\x18\x24\x2a\x12\x83\x48\xdc\x88\x58\x2d\x45\xea\x55\x4f\xf8\x42\x8c\x98\x4c\x66\xa9\xd0\x3a\x54\x89\x49\x55\x1c\x8b\x54\x43\x2b\x29\x37\x2a\x2d\x35\xd7\xd1\x4b\x11\x02\x06\x67\xa9\xca\x96\x23\x56\x5f\xc8\xb6\x4a\x98\xb6\xb3\x74\x6e\x3b\x38\xcb\x3b\xc0\x77\xb1\xd4\xe6\xbf\xea\xdf\xbf\x90\xda\x60\x99\x65\x9c\xa5\x3c\xae\x03\x11\x5f\x6b\x99\xcc\xb2\x98\xa7\x35\x05\x3a\x8c\xe9\x50\x2d\xc5\x88\xbd\x04\x70\x96\x3c\x14\x51\x87\xb1\x1b\x4b\x57\x04\xde\x00\x61\x1d\xb1\x9b\x43\xfc\x09\x55\xe6\x62\x81\x44\x83\x3f\x61\x88\xc9\xe9\xc5\xf9\x8f\xc7\x97\x95\x17\x8c\x45\x42\x87\xa9\x5c\x02\x59\x8d\x58\x77\x6d\x08\xf4\x7e\x22\x34\xe3\x8c\xa6\xd2\x41\xc9\x0a\x30\xd9\x54\xa5\xcc\xcc\x05\x0b\xe3\x4c\x1b\x91\x06\xec\x6a\x2e\xfc\xf7\x21\x4f\x98\x16\xe9\x8d\x0c\x05\x7b\xb5\x14\xc9\x25\xe0\x99\xbd\x56\x99\x11\x8c\x27\x11\xfb\xaf\x6c\x22\xd2\x44\x18\xa1\x1d\x1a\x59\x4a\xcb\x41\x07\x6c\x9c\xb0\x9f\xe6\x22\x61\x3c\x59\x47\x32\x93\x9a\x85\xa9\xe0\x46\x44\x7d\xc6\x59\x22\x6e\xeb\xe0\x8b\xc4\x32\x56\xab\x85\x48\x8c\x57\x9e\x19\xc5\x78\x1c\xab\x5b\x06\x6b\x33\x4d\x78\xcc\x4c\xca\xa7\x53\x19\xc2\x9b\x54\xf0\x70\x8e\x83\x22\xc0\x35\x33\x73\x6e\x98\xb8\x5b\x2a\x2d\x72\x30\x55\x4a\xc3\xf0\xe0\xfd\xfb\x32\xe2\x46\x26\x33\x66\xe6\xb2\x18\x08\x5b\xf0\x15\x8b\x05\x8f\xa0\xf5\x48\xea\x34\x43\xb4\x23\xee\x96\xd9\x24\x96\x21\x9b\xf2\x10\xaa\x25\xc2\xdc\xaa\xf4\x1a\x06\x90\x88\x10\x0a\x69\xc6\x75\xf3\xe8\x52\x71\x23\x81\x1e\xb0\x87\x89\x60\xf8\x38\x62\x2a\x33\x88\x3b\xc7\x0d\xae\x73\x24\xc3\xaa\x8a\x54\xa8\x87\xa1\x4a\x42\xb1\x34\x7a\xe8\xc6\x38\xa0\xbe\x65\x32\x1b\x52\x4f\x83\xa2\x27\xed\xa6\x42\xdc\x88\x94\x2d\x95\xd6\x72\x12\x8b\x3e\xd3\xc0\xd9\x26\xb1\x60\x91\x98\xf2\x2c\x36\x3a\x27\x88\x65\xcc\xcd\x54\xa5\x0b\xc6\x53\xc1\x32\x2d\xa2\x80\x5d\x0a\xc1\x10\xb7\x53\x29\xe2\x08\x4b\x2e\x54\x0a\x75\x0d\x97\xb1\x9d\xee\x33\xb5\x58\x72\x23\x2d\xa7\x60\x31\x2c\x7a\x76\x38\x62\x97\x86\x43\x2f\xb7\xd2\xcc\x65\x82\x14\xf9\x4f\x95\x32\x62\x1a\xd8\x12\x67\x0b\x99\xc8\x45\xb6\x60\x6a\xca\x0e\x8f\xd8\x42\x25\x66\xae\x99\x4a\xd9\x31\xbc\x29\x4a\x6b\xb6\x7f\x3b\x97\xe1\x1c\x47\x22\x35\x8b\x55\x32\x13\x69\x2f\xe8\x7a\x8b\x63\x99\x02\x73\x30\xd2\xf1\x01\xf7\xe7\x71\xf6\xd2\xf3\xca\x72\xda\x83\x35\x67\xcb\x01\x62\x64\x82\x24\x24\xdc\xea\x15\x11\x2d\x54\x00\x95\x28\x65\x99\x0a\x2d\x12\xcb\xe6\xe1\x31\x4f\x98\x9a\xfc\x53\x84\x06\xd0\x96\x42\x45\xa6\xe7\x2a\x8b\x23\xa0\x8d\x1b\x91\x1a\x96\x8a\x50\xcd\x12\xf9\x6b\xde\x9a\x06\xf2\x82\x6e\x62\x6e\x84\x36\x4c\x26\x44\xdb\x37\x3c\xce\x44\x1f\x97\x1b\xd0\x49\x2a\xa0\x5d\x96\x25\x5e\x0b\x58\x44\x07\xec\x07\x98\x0f\x99\x4c\xcb\xa2\xc4\xc9\xad\x50\x2d\x16\x59\x22\xcd\x0a\xc8\xc7\xa4\x72\x92\x19\x95\xea\x21\xb2\xe6\xa1\x96\xb3\x01\x4f\xc3\xb9\x34\x22\x34\x59\x2a\x40\xdc\x00\x05\xdd\x88\x04\x06\xa5\x83\x45\xf4\x49\xbe\x54\xf6\x2a\xe8\x33\x2b\x60\x75\xda\xa4\x32\x99\x95\x5e\x21\x07\xde\x88\x6b\xe0\xc1\xb0\xb6\x39\x55\xb7\x63\x29\x50\x0a\x8f\x00\x2b\xaf\x9f\x5f\x5e\x15\x4b\x12\xd1\x6e\x31\x5c\x14\xd5\x05\xb2\x01\x51\x32\x99\x0a\xe0\x6e\x52\xb3\x69\xaa\x16\x38\x85\x22\x89\x96\x4a\x26\x86\x98\x9e\x04\xc6\xa2\xb3\xc9\x42\x1a\x58\xef\xff\xca\x84\x36\x30\x0f\x01\x3b\x43\xb1\xcd\x26\x82\x65\xc0\x14\x80\xfe\xcf\x13\x76\xc6\x17\x22\x3e\x03\x59\xfb\x47\xa3\x1a\x30\xaa\x07\x80\xbe\xf6\xc8\xf6\xb5\x0e\xff\xcf\x56\xb0\xd8\x2a\xbd\x72\x12\xb5\x71\x76\xa0\x00\x4c\x0e\x60\x0b\xbe\xcb\xa9\x0c\x73\x1a\x87\x87\x91\xd0\x32\x15\x11\x9b\x88\x39\xbf\x91\x2a\x75\xcf\xd7\xd8\x7d\x50\xe9\xa6\x69\x89\xc2\xc7\xce\xcb\xd5\x8b\xcb\xf5\x57\x15\xf8\xf2\x92\x0e\x3a\xa1\x99\x16\x06\x78\xb8\x65\x65\x34\xa7\x40\x44\xb0\x7e\x6e\x44\x2a\xa7\x2b\xf8\x45\x73\x1f\x02\x0c\x38\x28\xa1\xfb\x0c\x19\x0b\xca\x3c\x98\x77\x2d\x90\xe3\x8b\x04\x79\xd7\x22\x33\x19\x8f\x19\xf4\x05\x0d\x8b\x68\x26\x06\x46\xa4\x0b\x99\x00\x75\xe0\xea\x4c\x85\x48\xc2\x74\xb5\x34\x2c\x05\xc9\xa2\xab\x63\xde\x36\x6e\xf8\xa0\x5c\x13\xd1\x65\x86\xb3\x75\xc1\x0d\x30\x81\x86\xb2\x15\x64\xd4\x57\xf5\x30\xc3\x51\xe9\x81\x29\x4a\x05\x2a\x2e\x20\x13\x41\x18\x02\xd1\x59\x21\x49\x4c\x6a\x22\xd8\x82\x9b\x70\x0e\x03\x9b\x71\x99\x68\xbb\x60\x22\xa9\x01\xb5\x99\xd4\xf0\x06\x94\x17\xa6\x80\x9d\xdf\xf0\x58\x46\x35\x28\x05\xf4\x4d\x65\x6c\x44\x3e\x11\x3a\x60\xa8\x62\xd4\x01\xb0\xc8\xb4\x01\xac\xb3\x8b\xb3\xd7\xcf\x99\x5e\x25\x86\xdf\x05\x8c\x9d\x13\x93\x45\xd8\xa5\x66\x62\xb1\x34\xab\x3e\x4b\x5c\xd3\x30\x9b\x52\xb3\xa5\x48\x41\x5e\xc1\x42\xb5\x55\x44\x5e\x23\x51\x09\x55\x32\xa8\x8d\x18\x90\xe6\x80\x88\x44\xb0\xa5\xc5\xb0\xed\x1c\xc7\xcc\x78\xcd\x48\xf6\x74\xed\xe0\x53\x26\x62\x0d\xdc\x48\xd4\x8b\x77\x98\x08\xbb\x7a\x7c\xac\x00\xad\x44\x22\x91\x24\x56\x0a\x6d\xa1\x8e\x60\xe0\x23\x8d\x58\x34\xd2\xc0\x06\x86\x50\x7c\x6c\x11\x9e\xa6\x7c\xd5\x50\xe2\x6e\x50\xa8\x19\x03\xc0\xdc\x80\xea\x18\xb5\x90\x61\x6d\x25\x8b\xa6\xb3\xd3\x56\xd4\xe9\x0a\x97\xe8\x31\x54\xc9\x54\xce\x16\x7c\x09\x62\xd1\x70\x99\x38\x6e\x7f\xf1\xfc\x87\x81\x48\x42\x15\x89\x88\x9d\x9d\x96\xb0\x37\xc9\x92\x28\x16\x55\x72\x75\xab\xd5\xae\xf0\x7c\x0e\xf7\xb4\x5f\x97\x88\x8f\x47\xa0\x6b\x68\x83\x36\x86\x9d\x79\xab\x59\x5a\x42\x2b\x80\x92\x09\x4e\x50\x6e\x60\x00\x93\x9e\xca\x19\x4e\x3e\xea\xf4\x4d\x13\xb6\x6d\x95\xc3\x07\x1a\x69\x7e\x5b\xc1\x1e\x14\x76\x8c\xd8\xb1\xf9\x00\x1f\x12\xc3\x4d\xc5\x54\xa4\x22\x09\x45\x44\xf0\xb3\x05\x5f\x6e\x68\xbd\x05\xcd\x00\xef\x04\xe6\xde\x0c\xa4\x35\x60\x1a\x5e\x37\x4a\x9d\x0a\xf9\x14\xd3\x73\xa1\x62\x19\xae\x46\x9d\x16\xf8\xe8\x36\x54\xf6\xa8\xeb\x76\x2e\xcc\x5c\xa4\xcd\x8b\x13\x47\xa7\x09\x0c\x90\xfa\x0c\x6c\x72\x19\x95\x16\xab\x65\x58\xa0\x42\xa0\xd2\xcb\x43\x54\xbb\xb1\x51\x54\x54\x34\x1b\x77\x5f\x13\xa2\xc6\x5d\xd0\x57\xc7\xdd\x57\x08\x24\x8f\xc7\x5d\xd4\x89\x5f\x2a\x43\xe4\xda\x00\x8a\x4a\xe2\x15\x0b\xe7\x22\xbc\x76\xd0\x94\x20\x68\x21\x6f\x40\x2a\x59\x99\xf3\x25\x93\x06\x24\x18\x28\x2f\xd8\xe4\x7a\x53\x61\x2c\x78\x6a\xc4\x9d\x61\xdf\x5f\x5d\x5d\x00\xc8\x4b\xae\xb5\x99\xa7\x2a\x9b\xcd\xbd\xa6\x4a\x2a\xb5\xff\x11\x49\xb6\xd8\x44\x14\xdd\xa6\x8a\xf0\xd2\x21\x6b\x43\x11\x87\xbf\xce\xbd\x48\x77\x33\xd9\x0e\x08\xc3\x67\xa7\x9b\x5f\x17\x48\xb3\x54\xd9\xd9\x91\xc0\xc9\xa0\xf2\xda\x19\x75\xb6\xd1\xf4\x7a\x1d\x58\xf2\xbc\x58\xdb\x40\xa3\x9c\x69\x11\xa6\xc2\x54\x39\x26\x55\xf6\xa7\x1b\x2d\x77\x50\xce\x56\x0d\x94\x17\x58\xf3\x1c\xed\x60\xcd\x22\x95\xec\x19\x5a\x40\x58\x43\xa6\x4c\xdd\x26\x7e\x8b\xfd\x9a\x71\x01\x8c\xc0\x7d\x91\xd6\x41\xb6\x13\x7c\x96\xaf\x5a\x20\xa1\x35\x36\x55\xa0\xa0\x00\xbc\xd7\x62\xa5\x91\x82\x81\x8d\x8d\xa0\x9e\x89\x75\x10\xa6\x66\x54\x02\x7f\x2a\x63\xeb\x8c\xb0\xeb\x33\xd6\xc1\xb5\x58\x8d\xd8\xb5\x58\x55\x5e\x8d\x13\x10\xf9\x59\xa2\x85\x01\x5f\xc2\xad\x8c\xa3\x90\xa7\x51\xa9\x31\xc0\x64\x66\xd4\x82\x1b\x19\xf2\x38\x5e\xb1\x99\x48\x44\x9a\xaf\x25\x3b\x82\xab\x8a\xa4\x96\x9a\x74\x1b\x67\x16\xd7\xac\xde\x48\x2d\xb8\x4c\xd8\x3e\x8c\x47\x67\x13\xfb\x53\xf7\xb0\x55\xa8\x52\xf4\xe3\xb5\xbc\xa7\x41\xac\xdd\xca\x38\x06\x13\xa3\x0c\x18\x18\x7e\x33\x5b\x03\x0c\x66\xdf\x3f\xb3\xa7\x99\x49\x01\xaf\xda\xa8\x54\x20\xc6\xcf\xa7\x1b\x46\x0c\xa3\x42\x40\xf4\x9c\xa3\x9a\xbe\x62\x8b\x2c\x36\x72\x19\x0b\x5c\xfc\xc3\x23\x52\x6f\x23\x5a\xf3\x64\x5c\x33\xb9\x58\xc6\xa0\x9f\x9c\xbe\xb8\x78\xd9\x03\x08\x12\x5a\x18\x9a\xed\xcb\x40\x04\xa0\x7f\x81\x4d\xbf\x62\x93\x54\xdd\x6a\x91\xc2\x80\x53\xc1\xb8\x61\xb1\x9c\x88\xd4\xac\x80\x56\x53\x01\xea\x1c\x88\x4f\xdf\x25\x02\x68\x96\x9a\x2d\x04\x4f\xb4\xa7\x6c\xf1\x84\xca\x73\xaf\x30\xb4\xc2\x13\x85\x8c\x3c\xcd\xbd\x4e\x28\xfb\x51\x25\xbc\x16\x31\xf6\x34\xe5\x32\xa6\x76\xc9\x14\xc9\x52\x20\x4c\x8b\x7c\xc0\xea\x75\x02\xb4\xcc\xb5\xdf\x78\xa8\x78\x2c\x34\xb8\x6e\x72\xe2\x95\xc9\x00\x60\xf6\xf1\xb8\xef\x24\x49\x31\x93\x2a\x05\x45\x35\x1d\x38\x69\x13\xf5\x5a\xce\x65\xee\x43\xdb\xd3\x6c\x92\xc9\xd8\x0c\x64\xc2\x5e\x9d\x66\x66\x6e\x17\x6b\x1a\x74\xef\x61\x34\x6c\x52\x25\x4a\x2c\x66\xef\xe5\xba\xca\x60\x6a\x6d\xd9\x4d\x3e\x27\x75\x03\x4e\x27\x71\x3b\x24\x87\xd3\x00\x86\x36\xb0\x92\x5e\x0f\x01\x18\x3d\xfc\x04\xff\x61\x57\xaf\x9e\xbd\x1a\xb1\xd3\x28\x62\x76\x0e\x33\x2d\xa6\x59\x6c\x85\xa9\x0e\x3c\x87\x4c\x9f\x81\xad\xdb\x67\x99\x8c\xbe\xde\xeb\xd4\x8e\x64\x1b\xe3\xdf\xa2\x70\x94\x14\xdc\x05\x5f\x6e\xd6\x6f\xed\x32\x1e\x75\xb6\x20\xb4\x4b\xab\x1f\xb8\x0b\x7b\xf6\xf2\x12\x15\x22\xe7\x31\xdd\xc0\x79\x71\x51\xba\x05\x6a\x14\xe9\x6c\x59\x2a\x8a\xf5\x39\x15\x1c\x9c\x03\x1a\xf9\xe3\x23\xf6\x2d\x71\xa0\x17\x8a\x47\xdf\xf0\x98\x27\xa1\x48\x2f\xc9\x33\x9b\x7b\x35\xd0\x1d\xa9\xe7\xc0\x63\x51\xbd\x15\xb3\x55\x9f\x86\x52\xdf\x1b\x80\x0c\x7e\xa4\x34\xd2\xe4\xe0\xa3\xa6\x2e\xf2\x96\x2e\xa9\x21\x5c\x22\x8f\xac\xc4\xc8\xc0\xf5\xcd\xb8\xb7\x22\x6a\xc4\x4f\x7f\xcd\xf0\x71\x6b\xa4\xe0\xa9\x04\x1b\x62\xc3\x68\x8f\x7f\x5a\x68\xd6\xa5\x0d\x41\x71\xe5\xf4\x2f\x18\x16\x8d\xda\x8e\x4d\x26\x91\xbc\x91\x11\xd8\xea\x28\xd7\x98\x36\xdc\x64\xe0\x37\xd4\x8a\x1c\xc1\x49\x04\x6b\x3c\xd5\xc8\x13\xd8\xed\x5c\xa4\x02\x6a\x1a\x9e\xce\x84\x29\x7c\xca\x25\xdc\x8c\x13\x07\xeb\x02\xf8\x2f\x58\x1c\x89\xfc\x57\x26\x18\x5f\x28\x40\x45\x1c\xaf\xfb\x3c\xb4\x75\xda\x85\xeb\xae\x24\xcb\xb7\xc9\x28\xa5\x41\xa2\x06\x4a\x94\x12\xd8\x19\x2a\x6d\x6d\x0c\x49\x06\xb0\x00\xb8\x4e\x60\xa1\xa9\x65\x17\x1b\xd7\x49\xf3\x04\x6f\x27\xf6\xe6\xba\x3e\x75\xd1\x74\x34\x51\xbe\x6b\x04\xc7\x6b\xb9\x02\xf9\xae\x75\x9f\xa4\x11\x8b\x15\x8f\xd8\x84\xa8\x3c\x67\xa2\x20\x3c\xfa\x4c\x98\x30\x28\x4b\x7c\x5f\xfd\x01\x01\xc0\x61\x51\xa9\x04\x38\x5a\xca\xb5\x49\x33\x74\x68\x6e\xc1\x29\x52\x49\xe0\xdc\xde\xb8\xe8\x4e\x7f\xba\x1c\x39\x44\xb0\xda\x85\xb7\x0f\x9c\x8f\x3d\x77\x14\x83\x5b\x3e\x3d\x76\xfa\x6b\x96\x8a\xd1\xee\xf5\xbe\x3b\xbb\xb8\x57\x7f\xe7\xdf\xfc\x70\x06\x3b\x7d\xa3\x1d\xeb\x9d\xc6\x72\xc2\x27\x9c\xea\xb6\xaf\xf7\x42\x4e\x6e\x24\x28\x6b\xd0\x1d\xfb\x5e\x69\xf3\x92\x36\x3e\x00\x69\xc9\x8a\x78\x7d\xbe\x85\x00\xf4\x08\x7a\x03\xee\x15\x02\xe3\x78\xa9\x12\xd1\xcb\xa7\xcc\x28\xbf\x0d\x9c\xdb\x0d\x84\xb6\xbe\x9a\xee\x23\x31\xe7\x45\x87\xf5\x05\x2a\xa4\xef\x95\x67\x73\x15\x47\x9a\x2d\x79\xca\x17\xc2\x88\xb4\xd8\x33\xf1\x31\xe1\x46\x50\xc7\x92\x03\x76\x61\x1d\xd6\xd6\xf2\x93\x53\x94\x6c\xb0\x84\x7c\x3c\x34\x80\xb5\x6d\x64\xf0\x01\x87\xf4\x85\x4a\x4d\x73\x89\xdc\x40\x19\xb1\xa7\x07\x1b\x4b\xf9\x48\xa0\x66\x9d\x23\x62\x09\xdf\x95\xf5\x91\x00\x82\xc8\x7f\xba\xee\x94\x01\x77\x92\x48\xd0\x8e\x05\xad\xd3\x73\x06\x7a\xa6\x75\x51\x4d\x0b\x03\x5c\x39\xb1\x1d\x3c\x3d\x80\xfe\x78\x9c\x0a\x1e\x81\x72\x0c\x32\x33\xf0\x04\x00\xd5\x43\x83\x57\xc9\x24\x04\x03\x3e\xd7\x9b\x5f\xaa\x48\x00\x26\x58\xca\x93\x59\xae\xf9\xd0\xaa\x27\xf3\x27\xb7\xe5\xa1\x9f\x03\xb0\x87\xd1\x67\xe8\x4c\x21\x29\x40\x36\x39\x7c\x21\xd7\x7a\x7a\xd0\x34\x3b\xf0\x01\x9a\xe7\x06\x36\x85\xcd\xf1\xd1\x86\x72\x0b\x7e\x07\x1b\x5e\x23\xf6\xe4\xf1\xe3\xe3\xc7\x9b\x0a\xda\x9d\xb1\x11\xdb\x34\x53\x40\x42\xd8\xa7\x98\xd1\x0e\x77\xdd\x07\x28\x43\xb7\x26\x8d\x93\x93\xe3\x5d\x68\x43\x3f\x04\x71\x5c\xee\x46\x1d\x27\x27\xc7\x7f\x3a\xf2\x38\x39\x39\xfe\x4f\xa5\x8f\x65\xaa\x8c\x0a\x55\x3c\x6a\x3b\xef\x5d\x57\xa3\xc1\xf3\xb6\xa6\x14\xc1\x9e\x03\x7a\xc5\x65\x12\xaa\x05\xb0\xc5\xc2\x20\x43\xec\x81\xf9\xb5\x8c\x41\xd3\xba\x3a\x43\xef\x54\xbb\xe6\x2e\x5e\xbf\xfa\xf9\xbf\x73\xf8\x51\x8a\x94\x1f\x95\xf6\x75\x90\x02\x4a\x5a\x86\xdb\x00\xc9\x96\x48\x57\xd2\x00\x2c\xb4\x8d\x07\x8a\x27\x8e\x86\x76\x1f\x79\x14\x81\x56\x23\x34\x30\x14\x67\xbf\x7a\xa3\x40\xe6\x35\x55\xe9\x2d\x4f\x23\x18\xa1\x99\x43\xe4\x41\x65\x9c\xb5\xc3\x09\x18\xfb\x3b\xea\xd6\x15\xd8\xad\x5e\xa4\x1b\x70\x80\xa6\x36\x82\x6d\x7b\x5a\x03\x13\x76\x70\x20\x80\x01\xb7\x7e\xa0\xa0\xf3\x1a\x95\x50\xb0\xa7\x5d\x15\x60\xb3\xc8\xa9\xe7\x82\x47\x20\xe2\x40\x8f\x8d\xd5\x0c\xbc\xa1\x85\x3b\x13\x81\xaa\x01\x56\x25\x0d\x70\xa2\xfe\x1f\xf2\x6c\x1d\x19\x60\xbf\x33\x39\x65\x2b\x95\xa1\x1f\x01\xd6\x97\x33\x32\x4a\x30\xda\x9e\x51\x9f\xaf\xf4\x0a\xad\x58\x94\xb7\xc5\x34\x58\x18\xc0\xb7\x46\xc3\xe1\xed\xed\x6d\x30\xe7\xcb\x54\xdd\xad\x02\x95\xce\x86\x91\xba\x4d\xa0\xdf\xe1\x51\x70\x34\x8c\x54\x38\xc4\x57\x03\xd7\x59\x60\xee\x0c\xf4\x06\x2a\x26\x72\x7b\x70\x55\xf0\x89\xca\x4c\x1d\x1d\x5e\x95\x9c\x5f\xe4\x36\xe6\xa9\x6f\x07\x99\x9c\xd3\x91\xb9\x07\x75\xd0\x3e\x20\x3d\x3e\x60\x8f\xd8\xb8\x7b\x75\x76\x01\x4e\x65\xf8\x8a\xfd\x90\x87\xb9\x5a\xd8\x5b\x8b\x9e\x6e\xec\xf6\x37\xa5\xc6\x95\x05\x4b\xa0\x0e\x60\x6f\x82\x2b\x8a\xb5\xb6\x3b\x8b\x80\xd2\x70\x0e\xf2\xb4\x56\xf5\x6a\xe7\x25\xde\xea\x29\x86\xff\x06\x00\xe8\x96\x12\x08\xfe\x86\x32\x5b\x9c\x06\xb4\x11\x6e\xb8\x69\x2f\x16\x0f\xbf\x38\x7e\xd2\x96\x3f\xe6\x2d\x6f\x90\x8b\x68\x7f\x02\x77\x81\xb2\x45\xac\x02\xfa\xbb\x52\x5c\x0e\xa4\x44\x8a\xe8\xa1\x24\x1b\x78\x0d\x93\xc2\xd4\x2d\x2f\xb1\x7c\x0f\x2c\x03\x9f\xe1\xa6\x75\x25\xf5\xfa\xc2\xea\xaf\x33\x16\x07\x2a\x2c\x7c\xac\x84\x48\x00\xba\x9f\x0b\x1e\x9b\x39\x6d\x7e\xd8\xc1\x95\x6b\x02\xd3\xd6\x22\x89\x50\x37\x80\x65\x05\x21\x6d\x2a\xf1\x9a\x81\x95\xc7\x66\xf2\x46\x24\x0c\x22\x0d\xfb\x05\x0e\x96\xdc\xcc\xd9\xd0\x76\xf1\xeb\xd0\x2a\x08\x10\xc2\x05\x5a\xfb\x42\x26\x02\x18\x4e\x83\x91\x8a\x41\x3d\x54\x3e\x15\xa1\x90\x37\x22\x8f\x31\xa3\xc9\x83\xce\x02\x74\xcb\x58\x95\x1c\xa2\xf5\xac\x91\x5a\x83\x00\x74\x1a\x00\x53\x73\xa8\xf4\x02\xd6\x38\xc2\xcd\xb2\xc4\xc8\x18\xab\xfa\x48\x21\x7e\x4d\xe0\xd4\x61\x88\x70\xcb\x63\xad\x98\x36\x6a\xe9\xba\x00\x5e\xe3\x54\x29\x3f\xf2\xea\xce\x45\x59\x9d\x3c\x86\xad\x04\x95\x44\x9a\xf1\x29\x38\x16\x2a\xa8\xd2\x86\xa7\x46\x7b\x02\x23\x51\x66\x40\x60\x5c\xa4\x6a\x02\x1d\x40\x14\xd6\x8a\x3d\x06\x6a\x38\x3c\x70\xcd\xd1\x14\x70\xf6\x78\x60\x9f\x30\x23\x17\x02\x78\x0d\x4f\x48\xf4\x72\x66\xe6\xa9\xd0\x60\x4a\x81\x0c\x35\xb7\x8a\xe9\x2c\x0c\x85\xd6\xe0\x1e\x54\x29\x0a\x04\x11\x15\x03\x30\x8a\x4d\x44\xa8\x16\x0e\x3b\x2b\xd0\x0c\xb2\xc4\xfd\x48\x05\xb0\x3c\x23\x6f\x44\xbc\xea\xe3\x92\xb9\x15\x71\x3c\x80\x40\x2a\x2f\x3c\xea\x9e\xfa\x1c\xac\xf7\xff\x4c\x85\x6e\x8b\x4b\x94\xb1\xd8\x33\xfa\x47\x9d\x16\xfc\xcc\xaf\xd0\x6c\x09\x97\x28\xb4\xd9\xdc\xad\x71\x39\x7c\x88\xd9\xeb\x02\x60\x50\xfb\x79\x0d\x02\x4a\x8f\xda\xf2\xe9\x6e\x4d\x65\x4f\x8c\xf2\x04\x5c\x7c\xea\xd6\x05\xd0\x9c\x5f\xe4\xaa\x12\x32\x58\xa4\x13\x2b\x5e\x61\x8b\x58\x13\x7f\x6c\x58\xab\x10\xea\x29\x40\x24\x85\x06\x58\x3a\x7b\x0e\x51\x95\xd8\x4e\xee\x5d\x2c\x88\xd1\xaa\x41\x67\xe7\xcf\x5e\x33\x17\x48\xce\xf6\x45\x30\x0b\xd8\xb8\x7b\x78\x10\xe0\x67\xf8\xd4\xed\x3d\x4f\xa3\x83\x83\xd1\x08\x7e\xf7\x90\xcb\x27\x8a\x1a\x96\x45\x24\x50\xd4\x67\xe3\xae\xab\x79\x30\xee\x02\xc7\x60\xe7\x17\x37\x27\xe8\xae\x1c\x77\x47\x23\xff\xe9\x93\x3c\x04\x14\xdc\xd9\x15\x5d\x02\x91\x02\xe6\x57\xbc\xa6\x74\x5a\xcd\x44\x61\x6c\x6c\x2c\x0d\xa8\xd0\x0b\x49\x6e\x3c\x2b\xe4\x04\x4f\x63\x29\xd2\x3c\x2a\x1a\x10\x5b\x04\x19\xa3\x06\x12\xc9\x08\x46\xcd\xe6\x1c\x18\xf0\x5c\xd4\xcd\xb1\xd5\x9b\xfa\xa8\x3b\x42\xdc\x1f\xb8\x0f\xbc\xf0\xdf\x60\x22\x0c\x0f\xca\xfb\x19\x30\x2b\x03\x37\x2b\x03\x0b\xf9\x80\x26\xb2\x88\xd7\x77\x62\x7a\xdc\xb5\xa2\x78\xf0\x57\x92\x14\x9e\xa0\x00\x7f\xff\xdf\xc6\x5d\xd7\x99\x0b\x19\x19\x77\x73\x37\xe3\x80\x2a\x8d\xbb\x45\xdc\x48\x9f\xb6\xb1\x64\xa9\x3b\x54\x8f\x0d\xbf\x16\x4c\x4c\xa7\x10\xe3\x29\xa7\xb5\xe3\x75\x41\x50\x00\x5f\x81\xaf\x93\xe0\xf0\x68\xa3\x42\xb6\x25\x8a\x68\x6d\x49\x20\xc9\x21\x84\x6b\xe4\x0e\xc3\xac\x50\x24\xd0\x90\xb8\xe3\x8b\x25\xc4\x15\x7b\x84\x89\x74\x99\x93\x65\xb7\xb7\x89\x91\x32\x17\x8e\x35\x62\xfb\xbf\xec\xef\xbf\x39\x18\x7c\xf1\xf6\x3d\xfe\x1f\xff\xf7\xfe\xd0\xfb\x7e\xf4\xe6\x60\x70\xe2\xbe\x3f\x7e\x73\x30\x78\xfc\xb6\x37\x0e\x7a\xbf\x1d\xff\xbe\x7b\xbd\xa1\xab\x72\x78\x44\x6f\x8e\xdf\x1c\x0c\x8e\xde\xf6\xfe\xd2\x7b\xbf\xff\x8b\x7e\xb4\x6f\x61\x39\x1d\x7c\xcb\x07\xd3\xb7\xbf\x1d\xf6\x4f\x7e\x1f\xf5\x7e\xfb\xfc\xf7\xb5\xa7\xef\x47\xbd\xde\xfb\xda\xc2\x4f\x7e\xdf\x1f\xad\x95\xde\xdf\x27\x08\x08\xaa\xe8\xfd\x61\x14\xbd\x7f\x73\x38\xf8\xe2\xed\xd7\x51\x6f\x3f\xd8\xf8\x1a\x86\xda\x6b\xee\xf0\xf1\xef\xfb\xfb\xeb\x5d\xf6\x7e\x3b\xec\x1f\xfd\xde\x7b\x3f\xfa\x23\xbb\x3e\x69\xec\x1a\x20\xae\x7b\xf5\xf5\x03\xc0\xb3\x01\xa0\xe3\x46\x80\x4e\x1a\x00\xfa\xed\xa0\x7f\xf4\xfb\x1f\x0b\xd4\x51\x23\x50\x8f\x9b\x81\x3a\xfe\x83\x81\x3a\x6c\x04\xea\x49\x33\x50\x27\x0f\x08\xd4\xa8\xa9\xff\xcf\x9b\xfb\x7f\xfc\x60\xfd\xf7\xf6\x3f\x0d\x3e\xeb\x7d\xad\x1f\xed\x8f\x87\xfb\x87\xd0\xd4\x53\xcb\x3d\x0e\x89\x2f\x60\x8b\xf4\x15\xfe\xdf\xeb\xfd\xa5\xb7\x91\xa1\xb5\x32\x3f\x19\x4b\xb2\x38\x06\x0f\xcf\x08\xe2\x3b\x44\x67\x5b\x7b\x9b\x02\x39\x19\x8b\x12\xfd\x03\x1e\xf9\x81\x73\x33\x9b\x83\xea\x4a\x76\xad\xad\x14\x75\x5a\xca\x87\xbd\x9a\x7e\x70\xfb\x15\xdc\x65\xda\x19\x57\xb1\x9c\x8a\x70\x15\xc6\xb9\x0d\x9a\xc7\xaa\x14\xfb\xab\x8c\x6b\xad\x42\x59\x0e\x79\xa9\x68\x4e\x24\x5f\xdd\x16\xb2\x3b\xd3\x54\xd9\x61\xcf\x0f\x85\xb1\xf3\xb2\x0e\x4f\x63\x0b\xd8\x8f\xb8\xf7\x5c\x38\x61\xf2\x61\xa3\x48\xfe\x7b\x42\x0d\x07\x7b\x1f\xe8\xda\xa0\x56\xb7\x94\xca\xfb\xeb\x7c\x20\x09\x51\xe8\x62\x7a\x91\x2b\xe3\xa3\xb6\xf3\xd8\x5d\xaf\x4b\x9a\xbd\x8b\xee\x2f\x4f\x85\xef\xf3\x22\x95\x32\x74\xea\x6e\x96\x44\x22\x8d\x31\xc2\xbe\xbc\xfb\x9a\x03\xd8\xb4\xf9\xed\x26\x96\x2f\x21\xf2\x88\x8e\x1b\xe5\xcd\xd7\x80\x88\x5a\x9f\x35\x39\xe8\x04\x12\x79\xe0\x6c\xe4\x9a\x6b\x78\xa3\x4a\xd4\xc6\xaa\x80\x0f\xbf\xdd\x52\xa0\x8a\x52\x7e\xab\xdd\x88\x0b\x67\x0a\xa1\xcc\x9d\x4f\x40\x05\x97\xa7\xde\x28\x8d\x62\xa7\x3f\x5d\x96\xd1\xad\xef\x87\x30\x7e\xfb\x30\x18\xda\x05\x4b\xf0\x09\x63\xae\xb5\x0c\x7d\xcb\x6e\x7b\xa5\x0a\xf6\x6a\xda\x58\x23\xcd\x32\x4e\x2b\x26\x28\x4f\x10\x8f\xd4\x4e\x5b\x6b\xf4\xcc\x16\xdf\xac\xa0\xee\x8e\x11\xf8\x14\x8e\xb3\xf3\x28\x16\x57\xd6\x1b\xd2\xae\x6a\x15\x37\x75\x2d\x55\xfc\xbd\xce\xc7\x03\x5e\x17\x38\x17\x21\x15\x85\xcc\x95\x22\xeb\xe8\xcc\xa1\x84\x60\xfa\x89\x98\x2a\x72\x42\x96\x90\xc5\xc2\x58\x69\x6a\xb5\xa8\x1a\x30\xcf\x0b\xe9\x0c\xd6\x25\x4f\xb5\x00\x11\x66\x4f\x3c\x62\xdf\x91\x9b\x1f\x2c\xfa\x25\xd3\x42\xb0\xbf\xba\x08\xb3\xe5\xf5\x2c\x98\xa9\x20\x12\x37\x43\x28\xfc\xc9\x05\x34\xf0\x8c\x6a\xfc\x2d\x60\xec\x94\x25\x12\x7d\x41\xbf\x8a\x54\xb9\xde\x30\x68\x30\x51\x4c\x2d\x65\x22\x55\xd2\x07\x4b\xc4\x1d\xdb\xc1\xd8\x41\xa2\x6a\x2a\x4f\x61\x29\x04\x71\xf9\x5d\xd9\xef\x0e\x14\xf0\xe4\x20\x0f\xf7\xde\xe8\xf6\x6e\x39\x71\xce\x2f\xe4\xd0\xd0\xb2\x5a\x4b\x95\xa1\xb5\x8f\xc7\xff\x50\xa4\xcd\x07\x2d\xcf\x9a\x36\xee\xb5\x3c\xa9\x9d\xb6\xcb\xf3\xe5\x8b\x6f\x82\x87\x46\x07\x16\xde\x75\xfc\x5d\x07\x11\xac\x0a\xfc\xae\xa6\xeb\x7c\x1b\xc8\x05\xb6\xdd\x78\x62\x40\xb1\x71\x03\x5f\xf3\x20\x20\x7b\x5f\x57\x4a\x70\x13\x68\xdc\x25\x9e\x34\xee\x8e\xd8\xa9\x63\x50\x18\x19\xc4\x1c\xea\xed\xca\x5e\xf0\x6b\xa1\x31\x86\x17\x44\x6f\x24\x42\x3c\x57\xac\x21\x1e\x57\xc8\x7c\xf7\xd4\xa4\x3c\xd1\xe8\x34\x8f\xf9\x4a\xa4\x6c\xff\xea\xec\x62\x78\x79\xf9\xa2\xc7\xc8\x6f\x87\xc2\x97\x4e\xf8\x51\x11\x0c\x13\x86\xff\x5d\xf6\xac\x88\x29\x87\x72\xe3\xb0\xa2\x08\xd3\x1e\xf0\xd8\xc9\x99\x91\x7f\x7c\x19\x82\x47\x03\x7e\xab\x03\xbe\xe0\xbf\xaa\x04\xb3\x1a\x9c\xe2\xd7\xe7\x67\x97\x43\x7b\xda\x75\x98\xe7\x07\x98\x65\x32\x12\x15\x07\x0d\x20\x59\x07\x73\xb3\x88\x3f\x09\xe3\x89\xc3\xcd\xcb\x17\xdf\x58\xbc\xb8\x98\x9b\xdd\xf0\xb2\x11\x21\x7f\x86\xa1\x26\xf1\x64\x9b\x64\x6e\xa7\x8c\xba\xbf\x81\xa3\xa0\x96\xa5\x5f\xbe\xf8\xa6\xf3\xa0\xec\x6a\xf3\xd1\x0c\xff\x6f\x80\xcd\x76\x1e\x68\xa9\xcf\xc2\xe5\xa8\xb3\xcb\x0a\x9f\x85\xcb\xfb\xa9\x6f\xdf\x9d\x5d\x3c\x88\xfa\x06\x00\xfc\xcf\xa8\x6f\x10\xcc\x70\x8a\x2e\xec\xdd\x19\xa3\x5f\x9b\x5e\xc1\x76\xdd\x5c\xdd\xba\x28\x09\x72\x8e\x4b\xed\x39\xc0\x69\x23\xbd\x6e\x2b\x52\x37\x70\x47\x58\xfe\xdf\xc5\x6a\x02\x67\xac\x46\xec\x12\xb5\x1f\x34\x3a\x78\xd2\xd0\x92\xb5\x2b\x6d\x9d\x0a\x30\xe4\xc3\x76\xe7\x1b\xd0\x35\xcd\x13\xd8\x53\x9a\xc1\x84\xd3\xd6\x19\xf0\x82\x1f\x2f\xce\xaa\x51\x21\xf5\xe6\x6a\x29\x7d\x03\x66\x61\x09\x66\x4a\xcd\x62\x81\x1c\xc1\x8b\x43\x17\xc9\x4c\x26\x02\xf9\xc6\x70\xae\x6e\x07\x46\x0d\x1d\xfc\x03\x8f\x31\xc8\x64\xf6\xc9\x0c\x61\x7f\x47\x40\x13\x0f\x7c\xa1\xc2\x5d\x71\x80\x55\x2a\x28\xb0\x67\x31\x50\xe2\x3a\x34\x78\xc3\xd6\x10\xd3\x4e\xd8\xc0\x63\x2e\x3f\x5e\x9c\xf5\x40\xcd\x03\x9c\xac\x51\x3c\xc6\xd9\xb4\x41\x91\x1f\x67\x20\xb5\x13\xa8\x44\xd6\xf9\x89\xf2\xcd\xa8\x2c\xe3\xc8\xe2\xd1\x0d\xfe\x13\x3b\x14\xc2\xd8\x43\xb3\x52\x4b\x4a\x2d\x0b\x23\xce\x1f\x96\x97\xb6\x66\x7e\x6d\x74\x9c\xd2\x32\xf6\xd5\x9b\xed\xf6\x7c\xd3\x9e\xdf\x29\xac\xaa\x7c\xef\x15\x96\x2d\xeb\x9e\xfe\x74\xd9\xed\xb3\x2e\xc6\x47\xc3\x97\x6f\x78\x2a\x7e\x10\x86\xc7\xf0\xe3\xbb\xb3\x0b\xf8\xe7\x65\x66\x78\x22\xef\xe0\x2b\xee\x6d\x18\x1e\x5e\x77\xed\x7e\x49\xf7\xc7\xcb\x25\x04\xeb\x77\x83\xce\x43\xcc\xe3\x00\x94\xb6\x36\xa5\x00\xdc\x16\xe5\xf2\xd1\xb4\x28\xfb\xdd\x96\xb8\x16\x12\xc0\x16\x17\x2d\x4a\xe6\xa8\x6a\x51\x96\xb0\xd8\xa2\xe4\xf9\x37\x3f\x74\x1e\x84\x60\xdb\x09\xfe\xad\x42\xbf\x15\xcd\x63\x50\xfc\xa8\xd3\x92\xd8\xb1\xb4\xe7\xb5\x04\xa2\xb7\xcf\xb8\x0b\x59\x5d\x23\x6e\x58\x1c\x36\x9b\x50\x14\xb0\x0b\x4a\xa6\x53\x22\x74\x17\x31\xdf\xb5\x74\x7b\x4e\x1c\x69\x23\xe1\x6e\x27\xda\x01\x73\x0d\x6d\x29\xf6\xfc\x6e\x6b\xb1\x16\x13\xb7\x7d\xd2\x06\x75\xae\xe6\x0d\xa5\x11\xb1\x9d\x7b\xce\x6d\x42\x11\x4d\xa3\x4e\x8b\x69\x75\x85\xc9\x1c\xad\x18\xa0\x7e\x80\x94\x3b\xea\x70\xbf\x80\xfd\x4a\x2b\x41\xe7\xfe\x2a\x98\x8b\xc0\x6b\x4d\xba\x1f\x43\x6f\x3f\x86\xde\x7e\x0c\xbd\xfd\x18\x7a\xfb\x9f\x1c\x7a\xbb\x95\xeb\x2f\x53\x79\xd3\x90\x3c\x61\x8d\x21\x52\xd9\x66\x9e\x7f\x41\x05\xee\xc7\xeb\xa9\xf6\x47\x1e\xff\x91\xc7\x7f\xe4\xf1\x1f\x79\xfc\x47\x1e\xff\x50\x3c\x7e\x93\x87\xa2\xcc\x0d\x1d\x2b\x06\xcc\xd5\xf0\x6e\xe2\x6f\x75\x41\x1e\x38\xeb\xfe\x96\x95\xd3\xfb\x81\x91\xd1\xe9\x0a\xdd\x74\x1a\xc0\x2d\x15\x2f\xdb\xad\xdf\x14\x73\xda\x3f\x10\xcb\x39\x9d\x4d\x28\x79\x8d\xfb\x4d\x2d\x7b\xf9\x6d\x51\xb1\xa2\x7c\x39\xc5\x99\x78\xa4\xcd\xd3\xda\xee\x2a\x19\x71\x09\x1f\x44\x55\xae\x59\x5c\x21\x97\x42\xb4\xcb\xec\x51\x97\x4d\x96\x9e\x0d\x3f\x81\x05\x9b\xf3\x09\x18\xe9\xd4\xa5\x44\x00\xe2\xad\xa4\xde\xf1\x82\x7b\x9c\xa7\x9b\x22\x5d\x60\x92\x30\xc5\x84\xdb\x84\xa9\x1b\xdb\x9e\x2e\x0e\xa7\x40\xd4\x6c\xe0\x67\xaa\x80\x19\xcd\x5b\xb3\x52\x3a\xc1\xf7\xbf\x2a\x48\xd5\x6a\x53\xb6\x62\x68\x50\x94\xb4\x49\xa5\x80\x98\x0b\xff\xa1\x12\x9b\x5d\x86\x1e\x5a\x69\x0f\x4f\x11\x87\x3f\xf9\x23\x5b\xe4\x36\x2f\x4e\x42\x96\x42\x1e\x95\x78\xe5\xc4\x9b\x03\x8b\x38\xf5\xe9\x4f\x97\x7d\xeb\x45\xb2\x7e\x2c\x70\x99\xba\xc3\xf1\x9a\x12\x5a\x78\x27\xbf\x5b\x91\xa4\xb2\xa7\x5d\xf0\x98\xad\xf6\xce\x10\xd5\x14\xc5\xdd\x6c\xa0\x07\x11\x3d\x00\x85\xe2\x99\xa5\x82\x3c\xfa\x6c\xa2\x32\x38\xe1\xa1\x7c\x78\x9e\x1e\xe0\x40\xe1\x20\x2c\xb2\x5a\x48\xb7\x01\x98\x82\x63\x1a\x8a\x52\x0e\x03\x5f\x76\x20\x90\xdb\xba\xe1\x3c\xd2\xf6\x9c\x12\x37\x92\xe7\x67\x72\x2c\x4a\x08\xad\x4e\xe3\x1b\x27\xec\x99\x12\xf6\x9c\xc7\xe6\xb6\xfe\xa0\x45\xdc\x77\x19\x5f\x00\x02\x71\x07\xdb\xac\xd2\xc4\xab\xea\xc9\x2e\x44\x14\xe5\xa2\x4c\x32\x1e\x17\x25\x36\x81\xfb\x68\xcd\x9b\x71\x4f\xae\xe6\x9a\x71\xcb\xf0\x0f\x42\x46\xc0\x4e\xd7\xba\x6a\xc9\xcd\xae\xbc\x4c\xb4\x3e\xc5\x01\x4b\x88\x56\x09\x5f\x50\xe2\x25\xd8\xd4\x01\xbd\x12\xd9\x40\x1e\x24\xff\x25\x9b\xab\x5b\x38\xb3\xd4\x07\x82\x75\xca\x28\x9c\xd1\x83\x18\x33\xf8\x4e\xf5\x40\x71\xea\x83\x1e\x94\x92\x2c\xce\x35\x9d\xbc\x4f\x0a\x1b\xa1\xa8\x46\xc7\x8e\xd6\x86\x85\xdc\x0f\x92\x9c\x42\x7a\xa7\xfa\x64\x15\xdb\xc5\xf9\xa0\xc4\x24\xa9\xe9\x0d\xa5\x3d\x6e\xb2\xa1\x14\x2d\x8e\x0d\x25\xdc\x68\x36\xf7\xb8\x45\x07\xd8\xec\x47\x6c\x74\xfa\x6e\x54\x1b\x40\x98\x41\xae\x71\x4a\x1e\x3b\xea\x6c\xd1\x1c\x2a\xe5\xf3\xb4\xde\x9c\x2d\x6d\xdc\xaa\x4b\x3f\x90\x1f\xcc\x0b\x8b\xd2\x01\xfb\xc6\x3b\xf8\x02\xb6\x17\x26\x57\x4b\x94\x55\xe2\xfd\x92\x9d\xdd\xed\xc2\x85\x5c\x88\xab\xd5\xb2\xe9\x75\x55\x05\xca\x8b\x33\xe9\x67\xf4\xfd\xe1\xfc\x87\xe7\x88\x49\x67\x68\xd9\x53\x81\x78\x6e\xc6\x03\xb0\xd8\x6e\xbe\xca\x73\xea\x92\xe1\x46\x1b\xd4\xe5\x78\xaa\xa6\x45\xee\x78\x29\x34\xb7\x2a\x61\xc0\x65\xbc\x1c\x81\x25\x03\xc7\x57\x7c\xd0\x26\x22\x11\x53\x69\xec\xf1\x1f\xaf\x56\x9f\x4d\x32\xc3\xbe\x3f\xbd\x00\x33\xc0\xfa\xaa\xb4\x81\xff\x83\xbf\x2a\xcf\x3a\x8e\x4b\x30\x5d\x91\xe5\x88\x3d\x82\x51\x03\x1e\x36\xd8\x95\xc2\xb3\xaa\x01\x63\xdf\xe5\x39\xdf\xf4\x52\x70\x2b\xa4\x30\xdd\xe5\x3e\x44\x90\xf4\x59\xa8\x75\x9f\xfd\x93\x32\x09\xf5\xe8\xf4\xde\x36\xd8\x5c\xa9\x7c\xd7\xdf\x65\x9c\x70\x25\x45\xc4\xf6\xe5\x82\xcf\x20\x53\x7b\x16\x49\xd5\x67\x10\x3d\xa0\x5c\x2f\xae\xf5\x58\x1a\x13\x03\x4e\x99\xb8\xb3\x9c\x25\xf7\x4e\x40\x14\x1c\x4a\x89\x70\x99\x81\x89\x81\xf9\x61\x0a\x48\x40\x44\x42\x2e\xe6\x20\x37\xac\x40\x9b\xfb\xa7\x12\x73\x95\x18\x95\x04\x0b\x11\xc9\x6c\x81\x1b\xcc\x66\x2e\x06\xb3\x5f\xe5\x72\xb0\x14\x09\x8f\xcd\x6a\x10\x1d\x1f\x4e\xa2\x27\x5f\x7c\x3e\x3d\xe4\x47\xdd\xce\xbd\x8e\x07\x95\xa9\xd0\x5b\x4b\x30\xbd\x40\x90\xf9\x9a\x02\x9a\xb1\xe8\x02\xc2\xe4\x0c\x40\x8f\x45\x41\x06\x01\x7b\x6e\x4f\xaf\xc1\xa4\x0c\x43\xad\xbf\x04\x1e\x9b\x6a\x61\xbe\xca\xcc\x74\xf0\x74\xdc\xed\xbb\x97\x30\x61\xde\xcf\x47\xf6\x3b\xa2\x79\xa8\x6f\x66\x9f\xdd\xb9\xd7\x5e\xe0\xd2\x50\x85\x46\x98\x81\x36\xa9\xe0\x0b\xfb\xf6\xe7\x41\x88\x37\xb5\x0c\xed\x3f\x3a\x9b\x8c\xbb\x45\x22\xa9\xab\x02\x5e\x5a\x35\xd6\x9a\xc4\x49\x01\x8b\x56\x24\x66\x50\x8c\x10\x43\x9d\x60\x02\x5f\x7f\x7b\xc6\x0e\x8f\x4f\x0e\x47\xe5\x42\xa3\xaf\x70\x1d\xb2\x71\x77\x38\xee\x82\x51\x87\xbf\x1e\xbd\x19\x77\xbf\x1c\x77\x0b\xaf\xd4\x5b\x70\xb1\xb9\x00\x32\x99\x94\xdb\xa0\x25\x09\x1a\xa9\x9a\x8e\xfc\xb0\xac\x9c\xba\x88\xd6\x16\x42\x6b\xfb\x05\xf3\xc6\xf1\x14\x38\x94\xb8\x33\x39\xf9\x41\x7c\x16\xb3\xe3\xb6\x7d\x2d\xe1\x3c\x33\xe4\x55\x9e\xac\x10\x35\x63\xbb\x49\x65\xc7\x6c\x1f\x73\x66\xd4\x35\x64\xca\xf6\x94\x69\x11\xab\xdb\xc0\xc1\x8c\x6f\xfd\xa4\xfd\x6a\x5a\x4e\xab\x0d\x13\xca\x43\x03\x82\x16\x1a\x07\x56\x41\x8a\x00\x94\xbe\x85\x5b\x06\x18\x9d\x84\x23\xb6\x52\x54\xd1\x7d\x70\x4b\x41\x04\x06\xc9\xd6\xe2\x0d\xa3\x88\x04\x83\x2a\x00\x8f\xd1\xee\xc8\xa1\xf2\x1f\xba\xee\x74\xb5\x85\xfd\xde\x5f\xff\xf6\xff\xf4\xbf\x1c\x8d\xc7\xe3\xee\xf0\xcd\xdb\xaf\x83\xaf\xa8\xba\x9b\xa9\xea\x5c\xc0\x30\xe1\x6c\x35\x21\xc5\xf5\xa6\x28\x49\x6d\x31\xa3\x43\xed\xb9\x21\x8c\xd7\x22\xea\x26\x84\x47\xae\x47\x84\xbe\x71\xf7\xab\x71\x97\xed\xdb\x1f\x43\xf6\xaf\x4c\x19\x11\x01\xd9\xca\x64\xd6\xa3\x4e\x4a\x0f\xfb\xfe\x7c\x10\xfd\x3d\x3d\x3a\xea\x83\x20\xd0\x59\x9a\x82\x1e\x4e\x66\x8f\xca\x60\x47\x12\x6b\x6b\x97\x83\xce\x61\xc4\xc7\x3e\x5b\xc6\x19\x14\x58\x15\x18\x62\xcf\x7f\x3e\x7b\x7e\x71\xc5\xc6\x63\x58\x39\x76\xfa\xce\x5e\xe3\x79\x0a\x68\x04\x8f\x99\xbb\x96\xa0\x22\xad\xee\xd3\xcb\xb3\xf3\x73\xaf\x15\x6e\x6f\xe6\x00\x90\x25\x7a\x37\x84\x0e\xf9\x92\xa8\x6e\xbc\xc1\xb3\x91\x9f\x00\xfc\x65\xff\x6b\xd9\xdb\xbf\x1b\xbc\xf9\xe5\xed\x1b\xb6\xdf\x1b\x8f\xed\xbc\x75\x87\x5f\x07\x5f\x8d\xef\x0e\x0e\x06\xe3\xbb\xc3\x6f\xc7\x77\x9f\x7f\xfb\xf6\xb3\xf7\xde\x02\x79\x8f\xeb\xe3\x3d\x2e\x8f\xf7\xb4\x3a\xde\xe7\x8b\xe3\x3d\xf0\x91\xf7\xb8\x34\x7a\xc3\x16\x2d\xef\x7f\xc9\x1e\xb5\x28\xf6\xd5\x7e\x8b\x42\xef\xbb\xfb\xe3\xf1\x1b\x7a\xf8\xf9\xb7\x6f\xdf\xbf\xf9\x65\x7c\x77\xf0\xac\x3b\x1e\xbf\xed\x3d\xea\xf6\x7a\x8f\xfe\xd2\xf9\x60\x2f\xcb\x7d\x32\xbc\x6b\x61\xee\xa3\x7e\x3d\x07\x7d\xe1\x35\x9d\xcf\x6f\x3e\x65\x44\x7a\xd3\x88\xbd\x46\xdb\x2f\xea\x6c\x13\x2d\x0d\x6d\x57\xe2\xc9\x48\xf3\x2a\x1c\x91\xc4\xba\x27\x82\xcd\x39\x24\x88\x8f\xdc\x01\xa4\xa2\x10\xa6\x21\xd0\x70\xbd\x8e\x0b\x78\xe7\x2e\xc1\x00\x50\x28\xe5\x79\x88\xd6\x62\x57\xca\x1e\x44\x94\xfe\x90\xf4\x1b\x87\x43\xdc\x73\xdc\x3d\x9f\x25\x2a\x15\xe3\x6e\x71\xfd\x40\x1e\x56\x8e\x07\x92\x95\x5f\xa7\xd1\x7a\x82\x54\x17\xb0\x20\xad\x66\x79\x72\x80\xf9\x09\x4e\x0e\x9e\x3a\xcb\x59\xf4\x71\x2f\xb0\x3a\xb0\x7d\x38\x29\x8c\x61\x4e\xf0\x7a\x46\x57\x22\x50\x6a\xdf\x1e\xa5\xa1\x54\x59\x62\xd6\x6a\x12\x43\xc5\xfb\xc8\x96\x29\x46\x2a\x2f\x04\x9c\x4c\xd7\x9b\x06\xe2\x06\xdb\x38\x8e\xda\x73\x03\xb9\x4f\x14\x46\x09\x30\xf2\xf2\xb0\x66\x8e\x71\x16\x55\x50\x14\xc8\x24\x4c\xf1\x8c\x19\xbc\x2f\xa0\x5b\x8f\xe8\x97\xda\x47\x32\x1a\xaf\x57\xab\xa5\xb5\x08\x11\xd4\x8a\x67\x1c\xf3\x49\xa0\xbe\x57\x72\x38\xe8\x3d\x4a\x32\x91\xe7\x1b\x49\xd9\x4f\x62\x92\xa7\x3a\xde\x03\xed\x2c\xcc\x62\x0e\xf9\x26\x4a\x0d\xee\x8f\xbb\x20\x5e\xed\x93\x71\xb7\x97\xf3\x5e\x38\xd1\xcf\xa7\x90\xac\x58\x22\xea\xe0\xd4\xff\xf7\xb9\x29\x3a\x17\xa8\xe6\x22\x25\xda\xbb\x6b\x90\xcd\x4e\x40\x11\x70\x07\xef\xc9\x7a\x66\x22\x4d\x95\xcb\x2c\x0a\x19\x3f\xec\x69\x22\x9f\x3e\x4b\x33\x84\xad\xc9\xc5\x52\x44\x80\x2c\x43\x13\x01\x95\x23\xc9\x67\x89\xd2\x52\x83\x96\x06\x03\x8d\xc5\x02\xd0\x7a\x9e\xe4\x51\xce\xb5\xa0\x95\xa0\x02\xe3\x9b\xe9\x90\x27\xba\x6a\x39\xb8\xf9\x44\xb3\xa2\x32\x38\x89\xf2\x8b\xc0\x01\x5a\x4d\x20\xab\x35\x02\x66\x0c\x54\xa8\x0f\x6a\x6d\xb6\x91\x07\x1b\xb8\x0b\xbc\xb5\xf4\xda\xd9\x91\xb1\x22\x27\x02\x74\x9f\x81\x19\xcc\x1b\xd2\x49\x94\xb8\xd7\x7a\x95\x86\xfb\x2e\x60\x29\x38\xb5\x0c\xa7\x94\x2d\xa1\x74\xf0\xd0\x17\x54\xb0\xab\x72\x0d\x62\x93\x68\x17\x62\x1a\x76\x99\xf8\x0a\x7b\x17\x61\x19\x00\x2c\x83\xbf\xe2\x77\x06\x97\x70\xfc\x2d\x80\x81\x75\xfb\xe4\x68\xf4\xdf\x30\x59\xf0\xab\xe2\xb1\x4d\x8c\x53\xe4\x03\xf0\x9a\x7d\x7c\x70\x6c\x5b\x23\x5d\x06\xab\x17\x28\x28\x2c\xf1\xc7\x07\xc7\x39\x7f\xd0\x01\x3b\xcb\xbd\xac\xe8\xf2\xf5\x90\x86\x55\xa0\x34\x90\xf5\xc9\xc1\x49\x51\xcb\xad\x3d\x8b\x68\xb8\x2a\x2b\xb0\xc9\x36\x88\x5f\x24\x8e\xdd\x94\xb1\x33\xa1\xd0\xff\x2c\x8e\xf3\xb6\xfa\x74\xd7\x21\xd0\xb4\xbf\x77\x17\xb0\xe7\xb3\x41\x6e\x8e\xa5\xfc\x36\xb0\x97\x37\x82\xef\x88\x12\xd4\x57\xee\x71\x84\x53\x1a\x22\x1d\x4e\x79\xc4\x27\x27\x8f\x3f\x3f\xf9\x9c\x7f\x31\x39\x3e\x08\xc3\xe3\xe9\x01\x3f\x99\x9c\x1c\xf2\xe8\xe8\xe9\xe7\x87\xd3\x2f\x1e\x3f\x3d\xfa\x9c\x7f\x71\x3c\x44\x55\x46\xbb\x6a\xb4\x8d\x06\x3e\xfb\xe9\xb0\x06\xad\xf9\x7d\x39\x39\xd7\x26\xc3\xbe\x81\x53\x67\x8e\x4f\x3b\x56\xea\x21\x36\xf8\x23\x73\x91\x3f\xe8\x95\x26\x1f\xe8\x82\x6a\xb8\xc6\x64\xab\x0e\xf4\xbd\xa5\x81\x51\x67\xcb\x58\xbb\x5e\xe1\x9c\xec\xab\x9e\xa7\x9c\xa0\xc6\xc9\xc6\x49\x2c\xc9\x3c\x5d\x5c\xd0\xd7\xbd\xc7\x6c\xd1\x96\xae\x88\x2c\x70\xcd\x6a\xdc\xfa\x90\x6a\xab\x7a\xdc\x0e\x77\xe9\x61\x45\xce\xd5\x6d\xfd\x1e\x31\x08\x2f\x3b\xff\xdf\xba\xb6\xfa\xec\xe7\x41\xfe\x03\xbe\x95\x1f\x80\x5f\xb3\xfc\x04\xbc\x93\x95\x27\xb0\xd5\x6a\xe5\xe3\xda\xe3\x01\x65\x9b\xaf\x20\xdc\x3f\xce\x68\x25\x9c\x4a\x72\x32\xcc\x6d\x3a\xda\x44\x1c\x77\x4f\x97\x4b\x61\xb5\x38\xbb\xe3\x5b\x8c\x39\xdf\xd6\x5d\x1f\x2b\xc7\x4a\xda\x25\xf8\x02\xb2\xe9\x3b\xbf\x30\xf0\x14\x71\x67\xef\x97\x2a\xa0\xa2\xde\x5e\x8b\x65\xcc\x43\xb1\x63\x77\x39\x6a\xf3\xbe\x52\x6c\x07\xba\x02\xb3\x2d\xef\x2e\xc7\x10\x68\x5a\x3e\xc2\x1e\xad\x41\x72\x3e\x85\xc4\xcd\x1f\x08\x08\xa9\xe5\xab\x3c\x0e\xc1\x39\xd2\xd0\x92\xa7\x9e\x5e\x82\x62\xb4\x63\x47\x89\xb8\xa9\xe9\xae\x84\xe3\xd2\xc0\xa9\x00\x8e\xae\xe2\xdf\x75\x4e\x61\xa9\xbd\xd9\xbe\xb7\xeb\xde\x92\xcb\x86\x02\x34\xc3\x1b\x4a\x58\xcc\x6f\x28\x80\x08\xbb\x1f\x6f\x64\x84\x2a\xb8\x1a\x02\xae\x36\x3c\x8d\xfe\x99\x69\x03\xea\xb6\x6e\xc7\x08\x1a\xab\x7b\xf3\x66\x0f\xe7\x7a\xaf\x70\x22\x49\x3a\x93\x4f\x1a\xfc\xba\xde\xba\xb4\x4a\x8c\xcb\x8e\x55\xd4\x2d\x65\xb2\x62\xbc\xd0\x3e\xbc\x6a\xc5\x99\x13\x97\xc2\x20\xe4\x4b\x69\x78\x2c\x7f\xe5\x74\x82\xb9\xa4\x9f\xd0\xa5\x37\xb0\x38\xc6\x5d\x7f\x11\x7c\xab\xd2\x71\xb7\x14\x1e\x4e\xf4\x37\xee\xde\x0d\x72\x0e\x08\xdf\xc6\xdd\x12\x14\x85\x26\x61\x21\x47\xe7\x74\x91\xc6\xaa\x18\x40\x15\x30\xb0\x59\xe6\xa2\x82\x2d\x58\x2b\xa8\xf5\x78\xa8\xca\xaf\x71\xea\x57\x2f\x87\xea\xd3\xed\x50\x83\xf2\x75\x84\xf6\x31\x36\x83\xbc\xd9\xee\xff\x01\xd0\xc3\x43\xec\xf7\xdb\xe2\xc2\x44\x1a\x86\x76\x16\x40\x15\x18\x07\x07\xb6\x06\xea\x17\x5d\x1f\x83\xd3\x9a\x8f\x92\x74\x95\x00\x5f\x96\x6f\x73\x1e\xce\x0f\x07\xb6\xd1\x01\xd0\xc6\x57\x90\xf6\xc4\xcb\x85\x45\x13\xe4\x94\xaf\xb6\xe0\x40\x36\xc5\x38\x76\x49\xc6\x73\xd5\x71\x83\x40\x4d\x54\x75\xc8\xc8\x98\xdc\xa4\x05\x0f\xe1\x2d\x5f\xe3\x55\x00\xdf\xf7\x0d\xab\xc6\xa9\x44\x4e\x13\xaa\x50\x77\x25\xcf\x56\x85\x56\xbb\x3d\xa7\xd3\x36\xd2\xfd\xfa\xc9\x7d\x77\x7f\xa3\xdf\x0d\xf6\xbe\xee\x6e\x3c\x7a\x72\xf8\x04\xb2\x3c\x42\x5b\xec\x24\x38\x0a\x3a\x8d\x29\x0c\xf9\xdd\x0b\x91\xcc\xcc\x7c\xc4\x0e\x0f\x8e\x4e\x9a\xcb\xc9\xc4\x95\x3b\x68\xe1\x13\xfc\xcb\xfb\x5f\xde\x0c\xfe\xaf\x4f\xfe\xf2\xe9\xff\xbd\xf7\xe8\xb3\x00\x73\x01\xfd\xe3\x97\x77\xff\x1f\x1f\xfc\xfa\xfe\xff\x7f\xfb\xd9\x07\x39\xd0\x5a\xa5\xe0\xd9\xe6\x65\xb3\x97\x82\x9c\x47\xed\x38\xa7\x2b\x4d\x8f\x27\xa2\xb2\xc9\xce\xa6\xbe\xf7\xde\x9f\x9f\x9c\x0f\xd5\x68\xf4\xc4\x7a\x64\x02\x6a\x2b\xd8\xd6\xaa\x08\x06\xad\xa6\xdf\xf7\xbd\x23\x52\xbb\xd6\xd7\x12\xc0\xe2\x8a\xe6\x44\x37\xee\xf6\x25\xba\x00\x85\xf6\xc5\xfd\x96\x89\xcc\xa8\x35\xe2\xf2\xce\x65\xe0\xf9\x6a\x35\x79\x77\xe0\x0a\x79\x74\x09\x14\x3e\x2c\x0a\x5b\xcc\x53\x07\x9a\xd4\x5e\xb1\xed\x5d\xef\x52\x19\xcb\xe6\x45\xae\xb3\x70\xee\x0d\xcf\x22\x07\x09\xdb\xe4\x1c\xa0\xde\xeb\xd0\x46\x8d\xf6\xb2\x3d\x34\xbe\xaf\x4c\xfe\x1e\x59\xdc\x4e\x12\x94\xf6\xcd\xdc\x96\x60\x0e\xa6\x37\xf7\x7b\x7b\x78\x29\x59\xe6\x6c\x7b\x6b\xe7\xcd\x39\x6c\xc8\xba\xc4\x81\x59\x12\x03\x51\x38\x13\x2b\x97\x36\xc4\x56\xa1\x69\xda\x6c\x1d\x4c\x38\xcc\x4b\x0d\x19\xc1\x15\x60\xe8\x75\xcb\xe3\x3d\x00\xb3\x16\xe8\xdc\x68\xc4\xa3\xa1\xf6\xaa\x56\x77\x20\x14\x41\x8d\xd5\x8c\xca\xd6\xb8\x11\x9b\x6c\x19\x00\xb5\xfb\xe9\x6f\x9f\xfd\xfc\xbb\x1a\x8f\xd9\xa7\xa1\x1c\x7d\x1a\x2e\xdf\x7d\x3a\x95\xa3\x4f\xa7\xcb\x77\x9f\x5e\xe9\x77\x9f\xa6\x66\xf4\xe9\x52\x46\x5d\x9b\x4b\x04\x5a\x0d\x55\x6a\x39\x3d\x1e\xf7\x70\x5b\xc8\x91\x0a\xb3\x1c\xf6\x91\x0b\x43\x0d\x27\x60\x90\x93\x81\x0e\xd1\x6f\x24\xa0\x06\x51\xa8\x92\x9b\xe1\x51\x70\x30\x2c\xad\x3f\x7b\x0e\xff\x69\x70\x14\x1c\x6f\x4a\x07\xd5\x96\xd7\xb5\xe4\x76\xa5\x3d\x90\x4f\xf7\x3f\x7d\xbf\x3f\xfe\xed\xcd\xe0\xb3\xb7\x5f\xbf\xf9\x7f\x7f\x7e\xfe\x76\xbf\x5f\x7c\xef\x3d\x1a\xff\xde\xfb\x7a\xff\xcd\xe9\xe0\x1f\x7c\xf0\xeb\xdb\xcf\xde\x8f\xdf\xbc\x21\xa6\xf8\x0e\x1f\xec\x8f\xf7\xdf\xfc\xd2\x7b\xfb\xd9\xb8\xd7\xfb\x7a\xbf\xdf\xfc\xae\xf7\x68\xfc\xb6\xd7\x7b\xff\xe6\x97\x4f\xdf\x8c\xc2\xc4\xa4\xf1\xe8\xed\xdb\x4d\xfb\x11\xad\x18\xea\x66\x5f\xc0\xda\x82\x80\xc2\x39\xa5\x96\x65\x60\x4e\x56\xf5\x42\xd0\x32\xa2\x81\x8c\xba\xbd\x5d\x19\x63\x85\x87\x20\xdb\x42\x3a\xdd\xd3\x0f\x28\x25\x1b\x97\x40\xa2\x6a\x78\x52\xf0\x3f\x43\x6a\xf7\x15\xad\xad\x68\x61\xa3\x27\x65\xcb\x6b\xf2\x24\x8f\x3a\x5b\x08\xc8\x79\x9c\x73\xff\x8a\xdb\x98\x45\xb6\xc5\x6e\xbd\xb0\x98\x89\xf5\x4f\xc3\x69\x34\x88\xe6\x09\x58\xa3\xe8\xc8\x93\x7a\x63\x54\xe2\xcc\xaa\x9b\xee\xce\x44\x08\x0c\xf1\x45\x16\xbc\x8b\xa4\xc6\x97\x41\x67\x77\x31\xc2\x37\xe6\x5d\x28\x0d\xb6\x4b\xfd\x96\x77\xc5\xbc\x5b\xed\x1d\x51\xaf\x0d\x79\xa3\xa4\x5c\xdf\x44\xca\xc7\xf3\x21\xb2\x31\xc2\x3b\xd8\x11\x8f\xcd\x85\x2a\x23\xf4\xea\x00\x1c\xd6\xf3\x5c\xc0\xa7\xd9\x4c\x6d\x5a\x29\x6d\xc0\xa2\xd4\x5c\xb0\x2b\xbe\x3d\x0f\x52\x09\xb8\xbc\x56\xf3\x61\xa3\xb3\xbc\x48\x41\x98\xf9\x88\x9a\x4f\x19\xe5\xd5\x36\x0d\xae\xc5\x92\x2a\x3e\x7a\xa5\x63\x35\xdb\x69\x78\xb6\x4a\xfd\xd8\x38\x35\x98\x9f\x98\x0d\x58\xe3\x60\x2e\xb1\xe4\xb6\x91\xa8\x44\xbc\x9a\x6e\x83\x0f\xb6\x71\xda\xce\xa9\xfb\xa3\xe3\x36\xa3\x1d\xf3\x75\xc9\xe5\xcd\xc9\x9f\x0a\x9a\x4d\xb7\x4d\xec\x42\xeb\x3b\x42\x51\x22\x09\xaa\xe7\xcc\x53\x2f\x23\x34\x09\xe8\x0a\x55\x58\x49\x4c\x7b\xea\x10\x0c\x02\x5b\xb7\x18\x8d\xa1\xb7\xd1\x43\x4b\x99\x52\x7c\xc8\x4e\x68\x74\x5c\x37\x0e\xaa\xeb\x6a\x56\x14\x0f\x1a\x4b\xfe\x56\x4d\xcb\x03\xd8\xc4\x42\x01\x3b\x79\x45\xf4\x20\x42\x44\x71\x7c\xb8\xc1\x83\xd8\xde\x9b\xe8\xff\x0d\xd8\xb5\x48\xdb\x25\x95\x1b\x60\x28\x73\xcb\xa2\x0b\x2e\xe3\x4e\x8b\x92\x6c\xc0\x22\x2e\x16\x2d\xf3\xda\x0d\x18\xcf\xcc\xbc\x65\x51\x8b\xff\x96\x85\xe3\x65\xda\x69\x51\x10\xb6\x79\xc4\xad\x6e\x59\x34\xcb\xc2\x65\xa7\x45\x49\xb8\x31\x3c\xdd\x09\x05\x47\x9d\xad\x05\xb1\xec\xd4\xb4\x05\x20\x69\x5d\x12\x62\xa3\x4c\xa7\x45\x51\x28\x1b\x8b\xb4\x6d\xd9\x30\x55\x49\xdb\x71\xe1\x6a\x38\xe8\x6c\x2d\x59\x14\x3e\xdc\xa5\xf0\x4e\x60\x1c\x77\xb6\x96\x2c\x0a\x9f\xec\xd2\xf2\xe3\xce\xd6\x92\x45\xe1\x27\xbb\x14\xfe\xfc\xa1\xb9\x67\x61\x53\xb4\x68\x99\x0c\xf6\xad\xe6\x47\x3d\xbb\xcd\xbb\x62\xb2\x9c\xaa\x34\xb6\x4f\xcb\xa2\x84\xd8\xed\x36\x66\x5b\x6a\x34\x0f\x51\x02\xf8\x5a\xb2\xdc\xb6\xf7\xb7\xb8\x3f\x02\x7a\xc4\x4e\x0e\xbe\x68\x33\x75\xf9\x75\x2e\x27\x4f\x0f\x5a\x14\x6f\x77\x53\x9f\xfb\x5b\x6e\xb9\xb5\xaa\x76\x22\x96\xde\x85\x54\x7f\x7f\x76\x61\xcf\xb3\x24\xd9\x62\x22\xd2\xca\x1c\x3c\x90\x38\xbf\x37\x8a\xb7\x5d\x95\xb3\x86\xe3\xc3\x07\xc6\xf0\xe6\xd0\x01\xff\x6f\xe0\x34\xa2\x16\x25\x01\xe1\x0f\xa5\xdf\xef\x9c\xe0\xab\x36\x81\xa9\x6f\x6e\x81\x9a\x4f\xc7\xd1\xcf\x4d\xee\x01\xd9\xb0\x27\x5e\xd8\x3a\x14\x4f\x5f\xcd\xc7\x5e\xf8\x93\x49\xc1\x92\x91\x08\x79\x5a\x58\x5f\xe8\x4b\x89\x50\x59\x9a\x69\xb8\xcc\x26\x69\xf2\xe2\x2c\x55\x44\xf1\x8c\xa5\x16\x6b\x4a\xc2\x95\x3c\x29\x44\x9a\x3b\x7f\xb2\xd7\x33\xf9\x7a\x72\xb3\xdd\xc0\x8e\x18\xbf\xe1\x32\x76\x39\x8c\x0b\xd0\x08\x15\xe0\x1b\xb2\xe9\x10\x72\xa3\x34\xf7\x36\x95\x23\xb6\x3c\xd0\x72\xef\xbd\x33\x02\xb5\x8a\x33\xba\x24\x0c\x17\x14\x8f\xc8\x9c\xa5\x7b\xdf\xa4\xf6\x40\x2c\xce\x8a\xdb\xcd\xba\x32\x4c\x94\x0d\x19\x81\xb0\xbf\x6d\xf0\x42\x94\xaa\xe5\xb2\x08\x80\x85\xbc\x16\xb0\xaa\xb1\x88\xb8\x0b\x85\x88\xf2\xc8\x4c\x6a\x2d\x85\xcb\xc7\x16\x62\x4f\xbb\x44\xb0\x0d\x40\xef\x69\x16\xf2\x25\x0f\xa5\x59\xd1\x5e\xbd\xb5\xf0\xe0\xfb\x0b\x87\x4a\xb4\x04\x61\x27\xae\xca\x43\x02\xd6\x14\xdd\x46\xfb\xaf\x18\x09\x56\x62\x38\xb0\x77\x40\x4c\xa7\x22\x15\x76\x9b\x11\x70\x8f\xe7\xb3\x12\x15\x7b\x2a\xd4\x26\x1c\x21\x82\xd0\xcf\xad\xe2\xa2\x9d\x76\x3e\x28\xac\xf8\x16\x65\x2f\xdb\x28\xbb\x2d\x25\xfa\xbf\x33\xd1\x1a\x38\xd5\xcf\xf8\x12\xb2\xfb\x9f\x29\x75\xbd\xc5\xfa\x2c\x71\xa0\xf5\xaa\x9e\x01\x46\xa1\xdd\xd8\x62\xe9\x30\x1b\x46\x9e\x62\xa5\xea\x66\xd1\x06\x07\x5e\xa2\xf2\xb6\x78\x5a\x34\xb0\x49\x68\x6d\xd9\xdb\xdd\xbe\xbf\x4b\x43\x83\x81\x58\xcc\x50\xf9\x89\x28\xa2\x15\x2c\x50\x4d\xe3\x0b\x3a\x0f\x63\xef\x2f\xb8\x09\xe7\x57\x2d\x04\x44\x65\x48\x79\x3d\x6f\x5e\x7c\x69\x81\xef\x81\xa5\x4e\x30\xc3\x3c\x88\x77\x11\x39\xa6\x4d\x43\x03\x8e\x1e\xb0\x6a\x30\x3d\xe5\xc7\xe3\xa1\xe9\xba\x9c\xd8\xe2\x8e\x87\x86\x68\x9b\x5a\x06\x06\xdf\xbd\x48\xc5\x54\xde\x51\x31\xf7\x7e\x89\x0f\x6d\x31\x3b\xed\xae\x35\x7f\xa3\x0b\x72\x11\x40\xff\xb9\xf0\xca\xdf\xb8\x0d\x72\x7c\x8b\xea\x24\xb5\xe2\x7a\x93\xf9\xcd\x0d\xd8\x8a\xeb\x6f\x53\x3b\xb6\x6a\xde\x5a\x53\x68\x49\x81\xd4\x7c\x68\x30\x4e\xaf\x85\xee\x54\xa9\xae\xcb\xe3\x82\x94\xc0\xb8\x8f\xce\x08\xc2\xee\x14\x5d\xa9\xa5\xd4\x84\xa7\x5d\x74\x42\xc3\xf9\x2a\x95\xe4\x65\xe0\x39\xb1\xc7\xa9\x4c\xb5\xb1\xd8\x02\x10\xa8\x2d\xa9\x5b\x52\xda\x2e\x1e\x89\x01\xc3\x89\x68\x55\xd2\x8e\xb7\xf3\x80\x76\x4c\x6e\x05\xec\x4c\xe9\xce\x7a\x70\x53\xab\x19\x6f\xb2\x4b\x10\x10\xbb\x66\x5d\x56\x0b\x90\xeb\x22\xca\x93\xbf\xd8\xcd\x6c\x5d\x5d\x07\x7d\xf7\x03\x77\x6e\x5d\x24\x8e\x18\x14\x07\xa6\x22\x11\xcb\x85\xc4\x5b\xe9\xdd\x6e\x11\x48\x3b\x91\x98\x74\xe5\x49\x6d\x38\xb4\x8b\xe0\xf6\xbd\x8b\x2c\x1d\x2c\x26\xcd\x12\x7b\xe8\x9d\x88\xd3\x93\x97\x25\x6d\xa2\x41\x61\xa2\xb3\x02\x0a\xa2\x8f\x98\x16\x70\xb2\xcd\x08\x4a\xf0\x40\x6b\xdb\x28\xc3\x63\x0f\x2d\xde\x76\x18\xc6\x92\xe7\x27\x6a\x82\x4e\x6b\x1d\xbf\x95\x29\xb9\x83\x82\xbf\x8b\x7a\xbf\x6d\x8b\xb2\x86\x62\x2a\x1b\x95\xa5\x05\x0a\xbc\xc4\x6c\xdc\x3a\xf4\x0a\xd7\x6c\x1d\x3e\x39\x7a\xf2\xd8\xdb\x3a\x3c\x0c\x76\xb0\xde\xdb\x99\xe4\x2d\xf7\x05\xab\xbb\x83\xcd\x5b\x83\x8f\xfe\xd2\x72\x42\x5a\x2d\xe3\x82\x19\xde\x63\x56\x88\x8b\x36\xcc\x0d\xb1\xf2\x8f\x53\xf4\x41\x53\xd4\xd6\x1e\x1e\x14\xe2\xae\x45\x49\x42\x50\xe7\x01\x0c\xe2\x05\xbf\x3b\x47\x05\x8e\x1d\xfe\xbb\x2e\x5c\xf3\xd4\xd9\x0d\x27\x02\x6a\xc9\xb6\xbb\x5e\x97\x08\x4e\x97\x79\xeb\x43\x28\xc2\xae\xad\x92\x22\xec\x12\x36\x38\xc9\x00\x07\xc2\x96\x74\xfd\x69\x1e\x71\xaa\x4b\x11\xa7\x6e\x99\x14\x07\xdf\x40\x9e\x41\x46\x15\x11\x82\xce\xb2\xf6\x1a\x34\xb8\x22\x99\x72\x9d\xa9\xee\x82\x57\x35\xa3\xa0\x55\x00\x60\x9f\x42\xcd\xd6\x22\x5c\x41\x01\x4a\x85\x0b\x6f\xf5\x7a\xea\xc1\xb9\x3a\x1a\x65\x88\xe1\xa4\x25\x64\x01\x1c\x57\x2f\x2e\xd9\x92\x6b\x6d\xe6\xa9\xca\x66\x73\xbf\xf6\x46\xf3\xaf\xad\xd2\x4d\xf2\x6f\xd4\xd9\x81\x79\x75\xa9\x92\x27\x56\x2c\xba\xfc\xf8\x94\x82\x14\x94\x1b\xd1\x7d\x42\x5b\x1d\x32\xb6\xda\xba\x2d\xcc\xa0\x9d\x4c\x21\x4b\xde\x35\xa6\x10\x85\xc0\xdc\xcb\x14\xda\xcd\x1c\x2a\x73\xe3\x16\x85\x2b\xc3\xcb\xeb\x6e\x52\x16\x5d\x00\x00\x0d\x8b\x62\xf5\x60\x9a\x78\xe9\xd9\xff\x9e\x5a\xdd\x8e\xba\xda\xae\xfa\x5a\x7b\x9d\x6d\xbb\xde\x46\x93\xd1\x4e\x6f\xf3\x0a\xd7\x28\x05\xed\x03\xa3\x77\x95\xdd\x1b\x23\xaf\xee\x21\xbf\x77\x91\xe1\xbb\x48\xe7\x2d\x47\xe9\xee\x25\xca\x77\x12\xd4\x6d\x85\x35\x7c\xdc\xd9\x80\x51\x67\x07\xfa\xe9\xba\x5a\x4d\x5c\x9a\xde\xde\x87\x4d\x57\xaa\x7e\xe4\xd3\x1f\xf9\xf4\x47\x3e\xfd\x91\x4f\xff\x9f\xcd\xa7\x5b\xf5\x0e\xf6\xd3\x0b\x35\xfb\x76\xb7\xd3\x17\xdd\x52\xb5\xfa\x43\x18\x6a\x5a\x5d\xc5\xce\x61\xed\x6b\xe5\x1b\x79\xbb\x57\x17\x2f\x22\xc5\x06\xcb\x07\x2b\xf6\x8a\xbb\x46\xd7\x4f\x4e\x80\x27\x99\x8e\x34\x34\x97\xeb\xe7\x67\x21\xfe\xb0\xd3\x0f\x35\x36\xa2\x43\xd2\x7f\xa6\x8d\x78\x6e\xbc\x1c\x86\xf6\xcc\x8c\x9b\x6b\x1a\xd8\x87\x98\x8a\xad\x16\x79\xac\x66\xa5\x34\x47\xa3\x4e\x8b\xa8\x9b\x17\x6a\xd6\x96\xc4\xab\xed\x7b\x44\x0e\xa1\xe2\xde\x58\x40\xba\x58\x73\x33\x51\x75\x09\x91\x6a\x42\xc8\xcb\x27\xc7\xe0\x48\x66\x25\xcf\xcb\xc3\xa7\xd6\xf1\x32\xeb\x74\x7b\x4d\xd9\x66\xea\xf3\xd5\x40\x62\x34\x2d\x53\x50\x23\x3f\x38\xef\x4e\xeb\x2c\x37\xf6\xc8\xdb\x34\x8b\x91\xb2\x5d\xb6\x1d\xca\x70\x84\x49\x36\xfe\x3c\xd9\x76\x58\x8b\x94\x57\xdd\x17\x6a\xe6\xee\xb3\xc2\x74\x3a\x6e\x8b\x69\xfd\x0c\x17\x94\x0c\x3a\x1f\xb2\xa3\x34\xd8\x42\xe9\x1b\x93\xfa\xec\xb0\x0c\xb7\x4b\xd8\x81\x1f\xb6\xdf\xb9\x97\xa0\xda\xf8\x3a\x4f\xd5\x73\x29\x62\x11\x1a\x55\x7b\x0e\xa1\x2c\xbb\xd6\xaa\xb8\x6b\xae\xc1\x33\x34\x95\xb1\xa1\xdb\x3c\x20\x16\x4e\x4d\x8b\x64\x40\x90\xc0\x0b\xf3\xf8\x22\x31\xd5\x73\x59\x3a\x54\x28\xb5\x4f\xbc\xb9\xc4\x02\x52\xd7\x73\x9e\x46\x79\x08\x74\x96\x68\x41\x49\x13\x1c\x1d\xd8\xd4\xb8\x16\x0e\x48\xf6\xdc\xed\xec\x6e\x0a\xa0\xef\xf8\xf9\x9d\xcb\x31\xda\x68\x30\x94\x10\x53\xad\x54\xce\x90\x1b\xf3\x89\x88\x99\x76\x28\xa3\x99\x07\x31\x49\x59\x97\xfc\x27\x18\x13\x74\xfa\xf2\x59\xb3\x59\xb3\xc5\xea\x2a\x01\x76\xba\xa1\x73\x0b\x64\xfe\x06\x1d\x39\x14\x98\x43\x7a\x2e\x24\xfb\x62\xd7\x02\x4e\xe7\x24\x10\xfa\x54\x04\x55\x51\x08\x51\x4c\xa9\x09\x04\x94\xc2\x42\xb6\x5e\xd0\xf9\x30\x4b\xec\x5a\x6c\x89\xa8\x2f\x0d\x12\xfa\xa6\x98\x32\x3b\x5a\x78\x90\xdb\x3b\xf9\x00\x0b\x5d\xa1\x19\xbc\x96\x8b\x17\xfe\x73\xb8\xd8\x01\xd0\x1c\x7d\xa9\x00\xfa\xb2\xd3\x0d\xa8\xdb\x03\x91\x07\xa2\x47\x41\xa6\xa6\x25\xac\x26\xee\x16\x11\x61\x94\x6e\x96\x70\x4d\x58\x8f\xea\x79\xd2\x07\x2f\x1c\xfc\xf3\x1c\xd2\xaa\x58\x55\x07\xf2\xcd\xbf\x54\x06\x9f\x3c\xc8\x50\x2d\x08\x3b\x0c\x94\x78\x39\x10\x58\x62\x95\x6d\x58\x07\x14\x47\xe1\x06\x44\x7b\xcd\x39\x52\xa4\x86\x84\xef\x2a\x75\x23\xca\xed\x5c\x4d\x4d\x38\xab\x2b\x51\xc9\x00\xa4\xc7\xaa\xb6\x0d\x42\x84\x4a\x4b\x78\xd8\xd0\x1c\x35\x85\xdc\xc7\x76\x84\x29\x19\x31\x33\x4a\xc4\x22\xba\x23\xc0\x5d\xf5\x21\x43\xb6\x10\xe9\x4c\x80\x69\x16\xce\x83\xce\x07\xfb\x47\x5a\xce\x41\x3b\xd3\x65\xbb\x54\x01\xb9\x72\x2d\x36\x19\x3f\x83\x1c\x9d\x8d\x85\xb6\x88\x9d\x36\xd0\x22\xcb\x7c\x01\xcb\xb5\x11\x43\x4e\x3d\xe1\xf1\x45\x0b\xae\xd1\x02\x8f\x25\x1a\xf5\x00\xb0\x9c\x10\x92\xd4\xa9\x29\xfb\x0d\x18\x1e\x12\xca\xef\x6c\xc9\x25\x64\x21\x3a\x75\xb9\x66\xfd\x77\xe4\x6a\xf1\x9b\x81\x16\x20\x42\xfc\x5f\x99\xbc\xe1\xb1\x0b\x46\x4c\x98\x88\x2d\xc3\x55\xd3\x35\x49\x01\xe1\x1b\xe0\x77\x01\x96\x95\xdb\x70\xdd\x6b\xb1\xea\xf6\xd7\x28\xbb\x7b\x9e\x50\x5a\xdc\x35\x5a\xce\xf9\x36\xee\x9c\x75\xf1\x5d\xf7\x3e\xc2\x65\xcb\xcc\x6e\x79\x5d\x4a\xf4\xba\xe0\x4b\xca\xf3\xca\x8d\x5a\xd4\xdc\xfd\x0e\x17\x1a\x5c\xc0\x2a\x03\xe4\x8c\x3a\x5b\xe6\xab\x5b\x2a\x9e\x5f\x9c\xe5\x6e\xb4\x70\x9a\x04\x53\x37\x4e\x09\x09\xe7\x22\xca\x62\x4a\x12\xdd\xa4\x74\x94\x94\x09\x52\x24\xbc\xd4\x66\x98\xf2\xfc\x65\xa9\x67\xd0\x4b\x16\x0a\x0e\xdc\xda\xbb\xd1\xef\xa5\x66\xc0\x60\x36\xe9\x5d\xf5\xc3\xf7\xd5\xae\xfc\x4a\x88\x8a\x80\x43\x53\x77\xe3\xf5\x18\xb9\x0a\x95\x2b\x50\xce\x40\x8b\x98\xae\x2a\x76\x20\x50\x88\x19\x96\xb2\xf5\x6d\x50\xc3\x22\x01\x69\xa7\xb4\x73\x1d\x22\x31\xba\x29\xa0\x32\x1e\x36\x2d\xd1\x53\xfc\x1e\xdd\x8e\x53\x77\x73\xce\xcd\xe1\x90\x46\x05\x31\x9b\x86\x9b\xcc\x8e\xe3\x27\x88\x9f\x5e\x6b\x56\x6a\xf6\x93\x4a\xaf\x5d\xe6\x1e\x57\x80\x49\x8d\x81\xe3\x05\x99\x42\xd3\x4a\x8f\x58\x2c\x93\xec\x0e\xef\xf5\x18\xa4\x2a\x16\x41\xb9\x04\x18\x62\x22\x1d\xb1\xbd\xbd\x8d\x5d\x92\x57\xfb\x22\xe6\x89\x78\x90\x7e\x17\x5c\x9b\xa2\xdf\x2b\xb4\xd2\xa8\x49\x0a\x79\x5e\xbb\xba\xab\xec\x31\xb1\xd1\xdb\x3e\xed\x04\x75\xca\x2a\x78\x23\xf2\x2b\x84\x02\xc6\x5e\x01\x1b\x59\xaf\x95\xf3\x39\x67\x64\xa2\x41\x4e\x6a\x3b\x28\xbc\x0b\x49\x01\xd1\x34\xdd\xde\x0d\x2f\xa7\x17\xe7\x23\x4a\x71\x16\xa1\xad\xed\xf9\x41\x20\x50\x13\xef\x1b\x88\xc5\x1d\x13\x1e\x68\xb0\xd6\x4a\x44\xde\xec\xfe\x68\xa3\x59\x56\x87\xde\x5c\xb2\xb2\xfa\xfe\xad\x0a\x7e\x4b\xd5\xe1\xcf\xad\xe8\xb7\x9d\x92\x96\x0a\xff\x1f\xad\xf4\xb7\xd4\x20\xdc\xc7\xe1\x67\x47\xa0\xff\x74\x06\xc0\x8e\xc3\x6e\x63\x08\xac\x0d\xfa\xa3\x31\x80\xc6\x40\x6b\x83\x60\xc7\x39\xd9\xae\x6a\xbb\xbf\x36\xc6\x41\x1b\x03\xa1\xa5\x91\xd0\x42\x5f\xdc\x65\x04\x9e\x00\xda\x34\x80\xdd\x8c\x86\x1d\x70\x5d\xa2\xe9\x8f\xc6\x43\x9d\xf1\xd0\x72\xc6\x5b\x14\xd9\xcd\x90\x80\x8f\x51\x31\xe5\xcb\x69\x9c\xee\xd2\x0c\x76\xbd\x0a\x65\x69\xee\xbf\xd8\x4d\xa5\xf6\x7d\xd2\xd0\x64\x62\xd9\x09\x5e\x76\xe5\xae\xc3\xcc\x13\x76\x6f\xba\x0d\xb3\xb4\x1b\x36\x84\xc9\x31\x03\x9e\x44\x83\x02\xb4\x61\xb7\x73\x2f\x16\x53\xc2\xc1\x15\x69\x63\xb8\xad\x76\x95\xb7\x8d\xe8\x30\x86\x83\xfd\x04\x44\x48\xbd\x02\xbd\x24\x2b\x86\xd0\x58\xbd\x00\xe9\x91\xf4\x02\x93\xca\x65\x2c\xd8\x5f\x73\x22\xef\xdb\x8c\x70\x7f\x23\x15\x34\x27\x77\x60\xa0\x39\x61\xfe\xd5\x7d\xfb\x5b\xd0\xf9\x30\x2d\xc2\xf6\xb6\xa9\x44\x65\xf0\xcf\xb1\x42\x29\xc3\xaa\xa0\xc1\x51\x2e\x3b\xa3\xdc\x61\x1f\xdc\x2e\xa3\x43\x8f\x74\x4c\x28\x8e\x4b\x85\x75\x60\xad\x82\xdc\x92\xea\x33\x5e\xde\xd8\x00\xb5\xef\xa5\xba\x04\xa4\x66\xb1\xe8\x43\xe6\x9a\xa9\x48\x8b\x27\x28\xb9\x5f\xaa\xe7\x77\x22\xcc\x9a\xef\xfa\xde\x89\x65\xed\xe6\x46\xfd\xaf\x42\xa3\xb2\x23\x2b\x69\x54\x05\xe9\xf9\x3a\xd5\x46\xcc\x40\x96\xff\x5c\x2a\x93\xbe\x46\xdb\xd3\x39\x01\x38\xb9\x6b\x05\xf5\x97\xee\x9a\x81\xc5\xc4\x9d\x08\xa6\x93\xa6\x34\x15\xd8\xba\x43\x28\xa8\xaa\x31\x2a\x7e\xfa\x41\xd0\xe5\x80\xda\x01\x67\xaf\x76\x50\xe8\x72\xbe\x5b\xaf\xca\x79\xfa\xdb\xf3\x7f\x65\x3c\x0e\xd8\x33\x67\xe8\x19\xe5\x1e\x51\xa1\x35\x39\x91\xdf\x7e\x0b\x06\x13\x76\xd2\x67\x5a\xd9\x55\x0a\x37\x0d\x42\x3a\xd6\x24\x5f\xc8\xc5\x1c\xe1\x15\x1c\x1c\x12\x2d\x19\x09\x9b\x9f\x29\x83\x78\xcf\x99\x4a\x57\x0f\x82\xd1\x82\x68\x2e\x45\xa8\x92\x48\xef\x80\xda\xab\x6a\x5d\x1f\xc7\x80\xcb\xa5\x48\xa5\x8a\x60\x00\x70\x82\xb8\x4a\xa4\xfb\x76\x8f\xd2\xd1\x97\x9a\xd2\x42\x2d\x96\x58\x9f\x29\xb8\x51\xf1\x56\x6a\xba\xdd\x22\x17\xa1\x74\x53\x4a\x2f\x47\x98\xb7\x2a\xca\x57\x32\x4a\xda\x74\x32\xe0\xad\x77\x47\xb1\x1c\xc9\x12\xb2\x8b\x05\x05\x37\xff\x80\x77\x6a\x3f\x82\x3b\x6b\x0d\x13\x37\x32\x34\xbd\x80\xfd\x43\xa4\x20\xea\x23\x96\x88\x99\xbd\xda\x85\x48\xbc\x08\xf0\xb2\xd7\x93\x72\xcd\x0e\xd8\x3e\x56\x63\x72\x01\xf7\xee\x71\x23\xe2\x55\xcf\xed\xaa\xe9\x95\x36\x62\x11\x74\x5a\x26\x44\x78\x72\xd2\x79\x88\xa8\x2b\x04\x76\x87\x99\xfd\xd1\x6d\xd7\x16\x98\x71\xf9\x5d\x4b\x53\x98\x8b\x17\xb5\x41\xb7\xf7\x74\x79\x2f\x5a\xc0\xb1\x99\x7c\x82\x21\xc9\x31\x06\x13\xcf\x90\xca\xe9\xee\xf5\x0f\xa7\xf1\xd6\xea\x4e\x93\x72\xbb\xb1\x01\x30\x3c\x64\xc8\x6b\x97\x4d\x09\xa5\x5d\x57\xd2\xa1\xd5\x65\x61\x2e\x52\x6b\xd4\xe8\x2f\xae\x52\xf0\xa7\x70\xe4\x15\xf9\xa1\xd5\x94\xf9\xe3\x01\x3b\xd8\x26\x8b\xad\x02\xc2\x59\x38\x57\x5a\x24\xe5\xce\xcf\x93\x69\xca\xed\xcd\x9c\x10\xef\x83\x77\xc8\x4d\xd7\x01\x2e\x72\xb6\xac\xbb\xed\x4a\xcd\xba\x55\x48\x98\x43\xef\xde\x95\x5a\xaa\x58\xcd\xac\xd5\x29\xfd\xc6\xc8\xed\xd8\xdc\x8c\x2c\x81\x57\x34\xf4\xda\x0d\x19\x0b\x62\x5a\x08\x38\xf6\x6b\x41\x3c\x04\x1b\xf6\x88\xd0\x70\x3b\x17\x40\xd8\x6b\x5d\xe4\xa9\x43\xa5\x66\x97\x68\x74\x50\x9b\x50\xf9\x7b\x39\x9b\xc7\xab\x53\x97\x68\xa2\x8f\xf7\xcb\x40\x60\xc6\x8d\x88\x57\x41\x6b\xf7\x62\xb7\xb3\x91\xa5\xd4\xe6\x58\xd9\xcc\x48\x30\x47\xfb\x69\xb4\x90\xed\x2e\xd4\xed\x96\xcb\xd7\x5f\xa8\x8b\x37\xb1\x81\xa6\x99\x88\x5b\x9b\x84\x9e\x85\x31\x97\x0b\x5d\xc9\xe0\x0a\xfb\x33\xa0\xa6\x01\x82\x22\x91\xac\x5c\x29\x1e\xa6\x8a\xd2\x18\x63\xbc\x83\xee\x39\x7f\x37\x71\x96\x1c\x4b\x6e\x56\xc9\x52\xb0\x77\xa4\x92\x16\x18\x56\xc6\x66\xe9\xc9\x26\x33\xa4\xcd\x03\xc6\x27\x70\x2b\x9c\x99\x0b\x99\xe6\xa8\xbf\xdf\x8e\x82\x83\xf5\xd5\x6d\x22\x52\xf0\x22\xd5\x97\xab\xa2\x73\xbd\x5e\x25\xb5\xa7\xbd\x77\x9d\x2f\x72\x14\xae\x21\x67\xfd\x0a\x3c\xc4\xd6\x8f\xa5\x58\x59\x77\xa5\xe8\x38\x81\xcc\x13\x70\x95\x9a\x19\xb1\x67\xca\x73\x00\x53\x26\x7f\x08\x19\x92\x53\xbc\x81\xc7\xf8\x9d\x60\x8c\x1f\x97\x8b\x22\xfd\x33\x40\x86\x3d\x0d\xd8\x79\x62\x6c\x4e\x7b\x1c\x0a\xc5\x14\x8d\xd8\xa9\xdf\x70\xde\x40\xd1\xfc\x92\x9b\x79\x91\x47\xd0\xb5\x49\x31\xbc\xd5\x61\x56\x48\xc0\xe7\x94\xb0\xe0\x70\x48\x8d\xee\xe9\xcd\x91\x47\x0d\x03\xd8\x50\xde\x76\xd7\xb9\xa7\xe8\x72\xfa\xe2\x2e\xd7\xee\x94\xeb\x54\x88\x84\x50\x8c\xb7\x5f\xb8\x82\x76\x41\xca\x3a\xfa\xc8\x43\xe0\xd7\xa5\x52\xc0\x7e\x2a\x77\x44\xaf\x30\x2e\x08\xa6\x8a\xba\x7a\x73\xf8\x16\xb6\x0a\x14\x45\xa8\xd5\x37\x56\x96\x1b\x58\x73\x4f\xe7\xa3\x27\x8e\x81\xf3\x0a\xcd\x39\xd3\x9c\x02\x55\xcb\x97\x67\xf1\xa5\x1c\x4e\x62\x35\xa1\x3d\x19\x7b\x2b\xd6\xf0\xe6\x70\x08\xdc\x4d\x07\x33\xe5\x36\x5e\x46\xec\xef\xcb\x88\x63\xc0\x5b\x65\x24\x98\x68\xc7\x3d\xd3\x34\xc7\xc0\x5b\xf3\x67\xcf\xa4\x76\x66\x23\xe5\x62\x80\x41\x43\x46\x19\x03\xfa\x9f\x8f\x65\x5e\x1d\x06\xe0\xe6\x32\x9b\x44\x6a\xc1\xc1\xbd\xa4\x98\x36\x6a\xc9\xf2\x8b\xef\x2d\x77\xa7\x16\xdc\xa2\xcc\x00\x52\xba\xbe\xa2\xae\x3d\xb8\xfc\x85\x92\x6d\xa4\x22\x07\xa3\x11\xdd\x88\xc9\xca\xa0\x69\x5b\x49\xaf\x0f\x1c\x34\xde\xba\x91\x3b\x9f\x70\x3d\xc3\xc5\x1c\x6b\x35\xd5\xc6\xdd\x7b\xaf\xbd\x2a\x64\x6d\x8a\x16\x1d\xdf\x6f\x0d\x6e\xd6\xfb\x60\x96\xdc\xd6\xdb\xa8\xb3\x6d\x65\x96\x8a\x6f\x0e\xc9\x7b\x0d\x45\x3f\x86\xe3\x7d\x0c\xc7\xfb\x18\x8e\xf7\x31\x1c\xef\x63\x38\xde\xc7\x70\xbc\x8f\xe1\x78\x1f\xc3\xf1\x6c\x38\x9e\x89\xf5\x25\x9c\x90\x92\x66\x75\x91\xaa\xa9\x8c\x6b\x8f\x50\x96\x26\xad\xbb\x5e\xc7\x3b\xec\x43\x37\x44\x5b\x7b\x17\x4e\x34\xf9\xa7\x6a\xe0\x19\x69\x1d\x85\xd2\xb1\x45\x9b\x28\x29\xf3\x7c\x29\x41\x8b\x81\x4a\x75\x5e\x26\xe7\x81\x4a\x85\x56\x59\x1a\x56\xe3\xa2\xbc\x7b\xe8\xa0\x93\x57\x71\xd4\xb7\x26\x18\x39\x55\xed\xc4\xfe\xa0\x22\x91\x26\xa0\xa4\xe0\xd0\x60\x36\x28\x9e\xcc\x3a\x93\xc1\x55\xeb\x5e\x96\xf6\xec\x80\x3e\xd6\x7c\x27\x6c\x22\xcc\xad\x10\x90\x57\x32\x16\x1c\xef\x87\x2b\x25\x6c\x9b\xc9\x1b\x48\x05\x9a\x7b\x0f\x6c\x4b\x46\xe5\xe7\xf7\x7c\x00\xf3\x7e\xed\x26\xa4\x35\x72\xa8\x61\xf6\x73\xf0\xdf\xc1\x3f\x60\x08\x2c\x5b\xce\x52\x1e\x81\x5b\xb5\xfc\xf2\xb3\x43\x8c\xe2\x22\xfb\x02\xdd\x24\xf5\x03\x31\xca\xf3\x6e\xb8\x6d\x8c\x75\x75\x11\xdd\x49\x59\x4c\xc7\x7c\xc0\xd3\xaa\xe2\x58\x65\xe6\x5e\x4a\x9f\xcd\x01\x3a\x6a\xc3\x36\xba\x94\x4e\x13\x59\x06\xa4\x9a\x1f\xb8\x73\xb7\x40\x70\x9a\x68\xd3\x8d\x2d\x60\xdf\xc0\xa1\x6a\x03\xca\x5d\xbc\x62\x21\x4f\x51\xb5\xb5\x3b\x94\x79\x6a\x4e\x87\x08\xf0\xa6\x26\xf6\x80\x6f\x09\x27\xc5\xe5\xce\xdc\x80\x9f\x51\x2d\xe7\x32\x0c\xd8\x69\xe2\xa6\xb2\xda\x50\xac\xd4\xb5\x66\xb1\xbc\x06\xe2\xa3\x48\xc0\x50\x2e\xe7\x90\xae\x87\x0d\xd8\xf3\xb3\x67\xdf\x3f\x1f\x3c\x3f\x7b\x76\x79\x3a\x38\xfb\xfe\xf4\xec\xfb\xd3\xa3\x83\xc1\xc5\xab\x17\xff\x7d\x78\x7c\xf0\x38\x7f\xff\x7a\xeb\xdb\xd3\xe7\x97\x87\x47\x4f\x07\xdf\x9d\xfd\x30\xb8\xfc\xfe\xf4\xe8\xf1\x93\xfc\xad\x6d\x7b\xfd\xfd\x42\x26\x57\x2f\x2e\xe9\x6a\xda\x11\xbb\x7a\x71\x79\x73\x18\x1c\x76\x3b\xf5\x8a\x47\xab\x83\xb6\x6d\x34\x4a\x37\xf6\xc6\x02\x6b\x93\x6c\x2b\xf8\xa6\x8d\x4b\xdc\x0a\xe4\x68\xdb\x63\x3c\x9e\xa9\x54\x9a\xf9\x42\xd3\xc6\x17\xdc\xf3\x2a\x66\xca\xc0\x7a\xc9\x55\x07\xa8\x00\xb4\x01\x9e\x08\x3d\xe7\xd7\x70\xb2\xdb\x6d\xe3\x41\x08\x37\x1c\xa6\x5b\xa8\x1b\xb8\x6b\xc7\xa4\x74\x30\x57\xa6\x56\x08\x83\x33\x9c\xf6\x6e\xc8\xac\xad\x26\x5d\xa4\xd5\xfa\xec\xf9\xe5\xe0\xec\x9b\xb3\x63\x40\x34\x63\xfb\x2b\xbe\x88\x7b\xd5\x69\xf7\xcb\x74\x3b\x1f\xa4\xb6\xb4\x54\x5a\xb6\x29\x01\x74\x32\xdf\x23\x89\xd6\x33\x54\xaa\xd6\x34\x4f\x98\x9d\x85\xc7\xec\x86\x8a\x91\xdf\x0d\x66\x63\x09\x77\x27\x87\x2a\xce\xef\xf8\x6b\x35\x71\x75\xa8\x87\x22\xd4\x81\x66\x87\xc1\x61\x9f\x1d\x06\x47\xc8\xcd\x0f\x83\x63\x7f\x2a\xea\x89\x1f\xde\xbc\x7c\x75\xf5\x7c\xc4\xc2\xfc\xda\x77\xe8\x79\x2e\x67\x73\x48\x68\x54\x1e\x28\xb9\x00\x60\xc0\xf4\xe8\xea\xc5\xe5\xe1\xd1\xa6\xe9\xdc\xec\x8c\x80\xcf\xc0\x6f\xeb\xa0\x7d\xd1\xc3\xf6\x45\x8f\xda\x17\x3d\xee\x7c\x10\xdd\x6d\x51\x51\x18\x93\x9e\x50\x1b\x75\xda\xd0\x9a\x5f\x03\x30\xcf\x6b\x79\x7d\xee\xfa\xc3\x75\xe7\x5c\x7b\xb7\xf2\x5a\x06\x0b\xf5\xab\x8c\x63\x1e\xa8\x74\x36\x74\xea\xcb\xf0\x12\x95\x89\x77\x97\x32\x12\xef\xae\x5e\x5c\x7e\xe2\x0b\xdb\x77\x10\x9b\xcc\x8d\x9c\xc0\xa5\x90\xab\x77\xc1\xd1\x53\x38\x11\xbc\x58\x88\x24\x12\x51\x70\xf4\x05\xf4\x00\x14\x56\x61\xf4\x0d\x0b\xff\xea\xc5\xe5\xbb\xd3\xe7\x97\xef\x0e\x8f\x9e\xbe\xfb\xee\xec\x87\x77\x39\xb3\x76\x2f\x8e\x1e\x3f\x71\x2f\x8e\x9f\x9e\xd0\x0b\xc7\xfb\xdf\x39\xde\xff\xae\x25\x93\xdf\x51\x44\x1c\x3d\x7e\xe2\xde\xdb\xce\x4b\xb5\x1b\xde\xde\x57\x78\x6d\x82\xab\xb9\xd7\xda\x95\x7b\xf4\x41\x62\x6b\x2b\x99\x2e\x50\x15\x6c\x47\xa0\xb6\xec\x1f\x47\x9a\x56\x2d\x2d\x13\xe5\xff\x28\x09\xd6\x4e\xc8\x71\xc1\x4a\xcf\x72\x56\x9a\x25\x24\x38\x37\xa4\x17\x7a\x98\x09\x53\x71\xd4\x6e\xb6\x54\x1c\xfd\x71\x53\xf5\x2a\x8e\xde\x4d\x78\x78\x7d\xcb\xd3\xe8\x4f\x34\x61\xe5\x75\xdb\xcc\x15\x9a\xd6\x66\xa5\x76\x03\x57\x78\xbd\xf1\xed\xbf\x97\x67\x0c\xd8\xa6\x36\x2b\xe3\x01\x35\xba\x32\xd6\xd7\x8d\x6f\xaa\xb5\x1a\xaa\xac\x97\x07\xac\x55\x70\xf2\xba\xf1\x4d\xb5\x56\x43\x95\x32\x0e\xa8\xeb\x5a\xdc\xe4\xcf\xeb\xf0\x58\x87\xbf\x6a\x7b\x0d\xed\x58\x20\x8a\x97\x15\x4d\xb7\x9e\x79\x1f\xfc\xb1\xbc\x60\xd3\x45\x25\xf5\x17\x94\xd0\x95\x23\x35\x7e\x01\xf2\x09\xa8\x94\x9d\xa1\x95\x17\xd0\xbf\x2e\x77\x3b\x1a\x0c\x8c\xd3\x22\xf7\x34\x60\xef\x02\xe9\x5a\x4e\x53\xdc\x76\x18\xac\xfb\x23\x7c\x77\x04\xb8\x9a\xeb\x5a\xd0\x1f\xca\xac\x5e\x17\x4a\xcd\xbb\x8a\xc5\x4b\xf1\x39\x79\x4f\x00\x03\xe8\x61\x09\x85\xe6\x50\xb8\xa1\x58\xa1\x05\x45\xfe\x0e\x7b\x14\x13\x82\xf3\xe0\x8e\x68\x71\xeb\x18\x1a\x5a\x66\x91\xb8\x11\xb1\x5a\xd2\x3e\xa0\x00\xaf\x30\x68\xf9\x7e\x91\x29\x66\x20\xb3\x1e\x08\x99\xe0\x70\xc1\x5a\x7b\x86\xe7\x0b\xa1\xb0\x02\xdf\x8c\x08\xa5\x16\xf1\x8a\x82\xef\xfc\xfa\xc5\xad\x2a\xe8\xc1\x5f\xa6\x0a\xee\xf0\xb4\x0e\x1c\xdc\x0d\xa2\x83\x6c\xa9\x88\xb2\xb0\x26\x21\xad\xa8\x3a\x80\xa4\xf6\xac\x82\xd2\x99\x39\x36\x11\xd6\x97\x52\x44\x04\xae\x84\x61\xb7\x22\x8e\x19\x8f\xd4\x92\xb6\x4d\x01\xbf\x2a\x61\x5a\x4d\xcd\x2d\x40\x18\xcb\x49\xca\xc1\xca\xbc\xf7\xde\xe5\xab\x78\xd3\x76\xa5\x4f\x42\x1b\x8a\xd9\x61\x6e\x28\x60\x49\xbc\x73\x2f\x2b\x60\xe3\xea\x34\x59\x22\x93\xd9\xab\x65\x63\xe0\x3e\x4f\x56\x4d\x57\x74\xb6\xbb\x08\x73\xc1\xef\xce\x0a\xbf\xe3\xe8\x03\x2d\xb3\xc1\x36\x23\xeb\xe0\xdf\x02\xea\xb6\x88\x2b\xf7\x47\x89\x07\x47\xec\xe8\x00\xff\x3a\x2d\x52\xf2\x1d\xd5\x97\xab\xf0\x49\x7f\xde\xf2\x18\xac\xea\x85\xad\x11\x84\x5d\x3a\xdb\x9d\x6e\xeb\x80\x6c\x4c\x0d\xf1\x88\x4b\x05\xfb\xc9\xa7\x71\xec\x02\xa5\x60\x89\x28\xec\x83\xc7\xc8\x01\x31\x28\x82\xdc\xa1\x32\xf5\x42\xd8\x9c\x9b\x58\xc3\x8d\xb6\x14\x8f\x5b\x89\xc5\x2a\x91\x9a\x1f\x8a\x55\x3a\xcc\x0d\x6a\xd9\xa5\x75\x5c\xbb\x32\x10\xd4\x22\x93\x4a\x7d\xa9\xd9\x4c\x24\x22\x85\x4c\x54\xe8\x10\xf2\x2d\xc2\xf5\xec\x44\x2e\x9c\x4e\x1a\x90\x66\xd4\xad\x36\x25\xc7\xe2\xff\x62\xef\xea\x97\xdb\x36\x92\xfc\xff\x7c\x8a\x29\x66\xaf\x4c\x26\x24\x24\xea\xc3\x76\xb8\x1f\x29\x59\x76\xb2\xae\x73\x1c\x55\xa4\x24\xb7\x17\x65\x53\x23\x60\x28\xa1\x0c\x02\x5a\x0c\x68\x5b\xb1\x7c\x6f\xb5\xff\xed\x5f\xfb\x00\xfb\x4c\x57\xbf\x9e\x9e\xc1\x00\x04\x40\x48\x96\xef\xbc\x55\xb2\xaa\x6c\x99\x9c\x8f\x9e\x9e\x9e\x9e\x9e\xfe\x6c\xc9\xed\xb5\x89\x72\x4c\xd9\xe5\xaf\xe3\xf4\x24\x5e\xaa\x6c\x55\xf4\xbb\xe8\xea\xbd\xdc\x1e\x22\x45\x57\x92\x19\x7d\xa8\x23\x46\xe7\xbc\x78\x01\x9b\x08\x9c\x62\xc0\x6e\x13\x25\xde\xc8\xd8\xa0\x2a\xcb\xab\x35\xa0\x39\xaf\x28\x2b\x8c\x8d\xa6\x7e\x0b\x92\xb0\x42\x05\xad\xa4\x4c\x48\x55\x1a\x05\x3a\x94\xff\x05\x03\x19\x6b\x31\xd3\xc3\x41\xf7\xa1\x88\xf8\xd6\xba\x1d\xbb\xb2\x08\xbd\x05\x36\xef\x0e\x95\xb2\x8e\xc8\x7e\xb8\xd9\xdd\xfe\xe8\xc8\x31\x29\x31\x9f\xac\xe0\x41\xf7\xe4\xaa\x50\xba\x1f\x82\xd6\xba\xd5\xfc\xc7\x96\x2b\x72\x9c\x5f\x66\xf9\x95\xe7\x2d\x06\x77\xff\xfc\xb5\x8a\xc4\x28\x4e\xc5\x19\x66\x1b\x63\x21\xeb\x49\x64\x7d\xfc\x6a\xb6\xe5\x05\x95\xbb\x3c\xb6\xbe\xb1\xd6\xce\x8c\xd2\x65\x4a\xea\x42\xcc\x1e\x42\xbe\x8d\x4d\x4e\xd3\xad\x1d\xe0\xd1\x96\x59\xb7\x54\xbd\x3e\xdf\xc8\x4a\x57\x45\x96\x25\x3a\x88\x55\xb1\xa0\x87\xe0\x45\xb1\x4c\xb6\xf2\x45\xf8\x68\x7f\x6f\x7b\xcc\x06\x71\x3f\xb4\xc0\xf3\x1d\x5c\x1f\xd4\x71\x37\xe9\x36\xd7\xb9\x3a\xef\xee\x3c\x7a\xf8\xd8\xe0\x80\x43\xc9\x0a\xe6\xac\xfe\xf0\xad\x2c\x09\x19\x49\xd7\xf7\x80\x59\x93\xd3\x96\x17\x59\x26\xf4\x12\xd1\x21\x24\x19\xe5\x4a\xbe\x6a\x01\x15\xcc\xb8\xdf\x78\x89\x84\x79\x3e\x24\x07\x40\x23\x22\x35\x8f\xc8\xea\x5b\x1d\x9f\xa7\x60\xd7\x92\x64\x2c\x62\xcd\x4c\x16\xc5\x85\x4c\x45\xaa\x20\xc2\xc9\xfc\x2a\xd8\x44\xe8\x5d\x57\x63\x99\x7e\x16\x7b\x3f\xb8\x6d\xf8\x83\x8f\x80\x6f\xe5\xdb\xef\x15\x15\xfd\xbb\xe5\xa9\xa8\x0d\x70\xfb\xf3\x91\x67\xcb\x86\xad\x01\x29\xfb\x69\x6a\x73\x9a\x0b\x14\x84\xad\x94\x97\x56\xa4\xbe\xd5\xf1\x8a\xd3\x30\x5b\xa2\xb7\x9f\x9f\xb4\xf4\x8d\xa6\xbc\x10\xc6\x1c\x30\x5a\x87\x6c\x2a\x3a\xd0\x80\xd0\x96\x02\x9e\x4f\x08\xaa\xc1\x0c\xeb\xfd\xed\x81\x3e\xa7\x20\x19\x1c\x58\x99\x76\x0d\x79\x97\xa7\xf2\xf1\xec\xcb\x9d\xbb\x3b\x94\x35\x40\xef\xf0\x78\xf6\x19\xf9\x93\x3e\xa8\x1d\x05\x6f\x7b\x9d\xd3\xa4\xb8\x38\xbc\x50\xe1\x2b\x7a\x10\xbd\x96\x49\xef\xf3\x59\xef\xb8\x7e\xb9\x3b\xcf\xe2\x9c\xa4\x21\xed\xcc\xfc\xc5\x1b\x54\x00\xa4\x77\x2b\x89\xa8\x9c\x7f\x34\xc4\x70\xd0\x30\x88\xb8\xa8\xd4\x86\x64\xe9\x48\xdb\x9c\x2a\x2e\x96\xc3\x5a\xdf\xcf\x93\xec\x8c\xe8\x48\x6a\x8f\x18\x71\x62\xc1\xb2\x09\x06\x3d\xa1\xb2\x6c\xfc\xb0\xc5\x03\x3c\x8f\xa3\x48\xa5\xa8\x95\x37\xa5\x16\xd6\xe5\xd3\xfc\x87\x2a\x95\x90\x40\x20\x4e\x4d\x64\x45\x5e\xf5\xa3\xe0\x54\xbd\x81\x01\x3e\x20\xe0\x83\x98\x91\x71\x3a\x24\xb2\x7f\x46\xb5\x49\xc9\xbb\x6c\x95\x82\x81\x1b\xa3\x9d\x19\x96\xdd\xcc\x32\x44\xe0\x84\xf1\x52\x26\x1c\x93\xa4\x27\x42\xc9\xf0\xc2\x38\x33\x3b\x21\x7f\x91\x4b\x73\x8b\x13\x57\x12\xab\x34\xc6\x23\x7b\xb1\x88\xdf\x4e\x84\x3a\x17\xa7\xc3\xdd\xed\xed\xa5\x3e\x1d\x4e\x50\x68\x39\xd8\xbf\x40\xc1\xd6\x5c\x9c\x0e\x77\x2e\xf6\xf6\x97\x00\xc7\xf8\xe5\x41\x5e\xa4\xce\x46\xee\x3e\x1d\xa6\xdc\x67\x85\x12\xaf\x23\xea\xf2\xcf\xbf\xe3\xf7\x1f\xbe\xd8\xde\x7e\xb2\x6f\x06\xf9\xd7\x3f\xf8\x93\xdd\x27\x87\x63\xcc\x60\x67\xe2\x7f\x96\xe6\x9f\x8b\xd3\xe1\xfa\x69\x2f\x32\x91\xe0\x39\x43\xdc\x67\xdf\xb8\x08\x98\x53\x84\x24\xee\xf8\x22\x97\x8b\x45\x1c\x8a\x68\x85\xe3\x46\x67\x79\x01\x67\x20\x48\x79\x27\x87\x47\x35\xea\xa0\xe5\x87\xa4\x30\x4e\xaf\xc0\xf7\x8e\xff\xf2\x52\x5c\x82\x40\x50\xaf\x30\xcb\x97\xa0\x92\x03\x78\xf7\x22\x4e\x16\x21\x3c\x48\xc6\x5c\x81\x27\x23\x9b\x25\x01\x62\x7c\x33\xe0\x98\x11\xa7\x61\x0e\x2f\x90\x48\xc0\x59\x34\x0d\xe1\x59\x6d\x00\xb2\xb2\x39\x3b\xd5\x94\xbc\x21\xcd\x88\xce\x55\x5e\xea\x53\x0c\x91\x5d\xc8\xd7\x2a\x7d\x60\x14\x1d\x67\xf0\x6b\x31\x99\x5e\x0d\x5f\xd3\x2b\x04\x4e\x9f\xa6\xe4\x16\x01\xf6\x0a\x14\xff\x86\x40\xc7\xa6\x43\x05\xee\x0e\xad\x89\xc8\x2e\xe3\xd4\x6e\xff\x3a\xeb\x09\x2f\xb2\x4c\x2b\x8f\xf8\x5d\xf5\xbb\x26\x77\x1b\xa7\x7e\x0a\x3c\x03\x84\x2f\x39\x37\x41\xe2\x0e\xdd\xbe\x71\x46\xaa\xf6\x64\x7e\x54\x8d\xed\x86\xe0\x35\xe3\x2c\xd3\x5e\x65\xf1\xb5\x36\x3b\xb3\xbd\x47\x7b\x8f\x77\x1f\xee\x3d\x42\x1c\xd2\xce\x5e\xf0\x78\x5f\x44\xf2\x0a\xb1\x45\xe2\x49\x56\x5c\x34\x47\x5c\x79\xcb\x18\x0e\x36\x65\x89\x1f\x6d\x5f\x8f\x7e\xde\x9e\x7e\xf9\xcb\x17\xa3\xd3\xc0\xfc\x32\xfe\x6a\x94\xea\xeb\x95\xbe\xfe\xe7\xdf\xf5\xf5\xbf\xfe\xa1\xaf\x97\xfa\x5a\x5f\x2f\xaf\x2f\xc6\xe3\x2f\xc6\xbf\xbb\xed\x33\xa0\x9f\xaa\xa2\xca\x4d\xab\x7d\x1c\x23\xf5\xab\xb1\x97\xe1\x8a\x3a\x5e\xae\x92\x42\xa6\x2a\x5b\x69\x4f\xfc\xe0\x3b\x8b\x1d\x70\x94\xc6\x73\x3a\xd6\x48\x54\x70\xa9\x5c\xc6\x72\xab\xe9\x0b\xc4\x73\x43\xee\xee\x54\x98\xbd\xa0\x9d\xd1\x86\xfd\x34\xab\x21\x80\x7e\xf8\x19\x24\xca\xdc\x6c\x3e\x00\x20\x7d\x56\x0f\x86\x08\x8d\xca\x16\x9e\x6b\x24\x87\xe0\x3a\xb7\x33\xdc\x06\x5c\x3f\x59\xaf\x96\xac\x63\x3c\x42\xaa\x70\x8a\xa4\xe0\x7b\x58\xe6\x6a\x6e\x85\x90\xed\x89\x98\xce\x4a\xbf\xc2\x9c\x68\x00\x3a\x99\x29\xeb\x70\xec\x53\xb0\x41\x84\xc1\x19\xdb\xde\x28\xc7\xf8\x47\xc0\x49\x32\xfb\x18\xdb\x9c\x6b\xff\xfb\x46\x3f\xb6\x38\x15\x8b\x15\xca\x8b\x58\x9f\x32\xe7\xb7\xe7\x3c\x21\x01\xd2\x74\x06\x48\x52\xb7\x2b\x04\x40\x74\x95\xca\x65\x1c\xd2\x35\x06\xf6\x46\x97\x90\xdb\x7f\xd3\xd7\xaa\xb1\xd1\xdd\x53\xe2\xae\x48\x8e\xd4\xd6\xd5\x33\x5f\xa5\x69\xa5\x34\x35\xf4\x3d\x09\xa7\x9a\x9e\xce\xc4\x28\x0e\x54\x30\x11\x72\x55\x64\x63\x23\x8a\x96\x6c\x50\xb2\xb4\xc3\xf3\xf1\x16\x11\x34\x91\x18\xfd\xcf\x3e\xa1\x1a\x57\xf4\x77\x97\x2a\x3d\xc6\x5d\x28\xfe\xf4\xc7\xbd\x60\xb6\x6d\x23\x5b\xf5\xd8\xee\x50\xae\x10\xd6\x6d\x88\xa9\x46\x7f\x66\xd6\x38\x0d\x57\xb9\x2f\x2e\x59\x39\x69\x45\x99\xfd\x81\x04\x99\x97\xbe\x75\xac\x60\x76\x3b\x60\xf7\xa6\x72\xe5\x48\xc6\xb2\xf5\x96\xa9\xc8\xbe\xfe\x28\x50\x1d\x99\x58\x24\x26\x4c\xc2\xa1\x01\xec\x12\xd1\xe8\x69\x51\x49\xe0\x6f\x21\xa7\x50\x25\x5d\x20\x2f\x40\x7a\x6e\x77\xf7\x2a\x5b\x31\x0b\x06\x07\x8e\x75\x98\xab\xc2\xe2\x70\xa4\x82\xf3\x60\x22\x1e\x11\x1d\x39\xe4\x58\xc1\x08\x19\x08\x62\x2d\x96\xf1\x79\x5e\xc6\x1b\xc1\xe9\x10\xc9\xdd\x88\x60\x73\xd4\x00\x4f\x33\x71\xbe\x92\xb9\x4c\x0b\xc5\x6b\xa3\x05\xda\x86\x54\x4c\x3b\x8e\x90\x0e\x3a\x94\x89\xa3\x87\x52\x84\xc2\x81\xa7\x9b\x47\x48\xa1\x43\x95\xca\x3c\xce\x5c\xce\xb9\x37\xf4\x04\x5d\xc8\x38\x01\x29\xd3\xda\x02\xbb\x2a\x5c\x65\x94\x84\x8f\x83\xab\xca\x08\xc1\xf5\x49\xec\x52\xcb\x36\xc5\x0a\x0e\x87\xd6\x36\x62\x16\xdf\x85\x2b\x3a\x15\x00\xeb\x7c\x15\x47\xa4\x0f\xa5\x0b\x9b\x0e\xe7\x74\x46\xdd\x13\x55\x78\x87\x09\xbe\x86\x96\x3a\xfd\x03\x84\x24\xcc\xa6\x80\x3a\x6d\xd1\x5f\x30\xa7\x4c\xc5\x32\x4b\x63\xc4\x35\x54\xe8\x0c\x62\x22\xef\x86\x3b\x31\xbc\xda\x4a\x61\x7d\xb1\x54\x08\x2d\x9c\x8b\x07\xae\xd9\xaf\x66\xa0\x5f\x39\x96\xec\x57\xad\x8a\x5f\xe9\xd1\xf3\xce\x35\xf9\xa3\x95\x1e\x4f\x87\x13\x17\x31\xf9\xc7\xd3\xa1\x93\x25\xa7\xcc\x68\x4f\x87\xef\x1f\x6c\x06\x36\x5b\xf8\xe6\xb2\x1a\x71\x22\x94\x21\xfd\x44\xd6\xb2\x55\x0e\xec\xa0\xbb\x2d\x56\x3e\xe4\xa1\xb4\xf9\x11\x94\x2b\xd4\x43\xb8\xd9\xfb\xa7\xda\xa7\x7a\x63\xb3\x24\x64\x9f\x02\x42\x16\x5e\x11\x0d\xde\x9c\x58\x3b\x29\xc8\x38\x20\x67\xd2\x9c\xfd\x10\x79\x8e\xe8\x5c\x9b\x2b\xa5\xf5\xaa\xc6\x45\xef\xf3\x29\x19\x86\xab\xe5\x2a\x71\x49\x26\xd7\x48\x63\x22\x22\xdf\x3c\x88\x3e\x96\x13\xac\xcf\xe1\x80\xc7\x34\x54\x81\x01\x32\xbc\x4a\x05\x96\x2d\xe2\x25\x17\x8f\xc0\x40\xd2\x3e\xcf\x1e\x68\x27\x22\xd7\x9c\xc8\x73\x98\x2b\xf3\xec\x0c\xba\x4a\xea\x88\xb5\x38\xa7\xd4\xa0\x2e\xae\x47\xaa\x69\xc1\x78\xb9\xf1\x37\xca\xca\xe8\x88\x77\x08\x6d\xb0\x26\x71\x0a\x5e\xf6\x83\x92\x2f\x19\x8c\x60\x1d\x85\x7c\x65\xfd\xd3\x8d\xf0\xf2\xb7\x55\x1c\xbe\x2a\x63\xfc\xeb\x7a\x50\x12\x91\xcb\xf7\x1a\x7d\xfb\x7b\xaa\xec\xf2\x07\xab\xe4\xbc\x7c\x75\x1e\x9c\x67\x41\xa4\x5e\x6f\xa1\xf1\x67\x47\x32\xd7\xea\x29\xf7\xf8\x93\x27\x5e\x4f\x2a\xb4\xe1\x49\x5b\x8a\xf8\xff\x4c\x97\x82\x8d\xbd\xf2\xd7\xc4\xe4\xd9\xce\xb6\x0e\xc4\xb7\x3c\x86\x4c\xa3\xe6\xa6\xda\xb7\x34\x97\x12\x89\x73\xfd\xcc\x16\xe5\xe5\x5d\xd1\x55\x21\x6d\x84\x5b\x6d\xb6\x2a\x74\x1c\xd9\xac\x16\x9a\xab\x5e\xe9\x6a\x2d\xf6\x92\x11\x67\x8b\xda\x39\x72\x3a\xae\x10\x2a\xb4\x68\x6b\x91\x64\x59\xce\x36\x6d\xa3\x82\x85\xec\x64\x2f\x8d\xca\xcc\x0b\x23\xd9\x63\xb9\xb5\x51\xb0\xc3\xf8\xf8\xf7\x5d\x92\x5c\x39\xba\x9f\xa3\xd7\x90\x52\x14\xeb\x2a\x5a\x1d\x6b\x64\xa9\x62\x4c\xc4\x70\x60\x1e\x64\xa6\x09\xdd\x0f\xd5\xb5\x15\x2a\x49\x74\x0b\x0c\xf4\xcc\xa2\xeb\xcd\x1b\xd6\x7b\x8e\xb1\x00\x92\x5c\x89\x7d\xf3\x34\x5a\x17\x23\x01\x14\xcc\x25\x69\x56\xc4\x1c\xa0\x71\x52\xca\xb4\xea\xff\x48\xa9\x10\x40\xbc\xf9\x94\xd4\x0a\x20\xd5\xf9\x9a\xa4\x57\x55\xb7\x91\xf4\xea\x89\x7b\x8c\x7f\x20\xa4\xa2\x7b\xb0\x0c\x24\x4e\x45\x76\x06\xb6\x05\x96\xe4\xf1\x11\x0e\x07\xe7\x33\x19\xe7\x42\xa5\xd1\x65\x16\x53\x28\x6c\x1b\x8f\xb1\xb4\x6a\x0e\x82\x8a\x8c\xde\xc3\xea\x32\x12\x0e\x4f\x4d\xd5\x1b\x48\xf8\x9c\xb8\x88\xe7\x71\x84\x7b\xc6\x26\xc1\x48\x40\x7c\x81\xd7\x37\x8c\xa3\x67\x9a\xf5\x21\x66\xe8\x4f\xe9\xbd\x0b\xdc\xa9\xfc\xa6\x46\xd6\x7a\xaf\x75\xe5\xe1\xad\x8c\xac\x35\x6b\x6a\xdd\xd8\xca\xa6\xc3\x4f\xdb\xc8\x6a\xd6\x70\x0b\x6c\xde\x1d\x2a\x65\x1b\x22\x3f\x19\x63\x6b\x71\x91\x2b\x19\x1d\x66\xab\xb4\x27\x8a\xbc\x0e\x0e\x41\xd8\xff\x52\xaf\x62\x5a\x68\x77\x32\x1b\xd5\x26\x87\xf8\x12\x68\x22\xf9\xc1\x76\xf9\x20\xb5\xc9\xa4\xae\x33\xa1\xa1\x5b\xb4\x25\x08\x1e\x71\x0c\xc8\x73\xb9\x62\x77\x2b\x2d\x56\x14\x94\xfd\x70\xcf\x2e\xe7\xd6\x36\x1d\x7f\x6f\x39\x39\x1f\xc9\x48\x3c\xa7\xfb\x2e\xd6\xc2\x4d\x66\xb5\x24\x71\x8b\x08\x52\x51\x8a\x58\x3e\xde\xd3\x24\x54\x93\x51\xfd\x9d\xb3\xe8\xa8\x6d\x47\xf3\x4e\x10\x77\x5f\x15\x71\x12\xff\xc6\x7b\x71\x78\xf4\x83\xbd\xbf\x60\xff\x03\x83\x9d\x88\xcb\x0c\x2e\x7c\x31\x69\x63\xf0\x22\x7e\x0d\x50\x29\x2d\x9e\x19\x24\x5e\x70\x02\x2a\xa3\x4c\x46\x92\xaa\x68\x15\xae\xc3\x66\x61\x2a\x63\x0a\x9b\xc3\x04\x01\x15\xfb\x01\x89\xcb\x2c\xcb\x93\x0f\x34\x0f\x59\xf7\xa6\xd6\x4c\x76\x1b\x0b\x8d\x6e\x7e\x38\x15\x89\x7e\x9e\xc2\x8b\xa8\x78\xaa\x12\xd9\x33\x59\x4e\xad\xd3\x3a\xb7\xe2\x07\x03\x3d\x61\x61\xaf\x84\x17\x7a\x24\x0b\x09\x0c\x2d\x62\x92\x53\x5c\xba\x54\x6a\xb7\x4e\x4d\x30\x21\xe8\x0b\x54\x66\x2b\x2f\x7c\x6f\x58\x0c\x04\xbb\x10\xde\x2d\xf6\x6a\xb0\xe4\x1c\xc2\xa1\x08\xda\xa8\xc2\x08\x7e\x9d\x15\x0c\xf9\xf2\xc6\x23\xcc\x3c\x79\x24\x4c\x5c\x80\xdc\x41\xe8\x8f\x17\x5a\xeb\xb4\x39\xc5\xad\xac\x34\x36\xf8\x11\x11\xb0\x8a\x43\xb1\xff\xf1\xf9\xe9\x2a\x4d\x55\x72\xa3\x4b\xa7\xd2\x65\x7d\x1b\x25\x8f\xe9\xdf\x3d\xa3\x38\x0d\x93\x15\xbd\x40\xdf\xa8\x33\x9d\xc1\x68\xa3\xc7\xad\x57\x12\xf6\x85\x07\x41\xc2\x95\x28\xe9\xe9\xec\x33\xbb\xf8\xb8\xe8\xea\xf4\xdb\xf4\xe2\x38\x0e\x49\xfd\xf6\x9d\x31\x35\x36\x7b\x3e\x54\x50\xda\xde\xd5\xb2\x34\xf6\x98\x06\x02\xbd\xc6\x35\x31\xd4\x98\x0c\x35\xd7\xe5\x05\xed\xc7\xda\x6f\x1e\x0c\x6e\xe1\x4d\x7e\x93\x80\xfa\x4b\xf6\xbe\x98\xae\xd2\x57\x69\xf6\x26\x9d\xd2\xd5\xa3\x1b\xc7\x6e\x1d\xd7\xe4\x3f\x9c\x0f\x3a\xf0\x65\x9a\xd8\x94\x2a\x4b\x98\x1c\x50\x1f\x93\xde\x01\x46\xa8\x47\xa1\x4c\xd3\x28\x5b\x34\x5f\x78\xc1\xa0\xbf\x4f\xa1\x53\xb8\xdb\x84\x84\x1b\x77\x74\xad\x07\x80\x2d\x2f\x07\x07\xa4\x6b\x57\xa6\x77\x94\x61\x98\xe5\x74\x54\x8a\xac\xed\xce\x28\xd3\x6c\x07\x83\x76\x62\x6f\xbb\x27\xba\x19\x3c\x32\xcb\xc6\xad\x36\xac\xca\x2a\x87\x65\xdb\x6a\x42\x21\xef\xf3\xf2\x21\xe5\x25\xb6\x74\x39\x17\x6d\x66\xd8\x4d\xcb\xa4\xe1\x5d\x27\xc9\x46\xd9\x38\x74\x77\x01\x3d\x64\xed\x10\xa5\xf0\x34\x8a\x03\x35\x11\x01\x4f\xbd\xbe\x2b\x0a\x49\x84\xb5\x08\x70\xbe\x02\xbb\x05\x63\x56\x0b\xe5\x8a\xec\x8f\x9e\x29\xcb\x5b\x17\x3f\xeb\x39\x57\x36\xad\xa0\xa4\x38\x23\x2f\x34\xac\x68\xa1\x24\xac\x44\xe6\xfd\x1f\xca\x4b\x13\x14\x11\xb3\x64\xf4\xb9\x78\x91\xc9\xe8\x09\xd7\x67\xfd\x56\xa6\xf2\x5c\x45\x88\x68\xca\x61\x52\x5d\xd4\x34\xb9\x3e\x92\xc9\x27\xac\x98\x8b\xcf\x49\x54\xb3\x4f\x56\x71\xb9\x22\x5b\x20\x5a\x73\x76\x98\x2b\x9b\x6e\x43\xb3\xb0\x1f\x87\xaa\x5a\x16\x36\x10\x53\xf1\xb5\x4c\xc0\x3d\x16\x94\xde\x9c\x4e\x10\xd4\x1a\xb5\x09\x57\xa9\x96\x45\xac\x17\xb1\x8a\x1a\xa0\xff\x5e\xc9\xe8\xea\x86\xb0\x1f\x54\x21\xc1\xa6\x2f\x0d\x12\x02\x5e\xd9\xda\xf7\x10\xb2\xae\x3e\x00\xe4\xa7\x2f\x8f\x3f\x12\x9e\xb1\xc3\x97\x89\x2c\x70\x1a\x6d\x54\x84\x78\xfa\xf2\xd8\x2e\xa5\x81\x3a\x38\x51\x1d\x2c\x8c\xaa\x40\xbb\x28\xed\x4e\xe5\x61\xf9\xbf\xd2\x18\x59\xfc\x96\xa5\x4a\x7f\x18\x32\x6e\xb3\x6d\x98\xba\xba\x55\xf8\x04\x22\x7c\x1e\x69\x63\x48\x22\x67\x08\xbd\x0a\x61\x83\x5e\xac\x92\x52\x0f\x72\x2b\x68\x87\x83\x1b\xe5\x04\x68\xcc\x4f\x7e\x68\x87\x07\x95\xc1\x29\xdf\x1e\xe1\x34\x42\xc2\x3f\x37\xbb\x79\xa1\xb4\x24\x0c\xdb\xe4\x88\x2e\x44\x22\x75\x71\x92\xcb\x54\xd3\x60\x10\x9a\xda\x5a\x7a\x42\x8a\x2c\xd4\x14\x0f\x93\xd6\x96\x1b\xc5\x3a\x61\xcb\xa4\xcf\x3f\x64\x0c\x28\xe3\xb3\xf4\x83\x86\x68\xbe\xcd\x6f\x34\x44\x57\xa8\x5a\x8f\x01\x36\x08\x30\x5d\x19\x20\xcc\x79\x9c\x0f\x36\x90\x54\x79\x6c\x41\x42\x32\x2c\x60\xa1\xb3\x1f\xa6\x78\x54\x07\x83\x1b\x02\x6e\x39\xcb\x91\x63\x2c\xc7\xcc\x57\x36\x42\xd3\xde\xb5\x06\xa1\xe3\x54\xed\x30\x6e\xa2\x6f\xa4\x86\x7d\x69\x8a\x72\x37\x37\xa8\xc1\xe6\xb5\xa7\xf7\xdd\x5a\x7c\x0c\xc0\xfb\xb3\xd7\xa8\x8b\xc3\x06\x28\x18\x81\xe4\xf7\xa6\xf0\x3d\xee\x18\x0e\x55\xf4\x46\x68\x5a\x55\xbf\x93\x2b\x28\x5c\xf0\x28\xcb\x8b\x5e\xd5\xe0\x1f\x6f\x77\xb6\xf2\x91\xc0\xc3\xda\xed\xa0\x8b\x81\x0d\x73\x40\x10\x5b\x0c\x4a\x9f\x66\x9b\x38\x04\xd2\x95\x4a\x4b\xbf\x65\xeb\x55\xcc\xa9\xe1\x88\x51\x79\xae\xd0\x50\x53\xd0\xe3\x94\x26\x78\xbc\x8d\xf9\x64\x42\x17\xa6\x25\x4b\xcf\xf8\xc5\xfd\xa0\x7c\x09\xb3\x38\x0d\xe3\xc8\xb3\x91\x50\xb9\x47\x8c\x62\x7c\x6f\x58\xa6\xe6\x5b\x88\xeb\x7c\x94\x26\xa1\x18\x39\xf9\xb3\xdc\xd5\x01\x70\xa5\x14\xe3\xc2\xe2\x0b\x4f\x75\xf1\x78\xbb\x6d\x77\xfa\x48\xb1\xf6\x0f\x9b\xc2\xe6\xe2\xe1\xfe\xfe\xee\x7e\x57\x43\xab\xf8\xe8\xda\xa9\xcd\xca\x0f\x4b\x19\xba\x37\x69\xec\xed\xed\xde\x84\x36\xf4\x5d\x10\xc7\xf1\xcd\xa8\x63\x6f\x6f\xf7\x93\x23\x8f\xbd\xbd\xdd\x7f\x57\xfa\xb0\xb9\x7b\xe6\x7d\xf7\x7d\xe8\xb2\xfd\x58\x74\xe8\x4a\x4e\xfb\x75\x7d\xad\x35\x06\xba\x40\x83\x52\xdd\xe2\x3c\x67\x2e\x13\xe4\x3d\x86\x2b\x6d\x96\xf7\x1c\xee\xe8\xfb\xef\xfe\xeb\x2f\x0e\x7e\x92\x0b\xab\x1f\xd9\x4c\x5f\x44\x77\x44\x01\x15\x99\x9c\xbd\x11\xad\xbc\x1b\x1b\xeb\x66\xb6\x5c\xae\x52\xef\xa1\x44\xaa\x6d\xf8\x08\x02\x0a\x45\x8f\x74\x6b\xa2\xf1\x56\x41\xcc\x6b\x91\xe5\xc8\x14\x01\xc6\xef\xe4\x42\x7f\x9d\x8d\xcb\x09\x84\xf8\x81\xf4\xc5\x35\xd8\x6d\x89\xdc\x66\x1c\xc0\xfa\xa7\xe8\x38\x98\x99\xd6\xc0\x8c\x53\x5d\x28\x19\x71\x35\x86\x2c\x67\xc5\x63\xed\x59\xf2\x40\xdb\x2e\x60\xb3\x5e\x84\x89\xe6\xec\x16\xe7\x50\xd1\x94\xe6\x77\x02\xaa\x01\xd8\x2c\x6d\x81\xd3\xcb\x83\x5d\x43\x06\xb9\x72\xc5\xc6\x2d\x0d\xef\x2a\x9c\x2f\x9b\xca\xad\x02\xa3\x2d\x85\xaa\xea\x3b\x0e\x94\x32\xca\xfb\x62\xda\x96\xf0\x42\x60\xfd\x9b\x37\x81\x75\x8f\x47\xec\x57\x94\xbd\x49\x31\xef\xd6\x4e\xb0\xb3\x15\x65\xe1\x16\x7d\x35\xb5\x93\x05\xc5\x5b\xe3\xac\x1f\xa7\xe6\x34\x43\xde\x36\xe9\xff\xab\x50\x39\x47\x8d\xf2\x29\x52\xfa\x89\x82\xad\xc4\x36\x32\xcd\x72\x3a\x8a\xf7\xe7\x57\x1a\xcc\x1e\x2c\x68\xe1\x71\x72\x3a\x3c\x39\x3c\x82\xc3\x3a\x7e\xa5\x79\xd8\xcc\x5c\x6f\xec\x58\x93\x6e\xb1\xe8\xe3\x64\x59\x93\x7d\x1d\x60\x6f\x83\x37\x79\x8f\xb6\x2a\xfc\xfb\x86\x60\x0f\xbb\x06\x40\x83\x93\xc3\xa3\x0d\x2d\x08\xfc\x8e\x36\x1b\xc5\x6c\x2b\xec\xf7\xbf\x16\x67\x5f\xee\x3e\xec\xcb\x1f\xdd\xc8\x1d\xf7\xa2\xca\x99\xbb\xa0\xad\x09\xfa\xf2\x74\xff\x38\x0e\x2c\x44\xaa\xe8\xae\x6e\xb6\xe7\xd0\x90\x20\x7d\x22\x82\x0e\x92\xda\x11\x8b\x4b\x37\x01\x15\x75\x9e\xab\x58\xaf\x1f\x2c\xce\xc9\x50\x19\x91\x41\x5d\xd9\xf2\x4c\x84\x04\xd0\x7d\x25\x52\x22\x68\xd0\x94\x80\x69\x6b\xc4\x32\x10\x3b\xba\xcc\xb3\x33\xc5\x45\x6c\xec\x30\x38\x79\x9c\x6f\x13\x5e\x9f\x93\x12\x07\xa8\x08\x21\xb6\xcc\x14\xbf\x6d\x19\x01\xa1\xc8\x10\x1e\x4e\xd6\x11\xa7\x26\x68\x50\x68\x58\x05\x0d\x56\x0f\x0d\x2d\x62\x7e\x6c\xd4\x07\x6f\x1e\x26\x33\x69\xeb\x8c\x48\x6e\xfd\x73\xf9\xeb\xea\x32\x28\xb0\x0d\x4c\xcd\xa2\xd2\x0e\x06\x0f\x3a\xf2\x56\xf5\xbc\x27\x7c\xa4\x30\xbf\x76\xfa\xa2\x93\x36\xdc\x92\xcb\x1b\x95\x09\xe0\x29\xc0\x6b\xac\x28\x65\xe3\xde\xa5\x15\x2a\x70\x13\xec\xed\x23\xd1\x1a\x4a\x79\x09\xb9\x80\x52\xa6\x86\x2a\x18\x11\x0b\xed\x5d\x18\x69\x56\x4c\x19\x8c\x23\xf2\x8d\x3b\x17\xea\xb5\xca\xaf\xc4\x3e\xd0\x34\xdb\xb6\xc3\xf1\x16\x48\xb1\x3f\x35\x9f\x38\x53\x07\xae\x11\xfe\x0e\x66\x46\x8d\xa7\x14\x40\x41\x78\x55\xa9\x64\x81\xbf\x0e\x2e\x04\x15\x95\x0b\x40\x54\x0b\xa2\xed\x2c\x76\xae\xd0\x68\x95\xda\xff\xf8\x05\x70\x26\xc4\x5b\x91\xae\x63\x5a\x28\x5d\xba\xe6\xdf\x5a\x9e\xc3\x79\xff\xf7\x14\xe8\x36\xa8\x10\x84\x48\x3c\xc5\xe7\x7c\xd0\x83\x9f\xf9\x1d\xda\x5f\xc2\x15\x0a\x6d\x7f\xee\xfa\x6a\x57\x64\xdb\x82\xeb\xd8\x07\x3c\x7b\xd9\x4f\xee\x98\xa4\x9f\xef\x71\x41\xe9\x79\x5f\x3e\x3d\x6c\xe8\xec\x5d\xa3\x92\xf3\x43\x5a\x3b\xc1\xf3\x23\x27\x2a\x11\x83\x25\x3a\x31\x2f\x1c\x49\x64\x6c\xe5\x8e\xc6\xb3\x6a\xc2\x7d\xe1\xce\x4c\x8a\x44\xf1\x0c\x6e\x18\x34\x8e\x73\xe5\x2c\x89\xd1\x88\x41\x87\xcf\x9f\x7e\x2f\x5c\x7c\x1f\xb9\xad\x23\x6c\x6e\x3b\xa0\x9f\xad\xc7\xd6\xcb\x6d\x11\x6d\x6f\xcf\xe7\xf8\xff\x98\xb8\x7c\x9a\xf1\xc0\xb1\xb5\xc5\x51\x41\xcb\xd3\xa1\xed\xb9\x7d\x3a\x24\x09\xe4\xf9\xd1\xeb\x3d\x12\xf3\x4e\x87\xf3\xb9\xff\xe9\x43\x3a\x4e\x2b\xae\xcd\x52\x93\x25\xd8\xca\x07\xdb\x74\x5d\xe8\x34\x92\x09\x0c\xd7\x21\x4c\x05\x10\xa1\x4d\xcc\x00\x16\x40\x97\x9c\x92\x79\x12\xab\xbc\xd9\xcf\xd3\x08\x7a\x51\x6c\x7c\x2f\x49\x17\x0b\x7c\x36\x6d\x13\xe9\x0f\x26\x24\x3b\xc2\x69\x41\xb3\xbf\x3d\x1b\x09\x82\x33\x55\xc8\x5a\x2d\x7e\xec\xca\xd4\xee\xca\xd4\x40\x3e\xe5\x8d\xf4\xc2\x28\x99\x95\x5b\xcf\xef\xe9\x1f\xf8\xa6\xf0\x2e\x0a\xb8\x82\xff\xe9\x74\xe8\x2c\x12\x1c\xd0\xd2\xe8\x17\x5e\xd6\x1f\xb2\xae\xb3\x71\x65\x3a\x12\x8f\x7d\x97\xdf\x78\xd1\xb8\xde\x32\x44\xc8\x0f\x6a\xd9\x0b\x66\x3b\x9d\x02\x59\x87\xa2\xb9\xf1\x48\x10\xc9\x11\x84\x6b\xe4\x8e\xf7\x41\x8d\x22\x2b\xb5\xb8\x3c\xc2\x24\xba\x74\x64\x39\x1c\x77\x31\x52\xcf\x1d\x70\xf4\xd7\x91\xf1\x06\xbc\xa6\xbf\xe9\xaf\xeb\x99\xf7\xfb\xce\xcf\xdb\xd3\x3d\xfb\xfb\xfe\xcf\xdb\xd3\xfd\x5f\xc6\xa7\xc1\xf8\xdd\xee\xfb\x9b\xf7\xdb\xb2\x5d\x66\x3b\xfc\xcd\xee\xcf\xdb\xd3\x9d\x5f\xc6\xbf\x1b\x5f\x8f\xfe\xaa\x3f\x1f\x19\x58\x0e\xa6\x5f\xcb\xe9\xe2\x97\x77\xb3\xc9\xde\xfb\xf9\xf8\xdd\xa3\xf7\x6b\x9f\x5e\xcf\xc7\xe3\xeb\xc6\xc6\x0f\xdf\x8f\xe6\x6b\xad\x47\x23\x86\x80\xa1\x8a\xae\x67\x51\x74\xfd\xf3\x6c\xfa\xe5\x2f\x5f\x45\xe3\x51\xd0\xf9\x35\x96\x3a\x6e\x9f\x70\xff\xfd\x68\xb4\x3e\xe5\xf8\xdd\x6c\xb2\xf3\x7e\x7c\x3d\xff\x98\x53\xef\xb5\x4e\x0d\x88\x9b\xbe\xfa\xea\x0e\xe0\xe9\x00\x68\xb7\x15\xa0\xbd\x16\x80\xde\x6d\x4f\x76\xde\x7f\x5c\xa0\x76\x5a\x81\xda\x6f\x07\x6a\xf7\x23\x03\x35\x6b\x05\xea\x61\x3b\x50\x7b\x77\x08\xd4\xbc\x6d\xfe\x47\xed\xf3\xef\xdf\xd9\xfc\xe3\xd1\x7f\x04\x5f\x8c\xbf\xd2\x9f\x8f\x4e\xb7\x46\x33\x0c\xf5\xd8\x70\x8f\x19\xf3\x05\x1a\x91\x7f\xc5\xdf\xe3\xf1\xef\xc6\x9d\x0c\xad\xd7\xf3\xb3\x67\x6e\xc4\x4d\x56\x1f\xfb\x27\x4a\xb5\x31\x0d\xc3\x01\xa1\xbb\x2c\x5c\xe5\x5d\xcb\xf6\xe4\x41\xcf\xfb\xe1\x41\xc3\x3c\x5e\x0d\x6e\x7e\x5c\x25\xf1\x42\x85\x57\x61\xe2\xde\xa0\xae\x34\x59\x69\x6b\x15\x52\xeb\x2c\x44\x62\x3b\x7e\x1e\x34\x48\x4e\x7c\xbf\x5a\xcf\x2b\xb6\xd9\xd6\x8b\x5e\xd9\xa2\x1e\x81\x78\x5e\x95\xe1\x79\x6d\xd6\xfb\xbf\x54\xc2\xb8\x65\xd3\x95\xfc\x43\x6a\x8d\xc1\x0f\x3e\x50\xb5\xc1\xa3\x6e\x68\xe5\xe6\x1b\x7c\x20\x09\x71\x84\x4b\x7e\xe4\x84\xf1\x79\xdf\x7d\x1c\xae\xf7\x65\xc9\xde\x56\x9e\xad\x6e\x85\xaf\xf3\x62\x91\x32\xb4\xe2\x2e\x39\xa4\x26\x94\xe5\xa0\x5a\x1b\xd5\x01\x68\x1d\xe2\xd8\xa7\xd7\xed\x91\xdd\xd8\xe6\xe2\x9b\x0d\x20\x7a\x59\xdf\x6e\x5c\x80\xf3\x26\xaf\x0a\xfc\xc8\x37\x1b\x1a\xd4\x51\x2a\xdf\xb8\xa0\xa3\x7a\xcc\x85\x2b\xa5\xe2\xb2\x32\xf8\x48\x3c\xf8\xe9\xb8\x8a\x6e\x7d\x3b\x84\xc9\x37\x77\x83\xa1\x9b\x60\x09\x3f\x61\x22\xb5\x8e\x43\xff\x65\xb7\xb9\x53\x0d\x7b\x0d\x63\xac\x91\x66\x15\xa7\xb5\x27\xa8\x4c\x09\x8f\x3c\x4e\xdf\xd7\xe8\xa1\x69\xde\x2d\xa0\xde\x1c\x23\xec\x9a\xc6\x8a\xb3\xe7\x51\xa2\x36\x38\xad\x76\xe3\xa6\x69\x24\xbb\xed\x6c\x20\xb0\x3a\x1e\x68\x5d\x6c\x69\x75\x2e\x1f\x5f\x76\xb7\x79\x65\xe2\x28\x41\xd4\x3c\x05\xbe\xaf\xb3\x5d\x04\x9e\xf0\xa8\x65\xd7\x40\x34\xc4\x1e\x5e\x22\x8e\x10\x57\x18\x32\x86\xdc\x45\x20\xa2\x38\x10\x69\x9c\xb8\x54\x23\x3c\x5b\x2d\xb9\xc8\x04\x2f\x11\xce\x16\x2b\x1b\xf2\x3a\x71\xf5\x0a\x86\xb8\xfa\x5d\x55\xef\x0e\x0a\x78\xb8\xed\x12\xf6\x74\xaa\xbd\x7b\x6e\x9c\xd5\x0b\x59\x34\xf4\xec\xd6\x53\x64\xe8\xad\xe3\xf1\x7f\x52\xe3\x9e\xf0\x41\xc7\xb3\x61\x8c\x5b\x1d\x4f\x1e\xa7\xef\xf1\x7c\xf9\xe2\x49\x70\xd7\xe8\xe8\xf6\xd0\x69\x59\xbf\x4b\x2c\x8d\xe3\x42\xbf\x67\x8b\x75\xbe\x0d\x72\x81\xd9\x4d\x22\x26\x83\x5d\xf3\x65\x2a\xd6\x34\x08\xb6\xbc\x72\x4d\x28\x21\x23\xd0\xe9\x90\x79\xd2\xe9\x70\x2e\x0e\x2c\x83\x22\x5f\x4b\x61\x51\x6f\xf4\x24\x4b\xf9\x4a\x69\x72\x28\xc5\xd5\x1b\x21\x8f\x32\x59\xbf\x64\x21\x54\xec\xac\xa7\x45\x2e\x53\x0d\x6d\xae\x48\xe4\x95\xca\xc5\xe8\xe4\xf0\x68\xeb\xf8\xf8\xc5\x58\xb0\xde\x8e\x2e\xdf\x90\x4e\xa0\x6d\xf2\xe7\x93\x93\xa3\x2d\xfc\x75\x3c\x36\x57\x4c\xd5\xb1\x8e\xf6\xb3\xf4\x33\xe5\x7b\xa6\x92\xb4\x3a\xca\x42\x1d\xc8\x37\x3a\x90\x4b\xf9\x5b\x96\x52\x2d\xde\x03\xfa\xf5\xd9\xe1\xf1\x16\xc2\x1a\x75\xb1\x65\x53\x48\xe7\xc8\xa3\xa0\x6a\x0a\x1a\x20\x59\x07\xc8\xc7\xf8\x59\x98\x9c\x59\xdc\xbc\x7c\xf1\xc4\xe0\xc5\xfa\xdc\xdc\x0c\x2f\x9d\x08\xf9\x14\x96\x9a\x26\x67\x9b\x6e\xe6\x7e\xc2\xa8\xfd\x33\xb5\x14\xd4\xb3\xf5\xcb\x17\x4f\x06\x77\xca\xae\xd8\x83\x37\x9a\x6f\x68\x07\x50\xb1\xe7\x83\x3b\x3a\xea\xe7\x61\x6b\x01\xf5\xe6\x13\x7e\x1e\x5e\xde\x4e\x7c\xfb\xe6\xf0\xe8\x4e\xc4\x37\x00\xf0\xff\x23\xbe\xc1\x99\xe1\x80\x54\xd8\x37\x67\x8c\x7e\xef\x5a\xd2\x4b\xf6\x92\x60\xe5\x78\xac\x3d\x05\x38\x1b\xd2\x9b\x4c\x91\xba\x85\x3b\xe2\xf8\x7f\x43\x19\xf4\xc0\x01\x8e\xcb\x18\x13\x99\xb6\x8c\x64\xde\x95\xa6\x0f\xaf\xd2\x6a\xea\x59\x87\x6d\x3e\x64\xfb\x2b\xdc\xc7\x73\x75\x8e\x0d\xb7\x29\xa3\x2f\x94\xf8\xf1\xe8\xb0\xee\x15\xd2\xfc\x5c\x0d\x7c\xa6\x10\x26\xd9\x2a\x0a\xce\xb3\xec\x3c\x51\xc4\x11\xbc\xc0\x13\x95\x9e\xc7\xa9\x22\xbe\xb1\x75\x91\xbd\x99\x16\xd9\x96\x85\x7f\xea\x31\x86\x38\x3d\xff\xcc\x64\x0c\xfc\x95\x81\x66\x1e\xf8\x22\x0b\x6f\x8a\x03\xea\x52\x43\x81\x09\x66\xa0\x1b\xd7\xa2\xc1\x5b\xb6\x46\x09\x7d\xc6\xc6\x08\xaf\xe4\x1f\x8f\x0e\xc7\x10\xf3\xc0\x1f\xd7\x28\x9e\xfc\x6c\xfa\xa0\xc8\xf7\x33\x88\x5d\x50\x0c\x93\xb5\x38\x53\x17\xf2\x75\x9c\x6d\x40\x65\x15\x47\x06\x8f\x76\xf1\x9f\x99\xa5\x30\xc6\xee\x9a\x95\x1a\x52\xea\xd9\x98\x70\x7e\xb7\xbc\xb4\x37\xf3\xeb\x23\xe3\x54\x8e\xb1\x2f\xde\x6c\x7e\xcf\xb7\xd9\xfc\x6c\x65\xf8\xf2\xd8\x8a\xe1\xc1\x4f\xc7\xc3\x89\x18\x1e\xfc\xb6\xca\x15\x7e\x79\x22\x73\xf5\xad\x2a\x64\x82\xff\x7c\x73\x78\x84\x7f\x5e\xae\x0a\x99\xc6\x6f\xf1\x2b\xd9\x36\x0a\x19\xbe\xe2\xda\xac\xc3\x1f\x8f\x51\x10\x42\x0d\x83\xc1\x5d\xec\xe3\x14\x42\x5b\x9f\x56\x00\xb7\x47\x3b\xb7\x9a\x1e\x6d\xbf\xd9\xe0\xd7\xc2\x17\xb0\xc1\x45\x8f\x96\x0e\x55\x3d\xda\x32\x16\x7b\xb4\x7c\xfe\xe4\xdb\xc1\x9d\x10\x6c\xbf\x8b\x7f\xe3\xa5\xdf\x8b\xe6\x75\x98\x75\x13\x7c\x85\xd8\xa9\xb5\xa7\xb5\x04\xd1\x9b\xcf\x2a\x49\x90\x2a\xc4\x8d\xc3\xa1\xde\x5e\x66\xf4\xb0\x3c\xca\xb4\x8e\xf1\xec\xf5\x09\xfd\x19\x3b\xd6\x0c\x0d\xdd\x3e\x67\x8e\xd4\x49\xb8\x9b\x89\x96\x6b\x80\xa4\x1b\x28\x6c\x2a\x9e\xbd\xdd\xd8\xac\xc7\xc6\x6d\xde\xb4\x69\x93\xaa\xb9\xa3\x35\x21\x76\x70\xcb\xbd\x4d\xd9\xa3\x69\x3e\xe8\xb1\xad\xb6\x31\x3f\x47\x6b\x0f\x50\xdf\x41\x8a\xfd\x0e\x3a\x43\xa2\x3a\x1e\xa5\xd5\x51\x82\xc1\xed\x45\x30\xeb\x81\xd7\x9b\x74\xef\x5d\x6f\xef\x5d\x6f\xef\x5d\x6f\xef\x5d\x6f\xff\x9d\x5d\x6f\x37\x72\xfd\xcb\x3c\x7e\xdd\xb7\x9a\x2c\xb7\x6d\xe7\xf9\x47\xdc\xe0\x76\xbc\x9e\x7b\xdf\xf3\xf8\x7b\x1e\x7f\xcf\xe3\xef\x79\xfc\x3d\x8f\xbf\x2b\x1e\xdf\xa5\xa1\xa8\x72\x43\xcb\x8a\x81\xb9\x06\xde\xcd\xfc\xad\xc9\xc9\x63\x2d\xcd\x83\x95\xfb\xc1\xc8\x38\xba\xa2\x35\x9d\x87\x3d\x2a\xff\xe9\x14\x77\x95\xa1\x84\x95\xfe\x41\x2c\xcf\x39\x36\xa1\xa2\x35\x9e\xf4\x48\x14\x42\x82\x95\x4b\x37\x6c\x2d\x75\x44\x9b\x07\x8d\xd3\x89\xb8\x4c\xfd\x86\x5c\x5c\x66\x15\x4c\x55\x76\x58\x4e\x35\xa5\x38\x42\x73\xbe\xb5\x55\xf5\x84\x25\x8d\x59\x98\xa5\x48\xe2\xab\xb7\xd8\x8b\x55\x4f\xcb\xd9\xed\x67\x5b\x9f\xe1\xc0\x3a\x3e\x81\x95\x2e\x5c\xac\x38\xe5\x7d\x98\x08\xd9\xe8\xdc\x63\x35\xdd\xec\xe9\x82\x4d\x32\x2f\x2d\x3e\x02\x4d\x6b\x7b\xa0\xcb\xe0\x14\x78\xcd\x06\x95\xcc\x0c\x32\x2f\x47\x33\xb7\x74\x5a\xa6\x91\xe0\xc4\x4e\x54\xa3\x73\x53\x1a\x0a\x93\x3e\x85\x28\x29\xfc\x6f\xd4\x89\x05\x23\xe6\x0f\xcd\x6d\x8f\x4f\x09\x87\x3f\xf9\x2b\x5b\xba\x37\x6f\x35\x3f\x2a\x5f\x6f\x16\x2c\xe6\xd4\x07\x3f\x1d\x4f\x8c\x16\xc9\xe8\xb1\xa0\x32\xb5\x09\x36\x6c\xfe\x14\x2f\xf2\xbb\x17\x49\x66\x26\xda\x85\x42\x60\xb4\x17\x43\xd4\xd0\x34\xd6\x4c\x0f\x2a\xba\x03\x0a\xa5\x98\xa5\x92\x3c\x26\x26\xcb\x2e\x36\xd5\x83\xe7\xf1\x36\x2d\x14\x81\xb0\xc4\x6a\x57\xda\xc6\xd4\x50\x7a\xcb\xd8\xd6\x4d\xb4\x20\xb0\xda\xba\x25\x1e\xa9\x46\xdc\x0d\x40\xbe\x8e\xa5\x8b\xc9\x31\x20\x30\x5a\xad\xc4\x77\x9a\x8a\xa7\x99\x32\x71\x1e\xdd\x63\x7d\xa4\x43\x6c\x76\x9e\x23\x4d\xd4\x5b\x98\x59\x63\x50\x4c\x2d\xb2\x8b\x10\x45\x31\x00\x4b\x99\xae\x64\x52\xb6\xe8\x02\xf7\xf3\x35\x6d\xc6\x2d\xb9\x9a\x1d\xc6\x1e\xc3\x8f\x84\x8c\x40\x1c\xac\x4d\xd5\x93\x9b\x81\x9a\xf8\xfa\x0d\x7d\x8a\x03\x4b\xa8\xa4\xd3\x4f\x92\x0c\x72\x25\xb1\x01\xe7\x24\xff\x7b\xd8\xa2\x10\xb3\x34\x01\xc1\x5a\x61\x14\x31\x7a\x71\x48\x74\x63\xfb\x41\x70\x9a\x40\x0e\xca\xf9\x2e\x76\x92\x8e\x9b\x93\xdd\x46\xd8\xab\xd1\xb2\xa3\xb5\x65\xd9\xf2\x0c\x60\xa3\xaa\x3d\x0f\x6e\xf7\x75\x3e\xad\x5c\x00\x3c\x74\x47\x6b\x8f\x9b\x74\xb4\xe2\xc3\xd1\xd1\xc2\xae\xa6\x7b\xc6\x0d\x32\x40\xb7\x1e\xb1\x55\xe9\xdb\x29\x36\xb8\x48\x0a\x53\x26\x24\x6b\xf4\x62\xa9\xc8\x0e\x6b\x3d\x6a\x49\x45\x1a\xbe\xbf\x75\x76\x11\xca\xa5\xf8\xec\x2d\xb2\xca\xe9\xde\x35\x75\xea\x9d\xaa\x89\xc9\x12\x79\xa6\x12\xa1\x2d\x6c\x8c\x53\xdc\x40\x1c\x36\xe9\x7f\x02\x91\x47\x1c\xbc\x7c\xda\x9c\x39\xaf\x47\x2c\x48\x05\xb0\x83\x8e\xc9\x0d\x90\xee\x1b\x7a\x3d\xf0\x89\xe7\x2c\xf8\x48\x13\x2f\x5e\x29\x4e\x25\x2d\x53\xe7\xa2\x6b\x64\xe0\x5c\x25\x4e\xef\xfe\x4a\x5d\x51\x23\x0e\xd7\x6b\x85\xae\xcf\x4b\x5b\x60\xce\xae\xaf\x6b\x8b\xc4\xdc\x4c\x10\x66\xb5\xf8\xc0\x49\xe9\x6e\x81\xe4\x20\x03\x96\x9a\xb5\x61\xb6\xd7\x91\xb0\x3f\x16\x17\x37\x00\xd4\xa1\x2f\x57\xa0\x2f\xb3\xdd\x58\xec\x03\x5c\xaf\x89\x61\x5d\x17\x31\x25\xd5\xc5\xce\x50\x51\x23\xc6\x28\xcb\xc5\x76\x08\xc3\x35\x9f\xa7\x13\x3c\x3a\xf1\xcf\x33\x54\x6e\x37\xaf\x51\xdc\x96\x2f\xb3\x82\x3e\xb9\x93\xa5\x1a\x10\x6e\xb0\x50\x96\xde\x41\x60\xa8\x93\x9f\xcb\x2b\xac\x84\x5f\x60\x76\x41\x5c\xa7\xc8\x21\x85\x02\x92\xa1\xd5\xe0\x15\xb9\x60\x4f\xcd\x43\x58\x77\xc5\x34\x4b\xa7\xf4\xa6\x6b\x1c\x83\x11\x91\xe5\x15\x3c\x74\x0c\xc7\x43\x51\xc2\x12\x33\x11\xb9\x38\x50\x1e\x7f\x2a\xa0\x67\xae\x5a\x7e\xa8\xc4\xa1\x58\x2a\x54\x68\xbc\xc4\x99\xef\x46\x6f\x8f\xa8\xad\xde\x7b\xd0\x2f\x7c\xa0\x9b\x5f\x5b\xae\xfd\x4a\xb5\x8f\x80\xef\x2d\x3a\x5b\x1b\x6d\x7c\x16\x6e\x86\x96\x58\xe6\x0b\x1c\xd7\x56\x0c\x95\x0e\x5b\x47\x3d\xb8\x46\x0f\x3c\x56\x68\xd4\x03\xc0\x70\xc2\xa5\xbc\x04\x95\xbe\x03\xc3\x23\x42\x79\x2f\x2e\x65\x9c\xa3\x14\x3a\x2a\x7f\x9c\x27\xaa\xf2\x1d\x3b\x58\xf8\xc3\x60\x04\xd8\x17\xff\xb6\x8a\x5f\xcb\x04\x2c\x16\x07\x39\x15\x2a\x31\x0c\x37\x5b\xac\xdd\x14\xd0\x3c\x40\x2f\x05\x96\xe5\x7c\x59\x87\xaf\xd4\xd5\x70\xb2\x46\xd9\xc3\xe7\x29\x5b\xd3\xd7\x68\xd9\xf1\x6d\x7a\x52\x0d\xe9\xbb\xe1\x6d\x2e\x97\x0d\x3b\x7b\x93\xc4\xac\x4b\x79\x39\x65\x2a\x28\xb2\x65\x83\xe7\x9a\x4d\x48\xfa\x0d\x15\x66\xc5\x4e\xcf\x07\x1b\x36\x6d\xbd\x4b\x43\x42\x56\x4e\xeb\x4d\xdf\xda\x0e\xc1\xa0\xdd\xc7\x37\x4e\x8b\xc6\xc4\xd5\xdd\x51\xda\x70\xd8\x74\x02\xc7\x46\xc0\x2b\xad\x6b\xe2\x4b\xed\xbb\x7b\xd1\xe5\x5e\x74\xb9\x17\x5d\xee\x45\x97\x7b\xd1\xe5\x5e\x74\xb9\x17\x5d\x3e\x4d\xd1\xc5\x72\xe6\xf9\x60\xc3\x56\x39\x16\x4e\xfb\x54\xbd\xd1\x28\x00\x8a\x39\x8b\x91\x45\x26\x6c\x39\x5b\xd3\xcc\x51\x15\x90\x30\xcb\x8d\x12\xd6\x4f\xd4\xde\x60\xbd\x3b\xa9\xd4\x04\x71\xc5\x21\x68\x0c\xce\x62\x42\x29\xc8\xcb\xbd\x40\xbb\xb5\x3c\xe5\xc1\xe0\x86\x94\x5a\x24\xfa\xc8\x14\xdf\xdc\x88\x96\xb2\xa9\xbd\xfe\x4e\x5e\x1c\x7b\xc6\xc9\xaa\xc2\xd2\xd5\x57\x8d\x53\x4e\xaf\x11\x0c\x6e\x7e\x3f\x87\x31\x7c\x27\x5b\x8f\x61\x05\xbe\x21\x37\xb6\x41\x68\xc0\x36\x97\x43\x20\xac\x99\xaf\xcb\xaa\x82\x5e\x48\x41\xaa\xce\x33\x0a\x1b\x72\x0c\xd8\x2e\x0f\x25\x79\xf4\x85\x7c\x85\xe2\x36\xdf\xf1\xe1\xc0\xa3\x01\xee\xea\xcb\xec\x35\x52\x7b\x17\x39\x5b\x26\x63\xce\x49\x85\x0c\x4f\x11\xf4\xf3\xce\xfe\x1e\x08\xf1\xb5\x9f\xaf\x83\xfd\x02\x9e\x3e\x3b\x9e\x1e\x3e\x39\xdc\x9d\x1e\xff\xf9\x40\x88\xd1\x95\x5c\x26\x63\x32\x9d\xd9\x65\x8b\x69\xa5\xcd\x70\x70\x2b\xc6\xdf\x83\x55\x6d\x64\x9f\x71\x7a\xf2\xe2\xf8\x47\x93\x38\xa6\xdf\x6e\x54\xba\xb4\xed\x09\xd5\x98\x91\x89\x4d\x49\x63\xb5\xbb\xc0\xbc\x35\xe1\x3a\x42\xea\xb5\x49\x4d\x68\x46\x13\x97\xf3\x66\x16\xcc\x26\x62\x16\xec\x90\x8c\x39\x0b\x76\x7d\xb4\x57\x40\x9e\x8b\x93\x17\xc7\xaf\x67\xc1\x0c\xdf\xbc\xfc\xee\xe4\xd9\xdc\x33\x3c\x61\x66\x54\xf5\x51\xba\xa8\xf6\xb2\x09\x64\xb0\x60\xfe\xe8\xe4\xc5\xf1\x6c\xe7\xb6\x7a\x68\x6f\x8c\xed\x7e\xcd\x66\xfd\x9a\xed\xf4\x6b\xb6\x3b\xb8\x15\x4d\x75\x30\xee\x96\xaf\x1a\x3e\x36\xaf\xc0\x4a\x8a\x06\xd4\xef\x97\xe7\xd5\xb4\x0d\x7a\x75\xe6\xea\x38\x94\xa8\xd4\xa1\xac\xf2\x34\xe2\xe3\xf6\xc5\x76\x24\x8b\x8b\xb9\x2b\xf7\x60\x99\xbb\xd7\x1a\x44\x6a\x99\xaa\x6d\xec\x97\x7e\xf0\x9b\xd2\x20\xf5\xc6\x2d\x85\x24\x5c\x3f\x5d\xc8\x62\xa5\xe7\xe2\xdd\xfb\xc1\xff\x0e\x00\xa4\x45\x11\x07\xbc\x8c\x01\x00")
 
 func manifests00CustomResourceDefinitionYamlBytes() ([]byte, error) {
 	return bindataRead(
@@ -462,7 +464,7 @@ func manifests00CustomResourceDefinitionYaml() (*asset, error) {
 	return a, nil
 }
 
-var _manifests00IngressCredentialsRequestYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x57\xdf\x6f\xdb\x36\x10\x7e\xf7\x5f\x41\xe8\xa5\x40\x01\xba\x0b\x86\x01\x03\xdf\x1c\x67\xeb\x06\xb4\x43\xe1\x6c\x2d\xb0\xb7\x13\x79\x56\x0e\xa5\x8e\x1a\x8f\x52\xb7\xfe\xf5\x03\x2d\x45\xb6\x2b\xcd\x59\x7e\x74\x08\xb2\xbc\xc5\xbc\xef\xe8\xbb\xef\xbe\x2f\xf4\x41\x43\xef\x31\x0a\x05\x36\xca\xfa\xd0\x3a\x1b\xd1\x21\x27\x02\xbf\x0c\x0d\xb2\x5c\xd1\x36\x2d\x29\xbc\xea\xce\x16\x1f\x89\x9d\x51\xeb\x11\x20\x1b\xfc\xa3\x45\x49\x8b\x1a\x13\x38\x48\x60\x16\x4a\x79\x28\xd1\x4b\xfe\x4b\x29\x1b\x38\xc5\xe0\x3d\x46\x9d\x42\xf0\xb2\xfc\xf8\xbd\x2c\x29\x18\x55\x9c\x2d\xbf\x29\x16\x4a\x31\xd4\x68\xd4\xf8\x3d\x9a\xb8\x8a\x28\x32\x44\xa4\x01\x7b\x14\xde\x15\xa8\xf7\x15\xea\xd0\x60\x84\x14\xe2\x42\x29\x60\x0e\x09\x12\x05\x1e\xbe\x9c\xd8\xfa\xd6\xe1\x32\xa2\x47\x10\x3c\xee\x86\xca\x7a\xb8\xad\x06\x86\x0a\x9d\x51\x45\x8a\x2d\x16\x37\xa7\x0a\xfa\xed\x75\x96\xbe\xa2\xea\x4a\x43\x07\xe4\xa1\x24\x4f\xe9\xaf\x5b\xdc\x43\x5c\x79\xd4\x1c\x1c\x6a\x87\x1d\xfa\xdc\xcc\x98\x2e\x0d\xda\xdc\x87\x60\xec\xc8\xe2\xca\xda\xd0\x72\xfa\x25\xb3\x92\x8f\xb5\x1a\xa8\x3a\xa4\x40\xd0\x46\x4c\x1b\xdc\xf6\x04\xf4\xe4\x7e\xc9\x99\x8c\xb1\x09\xbd\x33\x57\x36\x31\x74\xe4\x30\x5e\x0e\xe5\x28\x75\x1b\xc1\x64\x7c\x2f\x9a\xd5\x87\xcb\x77\x07\x57\xed\x22\x92\x20\x61\x8d\x9c\x7e\xe0\x14\x09\x87\xb1\x69\x85\xdb\x2d\xda\x64\xd4\xca\xfb\xf0\x69\x77\xa6\x14\xd8\x3c\x59\x33\x7c\xd2\x0a\x3d\x48\x22\xeb\x03\xb8\x12\x3c\xb0\x25\xae\xcc\x05\x8a\x8d\x54\xe2\x9b\x00\xee\x7c\x77\x8a\x51\xc6\x94\x18\xda\x84\xdf\x7d\x6b\xde\x90\xa4\x9f\x82\x24\x74\xbf\x07\xc6\xf9\xf8\xaf\x50\xc9\x8f\x21\x6e\x50\x42\x1b\xed\x0c\x68\x7d\x05\x5c\xe1\x75\x7c\x83\x36\x44\x77\x89\x69\x0f\x4c\x50\x99\xd7\x79\x18\xc7\x37\xc4\xe1\xb3\x51\xc5\xcb\x62\xa1\xb5\x5e\x3c\x4a\x03\x6a\xf8\xdc\x46\x7c\xb6\xe1\x81\x0d\x1f\xaf\xb5\xf2\xa8\x26\xe6\x8a\xc1\xe3\x39\xb1\x23\xae\x46\x63\xe5\x33\xa3\xd6\x59\x13\x54\xb6\xb9\x8a\xc7\x2b\xc0\xca\x36\xcf\xf2\x7b\x6a\xaf\xc0\xeb\xf5\xbb\x89\x50\x9b\x9c\xb8\x25\x46\xb7\x09\x1e\x8f\xb4\x2a\xaf\x1c\xcb\x12\x5c\x4d\xfc\x75\x94\xfa\x4f\xe2\xa3\xb2\xde\xdd\x7f\x7b\x05\x5e\x0f\xec\xa1\x18\xfb\xf9\xfc\xed\x3a\x83\xa7\xb4\x05\x4f\x96\xbe\xe0\x6b\xff\x3e\x16\x36\xb2\xe9\xce\x4c\xe9\x5b\xac\xe9\x4f\xd3\xb4\xa5\x27\x6b\x08\x6a\x63\x8c\x19\xe4\x94\x09\x37\x6f\x77\x1a\x8e\xc5\x1d\x52\x37\x08\xee\x6e\x99\x1f\x22\xa5\x7d\x26\xa4\xfe\x1f\xd2\x61\x03\xfd\x68\x8a\x21\x29\x2b\xfe\x1a\xad\x54\x07\xbe\xcd\x41\xe2\x84\x91\x31\x69\xe9\xac\x14\xff\x63\x22\x1c\xcb\xc8\xc1\x83\xdb\xff\x3f\x75\x5e\x13\x3e\x61\xec\xee\xb0\x00\x7c\x35\xe3\xe5\x82\xde\x4f\x7f\xbc\x1e\xfb\x6f\x22\xbc\x7b\x8a\xef\x7e\x02\xbc\x9f\x08\xe7\x64\x78\xb3\x14\x6f\xf0\xe5\x33\x41\x4f\xd3\xaf\xe0\xa9\x84\x12\x4e\xbe\x96\x93\x0a\x1f\xda\xab\xab\xbe\x88\xf9\x87\x72\x7e\xcb\xcc\xd3\x3a\xde\x29\x87\x33\x4f\x8e\xc5\xac\x9c\xbb\x08\x35\x10\xf7\x9b\xdd\x1c\xe4\xb7\xc6\x41\xc2\x9b\x50\x17\xe8\xf1\xdf\xa0\xfa\xc5\xf5\x10\x27\x23\x70\x6e\x1f\x3e\xdc\x24\x5f\xbc\x7c\x71\xb2\xa7\xa6\x4b\x9f\x73\x47\x79\xdd\x9d\xa9\x61\x17\xee\xbb\x39\x85\xe8\x3b\x39\x8d\xe8\xbb\xd8\x63\xe4\x34\x48\x4e\x36\x33\x6d\xfc\xc1\xad\xf2\x77\x00\x00\x00\xff\xff\xae\x26\xe6\x16\xfd\x12\x00\x00")
+var _manifests00IngressCredentialsRequestYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x57\x51\x6f\xdb\x36\x10\x7e\xf7\xaf\x20\xf4\x52\xa0\x00\xdd\x05\xc3\x80\x81\x6f\x8e\xb3\x75\x03\xda\xa1\x70\xb6\x16\xd8\xdb\x89\x3c\x2b\x87\x52\x47\x8d\x47\xa9\x5b\x7f\xfd\x40\x4b\xb6\x95\x5a\xb3\x97\xd6\x1b\x8c\x34\x6f\xb1\xee\x3b\xea\xee\xbb\xef\x0b\x75\xd0\xd0\x5b\x8c\x42\x81\x8d\xb2\x3e\xb4\xce\x46\x74\xc8\x89\xc0\xcf\x43\x83\x2c\x77\xb4\x4e\x73\x0a\x2f\xba\xab\xd9\x7b\x62\x67\xd4\x72\x07\x90\x15\xfe\xd1\xa2\xa4\x59\x8d\x09\x1c\x24\x30\x33\xa5\x3c\x94\xe8\x25\xff\xa5\x94\x0d\x9c\x62\xf0\x1e\xa3\x4e\x21\x78\x99\xbf\xff\x5e\xe6\x14\x8c\x2a\xae\xe6\xdf\x14\x33\xa5\x18\x6a\x34\x6a\xf7\x1e\x4d\x5c\x45\x14\x19\x22\xd2\x80\xbd\x17\xde\x14\xa8\xf7\x15\xea\xd0\x60\x84\x14\xe2\x4c\x29\x60\x0e\x09\x12\x05\x1e\x5e\x4e\x6c\x7d\xeb\x70\x1e\xd1\x23\x08\xde\xef\x86\xca\x7a\x38\xad\x06\x86\x0a\x9d\x51\x45\x8a\x2d\x16\xa7\x53\x05\xfd\x7a\x9b\xa5\xef\xa8\xba\xd3\xd0\x01\x79\x28\xc9\x53\xfa\xeb\x01\xe7\x10\x57\x1e\x35\x07\x87\xda\x61\x87\x3e\x37\xb3\x4b\x97\x06\x6d\xee\x43\x30\x76\x64\x71\x61\x6d\x68\x39\xfd\x92\x59\xc9\x8f\xb5\x1a\xa8\x1a\x53\x20\x68\x23\xa6\x15\xae\x33\x62\x4b\xee\xa7\x9c\xc9\x2e\x76\x40\xef\xc4\x91\x4d\x0c\x1d\x39\x8c\xb7\x43\x39\x4a\x3d\x44\x30\xf9\x4d\xbd\x68\x16\xef\x6e\xdf\x8c\x8e\xda\x9c\x24\x09\x12\xd6\xc8\xe9\x07\x4e\x91\x70\x18\x9b\x56\xb8\x5e\xa3\x4d\x46\x2d\xbc\x0f\x1f\x36\x48\xa5\xc0\xe6\xc9\x9a\xe1\x97\x56\xe8\x41\x12\x59\x1f\xc0\x95\xe0\x81\x2d\x71\x65\x6e\x50\x6c\xa4\x12\x5f\x05\x70\xd7\x9b\xa7\x18\x65\x97\x12\x43\x9b\xf0\xbb\x6f\xcd\x2b\x92\xf4\x53\x90\x84\xee\xf7\xc0\x38\x1d\xff\x15\x2a\xf9\x31\xc4\x15\x4a\x68\xa3\x9d\x00\x2d\xef\x80\x2b\xdc\xc6\x57\x68\x43\x74\xb7\x98\xa6\x4f\x3b\x02\x4b\x50\x99\x97\xb8\x43\x6c\x03\x71\xf8\x6d\x54\xf1\xbc\x98\x69\xad\x67\x17\xe9\x53\x0d\x1f\xdb\x88\x4f\x6e\x1d\xb9\xf5\x72\x1d\x98\x47\x75\xe0\xc1\x18\x3c\x5e\x13\x3b\xe2\x6a\x18\x44\x96\xae\x47\xa3\x96\x59\x13\x54\xb6\x79\x5e\x97\x2b\xc0\xca\x36\x4f\x97\xc5\x63\xbb\x2c\x5e\x2e\xdf\x1c\x08\xb5\xc9\x89\x6b\x62\x74\xab\xe0\xf7\x77\x45\xd6\xaa\xbc\x70\x2c\x73\x70\x35\xf1\x7f\xa3\xd4\x7f\x12\x1f\x95\xf5\xa6\xa9\x87\x2b\x70\x3b\xb0\x73\x31\xf6\xf3\xf5\xeb\x65\xae\xe4\x90\xb6\xe0\xc9\x8e\xee\xd6\xb8\x27\x2f\xd3\x57\xd8\xc8\xa6\xbb\x32\xa5\x6f\xb1\xa6\x3f\x4d\xd3\x96\x9e\xac\x21\xa8\x8d\x31\x66\x90\x53\x26\xdc\xbc\xde\x7c\xf0\xc4\xe2\x33\x52\x57\x08\xee\xf3\x32\xdf\x45\x4a\xfb\x4c\x48\xfd\x3f\xa4\x71\x03\xfd\x68\x8a\x21\x29\x7f\x1e\x6d\xd1\x4a\x75\xe0\x5b\x34\xaa\x20\x4e\x18\x19\x93\x96\xce\x4a\xf1\x15\x13\xe1\x58\x76\x1c\x9c\xdd\xfe\xff\xab\xf3\x9a\xf0\x01\x63\x27\x17\x64\xbc\x5c\xd0\xdb\xdb\x13\xfe\x3b\x10\xde\x43\x75\xf0\x89\x0b\xbf\x4c\x80\x5f\x26\xc2\x29\x19\x9e\x96\xe2\x09\x5f\x3e\x11\x34\x26\xe8\xf1\xf8\x15\x3c\x95\x50\xc2\xd1\xdb\xf2\xa0\xc2\x73\x7b\x75\xd1\x17\x31\x7d\x51\x4e\x2f\xa3\x79\x5a\xf7\x57\xcf\xe1\x99\x27\xc7\x62\x16\xce\xdd\x84\x1a\x88\xfb\xcd\x6e\x0a\xf2\x5b\xe3\x20\xe1\x29\xd4\x0d\x7a\xfc\x37\xa8\x7e\xbf\x1d\xe3\xb6\x1b\xa3\x9a\x5c\x9b\xc7\x9b\xe4\xb3\xe7\xcf\x8e\xf6\xd4\x74\xe9\x63\xee\x28\x6f\xc5\x13\x35\x6c\xc2\x7d\x37\xc7\x10\x7d\x27\xc7\x11\x7d\x17\x7b\x8c\x1c\x07\xc9\xd1\x66\x0e\x1b\x3f\xbb\x55\xfe\x1e\x00\xb2\x17\x17\x10\x24\x13\x00\x00")
 
 func manifests00IngressCredentialsRequestYamlBytes() ([]byte, error) {
 	return bindataRead(
@@ -477,12 +479,12 @@ func manifests00IngressCredentialsRequestYaml() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "manifests/00-ingress-credentials-request.yaml", size: 4861, mode: os.FileMode(420), modTime: time.Unix(1, 0)}
-	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x53, 0xd4, 0xf6, 0x31, 0xd0, 0xa5, 0x37, 0x8c, 0x3d, 0xa7, 0x1b, 0xde, 0x27, 0x6b, 0x86, 0x7e, 0x79, 0xe, 0x81, 0xa4, 0xa1, 0xa2, 0x39, 0xf6, 0x84, 0x93, 0x20, 0xe1, 0xbc, 0x71, 0xce, 0xe6}}
+	info := bindataFileInfo{name: "manifests/00-ingress-credentials-request.yaml", size: 4900, mode: os.FileMode(420), modTime: time.Unix(1, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x26, 0x90, 0x57, 0xce, 0xe4, 0x58, 0x20, 0x70, 0x6b, 0x16, 0xe4, 0x25, 0x78, 0x95, 0x3c, 0x86, 0x79, 0xd8, 0x70, 0xeb, 0xf1, 0x1d, 0x61, 0x52, 0x72, 0x94, 0x72, 0xe6, 0x75, 0x6e, 0x31, 0x9}}
 	return a, nil
 }
 
-var _manifests00NamespaceYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x94\x91\xbb\x6e\xe3\x40\x0c\x45\x7b\x7d\x05\xe1\xad\xc7\x8b\x6d\xf5\x0f\xbb\xcd\x02\xe9\xa9\x99\x6b\x89\x31\x45\x0a\x33\x94\x8c\xfc\x7d\x20\x3b\x0f\x1b\x29\x82\x94\x83\x39\xe7\xf2\x75\x16\x2b\x3d\xfd\xe3\x19\x6d\xe1\x8c\x8e\x17\x79\x42\x6d\xe2\xd6\xd3\xf6\xa7\x9b\x11\x5c\x38\xb8\xef\x88\xd8\xcc\x83\x43\xdc\xda\xfe\x24\x12\xcb\xba\x16\x1c\x2b\x14\xdc\x70\xf4\x05\xd6\x26\x39\xc5\x51\xfc\xb7\x0c\x73\xca\xea\x6b\x49\x33\x1b\x8f\x28\x3d\x1d\xa2\xae\x38\x7c\xaf\x36\xe8\xe9\xdd\x4a\x93\x8c\x53\xe2\x8d\x45\x79\x10\x95\x78\xf9\x41\x8e\xd8\xa8\x48\xe6\x05\xa9\x60\x83\xfa\x82\xfa\xa0\x3f\xe0\x57\xae\x41\x91\xc3\x77\xec\x86\x5c\xbc\x9e\xd5\xb9\x3c\x46\xb3\xaa\x5f\xae\x33\xdd\xfa\x9c\x61\xb1\xf3\xca\x03\xf4\x6d\x3d\xbf\xe8\x4a\x7d\x16\x49\xb3\x9b\x84\x57\xb1\x91\xc2\x49\xdd\xcf\x74\xf2\x4a\xff\x51\x37\xc9\xf8\x7b\xfb\x25\x1f\x9e\x91\xa3\x91\x18\xc5\x24\x8d\xec\xe3\x38\x5f\x5a\xce\xba\xb6\x40\xbd\x0b\xbe\x1b\x6f\xf7\xfa\xbb\xea\x62\x63\x45\x6b\x69\xdf\x02\x87\xd7\xee\x35\x00\x00\xff\xff\x90\xc9\xa6\xce\xfc\x01\x00\x00")
+var _manifests00NamespaceYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x94\x91\x41\x6e\xe3\x30\x0c\x45\xf7\x3e\x05\x91\x59\x2b\x83\xd9\xfa\x0e\x33\x9b\x01\xba\xa7\xa5\x1f\x9b\x0d\x4d\x1a\x12\xed\xa0\xb7\x2f\x9c\xb4\x41\x82\x2e\x8a\x2e\x05\xbd\xf7\xf5\x29\x9e\xc5\x4a\x4f\xff\x78\x46\x5b\x38\xa3\xe3\x45\x5e\x50\x9b\xb8\xf5\xb4\xfd\xe9\x66\x04\x17\x0e\xee\x3b\x22\x36\xf3\xe0\x10\xb7\xb6\x1f\x89\xc4\xb2\xae\x05\xc7\x0a\x05\x37\x1c\x7d\x81\xb5\x49\x4e\x71\x14\xff\x2d\xc3\x9c\xb2\xfa\x5a\xd2\xcc\xc6\x23\x4a\x4f\x87\xa8\x2b\x0e\xdf\xab\x0d\x7a\xfa\xb4\xd2\x24\xe3\x94\x78\x63\x51\x1e\x44\x25\xde\x7e\x90\x23\x36\x2a\x92\x79\x41\x2a\xd8\xa0\xbe\xa0\x3e\xe9\x4f\xf8\x95\x6b\x50\xe4\xf0\x1d\xbb\x35\xbd\x78\x3d\xab\x73\x79\x8e\x66\x55\xbf\x5c\x67\xba\xf5\x9c\x61\xb1\xf3\xca\x03\xf4\xe3\x7b\x7e\xd1\x95\xa2\xbb\x98\x66\x37\x09\xaf\x62\x23\x85\x93\xba\x9f\xe9\xe4\x95\xfe\xa3\x6e\x92\xf1\xf7\x76\x4b\x3e\xbc\x22\x47\x23\x31\x8a\x49\x1a\xd9\x7d\x39\x5f\x2a\x67\x5d\x5b\xa0\x3e\x04\x3f\x8c\xb7\x7b\xfd\xc3\xeb\x62\x63\x45\x6b\xc9\x17\x54\x0e\xaf\xdd\xfb\x00\x90\xc9\xa6\xce\xfc\x01\x00\x00")
 
 func manifests00NamespaceYamlBytes() ([]byte, error) {
 	return bindataRead(
@@ -502,7 +504,7 @@ func manifests00NamespaceYaml() (*asset, error) {
 	return a, nil
 }
 
-var _manifests0000_90_ingressOperator_00_prometheusroleYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x94\x8f\xb1\x6e\xe3\x30\x10\x44\x7b\x7d\x05\xe1\x9e\x3a\x5c\x67\xe8\x07\xae\xbf\x22\xfd\x8a\x1c\x4b\x0b\x53\xbb\xc4\x2e\xa9\x20\xf9\xfa\x40\xb2\xe3\x36\x48\xc5\x99\x01\xdf\x90\x43\x95\xdf\x60\xce\x2a\x53\xb0\x99\xd2\x48\xbd\xad\x6a\xfc\x49\x8d\x55\xc6\xfb\xd5\x47\xd6\x3f\xfb\xdf\xe1\xce\x92\xa7\xf0\x5f\x0b\x86\x0d\x8d\x32\x35\x9a\x86\x10\x84\x36\x4c\xa1\x9a\x6e\x68\x2b\xba\xc7\xfb\xd5\x9f\xb1\x57\x4a\x98\x82\x56\x88\xaf\x7c\x6b\x91\x65\x31\xb8\x47\xad\x30\x6a\x6a\x43\x08\x24\xa2\xed\x7c\xca\x8f\xb6\x10\x58\x52\xe9\x19\xa3\xa1\x80\x1c\xe3\x8b\x3e\xbe\xc1\xf3\x16\x53\xd1\x9e\xe3\x46\x42\x0b\xf2\x14\x2e\xcd\x3a\x2e\x3f\xa3\x8e\x72\xfb\xa6\xe2\xca\xcb\x1a\x69\x27\x2e\x34\x73\xe1\xf6\xf1\x8b\x1e\x96\xa5\x20\x8a\x66\xc4\x8c\x1d\xe5\x18\xf3\xc2\xad\x17\xf8\x34\xc4\x40\x95\xff\x99\xf6\x7a\xae\x8a\xe1\x72\x34\x1b\x5c\xbb\x25\x3c\x33\x87\xed\x9c\xe0\xa7\x81\xe4\xaa\x2c\xed\xe1\xaa\xe6\x43\xec\xb0\xf9\x79\x79\x41\x3b\xcf\xc2\xfe\x10\xef\xd4\xd2\x3a\x7c\x05\x00\x00\xff\xff\xd4\x51\x0b\x8b\xbe\x01\x00\x00")
+var _manifests0000_90_ingressOperator_00_prometheusroleYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x94\x90\xb1\x6e\xc3\x30\x0c\x44\x77\x7f\x85\x90\x5d\x2e\xba\x05\xfe\x81\xee\x1d\xba\xd3\xd2\xc5\x26\x22\x93\x02\x29\xb9\x68\xbf\xbe\x70\x92\x66\x2d\x3a\x89\x3a\xe8\x9d\xee\x48\x95\x3f\x60\xce\x2a\x53\xb0\x99\xd2\x48\xbd\xad\x6a\xfc\x4d\x8d\x55\xc6\xeb\xd9\x47\xd6\x97\xfd\x75\xb8\xb2\xe4\x29\xbc\x6b\xc1\xb0\xa1\x51\xa6\x46\xd3\x10\x82\xd0\x86\x29\x54\xd3\x0d\x6d\x45\xf7\x78\x3d\xfb\x43\xf6\x4a\x09\x53\xd0\x0a\xf1\x95\x2f\x2d\xb2\x2c\x06\xf7\xa8\x15\x46\x4d\x6d\x08\x81\x44\xb4\xdd\xbe\xf2\xc3\x2d\x04\x96\x54\x7a\xc6\x68\x28\x20\xc7\xf8\xa4\x8f\x18\x3c\x6f\x31\x15\xed\x39\x6e\x24\xb4\x20\x4f\xe1\xd4\xac\xe3\xf4\x37\xea\x28\x97\x5f\x2a\xae\xbc\xac\x91\x76\xe2\x42\x33\x17\x6e\x5f\xff\xf0\x61\x59\x0a\xa2\x68\x46\xcc\xd8\x51\x8e\x32\x4f\xdc\x7a\x81\x4f\x43\x0c\x54\xf9\xcd\xb4\xd7\x5b\xab\x18\x4e\x47\x42\x83\x6b\xb7\x84\x87\xe6\xb0\x9d\x13\x8e\x6d\xc5\x00\xc9\x55\x59\xda\xfd\x56\x35\x1f\xc3\x0e\x9b\x1f\x8f\x17\xb4\xdb\x59\xd8\xef\xc3\x27\xb5\xb4\x0e\x3f\x03\x00\xd4\x51\x0b\x8b\xbe\x01\x00\x00")
 
 func manifests0000_90_ingressOperator_00_prometheusroleYamlBytes() ([]byte, error) {
 	return bindataRead(
@@ -522,7 +524,7 @@ func manifests0000_90_ingressOperator_00_prometheusroleYaml() (*asset, error) {
 	return a, nil
 }
 
-var _manifests0000_90_ingressOperator_01_prometheusrolebindingYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x94\x91\xb1\x6e\xf3\x30\x0c\x84\x77\x3f\x85\x90\x5d\xfe\xf1\x6f\x81\xb6\x76\xe9\x9e\x02\xdd\x69\xe9\x62\xb3\x91\x49\x41\xa2\x0c\xb4\x4f\x5f\xb8\x48\xd3\xa9\x2d\xb2\x12\xfc\x8e\xbc\x3b\x2a\xfc\x82\xda\x58\x25\xb8\x3a\x51\x1c\xa9\xdb\xa2\x95\xdf\xc9\x58\x65\xbc\x1c\xdb\xc8\xfa\x6f\xfb\x3f\x5c\x58\x52\x70\x27\xcd\x78\x64\x49\x2c\xf3\xb0\xc2\x28\x91\x51\x18\x9c\x13\x5a\x11\x5c\xa9\xba\xc2\x16\xf4\xe6\x2f\xc7\x76\x1d\xb7\x42\x11\xc1\x69\x81\xb4\x85\xcf\xe6\x59\xe6\x8a\xd6\xbc\x16\x54\x32\xad\x83\x73\x24\xa2\xf6\x79\xb1\xed\x6a\xce\xb1\xc4\xdc\x13\xc6\x8a\x0c\x6a\x18\x6f\xf4\xfe\x0d\x4f\xab\x8f\x59\x7b\xf2\x2b\x09\xcd\x48\xc1\x1d\xac\x76\x1c\xfe\x46\x1b\xf2\xf9\x8b\xf2\x0b\xcf\x8b\xa7\x8d\x38\xd3\xc4\x99\xed\xed\x0e\x1d\x96\x39\xc3\x8b\x26\xf8\x84\x0d\x79\x37\x73\xc3\xab\x66\x9c\x70\xde\xad\x50\xe1\xa7\xaa\xbd\xfc\x92\xee\xe0\xdc\x77\xb8\x3f\x65\xd9\xfa\xf4\x8a\x68\x2d\x0c\xfe\xba\xfd\x8c\xba\x71\xc4\x43\x8c\xda\xc5\xee\xec\x60\x55\x61\xd3\xba\xd7\xf8\x11\x00\x00\xff\xff\x60\xe1\x61\x52\x02\x02\x00\x00")
+var _manifests0000_90_ingressOperator_01_prometheusrolebindingYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x94\x91\xb1\x6e\xeb\x30\x0c\x45\x77\x7f\x85\x90\x5d\x7e\x78\x5b\xa0\xad\x5d\xba\xa7\x40\x77\x5a\xba\xb1\xd9\xc8\xa4\x20\x51\x06\xda\xaf\x2f\x5c\xa4\xe9\xd4\x16\x59\x05\x9d\x4b\xde\x43\x2a\xfc\x82\xda\x58\x25\xb8\x3a\x51\x1c\xa9\xdb\xa2\x95\xdf\xc9\x58\x65\xbc\x1c\xdb\xc8\xfa\x6f\xfb\x3f\x5c\x58\x52\x70\x27\xcd\x78\x64\x49\x2c\xf3\xb0\xc2\x28\x91\x51\x18\x9c\x13\x5a\x11\x5c\xa9\xba\xc2\x16\xf4\xe6\x2f\xc7\x76\x7d\x6e\x85\x22\x82\xd3\x02\x69\x0b\x9f\xcd\xb3\xcc\x15\xad\x79\x2d\xa8\x64\x5a\x07\xe7\x48\x44\xed\x73\x62\xdb\xd3\x9c\x63\x89\xb9\x27\x8c\x15\x19\xd4\x30\xde\xe8\x7d\x1b\x9e\x56\x1f\xb3\xf6\xe4\x57\x12\x9a\x91\x82\x3b\x58\xed\x38\xfc\x8d\x36\xe4\xf3\x17\xe5\x17\x9e\x17\x4f\x1b\x71\xa6\x89\x33\xdb\xdb\x1d\x39\x2c\x73\x86\x17\x4d\xf0\x09\x1b\xf2\x5e\xe6\x86\x57\xcd\x38\xe1\xbc\x57\xa1\xc2\x4f\x55\x7b\xf9\xc5\xee\xe0\xdc\xb7\xdc\x9f\x5c\xb6\x3e\xbd\x22\x5a\x0b\x83\xbf\xfe\x7e\x46\xdd\x38\xe2\x21\x46\xed\x62\x77\xde\x60\x55\x61\xd3\xca\x32\x0f\x1f\x03\x00\x60\xe1\x61\x52\x02\x02\x00\x00")
 
 func manifests0000_90_ingressOperator_01_prometheusrolebindingYamlBytes() ([]byte, error) {
 	return bindataRead(
@@ -542,7 +544,7 @@ func manifests0000_90_ingressOperator_01_prometheusrolebindingYaml() (*asset, er
 	return a, nil
 }
 
-var _manifests0000_90_ingressOperator_02_servicemonitorYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x94\x92\xb1\xae\xd5\x4c\x0c\x84\xfb\xf3\x14\xab\xdb\x6f\xf2\xff\xa2\x4b\x8b\x44\x05\x34\x20\x7a\xc7\x99\x9b\x2c\x67\x63\xaf\x6c\x27\x12\x6f\x8f\x92\x9c\x73\x2b\xae\x10\xed\xec\xcc\xe8\xf3\xda\xd4\xca\x0f\x98\x17\x95\x21\xad\x2a\x25\xd4\x8a\xcc\x1d\xab\x41\xbd\x63\x5d\xfb\xfd\xff\xdb\xbd\xc8\x34\xa4\x6f\xb0\xbd\x30\xbe\x5c\xae\xdb\x8a\xa0\x89\x82\x86\x5b\x4a\x42\x2b\x86\x54\x64\x36\xb8\x67\x6d\x30\x3a\x2c\xd7\x83\x37\x62\x0c\x49\x1b\xc4\x97\xf2\x1a\xf9\x0f\x3e\x12\xd1\xa0\x28\x2a\x7e\xf4\xa5\x54\x84\xeb\x36\xa1\x33\x54\x90\xa3\x7b\x4b\x77\x45\xfb\x32\xae\x99\xab\x6e\x53\x5e\x49\x68\xc6\x34\xa4\x97\xb0\x0d\x2f\x7f\x8f\x3a\xea\xeb\x33\x95\x97\x32\x2f\x99\x76\x2a\x95\xc6\x52\x4b\xfc\xfa\x87\x9e\x22\x73\x45\x16\x9d\x90\x27\xec\xa8\xc7\x30\x6f\x71\x6f\xe0\x63\x0e\xc8\xd4\xb4\x48\x9c\x43\xe5\x34\x82\x0c\xf6\x5d\xef\x90\x4f\xa5\x62\x48\xfd\x4e\xd6\xdb\x26\xbd\x83\x0d\xe1\xfd\x7d\x1b\x61\x82\x80\x5f\xb0\xe7\x8f\x13\xb3\x6e\x12\x7d\x1c\xc1\x07\x5a\xc0\x76\xaa\x43\xfa\xf0\x9f\x9f\x4a\x53\x8b\x21\xad\x08\x2b\x7c\x29\xce\x0b\x8e\xad\x2c\x11\xed\x52\xa2\xfa\x47\x95\xd7\x32\x5f\x5f\x9c\x12\xd3\x03\x03\xc1\x7d\x33\x5d\x11\x0b\x36\xef\xf9\x74\xad\xd4\xfc\x42\x90\x39\x33\x2c\x3c\x33\xe5\x71\x93\xa9\xe2\x89\x96\x99\x3a\xb6\x78\xf4\x1d\x22\xec\xeb\x79\x0c\x0f\x94\xee\xfd\xb5\x77\xbe\xf3\x2d\xa5\x9f\x3a\x7e\xa6\x11\x75\x48\xac\x6b\x53\x81\x1c\x75\x8e\x0a\x0e\xb5\x0b\x75\xa5\xe0\xe5\x74\xf9\x93\xfd\x9d\x93\xfb\x1d\x00\x00\xff\xff\x68\x04\xae\x7e\xd0\x02\x00\x00")
+var _manifests0000_90_ingressOperator_02_servicemonitorYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x94\x92\x3f\x8f\xd5\x40\x0c\xc4\xfb\xf7\x29\x56\xd7\x6f\x02\xa2\x4b\x8b\x44\x05\x34\x20\x7a\xc7\x99\x97\x2c\x6f\xd7\x5e\x79\x9d\x48\x7c\x7b\x94\x3f\x77\x15\x4f\xe8\x94\x6e\x32\x33\xf9\x39\x36\xd5\xf4\x0b\xd6\x92\xca\x10\x8a\x4a\x72\xb5\x24\x73\xc7\x6a\xd0\xd6\xb1\x96\x7e\xfb\x78\x7b\x24\x99\x86\xf0\x03\xb6\x25\xc6\xb7\xd3\x75\x2b\x70\x9a\xc8\x69\xb8\x85\x20\x54\x30\x84\x24\xb3\xa1\xb5\xa8\x15\x46\xae\x76\xbd\x68\x95\x18\x43\xd0\x0a\x69\x4b\xba\x7b\xfc\x87\x8f\x44\xd4\xc9\x93\x4a\xdb\xfb\x42\x48\xc2\x79\x9d\xd0\x19\x32\xa8\xa1\x7b\x4b\x77\x49\xfb\x34\x96\xc8\x59\xd7\x29\x16\x12\x9a\x31\x0d\xe1\xc5\x6d\xc5\xcb\xff\xa3\x0d\xf9\xfe\x9a\x8a\x4b\x9a\x97\x48\x1b\xa5\x4c\x63\xca\xc9\xff\xbc\xa3\x27\xc9\x9c\x11\x45\x27\xc4\x09\x1b\xf2\x3e\xcc\x5b\xbc\x55\xf0\x3e\x07\x64\xaa\x9a\xc4\x8f\xa1\x62\x18\x41\x06\xfb\xa9\x0f\xc8\x97\x94\x31\x84\x7e\x23\xeb\x6d\x95\xbe\x81\x0d\xde\xfa\xc7\x3a\xc2\x04\x8e\x76\x7c\xe4\xfc\xe3\xc4\xac\xab\x78\xef\x7b\xf0\x42\x73\xd8\x46\x79\x08\x9f\x3e\xb4\x43\xa9\x6a\x3e\x84\x02\xb7\xc4\xa7\xd2\x78\xc1\xbe\x95\xc5\xbd\x9e\x8a\xe7\xf6\x59\xe5\x9e\xe6\x9d\x66\x7f\x98\x2e\x0c\x38\xf7\xd5\xb4\xc0\x17\xac\xad\xe7\xc3\x55\xa8\xb6\x13\x41\xe6\xc8\x30\x6f\x91\x29\x8e\xab\x4c\x19\xaf\x68\x91\xa9\x63\xf3\xab\x6f\x17\x61\xdf\x8f\x63\xb8\x50\xba\xe7\x6b\xef\xda\xc6\xb7\x10\x7e\xeb\xf8\x95\x46\xe4\x21\xb0\x96\xaa\x02\xd9\xeb\x1a\x32\xd8\xd5\x4e\xd4\x42\xce\xcb\xe1\xba\xce\xe3\xe9\xc9\xfd\x1d\x00\x68\x04\xae\x7e\xd0\x02\x00\x00")
 
 func manifests0000_90_ingressOperator_02_servicemonitorYamlBytes() ([]byte, error) {
 	return bindataRead(
@@ -562,7 +564,7 @@ func manifests0000_90_ingressOperator_02_servicemonitorYaml() (*asset, error) {
 	return a, nil
 }
 
-var _manifests0000_90_ingressOperator_03_prometheusrulesYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xc4\x57\x4d\x6f\xdc\x36\x10\xbd\xfb\x57\x0c\xb6\x3d\x38\x40\xb4\x8e\x0f\xb9\x08\xc8\x21\x48\x50\xb4\x97\x22\x30\x92\xf6\x28\x8c\xc5\x59\x69\x10\x8a\x54\x67\xc8\x5d\x2f\x5c\xff\xf7\x82\xfa\x8a\xac\xdd\xb5\x1d\xa7\x75\xf7\x24\x89\x9c\xc7\x37\xf3\x38\x8f\x5c\x6c\xf9\x0f\x12\x65\xef\x72\x68\xbc\xe3\xe0\x85\x5d\xb5\x2e\xbd\x90\xd7\x75\xe9\x9b\x8b\xed\xe5\xd9\x57\x76\x26\x87\x4f\xe2\x1b\x0a\x35\x45\xbd\x8a\x96\xce\x1a\x0a\x68\x30\x60\x7e\x06\xe0\xb0\xa1\x1c\xd8\x55\x42\xaa\x99\x6f\x49\x30\x78\x19\x06\xb4\xc5\x92\x72\xf0\x2d\x39\xad\x79\x13\xb2\x23\xf3\x2c\x5e\x93\xd5\x04\x05\x20\xde\x52\x0e\x68\x49\x42\x26\xd1\x92\x9e\x01\xa0\x73\x3e\x60\x60\xef\x86\x49\xec\x4a\x1b\x0d\xad\x85\x2c\xa1\xd2\x7a\x42\x5f\xb3\xbf\xe0\xeb\x26\x2b\xad\x8f\x26\x6b\xd0\x61\x45\x26\x87\x55\x90\x48\xab\xc7\x43\x95\xec\x66\x8c\xca\x6a\xae\xea\x0c\xb7\xc8\x16\xaf\xd9\x72\xd8\x7f\x07\x0e\xbb\xca\x52\xe6\xbc\xa1\xcc\xd0\x96\x6c\x4a\x76\x0a\xd7\x96\xca\x94\x47\x25\x3e\xb6\x43\x46\xd9\x50\xc5\x83\x3a\xad\xc7\x22\x74\xc5\x49\xcf\xf9\xf0\x92\xf5\x55\xca\xe1\xd7\xf7\x9f\xc4\xdf\xec\xaf\xc8\x7a\x34\xbf\x20\xdb\x61\x02\x00\xdd\xb4\x92\x43\xa0\xa6\xb5\x18\xa8\x10\x1f\x03\x49\x21\xdd\xc4\x62\x83\x6c\xa3\x10\xbc\x7b\x07\x97\x53\xc4\xc6\x4b\x0e\x6f\x9b\xe9\x7d\x2e\x4d\xff\x53\xda\x92\x74\xd5\xd8\xa1\x38\x76\xd5\x34\x76\x20\xd3\x10\x10\x9b\x06\x65\x3f\xf1\x84\x7e\x7d\x18\xd6\x9f\xcd\x34\xa4\xa5\x70\x1b\xba\xfd\xb8\xfa\x5c\xb3\xf6\x29\xc2\x86\x85\x14\x76\x35\xb9\x09\x24\x45\x2b\x04\x3f\xa2\x71\x50\x28\xbd\xdb\x70\x15\xa5\xe3\xf0\x1a\x76\x35\x97\x35\xec\xd8\x5a\x10\xd2\x68\x03\xb0\x83\x50\x13\xf4\x75\x00\xe7\x03\xb4\x5c\x7e\x65\x57\x41\x6c\x41\xa8\x24\x17\xec\x1e\x4a\x21\x0c\x64\xc0\x0b\x34\xde\xf0\x86\xc9\xf4\x21\xba\x5e\xcd\xd8\x36\xa4\x8a\x15\xe5\xb0\xba\x9f\x98\x02\x0a\x75\xfc\x12\xb0\x77\x70\x7b\x0b\x3f\xf7\x75\x5c\xb7\xde\xc0\xdd\xdd\x1a\xae\x7a\x06\xac\x1d\x09\xa1\xb4\x25\x42\x9a\xfe\x04\x12\xab\x13\xfa\x7f\xf4\x3b\xb7\x50\xbe\xc6\x36\x8d\x14\xb1\x4d\x2a\xbf\x79\x96\xca\xa5\x70\xe0\x12\xed\x77\xcb\xcc\x0a\x66\x4e\xe9\x69\xfa\x36\x14\x84\x4b\x05\xa1\xd6\x4b\x80\x50\x63\x58\x22\x3e\xa2\xc2\x88\x90\x54\xe8\x51\x52\x61\x8f\x01\x25\x71\x92\x22\x33\x81\x26\xcb\x82\xbb\x3b\xb8\x38\x94\xee\xa0\xf8\xbf\xf5\x6d\xfa\xc1\xbb\x20\xde\x5a\x92\x8f\x54\x09\x1a\x32\x0b\x29\x86\x76\x2e\xca\x69\x62\x7a\x34\xdc\xd5\xf1\x76\x7a\x7c\xb7\x1a\xe3\x57\x77\x2f\xd7\x99\x07\x49\x74\x15\x5a\x26\xf2\x34\x01\x53\x83\x1d\xe2\x69\xc0\x10\x75\x0e\x7b\x5c\xc5\xbf\x67\x1f\x01\x3e\xd7\x74\x4a\x9c\x8b\xc5\xf7\xa4\xd7\x50\xe3\x72\x9e\xc6\x3d\xbc\x71\xed\x7c\x8e\x2a\x84\xea\x5d\x6a\xcb\x47\xb5\xfd\xe2\x86\xf3\xc0\xd2\x73\xe5\x7d\x3f\x02\xf4\xfa\x3e\xaf\x27\xff\x15\x7d\xe3\x91\x64\x7e\x44\xe2\xc1\xcc\x26\xd0\xff\x43\xe0\x59\x4e\x0f\x6b\xfc\x13\x5c\x51\xe9\xc5\x74\x9e\x9b\x4e\xd5\x64\xde\x9d\xe1\xa6\x23\x25\xf9\xec\xe4\x23\x1b\x2f\xa0\xe4\xba\xa9\x5b\x46\x08\x64\x29\x8d\xed\xa7\xed\xd2\x6f\x81\x86\xdd\x79\x17\x59\x0c\x91\xf3\xcd\xd0\x5b\x77\xd1\x92\x14\x5a\xa3\x98\x57\x13\x6d\xe9\x78\xe4\x50\xda\xa8\x81\x24\x7f\x32\x44\xde\xb0\x5b\x52\xc0\x9b\x17\xa6\x80\x37\x0b\x0a\xb8\xad\x5e\x96\x02\x6e\xab\x05\x85\xbf\x22\xba\xc0\x96\xce\xdf\xac\xdf\xbe\x86\x97\xad\x07\x19\xc6\xa5\x2a\x1a\x1b\x38\x9f\x2e\x76\x7d\x50\xc1\x6e\xe3\x5f\xc1\xf5\x1e\xce\x83\xd5\x22\x90\x34\xec\xba\x0e\x3e\x4d\xe3\x18\x44\xbe\x88\xce\x35\x36\x0f\x5f\x28\xb3\xe0\xb3\x2e\x3e\xfb\x96\xc8\xe9\x4b\xe6\xa1\x1b\xfe\xc9\xa1\xf6\x31\x7c\xb0\xa8\xfa\x3b\x36\x73\xef\xe8\xd3\xfd\x46\x73\x34\xc5\xe0\x07\xc6\xb3\xd2\x8d\x63\xbb\x1e\xae\x28\x13\x5e\xd1\x35\xfa\xbd\x63\x6f\x30\xc6\xcb\xf9\x31\x74\x68\x8d\x0f\x99\xe3\x49\x7b\x3c\x34\x48\x18\xd8\x4c\xee\x36\x26\xd9\x99\xc0\x25\x18\xdc\x2f\xce\x93\x27\x99\xa5\x50\x32\x47\x74\xf7\x96\x49\xef\xd1\x29\x9d\x58\xcc\x7a\x57\x91\xa4\x6b\x4b\xba\xa6\x50\x5a\xfa\x9e\xa1\xce\x6f\x3e\x23\xec\x31\xc7\x54\x68\x58\xb5\xbf\x01\xd1\x03\x79\xcd\xc0\x27\xc5\xbf\xb8\xe1\xbf\x50\x77\x5d\xd5\x67\x2a\xdd\x7f\x48\x29\x17\x71\x04\x2c\xfc\xce\x91\x1c\x57\xba\xfe\x4f\x95\xee\x52\x81\xb4\xbc\x49\xdd\x37\x13\xc5\xf9\xb1\xe8\x03\xc9\x1f\x90\xfa\xc8\x32\x53\xee\xe3\x82\x27\xe5\xec\x63\x8f\x8b\xf9\x18\xe2\xd9\x3f\x01\x00\x00\xff\xff\x4a\xb1\x52\x38\xd3\x0f\x00\x00")
+var _manifests0000_90_ingressOperator_03_prometheusrulesYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xc4\x57\x4d\x6f\xdc\x36\x10\xbd\xfb\x57\x0c\xb6\x3d\x38\x40\xb4\x8e\x0f\xb9\x08\xf0\x21\x48\x50\xb4\x97\x22\x30\x92\xf6\x28\x8c\xc5\x59\x69\x10\x8a\xa3\x0e\xc9\x5d\x2f\xdc\xfd\xef\x05\xf5\x65\x79\x3f\x6c\xc7\x69\x5d\xf8\x22\x2d\x39\x6f\xde\xcc\x13\xdf\xd0\xd8\xf2\x1f\xa4\x9e\xc5\xe5\xd0\x88\xe3\x20\xca\xae\x5a\x96\xa2\x24\x7e\x59\x4a\x73\xb1\xbe\x3c\xfb\xc6\xce\xe4\xf0\x59\xa5\xa1\x50\x53\xf4\xd7\xd1\xd2\x59\x43\x01\x0d\x06\xcc\xcf\x00\x1c\x36\x94\x03\xbb\x4a\xc9\xfb\x4c\x5a\x52\x0c\xa2\xc3\x82\x6f\xb1\xa4\x1c\xa4\x25\xe7\x6b\x5e\x85\xec\xc8\x3e\x8b\x37\x64\x7d\x82\x02\x50\xb1\x94\x03\x5a\xd2\x90\x69\xb4\xe4\xcf\x00\xd0\x39\x09\x18\x58\xdc\xb0\x89\x5d\x69\xa3\xa1\xa5\x92\x25\xf4\xb4\x9c\xd0\x97\x2c\x17\x7c\xd3\x64\xa5\x95\x68\xb2\x06\x1d\x56\x64\x72\x58\x04\x8d\xb4\x78\x3a\xd4\x93\x5d\x8d\x51\x59\xcd\x55\x9d\xe1\x1a\xd9\xe2\x0d\x5b\x0e\xdb\xef\xc0\x61\x57\x59\xca\x9c\x18\xca\x0c\xad\xc9\xa6\x62\xa7\x70\xdf\x52\x99\xea\xa8\x54\x62\x3b\x54\x94\x0d\x5d\x3c\xe8\xd3\x72\x6c\x42\xfa\xeb\x9e\xf3\xe1\x25\xeb\xbb\x94\xc3\xaf\x1f\x3e\xab\xdc\x6e\xaf\xc9\x0a\x9a\x5f\x90\xed\xb0\x01\x80\x6e\x5b\xcd\x21\x50\xd3\x5a\x0c\x54\xa8\xc4\x40\x5a\x68\xb7\xb1\x58\x21\xdb\xa8\x04\x57\x57\x70\x39\x45\xac\x44\x73\x78\xdf\x4c\xef\x73\x69\xfa\x3f\x4f\x6b\xd2\xae\x1b\x1b\x54\xc7\xae\x9a\xd6\x0e\x64\x1a\x02\x62\xd3\xa0\x6e\x27\x9e\xd0\xe7\x87\x21\xff\x6c\xa7\x21\x5f\x2a\xb7\x09\x21\x87\xc5\x97\x9a\x7d\x5f\x22\xac\x58\xc9\xc3\xa6\x26\x37\x81\xa4\x68\x0f\x41\x46\x34\x0e\x1e\x4a\x71\x2b\xae\xa2\x76\x9f\xca\x5b\xd8\xd4\x5c\xd6\xb0\x61\x6b\x41\xc9\x47\x1b\x80\x1d\x84\x9a\xa0\xef\x03\x38\x09\xd0\x72\xf9\x8d\x5d\x05\xb1\x05\xa5\x92\x5c\xb0\x5b\x28\x95\x30\x90\x01\x51\x68\xc4\xf0\x8a\xc9\xf4\x21\x7e\xb9\x98\xb1\x6d\xc8\x7b\xac\x28\x87\xc5\xc3\xc2\x3c\xa0\x52\x57\x5d\x02\x16\x07\x77\x77\xf0\x73\xdf\xc7\x65\x2b\x06\x76\xbb\x25\x5c\x27\x38\x05\xf6\x1d\x09\xa5\xf4\x49\x84\xb4\xfd\x19\x24\x16\x27\xf4\xff\x24\x1b\x37\xd1\xeb\x95\xaf\xb1\x4d\x2b\x45\x6c\x93\xca\xef\x5e\xa4\x72\xa9\x1c\xb8\x44\xfb\xdd\x32\xb3\x07\x33\xa7\xf4\x3c\x7d\x1b\x0a\xca\xa5\x07\xa5\x56\x34\x40\xa8\x31\xec\x23\x3e\xa1\xc2\x88\x90\x54\xe8\x51\x52\x63\x8f\x01\x81\x38\x48\x8a\xcc\x04\x9a\x2c\x0b\x76\x3b\xb8\x38\x94\xee\xa0\xf9\xbf\xf5\x76\xf6\x51\x5c\x50\xb1\x96\xf4\x13\x55\x8a\x86\xcc\xc4\xb1\x97\x62\x38\xce\x45\x39\x6d\x4c\x8f\x86\xbb\x3e\xde\x4d\x8f\x57\x8b\x31\x7e\xb1\x7b\xbd\x93\x79\x50\x44\xd7\xa1\xfd\x42\x9e\x27\x60\x3a\x60\x87\x78\x3e\x60\x88\x7e\x0e\x7b\x5c\xc5\xbf\x67\x3f\x02\x7c\xa9\xe9\x94\x38\x17\x7b\xbf\x27\xbd\x86\x1e\xdf\xb7\x18\x78\x74\xce\x91\x7f\x9f\x3b\x9f\xa3\x2a\xa1\x17\x97\x8e\xe5\x93\xda\x7e\x75\xc3\x3c\xb0\xf4\x52\x79\x3f\x8c\x00\x8b\xdd\xcb\xcf\xe4\xbf\xa2\x6f\x3c\x52\xcc\x8f\x48\x3c\x98\xd9\x04\xfa\x7f\x08\x3c\xab\xe9\x71\x8d\x7f\x82\x6b\x2a\x45\x4d\xb2\x86\x6e\xaa\xa6\x39\xd2\x19\x6e\x1a\x29\xc9\x67\x27\x27\x5a\x89\x82\x27\xd7\x6d\x5d\x33\x42\x20\x4b\x69\x6d\x3b\x40\x65\xc3\x27\xd0\xb0\x3b\xef\xc6\x44\x31\x44\xce\x3f\x86\x6e\xc1\x17\x2d\x69\xe1\x6b\x54\xf3\x66\x08\x86\x34\x76\x44\x4d\x0e\xa5\x8d\x3e\x90\xe6\xcf\x86\xc8\x1b\x76\xfb\x14\xf0\xf6\x95\x29\xe0\xed\x1e\x05\x5c\x57\xaf\x4b\x01\xd7\xd5\x1e\x85\xbf\x22\xba\xc0\x96\xce\xdf\x2d\xdf\xbf\x85\x57\x25\xd3\x90\x61\xdc\x57\xc5\xc7\x06\xce\xa7\x8b\x5d\x1f\x54\xb0\x5b\xc9\x1b\xb8\xd9\xc2\x79\xb0\xbe\x08\xa4\x0d\xbb\xee\xde\x72\x9a\xc6\x31\x88\x7c\x2f\x3a\xf7\xb1\x79\xfc\x42\x99\x05\xc9\xba\xf8\xec\xbe\x90\xd3\x97\xcc\x43\x37\xfc\x93\x43\x2d\x31\x7c\xb4\xe8\xfd\xef\xd8\xcc\xbd\xa3\x2f\xf7\x9e\xe6\x90\xb1\x08\x32\x30\x9e\xb5\x6e\x5c\xdb\xf4\x70\x45\x99\xf0\x8a\xc4\xf9\xe1\xd8\x1b\x8c\xf1\x72\x3e\x86\x0e\xad\xf1\x31\x73\x3c\x69\x8f\x87\x06\x09\x03\x9b\xf1\x7d\x2a\x32\x91\x80\x4b\x30\xb8\xdd\x9b\x27\xcf\x32\x4b\xa5\x34\xf8\xd0\x3d\x48\x03\xe8\x20\x3a\x4f\x27\x92\x59\x71\x15\x69\xba\xff\x38\x10\x47\x29\xf5\x03\x43\x9d\x59\xea\x62\x84\x3d\xe6\x98\x1e\x1a\xf6\x3e\x79\xd7\xdc\xb5\x0f\xeb\x5a\x2e\x0e\x15\xff\xea\x86\xff\x85\xba\xeb\xaa\x7f\xa1\xd2\xbd\xf4\xa9\xb3\x45\x1c\x01\x0b\xd9\x38\xd2\xe3\x4a\xd7\xff\xa9\xd2\x5d\x29\x90\xd2\x9b\x74\xfa\x66\xa2\x38\x19\x9b\x3e\x90\xfc\x01\xa9\x8f\xa4\x99\x6a\x1f\x13\x9e\x94\xb3\x8f\x3d\x2e\xe6\x53\x88\x67\xff\x0c\x00\x4a\xb1\x52\x38\xd3\x0f\x00\x00")
 
 func manifests0000_90_ingressOperator_03_prometheusrulesYamlBytes() ([]byte, error) {
 	return bindataRead(
@@ -582,7 +584,7 @@ func manifests0000_90_ingressOperator_03_prometheusrulesYaml() (*asset, error) {
 	return a, nil
 }
 
-var _manifests01ClusterRoleBindingYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x94\x91\xbd\x4e\xec\x30\x10\x85\xfb\x3c\xc5\x68\x6f\xed\x5c\xdd\xee\x2a\x1d\x50\xd0\x2f\x12\xfd\xc4\x9e\x4d\x86\x75\x66\x22\xcf\x38\x12\x3c\x3d\x32\xfb\xd3\x80\x16\xa8\xed\xef\xe8\xcc\x77\xfe\xc0\x3d\x4b\x32\xf0\x99\x40\x57\x2a\xe8\x5a\x20\xe6\x6a\x4e\x05\x8a\x66\x02\x57\x60\x37\x78\xa2\xb2\x71\x24\xb8\x8b\x51\xab\x78\xdf\x1d\x59\xd2\x00\x0f\xa7\xaf\x7b\xcd\xd4\x82\x58\xa6\x0e\x57\x7e\xa6\x62\xac\x32\x40\x19\x31\xf6\x58\x7d\xd6\xc2\x6f\xe8\xac\xd2\x1f\xff\x5b\xcf\xfa\x77\xfb\xd7\x2d\xe4\x98\xd0\x71\xe8\x00\x04\x17\x1a\x5a\x03\xb1\x99\x0f\x1e\x58\xa6\x42\x66\xe1\xd2\xa9\x03\x40\x11\xf5\x8f\x0c\x6b\x04\x00\x4b\xcc\x35\x51\x5f\x28\x13\x1a\xf5\x57\xba\xe5\xf3\xb8\x84\x98\xb5\xa6\xb0\xa0\xe0\x44\x69\x80\x9d\x97\x4a\xbb\xef\x51\xa3\x7c\xb8\x50\x61\xe6\x69\x0e\xb8\x21\x67\x1c\x39\xb3\xbf\xfe\x22\x87\x65\xca\x14\x44\x13\x85\x44\x1b\xe5\x76\xcc\x15\xb7\x3a\xbe\x50\x74\x1b\xba\x00\x27\x97\x67\xc5\x67\xc3\x57\x29\x5f\xa8\x68\x0f\xb6\x62\xbc\xad\xac\xcd\xb7\xa7\x43\x93\xf5\x69\xac\xe6\x73\xe5\xc7\xa2\x75\xbd\xb1\xd2\x4f\x86\x79\x0f\x00\x00\xff\xff\x73\x86\x17\xa7\x42\x02\x00\x00")
+var _manifests01ClusterRoleBindingYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x94\x91\x31\x6f\xe3\x30\x0c\x85\x77\xff\x0a\x22\x37\xcb\x87\xdb\x0e\xde\xee\x3a\x74\x4f\x81\xee\xb4\xc4\xd8\x6c\x64\xd2\x10\x29\x03\xed\xaf\x2f\xd4\x24\x5e\x5a\xa4\xe8\xae\xef\xe9\xf1\x7b\xbf\xe0\x3f\x4b\x32\xf0\x99\x40\x57\x2a\xe8\x5a\x20\xe6\x6a\x4e\x05\x8a\x66\x02\x57\x60\x37\x78\xa2\xb2\x71\x24\xf8\x17\xa3\x56\xf1\xbe\x3b\xb3\xa4\x01\x1e\x2e\x4f\x8f\x9a\xa9\x05\xb1\x4c\x1d\xae\xfc\x4c\xc5\x58\x65\x80\x32\x62\xec\xb1\xfa\xac\x85\xdf\xd0\x59\xa5\x3f\xff\xb5\x9e\xf5\xf7\xf6\xa7\x5b\xc8\x31\xa1\xe3\xd0\x01\x08\x2e\x34\xb4\x06\x62\x33\x9f\x3c\xb0\x4c\x85\xcc\xc2\xad\x53\x07\x80\x22\xea\x1f\x19\xd6\x08\x00\x96\x98\x6b\xa2\xbe\x50\x26\x34\xea\x77\xba\xe5\xf3\xb8\x84\x98\xb5\xa6\xb0\xa0\xe0\x44\x69\x80\x83\x97\x4a\x87\xef\x51\xa3\x7c\xba\x51\x61\xe6\x69\x0e\xb8\x21\x67\x1c\x39\xb3\xbf\xfe\x20\x87\x65\xca\x14\x44\x13\x85\x44\x1b\xe5\x76\xcc\x8e\x5b\x1d\x5f\x28\xba\x0d\x5d\x80\x8b\xcb\xab\xe2\xab\xe1\x5d\xca\x17\x2a\x9a\x2d\x5b\x31\xde\x57\xd6\xe6\x3b\xd2\xa9\xc9\xfa\x34\x56\xf3\xb9\xf2\x63\xd1\xba\xde\x59\x69\xef\x70\xe7\x97\xf7\x01\x00\x73\x86\x17\xa7\x42\x02\x00\x00")
 
 func manifests01ClusterRoleBindingYamlBytes() ([]byte, error) {
 	return bindataRead(
@@ -602,7 +604,7 @@ func manifests01ClusterRoleBindingYaml() (*asset, error) {
 	return a, nil
 }
 
-var _manifests01RoleBindingYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x92\xbd\x6e\xe4\x30\x0c\x84\x7b\x3f\x05\xb1\x5b\xcb\x87\xeb\x0e\xee\x2e\x4d\xfa\x0d\x90\x9e\x96\x68\x9b\x59\x99\x34\x24\xda\x40\xf2\xf4\x81\x76\xbd\x3f\x01\xf2\x83\x20\x45\x52\xa4\x16\x47\x43\x7e\x33\x5b\xb8\x61\x09\x19\x6c\x20\xd0\x89\x12\x9a\x26\x48\x1a\x09\x4c\x81\x2d\xc3\x1d\xa5\x85\x3d\xc1\x7f\xef\x75\x16\xab\xab\x3d\x4b\x68\x60\xa7\x91\x8a\x92\xa5\xaf\x70\xe2\x7b\x4a\x99\x55\x1a\x48\x2d\xfa\x1a\x67\x1b\x34\xf1\x13\x1a\xab\xd4\xfb\x7f\xb9\x66\xfd\xb3\xfc\xad\x46\x32\x0c\x68\xd8\x54\x00\x82\x23\x35\xc0\xd2\x27\xca\xd9\x9d\xac\xd7\x87\x3c\xa1\xa7\xa6\x2c\x24\x79\xe0\xce\xdc\x2b\x73\x28\xa2\x76\x70\xc8\xe5\x3f\x00\x16\x1f\xe7\x40\x75\xa2\x48\x98\xa9\x3e\xab\x8b\x3b\xb7\xa3\xf3\x51\xe7\xe0\x46\x14\xec\x29\x34\xb0\xb1\x34\xd3\xe6\x63\x69\xa6\xd8\x9d\x54\x6e\xe0\x7e\x70\xb8\x20\x47\x6c\x39\xb2\x3d\x7e\xe2\x1f\x96\x3e\x92\x13\x0d\xe4\x02\x2d\x14\xcb\x31\x67\x79\x9e\xdb\x07\xf2\x96\x9b\xca\xc1\x11\xf1\x4a\x7e\x05\xff\x75\x64\x25\xd5\x1d\x75\x05\xd6\x25\xc3\x02\x72\xe2\xdb\xa4\xf3\xf4\x4e\x78\x6f\x9b\x3b\xe7\xaa\xed\x75\x1b\xa0\xd3\xf4\xb2\x4d\xa6\x10\x28\x92\xd1\x61\x0c\x50\xc2\xf5\x7c\xae\xb6\xc0\x72\x52\xac\xbb\x7b\x95\x8e\xfb\xcb\x5d\xdf\xd4\xba\xe3\x1a\xbf\x5d\xfb\x21\x5d\x7b\x0e\x00\x00\xff\xff\x2d\x9f\xa9\x08\xac\x04\x00\x00")
+var _manifests01RoleBindingYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x92\xbd\x6e\xe4\x30\x0c\x84\x7b\x3f\x05\xb1\x5b\xcb\x87\xeb\x0e\xee\x2e\x4d\xfa\x0d\x90\x9e\x96\x68\x9b\x59\x99\x34\x24\xda\x40\xf2\xf4\x81\x76\xbd\x3f\x01\xf2\x83\x20\x45\x52\xa4\x16\x47\x43\x7e\x33\x5b\xb8\x61\x09\x19\x6c\x20\xd0\x89\x12\x9a\x26\x48\x1a\x09\x4c\x81\x2d\xc3\x1d\xa5\x85\x3d\xc1\x7f\xef\x75\x16\xab\xab\x3d\x4b\x68\x60\xa7\x91\x8a\x92\xa5\xaf\x70\xe2\x7b\x4a\x99\x55\x1a\x48\x2d\xfa\x1a\x67\x1b\x34\xf1\x13\x1a\xab\xd4\xfb\x7f\xb9\x66\xfd\xb3\xfc\xad\x46\x32\x0c\x68\xd8\x54\x00\x82\x23\x35\xc0\xd2\x27\xca\xd9\x9d\xac\xd7\x87\x3c\xa1\xa7\xa6\x2c\x24\x79\xe0\xce\xdc\x2b\x73\x28\xa2\x76\x70\xc8\xe5\x3f\x00\x16\x1f\xe7\x40\x75\xa2\x48\x98\xa9\x3e\xab\x8b\x3b\xb7\xa3\xf3\x51\xe7\xe0\x46\x14\xec\x29\x34\xb0\xb1\x34\xd3\xe6\x63\x69\xa6\xd8\x9d\x54\x6e\xe0\x7e\x70\xb8\x20\x47\x6c\x39\xb2\x3d\x7e\xe2\x1f\x96\x3e\x92\x13\x0d\xe4\x02\x2d\x14\xcb\x31\x67\x79\x9e\xdb\x07\xf2\x96\x9b\xca\xc1\x11\xf1\x4a\x7e\x05\xff\x75\x64\x25\xd5\x1d\x75\x05\xd6\x25\xc3\x02\x72\xe2\xdb\xa4\xf3\xf4\x4e\x78\x6f\x9b\x3b\xe7\xaa\xed\x75\x1b\xa0\xd3\xf4\xb2\x4d\xa6\x10\x28\x92\xd1\x61\x0c\x50\xc2\xf5\x7c\xae\xb6\xc0\x72\x52\xac\xbb\x7b\x95\x8e\xfb\xcb\x5d\xdf\xd4\xba\xe3\x1a\xbf\x5d\xfb\x21\x5d\x7b\x1e\x00\x2d\x9f\xa9\x08\xac\x04\x00\x00")
 
 func manifests01RoleBindingYamlBytes() ([]byte, error) {
 	return bindataRead(
@@ -622,7 +624,7 @@ func manifests01RoleBindingYaml() (*asset, error) {
 	return a, nil
 }
 
-var _manifests01RoleYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xe4\x52\xbd\x6e\xde\x30\x0c\xdc\xf5\x14\x82\xb3\x15\x90\x8b\x6e\x85\xc7\x2e\xdd\x3b\x74\xa7\xa5\xfb\x6c\x22\x32\x29\x48\xb2\x81\xf4\xe9\x0b\xff\x36\xc9\xd7\x20\xe8\xd2\x25\x1b\x25\xde\x1d\xff\xee\xc1\xfe\xd0\x08\x7b\xd3\x6c\xeb\x08\xab\x09\x99\xaa\x66\xcb\xb5\x20\xde\x5a\xf3\xc8\x12\xba\x0d\x63\x28\xf1\x4f\xe4\xc2\x2a\x9d\xcd\x3d\xf9\x96\xe6\x3a\x6a\xe6\x5f\x54\x59\xa5\x7d\xfc\x5a\x5a\xd6\xcf\xcb\x17\x33\xa1\x52\xa0\x4a\x9d\xb1\x56\x68\x42\x67\x59\x86\x8c\x52\xdc\x29\x7f\x24\x4a\x22\x8f\x6e\x2d\x2a\x65\xe4\x5b\x75\x7f\xc1\x91\x88\xd6\xad\x42\x59\xf5\xac\x65\xf1\x71\x0e\x68\x33\x22\xa8\xa0\xbd\xd8\x6b\x75\xee\x27\xe7\xa3\xce\xc1\x4d\x24\x34\x20\x74\xb6\xa9\x79\x46\xf3\x3e\x75\x9d\xf7\x64\xb9\x91\x87\xd1\xd1\x42\x1c\xa9\xe7\xc8\xf5\xe9\x1f\x74\x58\x86\x08\x27\x1a\xe0\x02\x16\xc4\x75\x98\x8b\x9e\xe7\x88\xd2\x19\x67\x29\xf1\xf7\xac\x73\xda\xa6\x72\xd7\xe2\x5f\x68\x19\x6b\x33\x8a\xce\xd9\xe3\x80\x35\x9f\xd6\x0e\x16\xe4\xfe\xd9\xc7\xbd\x5a\xd3\xdc\x53\x93\x86\xb2\x05\x05\x79\x61\x8f\xfd\x01\x09\x49\x59\xea\xfe\x4a\xeb\x7d\x4b\x85\xd4\x45\xe3\x3c\xc1\x47\xe2\xe9\x00\x2e\x38\x51\x5e\xe5\xc6\xc3\x44\xe9\xd4\xf3\x19\x5b\xea\xdd\xbe\x28\x6d\x9c\x57\x9d\x05\xa4\xa8\x4f\xd3\xa5\xff\xac\xc1\x57\x8a\xce\x39\xf3\x96\x63\xab\xda\x80\x88\x8a\x3d\x4f\x12\xb6\xe0\x1b\x4b\x60\x19\x8a\x79\xb0\x2c\x27\xe3\xb0\xdb\x3e\xc8\x1f\x2b\xfe\x6f\xbb\xef\xf5\x3f\x8e\xc9\xdf\x5c\xe3\xbd\x27\xb2\xc6\xc3\xa1\x6b\xd4\x9f\x47\x7c\xe1\x88\xfd\xdc\xe6\x77\x00\x00\x00\xff\xff\x6a\x68\x9e\x35\xc3\x04\x00\x00")
+var _manifests01RoleYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xe4\x52\x3d\x8f\xd5\x30\x10\xec\xf3\x2b\xac\x77\x1d\x92\x83\xe8\x50\x4a\x1a\x7a\x0a\xfa\x8d\x3d\x2f\x59\x9d\xb3\x6b\xd9\x9b\x48\xc7\xaf\x47\xf9\x7a\xdc\xf1\x78\x02\x1a\x1a\xba\xb5\x3d\x33\xbb\xe3\x9d\x27\xf7\x45\x13\xdc\x55\x8b\xb3\x11\x4e\x33\x0a\x99\x16\xc7\x56\x91\xae\x6d\xf3\xcc\x12\xbb\x0d\xd3\x50\xe6\xaf\x28\x95\x55\x3a\x57\x7a\x0a\x2d\xcd\x36\x6a\xe1\x6f\x64\xac\xd2\x3e\x7f\xac\x2d\xeb\xfb\xe5\x43\x33\xc1\x28\x92\x51\xd7\x38\x27\x34\xa1\x73\x2c\x43\x41\xad\xfe\x94\x3f\x1e\x6a\xa6\x80\x6e\x6d\x2a\x75\xe4\xab\xf9\x5f\xe0\x48\x44\x6d\xeb\x50\x57\x3d\xe7\x58\x42\x9a\x23\xda\x82\x04\xaa\x68\x6f\xec\xb5\x3b\xf7\x93\x0f\x49\xe7\xe8\x27\x12\x1a\x10\x3b\x77\xb1\x32\xe3\xf2\x7b\xea\xea\xf7\x64\xf9\x91\x87\xd1\xd3\x42\x9c\xa8\xe7\xc4\xf6\xf2\x17\x3a\x2c\x43\x82\x17\x8d\xf0\x11\x0b\xd2\x6a\xe6\x46\x2f\x73\x42\xed\x1a\xef\x28\xf3\xe7\xa2\x73\xde\x5c\xf9\xdb\xc7\xbf\xd1\x6a\x9c\x2b\xa8\x3a\x97\x80\x03\x76\x79\xb7\x3a\x59\x50\xfa\x57\x17\xf7\x6a\x97\xcb\x3d\x35\x6b\xac\x5b\x51\x51\x16\x0e\xd8\x0f\x90\x98\x95\xc5\xf6\x53\x5e\xf7\x5b\x0d\x62\x8b\xa6\x79\x42\x48\xc4\xd3\x01\x5c\x70\xa2\x82\xca\x95\x87\x89\xf2\xa9\x17\x0a\xac\xfe\xc9\x5c\x94\x73\xbd\x9f\x2c\x22\x27\x7d\x99\x6e\xfa\xaf\x06\xfc\x49\xd1\x7b\xdf\x3c\x4a\xac\xa9\x8b\x48\x30\xec\xef\x24\x71\x2b\x3e\xb1\x44\x96\xa1\x36\x4f\x8e\xe5\x64\x1c\x71\xdb\x8d\xfc\x88\xe2\xbf\x8e\xfb\xde\xff\xff\x09\xf9\xc3\x6f\xbc\xcf\x44\xd1\x74\x24\x74\xad\xfa\x73\x89\x6f\x12\x11\x91\x60\x68\xbe\x0f\x00\x6a\x68\x9e\x35\xc3\x04\x00\x00")
 
 func manifests01RoleYamlBytes() ([]byte, error) {
 	return bindataRead(
@@ -642,7 +644,7 @@ func manifests01RoleYaml() (*asset, error) {
 	return a, nil
 }
 
-var _manifests01ServiceAccountYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x94\x90\xb1\x6e\xf3\x30\x0c\x84\x77\x3f\x05\x91\xcc\xf2\x8f\x7f\xd5\xd6\xb1\x73\x81\xee\x8c\x74\xb1\x89\xca\x94\x2b\x52\x06\xfa\xf6\x85\x82\x24\x53\x81\xa2\xb3\xee\xfb\xee\xc4\x33\xbd\xa4\x54\xbb\x3a\x5d\x6b\x23\x5f\x41\x75\x47\x63\xaf\x8d\xc4\x0d\xe5\x3a\xd3\xab\x93\xad\xb5\x97\x4c\x0d\x9f\x5d\x1a\x48\x79\x83\xed\x9c\x40\x96\xea\x8e\x3c\x9d\x69\x47\xdb\xc4\x4c\xaa\xda\x3c\x7d\x88\xe6\x48\x6f\x68\x87\x24\xdc\xfd\x13\xef\xf2\x8e\x36\x12\x91\x8e\xff\xd3\x06\xe7\xcc\xce\x71\xa2\x9b\x2f\x92\xe8\xd2\x60\x16\x1e\x03\xee\x0f\xb7\xa2\x38\x66\xa9\xad\x72\xf5\xf0\x43\x8e\x55\xab\xb3\x8f\xf6\xe1\x23\x12\x4d\xa5\x67\xcc\x0d\x05\x6c\x98\x9f\xf4\x2c\xf5\x9f\x5c\xb6\x90\x4a\xed\x39\x6c\xac\xbc\x20\x47\x3a\x79\xeb\x38\xfd\x8e\x8e\x8b\x3c\xa8\xb0\xca\xb2\x06\x3e\x58\x0a\x5f\xa4\x88\x7f\xfd\xc1\x23\xba\x14\x04\xad\x19\x21\xe3\x40\x19\x9f\x79\xe2\xdf\x01\x00\x00\xff\xff\xea\x53\x09\xdb\x95\x01\x00\x00")
+var _manifests01ServiceAccountYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x94\x90\xb1\x4e\xc4\x30\x10\x44\xfb\x7c\xc5\x2a\x57\x3b\x88\xd6\x1d\x25\x35\x12\xfd\x9e\x3d\x49\x56\x38\xeb\xb0\x5e\x47\xe2\xef\x51\x4e\x77\x54\x48\x88\xda\xf3\xde\x8c\xf7\x42\x2f\x29\xd5\xae\x4e\x73\x35\xf2\x15\x54\x77\x18\x7b\x35\x12\x6f\x28\xf3\x44\xaf\x4e\x6d\xad\xbd\x64\x32\x7c\x76\x31\x90\xf2\x86\xb6\x73\x02\xb5\x54\x77\xe4\xe1\x42\x3b\x6c\x93\xd6\xa4\x6a\x9b\x86\x0f\xd1\x1c\xe9\x0d\x76\x48\xc2\xdd\x3f\xf0\x2e\xef\xb0\x33\x11\xe9\x78\x1e\x36\x38\x67\x76\x8e\x03\xdd\x7c\x91\x44\x17\x43\x6b\xe1\x31\xe0\xfe\x70\x2b\x8a\xe7\x2c\x6d\xab\xcc\x1e\x7e\xc9\xb1\x6a\x75\xf6\xb3\xfd\xf4\x11\x89\xa6\xd2\x33\x26\x43\x01\x37\x4c\x3f\xf4\x24\xf5\x49\xae\x5b\x48\xa5\xf6\x1c\x36\x56\x5e\x90\x23\x8d\x6e\x1d\xe3\xdf\xe8\x79\x91\x07\x15\x56\x59\xd6\xc0\x07\x4b\xe1\xab\x14\xf1\xaf\x7f\x78\x44\x97\x82\xa0\x35\x23\x64\x1c\x28\xe7\x67\x22\x8d\x6e\x1d\xe3\xf0\x3d\x00\xea\x53\x09\xdb\x95\x01\x00\x00")
 
 func manifests01ServiceAccountYamlBytes() ([]byte, error) {
 	return bindataRead(
@@ -662,7 +664,7 @@ func manifests01ServiceAccountYaml() (*asset, error) {
 	return a, nil
 }
 
-var _manifests01ServiceYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x94\x90\xbf\x6a\x33\x31\x10\xc4\xfb\x7b\x0a\xe1\x5e\xf7\xf1\xe1\xca\x6a\x53\xa5\x33\x04\xd2\xef\xe9\xc6\xe7\x25\xba\x95\xd8\xdd\x3b\xf0\xdb\x07\xff\x4b\x48\x5c\x98\x94\xa3\xd1\x6f\x66\x24\x6a\xfc\x0e\x35\xae\x92\xc2\xfa\xbf\xfb\x60\x19\x53\x78\x83\xae\x9c\xd1\xcd\x70\x1a\xc9\x29\x75\x21\x90\x48\x75\x72\xae\x62\x67\x19\x02\x4b\x2e\xcb\x88\x5e\x51\x40\x86\xbe\x36\x88\x1d\xf9\xe0\x3d\xd7\x7f\x3c\xcc\x31\x97\xba\x8c\x71\x26\xa1\x09\x63\x0a\x1b\xd7\x05\x9b\xe7\xa8\xa1\x1c\xee\x54\x3c\xf2\x74\x8c\xb4\x12\x17\x1a\xb8\xb0\x9f\xfe\x90\xc3\x32\x15\x44\xa9\x23\xe2\x88\x15\xa5\x36\xe8\x0f\xdc\xae\xcf\xec\x07\x38\xfd\xde\xa0\x2b\xcb\x14\x33\xd4\xa3\x21\x2b\x3c\x0a\xcd\x48\x61\x86\x2b\x67\x8b\x5e\xac\x0b\xa1\xd0\x80\x72\xfb\x8f\xab\xcf\x32\x29\xcc\xe2\xb9\x8c\xbc\x6a\x77\x37\x6e\xe0\x4d\x5b\xa3\x8c\x14\xbe\x4a\xe3\x03\x67\x0d\xf9\x1c\xdc\xaa\xfa\xa5\x21\x3e\x04\x5d\xcd\x14\x76\xdb\xdd\xf6\x22\x9d\x74\x82\xef\x2f\x87\xdf\xd7\x0c\x05\xd9\xab\x3e\x99\xe9\xa7\x86\x14\x5e\xca\x62\x0e\x7d\xdd\x77\x9f\x01\x00\x00\xff\xff\x4b\xbb\xe1\xcf\x1a\x02\x00\x00")
+var _manifests01ServiceYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x94\x90\xbf\x6a\x33\x31\x10\xc4\xfb\x7b\x8a\xc5\xbd\xee\xe3\xc3\x95\xd5\xa6\x4a\x67\x08\xa4\xdf\xd3\x8d\xcf\x4b\x74\x92\xd8\xdd\x3b\xf0\xdb\x07\xff\x49\x88\xe3\xc2\xa4\x1c\x46\xbf\x99\xd1\x72\x93\x77\xa8\x49\x2d\x91\xd6\xff\xdd\x87\x94\x31\xd2\x1b\x74\x95\x84\x6e\x86\xf3\xc8\xce\xb1\x23\xe2\x52\xaa\xb3\x4b\x2d\x76\x96\x44\x52\x52\x5e\x46\xf4\x8a\x0c\x36\xf4\xb5\xa1\xd8\x51\x0e\xde\x4b\xfd\x27\xc3\x1c\x52\xae\xcb\x18\x66\x2e\x3c\x61\x8c\xb4\x71\x5d\xb0\x79\x8e\x1a\xf2\xe1\x8b\x0a\x47\x99\x8e\x81\x57\x96\xcc\x83\x64\xf1\xd3\x1f\x72\xa4\x4c\x19\xa1\xd4\x11\x61\xc4\x8a\x5c\x1b\xf4\x0e\xb7\xeb\x37\xfb\x01\xce\xbf\x37\xe8\x2a\x65\x0a\x09\xea\xc1\x90\x14\x1e\x0a\xcf\x88\x34\xc3\x55\x92\x05\xcf\xd6\x11\x65\x1e\x90\x6f\xf7\xb8\xfa\x52\x26\x85\x59\x38\x97\xb1\x57\xed\x88\xee\xc0\x9b\xb6\xc6\x09\x91\xbe\x4b\xc3\x03\x67\x0d\xe9\x1c\xdc\xaa\xfa\xa5\x21\x3c\x04\x5d\xcd\x48\xbb\xed\x6e\x7b\x91\xce\x3a\xc1\xf7\x55\xfd\xe7\x33\x43\x46\xf2\xaa\x4f\x66\xfa\xa9\x21\xd2\x4b\x5e\xcc\xa1\xaf\xfb\xee\x73\x00\x4b\xbb\xe1\xcf\x1a\x02\x00\x00")
 
 func manifests01ServiceYamlBytes() ([]byte, error) {
 	return bindataRead(
@@ -682,7 +684,7 @@ func manifests01ServiceYaml() (*asset, error) {
 	return a, nil
 }
 
-var _manifests01TrustedCaConfigmapYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x94\x91\xbd\x4e\xec\x30\x10\x85\xfb\x3c\xc5\x68\xb7\x76\xae\x6e\x9b\x96\x9a\x0e\xd1\x4f\xec\x93\x64\x58\x67\xc6\xb2\x27\x8b\x78\x7b\xb4\x7f\xb0\x20\x24\x44\xe9\xb1\xbf\x63\x9d\x6f\xf6\xf4\xb4\x80\x14\xfe\x6a\xf5\x40\x56\x50\xd9\xad\x92\x34\xaa\x68\xc5\xb4\xc9\x98\x41\xd3\x69\xa4\x2f\x88\x2e\x3a\x77\x7b\xf2\x05\xe4\x75\x6b\x8e\x44\x91\x69\xdc\x34\x65\x90\xa8\x1b\xf9\x22\x8d\xa2\xe9\x24\xf3\xca\xa5\xef\xb8\xc8\x33\x6a\x13\xd3\x81\x8e\xff\xbb\x83\x68\x1a\xe8\xe1\x7c\xff\xc8\xa5\x5b\xe1\x9c\xd8\x79\xe8\x88\x58\xd5\x9c\x5d\x4c\xdb\xe9\x48\x24\x1a\xf3\x96\xd0\x57\x64\x70\x43\x6f\x05\xda\x16\x99\xbc\x17\xfb\x27\xe3\x1a\x62\xb6\x2d\x85\x95\x95\x67\xa4\x81\x76\x5e\x37\xec\x7e\x47\x1b\xf2\x74\xa3\xc2\x22\xf3\x12\xf8\xc8\x92\x79\x94\x2c\xfe\xf6\x87\x1c\xd1\x39\x23\xa8\x25\x84\x84\x23\xf2\xc9\xdf\x17\xfc\x47\x2c\x56\xb0\x23\x98\xe6\xfb\xbf\x32\x8f\xc8\xd7\xe2\x17\x7f\xdf\xfa\x9e\xfd\x87\xab\xf6\x10\xf9\x62\xfd\x2e\x41\x79\xc5\x40\x9f\x0f\xae\xa3\x56\x38\x62\xa0\x8f\xb0\x20\x3a\x57\xb4\x16\x6e\xdb\xee\xde\x03\x00\x00\xff\xff\x4b\xe9\x81\x95\x05\x02\x00\x00")
+var _manifests01TrustedCaConfigmapYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x94\x91\xb1\x6e\xeb\x30\x0c\x45\x77\x7f\x05\x91\xcc\xf2\xc3\x5b\xbd\x76\xee\x56\x74\xa7\xa5\x6b\x9b\x8d\x4c\x0a\x12\x9d\xa2\x7f\x5f\xa4\x71\x8a\x04\x28\x50\x74\xd4\x15\xce\x25\x78\x78\xa4\x97\x05\xa4\xf0\x77\xab\x27\xb2\x82\xca\x6e\x95\xa4\x51\x45\x2b\xa6\x4d\xc6\x0c\x9a\x2e\x91\xbe\x21\xba\xe8\xdc\x1d\xc9\x17\x90\xd7\xad\x39\x12\x45\xa6\x71\xd3\x94\x41\xa2\x6e\xe4\x8b\x34\x8a\xa6\x93\xcc\x2b\x97\xbe\xe3\x22\xaf\xa8\x4d\x4c\x07\x3a\xff\xef\x4e\xa2\x69\xa0\xa7\xaf\xff\x67\x2e\xdd\x0a\xe7\xc4\xce\x43\x47\xc4\xaa\xe6\xec\x62\xda\x2e\x4f\x22\xd1\x98\xb7\x84\xbe\x22\x83\x1b\x7a\x2b\xd0\xb6\xc8\xe4\xbd\xd8\x3f\x19\xd7\x10\xb3\x6d\x29\xac\xac\x3c\x23\x0d\x74\xf0\xba\xe1\xf0\x3b\xda\x90\xa7\x1b\x15\x16\x99\x97\xc0\x67\x96\xcc\xa3\x64\xf1\x8f\x3f\xf4\x88\xce\x19\x41\x2d\x21\x24\x9c\x91\x2f\xfe\x1e\xf0\x1f\xb1\x58\xc1\x8e\x60\x9a\xef\x67\x65\x1e\x91\xf7\xc5\xaf\xfe\x1e\xa9\xab\xff\xb0\x6b\x0f\x91\xaf\xd6\xef\x1a\x94\x57\x0c\xb7\xbb\x84\xc8\x7b\xd4\x0a\x47\x0c\xf4\x5d\x16\x44\xe7\x8a\xd6\x82\x15\x54\x76\xab\xdd\xe7\x00\x4b\xe9\x81\x95\x05\x02\x00\x00")
 
 func manifests01TrustedCaConfigmapYamlBytes() ([]byte, error) {
 	return bindataRead(
@@ -702,7 +704,27 @@ func manifests01TrustedCaConfigmapYaml() (*asset, error) {
 	return a, nil
 }
 
-var _manifests02DeploymentIbmCloudManagedYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xcc\x57\xdd\x6f\xdb\x36\x10\x7f\xf7\x5f\x41\x64\x03\xba\x01\xa5\xe5\xa4\x1f\x5b\x05\xe4\xc1\x73\xdc\x26\x40\xe2\x18\x76\xd0\x62\x4f\xc6\x99\x3a\xdb\x9c\x29\x52\x3d\x1e\xdd\x08\xc3\xfe\xf7\x81\x92\xeb\x48\xb6\xf3\x01\x74\x0f\xd3\x93\x44\xde\xfd\x8e\xf7\xbb\x0f\x9e\x7e\x12\x5f\xfa\x93\xd1\xd5\xe8\x53\x2a\x2e\x6e\xc5\xe8\xf6\x4e\x0c\x2f\xae\xee\x5e\x8b\x25\x5a\x24\x60\xcc\xc4\xbc\x14\x2b\x50\xeb\x24\x14\x19\x30\xca\x82\xdc\x42\x1b\x94\x39\x58\xbd\x40\xcf\xbe\xeb\x57\x1d\x28\xf4\x67\x24\xaf\x9d\x4d\x05\x14\x85\x4f\x36\xa7\x9d\xb5\xb6\x59\x2a\x2e\xb0\x30\xae\xcc\xd1\x72\x27\x47\x86\x0c\x18\xd2\x8e\x10\x60\xad\x63\x60\xed\xac\x8f\x9f\x42\x28\x67\x17\x7a\xd9\x75\x05\x5a\xbf\xd2\x0b\xee\x6a\x97\x68\xfb\x17\x2a\x8e\x16\xef\xcb\x54\x68\xbb\x24\xf4\x5e\xba\x22\x1e\xcc\x51\xa5\xa6\xad\x32\x21\xc3\x2e\xa1\x41\xf0\xb8\xa7\x3f\xcf\xa5\x32\x2e\x64\xf1\xb0\xb0\xc4\x2c\x15\x27\x4c\x01\x4f\x3a\x42\x58\xc8\xf1\x28\x66\xdc\xf0\x05\x28\x4c\xc5\x0e\x4c\x1e\xc8\xf9\x02\x55\x3c\x38\x61\x61\xb4\x02\x9f\x8a\xd3\x8e\x10\x1e\x0d\x2a\x76\x54\xbb\x94\x03\xab\xd5\x35\xcc\xd1\x6c\x7d\x7c\xc2\xaa\xe7\x48\xf6\xb2\xac\x05\xb9\x2c\x30\x15\x13\x54\x84\xc0\xd8\x11\x82\x31\x2f\x0c\x30\x6e\x81\x1b\x44\xc6\xe7\x80\xcc\x0a\x03\x68\x89\xdc\xfd\xe6\x68\x6d\x1c\x64\x6d\x66\x6a\x3e\x62\x54\x52\xf1\xea\xef\x13\x5c\x2c\x50\xf1\x49\x2a\x4e\xc6\x84\x0b\x24\xc2\xec\x22\x90\xb6\xcb\xa9\x5a\x61\x16\x8c\xb6\xcb\x93\x7f\x5e\x6d\xa1\x4d\xcb\xa3\x27\x7c\x12\xe2\x3b\x4b\xdb\x00\x33\x68\x8b\xb4\x53\x95\x42\xb9\x3c\x07\x9b\x3d\x60\xc9\xe3\x38\xf5\x96\x67\x20\x6e\x7c\x4b\xb9\x8b\x55\x63\xf5\xe7\x5f\xbe\xf4\xef\x06\x97\xb3\x51\xff\x66\x38\x1d\xf7\x07\xc3\x5f\x5b\x2a\x3a\x87\x65\x5b\xfc\xea\xa6\xff\x69\x4f\x48\x81\x05\x2a\x8f\xc8\x0e\xfa\xa3\xfe\xe4\xcf\xd9\x11\x95\x6d\x02\xca\x4d\x5d\x06\x2d\xad\xc9\xf0\x7a\xd8\x9f\x0e\x67\x9f\x87\x93\xe9\xd5\xed\xe8\x41\x11\xed\xa6\xe9\x7b\xcd\xe4\x9e\xf4\x6e\x5f\x88\x0d\x98\x80\xa9\xe8\x75\x7b\xdd\x53\xe9\x2d\x14\x7e\xe5\xf8\x40\x7f\xcf\xfd\x7d\xfd\x8f\xe4\xf2\xb4\xb1\x28\xc4\x42\xa3\xc9\x26\xb8\x68\xaf\x6e\xd7\xc7\xc0\xab\x74\x97\x71\xdd\x63\x8c\xd7\x66\x2b\x4e\x0e\x0f\xbb\x4b\xbb\xc4\x91\x5e\x6a\x2b\x57\x50\xd5\xb3\x24\x17\x18\x29\xdd\xbc\xed\xf6\x0e\xb0\x9a\x34\xbf\x00\x52\x99\xe0\x19\xe9\xa0\x4a\xd3\x58\x32\xfe\x81\xa1\x2a\x9e\x3f\xaa\x3f\x0e\xc6\x8c\x9d\xd1\xaa\x4c\xc5\xd5\x62\xe4\x78\x4c\xe8\x63\x7b\xfb\x2e\xf5\x44\x3d\xc4\x87\xd0\xbb\x40\x0a\x7d\x93\x6e\xc2\xaf\x21\xf6\xd2\x76\x08\x54\x11\x52\x71\xda\xcb\x5b\x8b\x39\xe6\x8e\xca\x54\xbc\x7b\x7f\xa3\x77\x1b\x1e\x55\x20\xcd\xe5\xc0\x59\xc6\x7b\x6e\xc2\x80\x31\xee\xdb\x98\xf4\x46\x1b\x5c\xe2\xd0\x2b\x30\x55\xab\x48\xc5\x02\x8c\xc7\x86\xa4\x82\x02\xe6\xda\x68\xd6\xb8\x77\x90\x8c\x5c\xd1\x5e\x91\xa2\x7f\x7d\xfd\xd0\x6b\x90\x72\x6d\x2b\xd8\x1b\xf4\x3e\xb2\xb4\x65\xe8\x23\x18\x33\x07\xb5\xbe\x73\xd7\x6e\xe9\x6f\xed\x90\xa8\xc1\xc5\xc6\x99\x90\xe3\x8d\x0b\xb6\xe9\xba\x14\x79\x5c\xa9\x33\x2f\x41\x56\x49\xb1\xd6\x89\x02\xc9\x14\x3c\x27\x78\xcf\x04\x8a\x31\x4b\x0a\x6c\x52\x53\xf3\x5e\xc9\x60\x26\x15\xb4\xe8\x85\xec\xd6\x9a\xb2\xda\xc6\x47\x0c\x6d\x80\x12\x0a\x36\xf1\xb1\xed\xb2\x4f\x1e\xd2\xc4\x23\x6d\xb4\x42\x50\x2a\x8a\x1f\x98\x9c\xbb\x60\x33\xe9\x41\xb2\x5b\xa3\x7d\xce\xac\x14\x40\xcb\x96\xb7\x52\x1a\xb7\x64\xe7\x39\x43\xa2\xd6\x7a\x15\x56\x94\x46\x7b\x46\x2b\x21\xcb\x62\x4a\x9d\xa7\x1f\xde\x7c\x78\xd3\x92\x63\xe3\xa5\xd2\xc5\x0a\x49\xfa\xa0\x19\xfd\xf9\xdd\xf5\x74\x36\x1c\x5c\x5c\x0e\x67\x93\x69\x7f\xf6\xe5\xea\xee\x72\xd6\x1f\x4e\x67\xa7\x67\xbf\xcf\x3e\x0d\x6e\x66\xd3\xcb\xfe\xd9\xbb\xf7\xaf\x1f\xa4\x86\x83\x8b\x67\xe4\x0e\x70\x06\x7f\x0c\x5e\x84\x73\x54\xee\x09\xb4\x96\x67\xa1\xf0\x4c\x08\xf9\xf9\x8a\xb9\x48\x93\xe4\xf4\xec\xb7\x6e\xd5\xff\xd2\xf7\xbd\x5e\xaf\x97\x1c\xd2\x80\xc4\x32\x0e\x28\xe7\x55\xe6\xb0\xf1\x49\x41\x7a\x03\x8c\xf1\xbd\xab\xf6\x6e\x90\xa8\xb2\xdd\x97\x6b\x2c\x9f\xd0\x5c\x63\xb9\xdf\x48\xbe\x06\x28\xe3\x6d\x7a\xd0\x50\xd6\x61\x8e\x92\xe6\xa0\xb6\xb3\xcb\x5e\x1f\xa9\xd3\x66\x4f\x68\xb7\x5b\x38\x6a\x57\xc3\xee\xe6\x1c\x3b\xe2\x54\xb4\xa2\xff\x1d\x2c\x47\x26\xad\xfc\x7f\xde\x65\xde\xf6\xfe\x3f\x5d\xe6\xa5\xdd\xa2\x11\xb9\xc7\x78\x8a\x71\x7f\xae\x50\xad\xcb\x70\xda\x1a\xe8\xe2\x13\xa3\x46\x16\x19\x7d\x15\x79\x9f\x0a\xa3\x6d\xb8\xdf\xee\x17\xa4\x5d\xc5\x90\x01\xef\x47\x95\x45\x5f\x7a\xc6\x7c\x77\xc7\x28\xd2\xac\x15\x98\xce\x33\x94\x52\xb0\x7d\x3f\x72\x76\xe2\x1c\xef\xb5\x2d\x8f\x4a\xb9\xbc\x18\xd7\x83\x78\x93\xb1\xed\xdc\x18\x2c\xeb\x1c\x2f\x70\x01\xc1\xf0\xce\x50\xd5\xc3\xfa\x75\x0f\x1b\x3d\x75\x47\xb1\x33\xf1\xbb\x39\x4e\x4a\x51\x8f\x88\xa9\x18\xb9\xed\x4c\xf8\x70\x9e\x35\x96\x69\xc5\x96\x24\x67\xb0\xdb\x66\x28\x87\xe8\xf6\x4e\x76\x77\xb5\x8a\xe1\xbd\xf6\xec\x8f\xe0\x0f\xef\x51\x05\x3e\x02\xbf\x87\x1c\x2c\x21\xa8\x15\xcc\x1b\x47\x79\x04\xbe\xe9\xd3\x14\x95\xb3\x59\x1c\xd8\xcf\x7a\x3f\x60\xdd\x3a\x96\x31\x6d\xca\x1f\xb4\x5d\x27\x75\x83\xe8\xc7\xf3\xb4\xbe\x98\x9a\xf1\xae\x57\x46\x8f\x68\xc8\xed\x4f\xd5\x0d\xb4\xca\x4a\x33\xe6\xad\xca\x93\xb5\x93\x0a\xe4\x3c\xd8\xcc\x60\xab\x49\x56\x59\x5d\xd5\x56\xd5\x5d\x77\x32\x2f\xbc\x7b\x1f\xd9\x90\x4f\x5f\x9d\x05\xb9\xf8\xe7\x87\x59\xcb\xd9\xc3\x86\x26\xf7\xd2\xfa\x2e\xa2\xec\x8f\xb1\x10\x32\x8d\xb6\xf5\x3f\xb7\x27\xb1\xf5\xaf\x3a\xc2\xbf\x01\x00\x00\xff\xff\x89\x10\xa4\x60\x10\x0f\x00\x00")
+var _manifests01WebhookServiceYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x94\x90\xbf\x6e\xf3\x30\x0c\xc4\x77\x3f\x05\x91\x5d\xfe\xf0\xa1\x99\xb4\x76\xea\x16\xa0\x40\x77\x5a\xbe\xd8\x44\x64\xd1\xa0\x68\x17\x79\xfb\x22\x7f\x5a\x24\xcd\x10\x74\x3c\xe8\xee\x77\x27\xf2\x2c\x1f\xb0\x2a\x5a\x22\xad\xff\x9b\x83\x94\x3e\xd2\x3b\x6c\x95\x84\x66\x82\x73\xcf\xce\xb1\x21\xe2\x52\xd4\xd9\x45\x4b\x3d\x49\x22\x29\x29\x2f\x3d\x5a\x43\x06\x57\xb4\x3a\xa3\xd4\x51\xf6\xde\x8a\xfe\x93\x6e\x0a\x29\xeb\xd2\x87\x89\x0b\x0f\xe8\x23\x6d\xdc\x16\x6c\x9e\x47\x2b\xf2\xfe\x3b\x15\x46\x19\xc6\xc0\x2b\x4b\xe6\x4e\xb2\xf8\xf1\x0f\x1c\x29\x43\x46\x28\xda\x23\xf4\x58\x91\x75\x86\xdd\xc5\xeb\xe5\x9b\x6d\x07\xe7\xdf\x1b\x6c\x95\x32\x84\x04\xf3\x50\x91\x0c\x1e\x0a\x4f\x88\xf4\x89\x6e\x54\x3d\x84\x5b\x47\x43\x94\xb9\x43\xbe\x1e\xe6\x62\x94\x32\x18\x6a\x0d\xa7\x56\x76\xb5\x86\xe8\x8e\x70\xd5\x75\xe6\x84\x48\x3f\xed\xe1\x21\x57\x67\xa4\x13\x78\x56\xf3\x73\x43\x78\x00\x5d\x1e\x23\x6d\xb7\x2f\x67\xe5\x6c\x03\x7c\xa7\xe6\xb7\xae\x8a\x8c\xe4\x6a\x4f\x56\xfa\x71\x46\xa4\xd7\xbc\x54\x87\xbd\xed\x9a\xaf\x01\x00\x59\xe1\x5d\x78\x22\x02\x00\x00")
+
+func manifests01WebhookServiceYamlBytes() ([]byte, error) {
+	return bindataRead(
+		_manifests01WebhookServiceYaml,
+		"manifests/01-webhook-service.yaml",
+	)
+}
+
+func manifests01WebhookServiceYaml() (*asset, error) {
+	bytes, err := manifests01WebhookServiceYamlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "manifests/01-webhook-service.yaml", size: 546, mode: os.FileMode(420), modTime: time.Unix(1, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x8c, 0xd9, 0x21, 0x90, 0xe8, 0xbb, 0xc4, 0x1b, 0xa2, 0xa7, 0xe3, 0x6b, 0x7e, 0xe6, 0xa7, 0xd2, 0xc7, 0x69, 0x9e, 0x9d, 0xbe, 0x3, 0x5b, 0x37, 0xa1, 0x92, 0xb2, 0x25, 0x14, 0x74, 0xea, 0xbe}}
+	return a, nil
+}
+
+var _manifests02DeploymentIbmCloudManagedYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xcc\x57\x41\x6f\xdb\x3a\x12\xbe\xfb\x57\x10\xd9\x05\xba\x0b\x94\x96\x93\xa6\xdd\x2d\x81\x1c\xbc\x8e\xdb\x04\x48\x1c\xc3\x0e\x5a\xec\xc9\x18\x53\x63\x99\x6b\x8a\x54\xc9\xa1\x1b\x61\xf1\xfe\xfb\x03\x65\x5b\x96\x1c\xc7\xc9\x43\xdf\xe1\x41\xbc\x68\x38\xf3\x0d\xf9\x71\x66\x38\xfc\x1b\xfb\xde\x9f\x8c\x6e\x47\x5f\x05\xbb\x7e\x60\xa3\x87\x47\x36\xbc\xbe\x7d\x7c\xcf\x32\x34\xe8\x80\x30\x65\xf3\x92\x2d\x41\xae\x92\x50\xa4\x40\xc8\x0b\x67\x17\x4a\x23\xcf\xc1\xa8\x05\x7a\xf2\x5d\xbf\xec\x40\xa1\xbe\xa1\xf3\xca\x1a\xc1\xa0\x28\x7c\xb2\x3e\xef\xac\x94\x49\x05\xbb\xc6\x42\xdb\x32\x47\x43\x9d\x1c\x09\x52\x20\x10\x1d\xc6\xc0\x18\x4b\x40\xca\x1a\x1f\x7f\x19\x93\xd6\x2c\x54\xd6\xb5\x05\x1a\xbf\x54\x0b\xea\x2a\x9b\x28\xf3\x3f\x94\x14\x3d\x3e\x95\x82\x29\x93\x39\xf4\x9e\xdb\x22\x2e\xcc\xba\xca\x4c\x19\xa9\x43\x8a\x5d\x87\x1a\xc1\xe3\x81\xfd\x3c\xe7\x52\xdb\x90\xc6\xc5\x42\x86\xa9\x60\x67\xe4\x02\x9e\x75\x18\x33\x90\xe3\x51\xcc\x38\xe1\x0b\x90\x28\x58\x0d\xc6\x9f\xe9\xf9\x02\x65\x5c\xb8\xc3\x42\x2b\x09\x5e\xb0\xf3\x0e\x63\x1e\x35\x4a\xb2\x2e\xce\x30\x96\x03\xc9\xe5\x1d\xcc\x51\xfb\x8d\xe0\x84\x57\x4f\x91\xec\xac\xdc\x28\x52\x59\xa0\x60\x13\x94\x0e\x81\xb0\xc3\x18\x61\x5e\x68\x20\xdc\x02\x37\x88\x64\xec\x08\x99\x71\x10\xb8\x0c\xa9\xfb\xd3\xba\x95\xb6\x90\xb6\x99\xd9\xf0\x11\x4f\x45\xb0\x77\xff\x3f\xc3\xc5\x02\x25\x9d\x09\x76\x36\x76\xb8\x40\xe7\x30\xbd\x0e\x4e\x99\x6c\x2a\x97\x98\x06\xad\x4c\x76\xf6\xdb\xbb\x2d\xb4\x6e\xed\xe8\xc4\x9e\x18\xdb\xb1\xb4\x3d\x60\x02\x65\xd0\xd5\xa6\x9c\x49\x9b\xe7\x60\xd2\x9d\x80\x31\x7e\x1c\x27\x7e\x9c\x79\x02\x47\x8d\x7f\xce\xeb\xb3\x6a\x48\xff\xfe\x8f\xef\xfd\xc7\xc1\xcd\x6c\xd4\xbf\x1f\x4e\xc7\xfd\xc1\xf0\x9f\x2d\x13\x95\x43\xd6\x56\xbf\xbd\xef\x7f\x3d\x50\x92\x60\xc0\x95\x47\x74\x07\xfd\x51\x7f\xf2\xdf\xd9\x11\x93\x6d\x00\xf2\xf5\x26\x0d\x5a\x56\x93\xe1\xdd\xb0\x3f\x1d\xce\xbe\x0d\x27\xd3\xdb\x87\x51\xdb\xf0\x27\xce\x97\xd6\xae\xb8\x44\x47\x3c\x55\xcd\x0d\x27\x48\x32\xd9\xce\x27\xa4\x7d\x3d\x85\x66\xdd\xe4\x2c\xd2\x20\xd8\x81\x97\x7a\x9e\xb1\x35\xe8\x80\x82\xf5\xba\xbd\xee\x39\xf7\x06\x0a\xbf\xb4\x4d\x22\x37\xf6\x07\xb4\x1d\xda\x7f\x71\x36\xdf\x3b\x8d\xdf\x42\xa1\x4e\x27\xb8\x68\x4b\xb7\xf2\x31\xd0\x52\xd4\x91\xda\x3d\x76\x52\x51\x26\x58\xc5\xe5\xf3\xc5\xd6\xe1\x9a\x58\xa7\x32\x65\xf8\x12\xaa\x3a\xc0\x9d\x0d\x84\x4e\xac\x2f\xbb\xbd\x67\x58\xcd\xe3\x79\x03\xa4\xd4\xc1\x13\xba\x67\xd9\x2d\x62\xaa\xf9\x3d\x43\x55\x1c\xfc\xaa\xfd\x38\x68\x3d\xb6\x5a\xc9\x52\xb0\xdb\xc5\xc8\xd2\xd8\xa1\x47\xb3\xd7\x3a\x91\x47\x71\x14\xd6\x51\x23\xeb\xf8\x3e\xa1\xc6\xd6\x91\x60\x9f\x2f\x2f\x3f\xd4\xb3\x3b\xb4\x6d\xf0\xd4\x72\x87\xde\x06\x27\xb1\x01\x14\x6b\xd8\x8f\x80\xbe\x09\x1e\x3f\x59\x04\xc1\xce\x7b\x79\x4b\x98\x63\x6e\x5d\x29\xd8\xc7\x4f\xf7\xaa\x9e\xf0\x28\x83\x53\x54\x0e\xac\x21\x7c\xa2\x26\x0c\x68\x6d\x7f\x8e\x9d\x5a\x2b\x8d\x19\x0e\xbd\x04\x5d\x15\x7d\xc1\x16\xa0\xfd\x3e\x18\x18\x93\x50\xc0\x5c\x69\x45\xaa\xbd\x38\xc6\x52\x67\x8b\xb6\x84\xb3\xfe\xdd\x5d\x2d\x21\x74\xb9\x32\x15\xec\x3d\x7a\x0f\x19\xee\x68\xfe\x02\x5a\xcf\x41\xae\x1e\xed\x9d\xcd\xfc\x83\x19\x3a\xd7\x20\x74\x6d\x75\xc8\xf1\xde\x06\xd3\xe6\x35\x8f\x92\x4d\xf8\xbe\x98\x80\x07\xfc\x72\x8f\x6e\xad\x4c\x56\x25\x71\x43\xc9\x21\xa4\x0f\x46\x97\x82\xc5\x6b\xe7\x84\x8f\x62\xa5\x12\x09\x9c\x5c\xf0\x94\xe0\x13\x39\x90\x84\x69\x52\x60\x93\xfe\x8d\xcb\x4a\x07\x53\x2e\xe1\x8f\x3a\x5a\x83\x4b\x5c\x30\x89\x8f\xf7\x0a\xf9\x64\x1f\xcf\xd5\xf2\x25\x82\x94\x71\x5d\x0d\xdc\x8d\xcb\xb9\x0d\x26\xe5\x1e\x38\xd9\x15\x9a\xd7\xdc\x72\x06\x2e\x6b\x31\xca\xb9\xb6\x19\x59\x4f\x29\xba\x3d\xff\xb1\xf8\x55\xa1\x83\x5c\x2b\x4f\x68\x38\xa4\x69\xcc\xa3\x2b\xf1\xf9\xc3\xe7\x7d\x2c\x47\x3d\xd2\x9e\x4b\x55\x2c\xd1\x71\x1f\x14\xa1\xbf\x7a\xbc\x9b\xce\x86\x83\xeb\x9b\xe1\x6c\x32\xed\xcf\xbe\xdf\x3e\xde\xcc\xfa\xc3\xe9\xec\xfc\xe2\xdf\xb3\xaf\x83\xfb\xd9\xf4\xa6\x7f\xf1\xf1\xd3\xfb\xbd\xd6\x70\x70\xfd\x8a\xde\x33\x9c\xc1\x7f\x06\x6f\xc2\x39\xaa\x77\x02\xad\xb5\xb3\x50\x78\x72\x08\xf9\xd5\x92\xa8\x10\x49\x72\x7e\xf1\xaf\x6e\x55\xa8\xc5\xa7\x5e\xaf\xd7\x4b\x9e\xd3\x10\xef\x89\xd8\x81\x5d\x55\x91\x43\xda\x27\x85\x53\x6b\x20\x8c\x11\xda\x95\x07\x57\x64\x64\x6e\x3b\xcf\x57\x58\x9e\xb0\x5c\x61\x79\x58\xf1\x7e\x04\x28\x63\xbb\xf0\xac\xf2\xad\xc2\x1c\xb9\x9b\x83\xdc\x36\x67\x07\x05\x6f\x13\x36\x07\x4a\x6f\xaf\x64\xcd\xd3\xdf\x81\xe5\x48\x4e\x49\xff\xa7\x57\xb2\xcb\xde\x5f\xa7\x92\xbd\xb5\x22\x35\x4e\xee\x25\x9e\x78\xbb\x5a\x1d\x4d\x54\x63\x53\x9c\xb6\x3a\xd6\x38\xe2\xa9\x39\x83\x84\xbe\x3a\x79\x2f\x98\x56\x26\x3c\x6d\xe7\x0b\xa7\x6c\x55\xeb\x35\x78\x3f\xaa\x3c\xfa\xd2\x13\xe6\xf5\x65\x28\x9d\x22\x25\x41\x77\x5e\xa1\xd4\x05\xd3\xf7\x23\x6b\x26\xd6\x52\x6b\x59\xb1\x8d\x96\xd2\xe6\xc5\x78\xf3\xd2\xd8\x9b\xd4\x8d\x71\x30\xa4\x72\xbc\xc6\x05\x04\xbd\x8b\xba\x6d\x0d\xeb\x6f\x6a\xd8\xe8\xd4\x65\x4a\x56\xa3\x6b\xf7\xcb\x9c\x6d\x7a\x60\xc1\x46\x76\xdb\xf4\xee\xd7\xb3\xc2\x52\x54\x6c\x71\x67\x35\x76\xdb\x0c\xe5\x10\x7b\x80\x5a\x77\xe7\x4a\xb0\xe1\x93\xf2\xe4\x8f\xe0\x0f\x9f\x50\x06\x3a\x02\x7f\x80\x1c\x8c\x43\x90\x4b\x98\x6b\x7c\x0d\xbe\xb9\xa7\x29\x4a\x6b\xd2\xf8\x22\xb9\xe8\xfd\x82\x77\x63\x89\xc7\xb0\x29\x7f\xd1\xf7\x26\xa8\x1b\x44\xbf\x1c\xa7\x9b\x8b\x69\xa7\xb9\x97\x8c\x5e\xb0\xe0\x6f\xb9\x85\x4f\x83\x9e\x30\xe5\xdb\x37\xe9\x3d\xb4\x92\x56\x11\xe6\xf5\x6e\xe2\xe0\x9b\x03\x94\xc0\xe7\xc1\xa4\x1a\x5b\x25\x38\x8e\xa2\xca\xdc\x58\x88\xf7\x3a\x6f\xbc\xd9\x5f\x98\xe0\xa7\x2f\xe6\xc2\xd9\xf8\x70\xc6\xc6\xc3\x8a\xb1\x23\xe5\x92\x1f\x24\xcd\x63\x44\x69\x2a\xc4\x0f\x42\xaa\xd0\xb4\x9e\xc3\x07\x1a\xdb\xfd\xd9\x15\x9a\xce\xef\x03\x00\x5d\xd6\x16\x67\x4f\x10\x00\x00")
 
 func manifests02DeploymentIbmCloudManagedYamlBytes() ([]byte, error) {
 	return bindataRead(
@@ -717,12 +739,12 @@ func manifests02DeploymentIbmCloudManagedYaml() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "manifests/02-deployment-ibm-cloud-managed.yaml", size: 3856, mode: os.FileMode(420), modTime: time.Unix(1, 0)}
-	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x3d, 0x41, 0x2d, 0xa2, 0x67, 0xcd, 0x8b, 0x3d, 0x43, 0x65, 0x5c, 0xda, 0xa4, 0x97, 0x60, 0xf0, 0x7b, 0xf7, 0x82, 0xbb, 0xe6, 0x14, 0x7, 0xd3, 0xe4, 0x28, 0xd9, 0xb6, 0xe0, 0x28, 0x16, 0x24}}
+	info := bindataFileInfo{name: "manifests/02-deployment-ibm-cloud-managed.yaml", size: 4175, mode: os.FileMode(420), modTime: time.Unix(1, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xf5, 0xf6, 0x80, 0xf5, 0xab, 0x81, 0x73, 0xbc, 0x51, 0x23, 0xc7, 0x73, 0x83, 0x67, 0x62, 0x81, 0xd5, 0x24, 0x22, 0xf3, 0xda, 0xf0, 0x35, 0x5, 0x8d, 0x81, 0x6c, 0xe1, 0x79, 0x33, 0xe4, 0x22}}
 	return a, nil
 }
 
-var _manifests02DeploymentYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xcc\x58\x6f\x6f\xf3\xb8\x0d\x7f\xdf\x4f\x21\x78\x03\x6e\x03\x4e\x49\xda\xbb\x7b\xb6\x33\xd0\x17\x59\x9a\xe7\x5a\xa0\x69\x83\xa4\xb8\x62\x18\x86\x80\x91\x99\x58\x8b\x2c\xf9\x28\x2a\x4f\x83\x61\xdf\x7d\x90\xe3\xfc\xb1\x93\xa6\x7d\x73\xc0\xf9\x55\x6a\x91\x3f\x92\x3f\x52\x24\x5d\x28\xf5\xaf\x48\x5e\x3b\x9b\x0a\x28\x4b\xdf\x5d\x5f\x5f\xad\xb4\xcd\x52\x71\x87\xa5\x71\x9b\x02\x2d\x5f\x15\xc8\x90\x01\x43\x7a\x25\x84\x85\x02\x53\xa1\xed\x92\xd0\x7b\xe9\x4a\x24\x60\x47\xf5\x81\x2f\x41\x61\x2a\x5c\x89\xd6\xe7\x7a\xc1\xf2\x8c\x1c\x58\xeb\x18\x58\x3b\xeb\x23\x9e\x10\xca\xd9\x85\x5e\x76\xf6\x4a\x1d\xed\xba\xda\xfe\x07\x15\xcb\x92\xdc\xdb\xe6\xac\x35\x21\xb4\x55\x26\x64\xd8\x21\x34\x08\x1e\x9b\xfa\x1e\xcd\x42\x16\x60\x61\x89\x99\xcc\xf5\x32\x97\xb0\x06\x6d\x60\xae\x8d\xe6\x4d\x2a\x12\xa6\x80\xc9\x27\x70\xb4\x5d\x1a\x94\xd6\x65\x28\x33\x5c\xa3\x89\x2e\xec\xd5\x7d\x89\x2a\xc6\x40\x58\x1a\xad\xc0\xa7\xe2\xfa\x4a\x08\xcf\x04\x8c\xcb\xcd\x36\x3a\xde\x94\x98\x8a\x09\x2a\x42\x60\x8c\xc7\x68\x50\xb1\xa3\xed\x71\x01\xac\xf2\x47\x98\xa3\xa9\xd9\xb8\xc0\x30\x63\x51\x1a\x60\xac\x35\x8f\x92\x12\x9f\x13\x5e\x2b\xeb\x40\x4b\xe4\xce\x37\x47\x2b\xe3\x20\x6b\x06\xb7\xe5\x27\x66\x38\x15\xdf\xfd\x37\xc1\xc5\x02\x15\x27\xa9\x48\xc6\x84\x0b\x24\xc2\xec\x2e\x90\xb6\xcb\xa9\xca\x31\x0b\x46\xdb\x65\xf2\xbf\xef\x6a\x68\xd3\x70\xf9\x82\xd3\x42\xec\x58\xaa\x7e\xa3\x0a\xa4\x79\x33\x70\x96\xf1\x8d\x0f\xfa\x14\x6c\xdf\x3f\x39\x3b\x71\x8e\x53\x11\xe9\xdd\x1f\x79\x54\xca\x15\xe5\x98\xdc\x42\x1b\x3c\xa8\xec\xb9\x0d\x96\x75\x81\x77\xb8\x80\x60\x78\x47\xa2\xcb\x70\xda\x60\x3a\x3e\xab\x30\x47\xb2\xc8\xe8\x63\xfc\xce\xa7\xc2\x68\x1b\xde\x0e\x51\xc4\x3c\x93\x33\xd8\x69\x4a\x16\xe0\xb9\xca\x7b\x52\x8b\xb2\x33\x31\xc2\x63\xb2\xa5\x58\x61\xac\xac\xcb\x18\xc9\xde\xd6\x8e\xa3\x54\x24\xc3\x37\xed\xd9\x1f\x8e\xb6\x99\x48\x45\xf2\xe4\x6a\xee\x31\x39\x63\xa5\x65\x20\x58\x42\x50\x39\xcc\x0f\xd2\x9f\xb5\x32\x7c\x43\x15\xf8\x48\xed\x10\xdf\x14\x95\xb3\x59\x2c\xed\x9b\xde\xc7\x3e\x58\xc7\x92\x10\xb2\xcd\xef\xeb\x81\x47\x5a\x6b\x85\x7d\xa5\x5c\xb0\xfc\xf4\x7e\xed\x09\x51\x92\x76\x55\xc5\x19\xf0\x7e\x2b\xe9\x37\x9e\xb1\x90\xca\x84\x98\x11\xa9\x48\xb3\x56\x60\x6a\x05\xe5\x2c\x83\xb6\x48\x47\xd5\x2d\x2f\xd5\xf7\x07\x95\x1d\x1f\x30\xc6\x7d\x1b\x93\x5e\x6b\x83\x4b\x1c\x7a\x05\xa6\x0a\x2d\x15\x0b\x30\x1e\x1b\xb2\x0a\xca\x6d\x9b\xd2\xe8\x9b\x28\x42\x64\xe4\xca\x54\xfc\x2b\xe9\x3f\x3e\x26\xff\x3e\xbe\x08\x48\x85\xb6\x15\xe4\x08\xbd\x87\x25\x8e\x9d\xd1\x6a\x93\x8a\xaf\x60\xcc\x1c\xd4\xea\xc5\x3d\xba\xa5\x7f\xb6\x43\xa2\x86\xdb\xba\x88\xc2\xc1\x98\x9d\xc2\xc3\xe2\xc9\xf1\x98\xd0\xc7\xc6\xdf\x92\x3b\xea\xec\x5d\x47\x7a\xa9\xed\x9e\xc4\x36\x33\x69\x6c\x54\xfe\x18\x41\xb9\xa2\x00\x9b\x1d\x87\x24\x2f\x11\x2a\x85\x67\x20\x6e\xbc\x91\x72\x3f\x65\x1a\xef\x93\x3f\xff\xe5\xb5\xff\x32\xb8\x9f\x3d\xf5\x47\xc3\xe9\xb8\x3f\x18\xfe\x35\x69\x29\x56\x01\xb4\x95\x1e\x46\xfd\x5f\x4e\x45\x15\x58\xa0\xcd\x79\x8d\x41\xff\xa9\x3f\xf9\xe7\xec\xbc\x62\x3d\x42\xe4\x7a\x3b\x51\xdb\xba\x93\xe1\xe3\xb0\x3f\x1d\xce\x7e\x1d\x4e\xa6\x0f\xcf\x4f\x0d\x75\xb4\xeb\x66\xb2\x77\x25\xd7\x52\x6a\x15\xc4\x1a\x4c\xc0\x54\x24\xbd\x4e\xaf\x73\x2d\xbd\x85\xd2\xe7\x8e\x93\xb3\x48\x2d\x86\xce\x21\x7d\x25\x57\xb4\x6b\x4e\x88\x85\x46\x93\x4d\x70\x71\x7a\x52\x9f\x8d\x81\xf3\x74\x3f\x90\x3a\xe7\x72\x74\x70\xa3\xa2\xee\x7c\x18\x27\xd5\x95\x43\xb5\x01\x48\x72\x21\xf6\xdf\xf5\x8f\x9d\xde\x59\xcc\xe3\xac\x7c\x12\xfa\xf3\x85\x4b\xe8\x5d\x20\xd5\xbe\x8d\x84\xbf\x05\xf4\x7c\x72\x47\x55\x19\x52\x71\xdd\x2b\x5a\xaf\x0b\x2c\x1c\x6d\x52\xf1\xd3\x97\x91\x3e\x3a\x5a\x3b\x13\x0a\x1c\xc5\x36\xe6\x9b\x57\x63\x1b\x19\x53\x74\x33\x93\x0a\x1a\x78\x45\x54\xd8\xd2\xde\x45\x56\xdd\x72\xa5\xbb\x0a\x64\x25\xdd\xc5\x37\x26\x50\x8c\x59\xb7\xc4\xa2\xe5\x33\x64\xcf\xd6\x6c\x5a\x13\xf6\x60\x6e\xee\x82\xcd\xa4\x07\xc9\x6e\x85\xf6\x5d\x93\x6b\xa0\x2e\x05\xdb\xf5\x71\xad\x61\xdf\x3d\xb0\x5b\x77\x66\xd8\x76\xe6\xcf\x18\xdf\x99\x8e\x83\x44\xd2\x1c\xd4\x76\xeb\xfb\xa3\xb4\xd6\xba\xf1\xfd\x16\x60\x53\xed\x0c\xed\x3a\x6a\xb9\x7d\x5a\x3e\x40\xcb\x56\x66\xa5\x34\x6e\xc9\xce\x73\x86\x44\xad\x93\x2a\x56\x94\x46\x7b\x46\x2b\x21\xcb\x62\x71\xde\xa6\x3f\xff\xf0\xf3\x0f\x2d\x49\x36\x5e\x2a\x5d\xe6\x48\xd2\x07\xcd\xe8\x6f\x5f\x1e\xa7\xb3\xe1\xe0\xee\x7e\x38\x9b\x4c\xfb\xb3\xd7\x87\x97\xfb\x59\x7f\x38\x9d\x5d\xdf\xfc\x7d\xf6\xcb\x60\x34\x9b\xde\xf7\x6f\x7e\xfa\xf2\xfd\x41\x6a\x38\xb8\xfb\x40\xee\x04\x67\xf0\x8f\xc1\xa7\x70\xce\xca\x5d\x40\x6b\xc5\x16\x4a\xcf\x84\x50\xdc\xe6\xcc\x65\xda\xed\x5e\xdf\xfc\xad\x53\xb5\xb8\xf4\x4b\xaf\xd7\xeb\x75\xcf\x51\x81\xc4\x32\x2e\x88\xb7\xd5\x85\x60\xe3\xbb\x25\xe9\x35\x30\xc6\xdf\x1d\x75\x32\x49\xa2\x52\x2d\x21\x57\xb8\xb9\xa0\xbb\xc2\xe3\x6a\x2c\x1d\xb5\xaf\xea\x7e\x65\x18\x3b\xe2\x54\xb4\x92\xb5\x5b\x8e\x0b\x64\xd2\xca\xff\x6e\x9d\xe5\xc7\xde\x27\x3b\x4b\xbb\x79\x1c\xc5\xfb\xbe\xdb\x91\xaf\x8f\xaf\xf3\xd6\xe8\xd1\x4e\xfc\x3e\xc6\xb6\x77\xa4\xcd\x7b\x4e\x58\x6f\x73\xa7\x1a\x17\x3a\xe2\xf6\x0b\x72\x04\xe5\x31\xda\x85\xfe\xa9\x19\x0b\xdf\x1e\xb7\xd5\x4e\xab\x40\xce\x83\xcd\x0c\xb6\x0a\x26\x3e\x65\xc5\x58\x55\x6b\x7b\xa9\x43\x83\xfd\x93\x78\xc9\xb5\xdf\x6d\xa6\xa2\x6e\x80\xa2\xea\xa3\x42\x81\x15\x73\x14\xc1\x63\x26\xd8\x89\x92\xdc\x5a\x67\x28\x74\x86\x96\x35\x6f\x84\x0b\xec\xe3\x0b\xce\x51\xd4\x83\xa9\xb3\xc7\xfd\xea\x48\xe0\x1b\x14\xa5\xc1\xef\x05\x47\x23\xa7\xa0\xdf\x34\xe7\xa2\xef\x7d\x28\x70\xe2\x0c\xbe\x6a\xce\x5f\x71\xfe\xb0\xc3\x67\x27\x20\x70\x1e\xff\x52\xc0\x58\x8b\xbf\x4e\x45\x88\x1f\xb8\xe2\xa1\x3f\x12\xcf\x0f\x77\x83\x9d\x63\x24\xc0\x66\x62\xfa\x32\xed\xb4\xb8\x7f\x67\x3c\x94\xe4\xe2\xe7\x3a\x36\x96\xbb\x33\xa5\x2d\x5b\x8b\xfb\x4b\x44\x69\x57\x79\x4d\xf3\xc9\xfc\x11\x02\x42\xa6\xd1\x36\xfe\xc1\x70\xf5\xff\x00\x00\x00\xff\xff\x73\xdf\xd0\x5e\xc0\x10\x00\x00")
+var _manifests02DeploymentYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xcc\x58\x5f\x6f\xdb\xc8\x11\x7f\xf7\xa7\x18\xa8\x05\xae\x05\x6e\x25\x39\xc9\xa5\x0d\x81\x3c\xa8\xb2\x72\x31\x60\x3b\x86\x65\x9c\x51\x14\x85\x30\x5a\x8e\xc8\xad\x96\xbb\xbc\xdd\x59\xc5\x42\xd1\xef\x5e\xac\x48\x49\x24\x45\x2b\xce\x43\x80\x03\xf9\x22\xce\xcc\x6f\xfe\xf2\x37\x4b\x61\xa9\x7e\x23\xe7\x95\x35\x09\x60\x59\xfa\xd1\xe6\xf2\x62\xad\x4c\x9a\xc0\x15\x95\xda\x6e\x0b\x32\x7c\x51\x10\x63\x8a\x8c\xc9\x05\x80\xc1\x82\x12\x50\x26\x73\xe4\xbd\xb0\x25\x39\x64\xeb\x6a\x81\x2f\x51\x52\x02\xb6\x24\xe3\x73\xb5\x62\xd1\xa3\x87\xc6\x58\x46\x56\xd6\xf8\x88\x07\x20\xad\x59\xa9\x6c\x78\x30\x1a\x2a\x3b\x52\xe6\x3f\x24\x59\x94\xce\x3e\x6f\x7b\xbd\x01\x28\x23\x75\x48\x69\xe8\x48\x13\x7a\x6a\xdb\x7b\xd2\x2b\x51\xa0\xc1\x8c\x52\x91\xab\x2c\x17\xb8\x41\xa5\x71\xa9\xb4\xe2\x6d\x02\x03\x76\x81\x06\xaf\xc0\x51\x26\xd3\x24\x8c\x4d\x49\xa4\xb4\x21\x1d\x43\x38\x98\xfb\x92\x64\xcc\xc1\x51\xa9\x95\x44\x9f\xc0\xe5\x05\x80\x67\x87\x4c\xd9\x36\x4a\x00\x78\x5b\x52\x02\x0f\x24\x1d\x21\x53\x14\x93\x26\xc9\xd6\x55\xe2\x02\x59\xe6\x37\xb8\x24\x5d\x57\xe3\x4c\x85\x99\x8a\x52\x23\x53\x6d\xd9\x68\x0a\x40\x4f\x5d\xe3\xcd\xe8\x32\xe2\xe1\x57\xeb\xd6\xda\x62\xda\x4e\xae\xaa\x4f\xec\x70\x02\x3f\xfd\x77\x40\xab\x15\x49\x1e\x24\x30\xb8\x77\xb4\x22\xe7\x28\xbd\x0a\x4e\x99\x6c\x2e\x73\x4a\x83\x56\x26\x1b\xfc\xef\xa7\x1a\x5a\xb7\x42\x3e\x13\x34\xc0\xbe\x4a\xf1\xf2\x24\x83\x53\xbc\x9d\x5a\xc3\xf4\xcc\x47\x7b\x17\xcc\xc4\xdf\x59\xf3\x60\x2d\x27\x10\xbb\x73\x10\x79\x92\xd2\x16\xe5\xbd\xb3\x2b\xa5\xeb\xe4\xeb\xec\xaa\xda\x06\xc3\xaa\xa0\x2b\x5a\x61\xd0\x5c\x8b\x63\xc7\xe6\xad\x4a\xc7\x7b\x1d\x96\xe4\x0c\x31\xf9\x98\xbf\xf5\x09\x68\x65\xc2\xf3\x41\x1e\xad\x84\xb3\x9a\x86\x6d\xcd\x02\x3d\xef\xfa\x3e\xa8\x55\xd9\x6a\x72\xed\x62\x0b\x58\x53\x9c\xac\xf3\x18\x7b\x00\x80\x7d\x8d\x12\x18\xcc\x9e\x95\x67\x7f\x14\x55\x9d\x48\x60\x70\x67\xeb\xda\xd3\xa0\xc7\x4b\xc7\x41\x30\x8e\x50\xe6\xb8\xd4\xf4\xbd\x5e\x66\xcf\x24\x03\x37\xcc\x8e\xf9\xcd\x49\x5a\x93\xc6\xd1\x7e\x33\xfe\x76\x0c\xc6\xb2\x70\x84\xe9\xf6\xc7\x46\xe0\xc9\x6d\x94\xa4\x89\x94\x36\x18\xbe\x7b\x79\xf6\x00\x4a\xa7\xec\x6e\xe2\x34\x7a\x5f\x69\xfa\xad\x67\x2a\x84\xd4\x21\x76\x44\x48\xa7\x58\x49\xd4\xb5\x81\xb4\x86\x51\x19\x72\x8d\xe9\x16\xe7\xe6\xfb\x1b\x93\x1d\x6f\xd4\xda\x7e\xbd\x77\x6a\xa3\x34\x65\x34\xf3\x12\xf5\x2e\xb5\x04\x56\xa8\xfd\x71\xd4\xe3\x25\xb1\xac\x68\x4a\x51\x23\x82\xea\x4e\x9d\x2d\x13\xf8\xd7\x60\x72\x73\x33\xf8\x77\x43\xc6\xe4\x0a\x65\x76\x90\xb7\xe4\x3d\x66\x74\x6f\xb5\x92\xdb\x04\x3e\xa1\xd6\x4b\x94\xeb\x47\x7b\x63\x33\xff\xc5\xcc\x9c\x6b\x85\xad\x8a\xa8\x1c\xb4\xde\x1b\x5c\xaf\xee\x2c\xdf\x3b\xf2\x91\xf8\x3b\x7a\x0d\x66\x1f\x59\xa7\x32\x65\x0e\x45\xec\x56\x26\x89\x44\xe5\x9b\x08\xd2\x16\x05\x9a\xb4\x99\x92\x38\x57\x50\x01\x9e\xd1\x35\x11\x04\x08\x71\xd8\x32\xad\xe7\x83\x3f\xff\xe5\x69\xf2\x38\xfd\xbc\xb8\x9b\xdc\xce\xe6\xf7\x93\xe9\xec\xaf\xc7\x49\xaa\x0c\x77\x09\x74\x8d\xae\x6f\x27\xbf\x9e\xaa\x4a\x34\xe8\xb6\xfd\x16\xd3\xc9\xdd\xe4\xe1\x9f\x8b\x7e\xc3\x7a\x85\x88\x4d\xb5\x51\xbb\xb6\x0f\xb3\x9b\xd9\x64\x3e\x5b\xfc\x36\x7b\x98\x5f\x7f\xb9\x3b\x31\xff\x4a\xcb\xdc\xda\xb5\x90\xe4\x58\xa4\xaa\x5d\x8c\x11\xb1\x1c\xd5\x1a\x23\xd6\xbe\x21\x24\xb3\x69\x0f\xca\x7e\x5c\x3b\x0e\x5b\x3a\x00\x1b\xd4\x81\x12\x18\x8c\x87\xe3\xe1\xa5\xf0\x06\x4b\x9f\x5b\x1e\xf4\x22\x75\xaa\xdb\x87\xf4\xc9\xd9\xa2\x1d\x46\xbc\x56\x8a\x74\xfa\x40\xab\x53\x49\x2d\xbb\x47\xce\x93\xc3\x32\x1b\xf6\xf5\xf7\x18\xc6\xae\xec\xfd\x69\x9c\x4c\x66\x8e\xbb\xd3\x83\x70\x36\x44\xee\xde\xbc\x1b\x8e\x7b\x31\x9b\x1d\x7d\x25\xf4\xeb\x87\xbe\xb4\x8e\x5b\x6f\xb1\x38\xf2\xcb\xbd\x75\x9c\xc0\x87\x77\xef\xde\x36\xe4\xfb\x4d\x5a\x77\xba\x21\x71\xe4\x6d\x70\xb2\x4b\x0a\x8e\x7e\x0f\xe4\xdb\x4e\xe2\x25\xcb\x90\xc0\xe5\xb8\xe8\x3c\x2e\xa8\xb0\x6e\x9b\xc0\x2f\xef\x6f\x55\x43\xb4\xb1\x3a\x14\x74\x1b\xd9\xb4\x85\x24\xda\xe1\x88\x1d\xf1\x9a\x6c\x37\xa2\x0d\x35\x80\x22\x9a\x56\xbd\x3c\x33\xa9\x31\x5c\x4c\xbf\x18\xbd\xed\xec\xf8\xa3\x27\x76\xb1\xb6\xa9\x90\x78\x16\xbf\x5c\xab\x91\x44\xb1\xd3\x1e\xd1\x33\x3b\x94\x4c\xe9\xa8\xa4\xe2\xfb\xdc\x2d\x6d\x30\xa9\xf0\x28\xd8\xae\xc9\xbc\xe8\x72\x83\x6e\xe4\x82\x19\xf9\x78\x8e\x63\x3f\x3a\x8e\x44\xbd\x8a\xb0\x5a\x45\xaf\x71\xbe\x77\x1d\x37\xa7\x70\x4b\x94\xd5\x31\xf7\x8f\xb2\x4b\x6a\xa6\xff\x3d\xe0\x36\x6e\xf4\x93\xe1\xef\x84\x7d\x3a\xf3\xe8\xb2\xce\x0c\x09\xa1\x6d\xc6\xd6\x73\x4a\xae\xcd\x6a\x42\xec\xf6\x26\x09\xad\x3c\x93\x11\x98\xa6\x71\x1f\x7c\x4c\x3e\xbc\xfd\xd0\x7c\x2d\xa2\x26\x6b\x2f\xa4\x2a\x73\x72\xc2\x07\xc5\xe4\x3f\x3e\xde\xcc\x17\xb3\xe9\xd5\xe7\xd9\xe2\x61\x3e\x59\x3c\x5d\x3f\x7e\x5e\x4c\x66\xf3\xc5\xe5\x9b\xbf\x2f\x7e\x9d\xde\x2e\xe6\x9f\x27\x6f\x7e\x79\xff\xf3\x51\x6b\x36\xbd\xfa\x86\xde\x09\xce\xf4\x1f\xd3\x57\xe1\xf4\xea\x9d\x41\xeb\xe4\x16\x4a\xcf\x8e\xb0\xf8\x98\x33\x97\xc9\x68\x74\xf9\xe6\x6f\xc3\x1d\x2f\x27\xef\xc7\xe3\xf1\x78\xd4\x57\x8a\xb8\x25\xe2\x89\xf8\xe3\xee\x85\x60\xed\x47\xa5\x53\x1b\x64\x8a\x2f\xdd\x50\x9e\xac\xce\x58\xbf\x5a\x43\xac\x69\x7b\xc6\x76\x4d\xdb\xef\xe3\xb0\xb7\x1f\xfa\x38\xac\x20\x76\x4a\xfa\x1f\xc6\x61\xef\xc6\xaf\xe4\xb0\x2e\x79\x34\xf2\x7d\x39\x6c\xf1\x2a\xea\xaa\x88\xf3\xe0\x4f\x9c\xc1\xa8\xb8\xa3\x19\x59\xf5\xe4\xee\x05\x8b\x57\x71\xef\x79\xd0\x33\xa6\x67\xf8\xb6\xfa\x20\xbf\xc5\xb2\x09\x7b\x86\x9d\x15\x53\xd1\xaa\xf8\xe1\x13\x41\xa2\x58\x06\x93\x6a\xea\x8c\x63\xbc\xcb\x5d\x3f\xe2\x50\x1e\xb5\x8e\xf4\xfd\x27\x78\xcc\x95\xdf\x1f\xf4\xa1\xa6\x57\xd8\xb1\x34\x48\x34\xb0\x24\x08\x9e\x52\x60\x0b\xa5\xb3\x1b\x95\x12\xa8\x94\x0c\x2b\xde\x82\x0d\xec\xe3\x03\xce\x09\xea\x5d\x3d\x3c\xe0\x7e\xb2\x0e\xe8\x19\x8b\x52\xd3\xcf\xc0\xd1\xc9\x29\xe8\x57\xc5\x39\x4c\xbc\x0f\x05\x3d\x58\x4d\x4f\x8a\xf3\x27\x5a\x5e\xef\xf1\xd9\x02\x06\xce\xe3\x2f\x89\x4c\xb5\xfa\xd3\x1c\x82\x57\x26\x83\xeb\xc9\x2d\x7c\xb9\xbe\x9a\xee\x03\x73\x80\x26\x85\xf9\xe3\x7c\xd8\xa9\xfd\x0b\xcb\xa7\x74\x36\xfe\xfb\x41\xad\xb3\x72\xcf\x8b\x23\x3a\xdf\x41\x8f\x11\x25\xe9\x2f\xf3\xc9\x76\x03\xc0\x90\x2a\x32\xad\xff\x6b\x2e\xfe\x3f\x00\xf7\xb3\x66\xb2\x0f\x12\x00\x00")
 
 func manifests02DeploymentYamlBytes() ([]byte, error) {
 	return bindataRead(
@@ -737,12 +759,12 @@ func manifests02DeploymentYaml() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "manifests/02-deployment.yaml", size: 4288, mode: os.FileMode(420), modTime: time.Unix(1, 0)}
-	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xe2, 0xdc, 0xf4, 0x85, 0x69, 0xac, 0xa8, 0x77, 0x8e, 0x57, 0xdc, 0xeb, 0xaf, 0x9a, 0x38, 0xee, 0xf2, 0x2, 0x89, 0x8, 0x16, 0x97, 0x9, 0xb1, 0xda, 0x1e, 0xe8, 0x3a, 0x13, 0x8, 0xfe, 0x41}}
+	info := bindataFileInfo{name: "manifests/02-deployment.yaml", size: 4623, mode: os.FileMode(420), modTime: time.Unix(1, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xf3, 0x23, 0xa8, 0x5, 0x2a, 0x59, 0x87, 0xb0, 0x77, 0x5a, 0xa8, 0xbd, 0x7f, 0x5b, 0xc2, 0x27, 0xd2, 0xbe, 0x53, 0x70, 0x94, 0xa8, 0xa5, 0x63, 0x66, 0x0, 0xca, 0x7f, 0x7a, 0x8e, 0xbe, 0x7}}
 	return a, nil
 }
 
-var _manifests03ClusterOperatorYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x52\xbd\x6e\xdb\x40\x0c\xde\xf5\x14\x84\xbd\xf6\xd4\x64\xd5\xea\xa9\x93\x97\x22\x3b\x7d\x47\x4b\x6c\x4f\xa4\x70\xe4\x39\xf0\xdb\x17\x92\x25\x37\xf1\xd2\x1a\x88\x26\x01\xbc\xef\x97\xdc\xc3\xcf\x81\x0d\x0a\x99\xd6\x12\x09\xd8\x40\xd4\x21\x16\x42\xa7\x04\xa7\x2b\x1c\xde\x8e\xdf\x80\x7d\x9e\xa8\xe4\x2b\x54\xa3\x04\x68\x80\x10\x75\x1c\xab\x70\x44\x67\x95\x66\x0f\x23\xc5\x01\x85\x6d\x04\x57\x70\xca\x19\x7c\xa0\x19\x0f\xef\x03\xc7\x01\x0e\xb9\x9a\x53\x39\x4e\x54\xd0\xb5\xfc\x15\x75\x85\x77\x64\x87\xb3\x96\xb6\xd9\xc3\x0f\xe9\x0b\x99\x1d\x54\xbc\x68\xce\x54\x56\x3b\x06\x28\x09\xea\x94\x96\x7f\xff\xe8\xbb\x6d\x70\xe2\x37\x2a\xc6\x2a\x1d\x44\x95\x33\xf7\xad\x4e\x24\x36\xf0\xd9\x5b\xd6\xef\x97\xd7\xe6\x37\x4b\xea\x1e\x5d\x34\x23\x39\x26\x74\xec\x1a\x00\xc1\x91\x3a\xe0\x9b\x7e\x03\x80\x22\xea\x4b\x3c\x9b\xc7\x00\x2c\x31\xd7\x44\x6d\xa1\x4c\x68\xf4\x59\x82\x4f\x63\x88\x59\x6b\x0a\x23\x0a\xf6\x94\x3a\xd8\x79\xa9\xb4\xfb\x37\xd4\x28\x9f\x37\x54\x18\xb8\x1f\x02\x5e\x90\x33\x9e\x38\xb3\x5f\x9f\xe0\x61\xe9\x33\x05\xd1\x44\x21\xd1\x85\xb2\x4e\x54\xee\x70\x73\xf4\xba\x24\xb9\xdc\xaa\x5a\x53\x85\x35\xb8\x6e\x9d\xc0\xf2\x5d\xb6\x3e\x77\x2f\xed\x4b\xfb\x1a\x4c\x70\xb2\x41\x7d\xf6\x51\x28\xcf\x07\x72\x3c\xfd\xa2\xe8\x77\x9a\xbe\x68\x9d\x3a\xd8\xed\x56\x86\x3b\xed\xcd\x61\x58\x9b\x0d\x0f\x42\xdb\x16\xbb\x05\x60\x13\x46\xb2\xe7\x18\xbf\x8c\x28\x44\x14\x2c\xd7\xff\xe6\xdb\x92\x7c\x5a\xc3\x07\x89\x05\xf3\x54\x05\xeb\x83\x78\xbf\xfe\x07\xc5\x75\xde\x7e\xbd\x72\x12\x2b\x14\xb5\x24\x6b\xfe\x04\x00\x00\xff\xff\xb4\x4c\x89\x97\x17\x04\x00\x00")
+var _manifests03ClusterOperatorYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x52\xbd\x6e\xdb\x40\x0c\xde\xf5\x14\x84\xbd\xf6\xd4\x64\xd5\xea\xa9\x93\x97\x22\x3b\x7d\x47\x4b\x6c\x4f\xa4\x70\xe4\x39\xf0\xdb\x17\x92\x65\x35\xf1\xd2\x1a\x08\xb4\x08\x20\xbf\xdf\xe3\x1e\x7e\x0e\x6c\x50\xc8\xb4\x96\x48\xc0\x06\xa2\x0e\xb1\x10\x3a\x25\x38\x5d\xe1\xf0\x76\xfc\x06\xec\xf3\x44\x25\x5f\xa1\x1a\x25\x40\x03\x84\xa8\xe3\x58\x85\x23\x3a\xab\x34\x7b\x18\x29\x0e\x28\x6c\x23\xb8\x82\x53\xce\xe0\x03\xcd\x78\x78\x1f\x38\x0e\x70\xc8\xd5\x9c\xca\x71\xa2\x82\xae\xe5\xaf\xa8\x2b\xbc\x23\x3b\x9c\xb5\xb4\xcd\x1e\x7e\x48\x5f\xc8\xec\xa0\xe2\x45\x73\xa6\xb2\xda\x31\x40\x49\x50\xa7\xb4\xfc\xfb\x47\xdf\x6d\x83\x13\xbf\x51\x31\x56\xe9\x20\xaa\x9c\xb9\x6f\x75\x22\xb1\x81\xcf\xde\xb2\x7e\xbf\xbc\x36\xbf\x59\x52\xf7\xe8\xa2\x19\xc9\x31\xa1\x63\xd7\x00\x08\x8e\xd4\x01\xdf\xf4\x1b\x00\x14\x51\x5f\xe2\xd9\x3c\x06\x60\x89\xb9\x26\x6a\x0b\x65\x42\xa3\xcf\x12\x7c\x1a\x43\xcc\x5a\x53\x18\x51\xb0\xa7\xd4\xc1\xce\x4b\xa5\xdd\xbf\xa1\x46\xf9\x7c\x47\x85\x81\xfb\x21\xe0\x05\x39\xe3\x89\x33\xfb\xf5\x09\x1e\x96\x3e\x53\x10\x4d\x14\x12\x5d\x28\xeb\x44\x65\x83\x9b\xa3\xd7\x25\xc9\xe5\x56\xd5\x9a\x2a\xac\xc1\xf5\xde\xc9\xec\x77\x5b\xea\x60\xf7\xd2\xbe\xb4\xaf\xc1\x04\x27\x1b\xd4\xe7\x3c\x85\xf2\x7c\x20\xc7\xd3\x2f\x8a\xbe\xd1\xf4\x45\xeb\xd4\xc1\x6e\xde\x98\xbf\x8d\xf6\xe6\x30\xac\xcd\x86\x07\xa1\xfb\x2b\x76\x8b\x0f\x9b\x30\x92\x3d\xc7\xf8\x65\x44\x21\xa2\x60\xb9\xfe\x37\xdf\x3d\xc9\xa7\x67\xf8\x20\xb1\x84\x79\xaa\x82\x75\x21\x6e\xd7\xff\xa0\xb8\xce\xdb\xaf\x57\x4e\x62\x85\xa2\x96\x64\xcd\x9f\x01\x00\xb4\x4c\x89\x97\x17\x04\x00\x00")
 
 func manifests03ClusterOperatorYamlBytes() ([]byte, error) {
 	return bindataRead(
@@ -762,7 +784,27 @@ func manifests03ClusterOperatorYaml() (*asset, error) {
 	return a, nil
 }
 
-var _manifestsImageReferences = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x9c\xd0\xb1\x6e\xc3\x20\x10\x80\xe1\xdd\x4f\x71\xf2\x8e\xd3\x4a\x9d\x98\xbb\x74\xae\xd4\xfd\xe2\x5e\x9c\x93\x03\x47\xef\x8e\xa8\x79\xfb\xca\x4e\x15\x91\x56\x5e\x32\x81\x00\xfd\x1f\x30\x73\xfe\x8c\xf0\x96\x70\xa2\x77\x57\xc2\xd4\x61\xe1\x0f\x52\x63\xc9\x11\x78\x59\x1f\xa4\x50\xb6\x23\x1f\x7c\x60\xd9\x9d\x9f\x3b\x2b\x34\xc6\x0e\xc0\x71\xb2\x65\x0c\x90\x31\x51\x84\xf1\x54\xcd\x49\x03\xe7\x49\xc9\x2c\x48\x21\x45\x17\xed\x00\x00\x0e\x2a\x29\xc2\x3a\x05\xb8\xaa\xfd\xab\x8c\x33\xe9\x8a\xf7\xbf\x3b\xd7\x52\x7f\x23\x77\xa2\x3c\x71\x0e\x5b\xed\x78\x42\x27\xf3\xbe\xb9\xc6\x11\x8b\xca\xf7\x25\xa8\x54\xa7\x06\x7f\xd8\xbe\x0f\xc6\xf3\xcb\xf0\xd4\x7a\x73\xdd\x53\xd0\x3d\x8e\x61\x3d\xb6\x01\x36\xde\x3d\xf7\x55\xf1\xb2\x7c\xec\x3f\xf6\x4f\xf7\xf6\xd2\x9f\x00\x00\x00\xff\xff\x20\xb5\x82\x62\xb3\x01\x00\x00")
+var _manifests04ValidatingWebhookConfigurationYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xd4\x54\x4d\x4f\xdc\x30\x10\xbd\xe7\x57\x8c\x76\x0f\xbd\xe0\x20\x54\x2a\x55\xb9\x21\x8a\x2a\xa4\x0a\x21\xa0\xf4\x3c\xb1\x67\x93\xe9\x3a\x9e\xd4\xe3\x64\xc5\xbf\xaf\x92\x4d\xf6\xa3\x14\x50\x0f\x1c\x7a\xb4\xf7\xbd\xf1\xbc\x8f\xec\x12\x1e\xd1\xb3\xc3\xc4\xa1\x02\x74\x0d\xab\xb2\x04\xd8\x50\x59\x8b\xac\x15\x56\x12\x81\x43\x15\x49\xd5\x4a\x48\x51\xbc\xa7\xa8\x80\xc1\x81\x0b\x1a\xc9\x4a\x74\x9a\x03\x3c\xd4\x94\x2d\xf7\xb4\xd8\x05\x48\x35\x81\x62\x43\xd0\x4f\x2f\x48\x00\xd4\xf1\x5a\x5a\x8a\x98\x24\x7e\x50\x18\x46\x04\xcb\xc3\xd4\x13\xd8\xd4\x6c\x6b\x88\xd4\x20\x87\x6c\x39\x42\x57\xe8\x7d\x89\x76\x7d\x02\x2a\xd3\x05\xfb\x2e\x12\xb4\xe2\xd9\x3e\x01\x2b\x2c\xae\xab\x20\x91\x16\x90\x04\xb0\x17\x76\x50\x7a\xb1\xeb\x41\xd1\xc5\xed\x75\xb6\x84\x48\xbf\x3a\xd2\xa4\xb0\xa9\x29\x1c\x2d\x30\xd0\xbb\x80\x3d\xb2\xc7\xd2\x53\x9e\x61\xcb\x8f\x14\x07\x0f\x8a\xbd\x1d\x91\x2a\xd6\x14\x47\x09\xf9\xfa\xb3\xe6\x2c\xa7\xfd\x59\xb6\xe6\xe0\x8a\x03\xff\x7e\x6c\xd5\x5f\x4a\x58\x71\xd5\x6d\xe1\x59\x43\x09\x1d\x26\x2c\x32\x80\x80\x0d\x15\xb3\x9d\x66\xde\x21\x03\xc0\x10\x24\x8d\x78\x1d\x70\x00\x1c\xac\xef\x1c\xe5\x91\x3c\xa1\x52\x2e\x2d\x05\xad\x79\x95\x86\xa7\xb9\x6c\x8c\xf5\xd2\x39\xd3\x60\xc0\x8a\x5c\x01\x8b\x14\x3b\x5a\xbc\x4d\x55\xf2\xab\x99\x65\x6a\xae\x6a\x33\x69\x67\xcf\xe9\xe9\x1f\xe6\x70\xa8\x3c\x99\x20\x8e\x8c\xa3\x9e\xfc\x20\xe6\x88\xae\x14\x7b\xb6\x94\x97\x94\xf0\x98\xcb\xe1\x27\xd9\x64\x2c\x96\x5d\x70\x9e\x76\xac\xb9\x3c\x45\x66\x8e\x9d\x3a\x28\x5e\x3e\x9b\x76\x34\x32\x83\x7d\x56\x77\xd4\x33\x6d\xa6\x0c\x47\x37\x0d\xf4\x67\x19\x80\xf5\x4c\x21\x6d\xc3\x29\x0e\x57\xdc\x1e\xe6\x74\xa6\x2d\x0e\xee\xb4\x45\x4b\x05\xec\x1e\x34\x7f\x09\x70\x00\xb7\x98\xea\x02\x4e\xa7\xb6\xd3\xee\x67\x73\xc0\x14\xd3\x9f\x99\x67\xb2\xe6\x01\x12\x53\x01\xe7\xe7\x1f\x33\x98\x5b\x7e\x3b\x96\xbc\x80\x6d\xc3\x33\x00\x65\x47\x57\xab\x15\xd9\xa4\x05\xdc\x48\xa0\x0c\x20\x71\x43\xd2\xa5\xfb\xe1\x4b\x72\x5a\xc0\xa7\xa9\x6a\xe3\xe2\xf7\xe4\xc9\x26\x89\x5b\x95\x0d\x26\x5b\x7f\xc3\x92\xfc\x54\x34\x80\x75\x57\x52\x0c\x94\x68\xac\xf5\x5c\xd7\x7c\xeb\xc6\xab\xa2\x63\xe7\x69\x72\x18\x5b\xfe\x1a\xa5\x6b\xa7\xa9\x06\x5e\xca\x09\x60\xff\x85\xed\xc0\x63\x3e\x30\x71\x0e\x7f\xb8\xbc\xbb\xba\x78\xb8\x9a\x0e\xdf\x6f\xbf\xcc\x87\x48\x2a\x5d\xb4\xb4\x03\x3e\xb3\x54\x47\x92\x5a\x69\xa9\x80\x9b\xd9\x0c\xb7\xab\xd6\xc1\x9f\xd7\xc4\xfd\x9f\xaa\xf5\x27\xec\x59\xc5\x76\xf2\xde\xa1\x5a\xaf\xc5\xfe\x96\x97\xef\x14\xff\x3e\xcd\x17\x62\xff\x3d\x00\x12\xbe\x3d\x0a\xe7\x06\x00\x00")
+
+func manifests04ValidatingWebhookConfigurationYamlBytes() ([]byte, error) {
+	return bindataRead(
+		_manifests04ValidatingWebhookConfigurationYaml,
+		"manifests/04-validating-webhook-configuration.yaml",
+	)
+}
+
+func manifests04ValidatingWebhookConfigurationYaml() (*asset, error) {
+	bytes, err := manifests04ValidatingWebhookConfigurationYamlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "manifests/04-validating-webhook-configuration.yaml", size: 1767, mode: os.FileMode(420), modTime: time.Unix(1, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x50, 0xc0, 0x63, 0x74, 0xfe, 0x5d, 0xf8, 0xea, 0x9e, 0x4, 0xcc, 0x2b, 0x4, 0xb, 0x1a, 0xc9, 0x7a, 0x30, 0x38, 0xe0, 0xf0, 0xee, 0xe9, 0x41, 0x50, 0xe2, 0x5e, 0xca, 0x9b, 0xb0, 0xc1, 0x71}}
+	return a, nil
+}
+
+var _manifestsImageReferences = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x9c\xd0\xb1\x4e\xc4\x30\x0c\x80\xe1\x3d\x4f\x61\x65\x4f\x0f\x24\xa6\xcc\x2c\xcc\x48\xec\xbe\xe2\xeb\x59\x6d\xe3\x60\xbb\x27\xee\xed\x51\x7b\x08\xe5\x40\x5d\x98\x12\x25\xd1\xff\x45\x1e\xb9\xbc\x67\x78\x99\x71\xa0\x57\x57\xc2\x39\x60\xe5\x37\x52\x63\x29\x19\x78\x3d\xef\xa4\x52\xb1\x33\x9f\xbc\x63\x39\x5c\x1e\x83\x55\xea\x73\x00\x70\x1c\x6c\x5d\x13\x14\x9c\x29\x43\x3f\x2d\xe6\xa4\x89\xcb\xa0\x64\x96\xa4\x92\xa2\x8b\x06\x00\x80\x93\xca\x9c\x61\xdb\x02\xdc\xd4\xf8\x2c\xfd\x48\xba\xe1\xf1\xfb\xe6\x56\x8a\x3f\xe4\x41\x94\x07\x2e\x69\xaf\x9d\x27\x74\x32\x8f\xcd\x37\xce\x58\x55\x3e\xaf\x49\x65\x71\x6a\xf0\x7f\xdb\xf7\xc1\x7c\x79\xea\x1e\x5a\x6f\x5c\x8e\x94\xf4\x88\x7d\xda\x9e\xed\x80\x8d\x77\xcf\x7d\x2c\x78\x5d\x07\xfb\x87\xfd\xd5\xcd\x13\x3a\x99\xc7\xf0\x35\x00\x20\xb5\x82\x62\xb3\x01\x00\x00")
 
 func manifestsImageReferencesBytes() ([]byte, error) {
 	return bindataRead(
@@ -933,12 +975,16 @@ var _bindata = map[string]func() (*asset, error){
 
 	"manifests/01-trusted-ca-configmap.yaml": manifests01TrustedCaConfigmapYaml,
 
+	"manifests/01-webhook-service.yaml": manifests01WebhookServiceYaml,
+
 	"manifests/02-deployment-ibm-cloud-managed.yaml": manifests02DeploymentIbmCloudManagedYaml,
 
 	"manifests/02-deployment.yaml": manifests02DeploymentYaml,
 
 	"manifests/03-cluster-operator.yaml": manifests03ClusterOperatorYaml,
 
+	"manifests/04-validating-webhook-configuration.yaml": manifests04ValidatingWebhookConfigurationYaml,
+
 	"manifests/image-references": manifestsImageReferences,
 }
 
@@ -1024,9 +1070,11 @@ var _bintree = &bintree{nil, map[string]*bintree{
 		"01-service-account.yaml":                                {manifests01ServiceAccountYaml, map[string]*bintree{}},
 		"01-service.yaml":                                        {manifests01ServiceYaml, map[string]*bintree{}},
 		"01-trusted-ca-configmap.yaml":                           {manifests01TrustedCaConfigmapYaml, map[string]*bintree{}},
+		"01-webhook-service.yaml":                                {manifests01WebhookServiceYaml, map[string]*bintree{}},
 		"02-deployment-ibm-cloud-managed.yaml":                   {manifests02DeploymentIbmCloudManagedYaml, map[string]*bintree{}},
 		"02-deployment.yaml":                                     {manifests02DeploymentYaml, map[string]*bintree{}},
 		"03-cluster-operator.yaml":                               {manifests03ClusterOperatorYaml, map[string]*bintree{}},
+		"04-validating-webhook-configuration.yaml":               {manifests04ValidatingWebhookConfigurationYaml, map[string]*bintree{}},
 		"image-references":                                       {manifestsImageReferences, map[string]*bintree{}},
 	}},
 }}
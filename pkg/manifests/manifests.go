@@ -53,6 +53,13 @@ const (
 	// ingress operator's canary end-to-end check controller.
 	OwningIngressCanaryCheckLabel = "ingress.openshift.io/canary"
 
+	// OwningGatewayNamespaceLabel and OwningGatewayNameLabel should be
+	// applied to any objects "owned by" a gateway.  Gateways are namespaced
+	// and the objects that the operator creates for them are in the
+	// operator and operand namespaces, so an ownerref cannot be used.
+	OwningGatewayNamespaceLabel = "gateway.operator.openshift.io/owning-gateway-namespace"
+	OwningGatewayNameLabel      = "gateway.operator.openshift.io/owning-gateway-name"
+
 	// IngressControllerFinalizer is used to block deletion of ingresscontrollers
 	// until the operator has ensured it's safe for deletion to proceed.
 	IngressControllerFinalizer = "ingresscontroller.operator.openshift.io/finalizer-ingresscontroller"
@@ -61,6 +68,23 @@ const (
 	// operator has ensured it's safe for deletion to proceeed.
 	DNSRecordFinalizer = "operator.openshift.io/ingress-dns"
 
+	// ManagedDNSRecordLabel, when set to "true" on a dnsrecord in any
+	// namespace, requests that the operator publish the dnsrecord even
+	// though no ingresscontroller owns it.  A dnsrecord with an owner
+	// reference to any object is published as well.  Creating dnsrecords
+	// requires the "openshift-ingress-operator-dnsrecord-editor" cluster
+	// role, which a cluster administrator can bind in a namespace, and
+	// the namespace must have AllowDNSRecordsNamespaceLabel.  The DNS name
+	// must be a subdomain of the domain of an ingresscontroller.
+	ManagedDNSRecordLabel = "ingress.operator.openshift.io/managed-dnsrecord"
+
+	// AllowDNSRecordsNamespaceLabel, when set to "true" on a namespace,
+	// allows dnsrecords in the namespace to be published.  Only users who
+	// can update namespaces, which project administrators cannot, can set
+	// the label, so a cluster administrator must opt each namespace in.
+	// The operator namespace does not need the label.
+	AllowDNSRecordsNamespaceLabel = "ingress.operator.openshift.io/allow-dnsrecords"
+
 	// DefaultIngressControllerName is the name of the default IngressController
 	// instance.
 	DefaultIngressControllerName = "default"
@@ -16,6 +16,8 @@ import (
 
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+
+	gatewayapiv1beta1 "sigs.k8s.io/gateway-api/apis/v1beta1"
 )
 
 var (
@@ -42,6 +44,12 @@ func init() {
 	if err := routev1.Install(scheme); err != nil {
 		panic(err)
 	}
+	// The Gateway API CRDs are not guaranteed to exist; the scheme only
+	// needs to know the types, and controllers that use them must check
+	// that the CRDs are installed before watching them.
+	if err := gatewayapiv1beta1.AddToScheme(scheme); err != nil {
+		panic(err)
+	}
 }
 
 func GetScheme() *runtime.Scheme {
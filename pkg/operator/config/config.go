@@ -15,5 +15,10 @@ type Config struct {
 	// CanaryImage is the ingress operator image, which runs a canary command.
 	CanaryImage string
 
+	// WebhookCertDir is the directory with the serving certificate and key
+	// for the validating admission webhooks.  If it is empty, the webhooks
+	// are not served.
+	WebhookCertDir string
+
 	Stop chan struct{}
 }
@@ -8,7 +8,9 @@ import (
 
 	operatorv1 "github.com/openshift/api/operator/v1"
 	"github.com/openshift/cluster-ingress-operator/pkg/operator/controller"
+	ingresscontroller "github.com/openshift/cluster-ingress-operator/pkg/operator/controller/ingress"
 
+	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -18,7 +20,8 @@ import (
 // ensureDefaultCertificateForIngress creates or deletes an operator-generated
 // default certificate for a given IngressController as appropriate.  Returns true
 // if it the secret exists, or false if it does not, as well as any errors.
-func (r *reconciler) ensureDefaultCertificateForIngress(caSecret *corev1.Secret, namespace string, deploymentRef metav1.OwnerReference, ci *operatorv1.IngressController) (bool, error) {
+func (r *reconciler) ensureDefaultCertificateForIngress(caSecret *corev1.Secret, deployment *appsv1.Deployment, deploymentRef metav1.OwnerReference, ci *operatorv1.IngressController) (bool, error) {
+	namespace := deployment.Namespace
 	ca, err := crypto.GetCAFromBytes(caSecret.Data["tls.crt"], caSecret.Data["tls.key"])
 	if err != nil {
 		return false, fmt.Errorf("failed to get CA from secret %s/%s: %v", caSecret.Namespace, caSecret.Name, err)
@@ -27,6 +30,17 @@ func (r *reconciler) ensureDefaultCertificateForIngress(caSecret *corev1.Secret,
 	if err != nil {
 		return false, err
 	}
+	// The ingress controller does not roll out a user-specified default
+	// certificate that has never been valid, so the router deployment may
+	// use the operator-generated certificate even if the ingresscontroller
+	// specifies a default certificate.
+	if !wantCert && ingresscontroller.DeploymentDefaultCertificateSecretName(deployment) == controller.RouterOperatorGeneratedDefaultCertificateSecretName(ci, namespace).Name {
+		generated := ci.DeepCopy()
+		generated.Spec.DefaultCertificate = nil
+		if wantCert, desired, err = desiredRouterDefaultCertificateSecret(ca, namespace, deploymentRef, generated); err != nil {
+			return false, err
+		}
+	}
 	if !wantCert {
 		// If the operator generated certificate is not being used, ensure that the ingress controller's
 		// Spec.DefaultCertificate secret exists before deleting the operator generated secret.
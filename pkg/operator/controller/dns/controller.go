@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"os"
 	"reflect"
+	"sync"
 	"time"
 
 	"github.com/google/go-cmp/cmp"
@@ -59,26 +60,72 @@ const (
 	// will use to authenticate with the cloud API.
 	cloudCredentialsSecretName = "cloud-credentials"
 
+	// DNSRecordTTLAdjustedConditionType is the type of the zone condition
+	// that indicates whether the DNS provider published the record with a
+	// TTL other than the one that the record specifies.  The condition is
+	// reported only for providers that implement dns.TTLAdjuster.
+	DNSRecordTTLAdjustedConditionType = "TTLAdjusted"
+
+	// DNSRecordOwnershipTrackedConditionType is the type of the zone
+	// condition that indicates whether the DNS provider records the owner
+	// of the record in a companion TXT record.  If it does not, the
+	// condition has the reason "Unsupported" because the operator cannot
+	// tell whether a record with the same name belongs to another cluster
+	// or DNS management tool.
+	DNSRecordOwnershipTrackedConditionType = "OwnershipTracked"
+
 	// kubeCloudConfigName is the name of the kube cloud config ConfigMap
 	kubeCloudConfigName = "kube-cloud-config"
 	// cloudCABundleKey is the key in the kube cloud config ConfigMap where the custom CA bundle is located
 	cloudCABundleKey = "ca-bundle.pem"
+
+	// maxConcurrentReconciles is the number of dnsrecords that the
+	// controller reconciles concurrently.  Reconciling several records
+	// concurrently allows the DNS provider's changes for the same zone to
+	// be batched.
+	maxConcurrentReconciles = 10
+
+	// providerCallTimeout is the deadline for each call to the DNS
+	// provider.  It allows for the time that a change spends queued by the
+	// batching provider and for retries of throttled requests.
+	providerCallTimeout = 2 * time.Minute
 )
 
 var log = logf.Logger.WithName(controllerName)
 
 func New(mgr manager.Manager, config Config) (runtimecontroller.Controller, error) {
 	reconciler := &reconciler{
-		config:   config,
-		client:   mgr.GetClient(),
-		cache:    mgr.GetCache(),
-		recorder: mgr.GetEventRecorderFor(controllerName),
+		config:      config,
+		client:      mgr.GetClient(),
+		cache:       mgr.GetCache(),
+		recorder:    mgr.GetEventRecorderFor(controllerName),
+		providers:   &sharedProviderState{},
+		batchConfig: dns.DefaultBatchConfig(),
 	}
-	c, err := runtimecontroller.New(controllerName, mgr, runtimecontroller.Options{Reconciler: reconciler})
+	// Create a new cache to watch on DNSRecord objects from every
+	// namespace so that other components can request DNS records.
+	dnsRecordCache, err := cache.New(mgr.GetConfig(), cache.Options{
+		Scheme: mgr.GetScheme(),
+	})
 	if err != nil {
 		return nil, err
 	}
-	if err := c.Watch(&source.Kind{Type: &iov1.DNSRecord{}}, &handler.EnqueueRequestForObject{}, predicate.GenerationChangedPredicate{}); err != nil {
+	// Add the cache to the manager so that the cache is started along with the other runnables.
+	if err := mgr.Add(dnsRecordCache); err != nil {
+		return nil, err
+	}
+	reconciler.dnsRecordCache = dnsRecordCache
+	c, err := runtimecontroller.New(controllerName, mgr, runtimecontroller.Options{
+		Reconciler:              reconciler,
+		MaxConcurrentReconciles: maxConcurrentReconciles,
+	})
+	if err != nil {
+		return nil, err
+	}
+	isManagedRecord := predicate.NewPredicateFuncs(func(o client.Object) bool {
+		return o.GetNamespace() == config.Namespace || isRequestedRecord(o) || slice.ContainsString(o.GetFinalizers(), manifests.DNSRecordFinalizer)
+	})
+	if err := c.Watch(source.NewKindWithCache(&iov1.DNSRecord{}, dnsRecordCache), &handler.EnqueueRequestForObject{}, predicate.GenerationChangedPredicate{}, isManagedRecord); err != nil {
 		return nil, err
 	}
 	if err := c.Watch(&source.Kind{Type: &configv1.DNS{}}, handler.EnqueueRequestsFromMapFunc(reconciler.ToDNSRecords)); err != nil {
@@ -109,17 +156,34 @@ func New(mgr manager.Manager, config Config) (runtimecontroller.Controller, erro
 type Config struct {
 	Namespace              string
 	OperatorReleaseVersion string
+	// RouteCache is a cache of routes in all namespaces, which is used to
+	// reject dnsrecords for the hosts of admitted routes.
+	RouteCache cache.Cache
 }
 
 type reconciler struct {
 	config Config
 
-	client           client.Client
-	cache            cache.Cache
+	client         client.Client
+	cache          cache.Cache
+	dnsRecordCache cache.Cache
+	recorder       record.EventRecorder
+
+	// providers holds the DNS provider that reconciles share.  Each
+	// reconcile captures the current provider in the fields that follow
+	// and uses it without holding a lock.
+	providers *sharedProviderState
+
 	dnsProvider      dns.Provider
+	batchingProvider *dns.BatchingProvider
 	infraConfig      *configv1.Infrastructure
 	cloudCredentials *corev1.Secret
-	recorder         record.EventRecorder
+
+	// batchConfig configures the batching provider that wraps the DNS
+	// provider.  Its rate limiter is shared by the batching providers
+	// that the reconciler creates so that the rate limit is kept when the
+	// DNS provider is recreated.
+	batchConfig dns.BatchConfig
 }
 
 func (r *reconciler) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
@@ -147,23 +211,26 @@ func (r *reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 		return reconcile.Result{Requeue: true}, nil
 	}
 
-	if err := r.createDNSProviderIfNeeded(dnsConfig, record); err != nil {
+	state, err := r.createDNSProviderIfNeeded(dnsConfig, record)
+	if err != nil {
 		return reconcile.Result{}, err
 	}
+	r = r.withProviderState(state)
 
 	// If the DNS record was deleted, clean up and return.
 	if record.DeletionTimestamp != nil {
-		if err := r.delete(record); err != nil {
+		if err := r.delete(ctx, record); err != nil {
 			log.Error(err, "failed to delete dnsrecord; will retry", "dnsrecord", record)
 			return reconcile.Result{RequeueAfter: 15 * time.Second}, nil
 		}
 		return reconcile.Result{}, nil
 	}
 
-	// Only process records associated with ingresscontrollers, because this isn't
-	// intended to be a general purpose DNS management system.
-	ingressName, ok := record.Labels[manifests.OwningIngressControllerLabel]
-	if !ok {
+	// Only process records that are associated with ingresscontrollers or
+	// that other components have requested using ManagedDNSRecordLabel or
+	// an owner reference.
+	ingressName, ownedByIngressController := ingressControllerOwner(record, r.config.Namespace)
+	if !ownedByIngressController && !isRequestedRecord(record) {
 		log.V(2).Info("warning: dnsrecord is missing owner label", "dnsrecord", record, "ingresscontroller", ingressName)
 		return reconcile.Result{RequeueAfter: 1 * time.Minute}, nil
 	}
@@ -176,7 +243,21 @@ func (r *reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 		return reconcile.Result{}, nil
 	}
 
-	if err := r.cache.Get(ctx, types.NamespacedName{Namespace: record.Namespace, Name: ingressName}, &operatorv1.IngressController{}); err != nil {
+	if !ownedByIngressController {
+		// The ingresscontroller controller adds the finalizer to the
+		// records that it creates; other components may not know to
+		// add it.
+		if !slice.ContainsString(record.Finalizers, manifests.DNSRecordFinalizer) {
+			updated := record.DeepCopy()
+			updated.Finalizers = append(updated.Finalizers, manifests.DNSRecordFinalizer)
+			if err := r.client.Update(ctx, updated); err != nil {
+				log.Error(err, "failed to add finalizer to dnsrecord; will retry", "dnsrecord", record)
+				return reconcile.Result{RequeueAfter: 15 * time.Second}, nil
+			}
+			log.Info("added finalizer to dnsrecord", "dnsrecord", updated)
+			record = updated
+		}
+	} else if err := r.cache.Get(ctx, types.NamespacedName{Namespace: record.Namespace, Name: ingressName}, &operatorv1.IngressController{}); err != nil {
 		if errors.IsNotFound(err) {
 			// TODO: what should we do here? something upstream could detect and delete the orphan? add new conditions?
 			// is it safe to retry without verifying the owner isn't a new object?
@@ -195,7 +276,25 @@ func (r *reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 	if dnsConfig.Spec.PublicZone != nil {
 		zones = append(zones, *dnsConfig.Spec.PublicZone)
 	}
-	requeue, statuses := r.publishRecordToZones(zones, record)
+	var (
+		requeue  bool
+		statuses []iov1.DNSZoneStatus
+	)
+	err = validateDNSRecord(record)
+	if err == nil && !ownedByIngressController {
+		policy, lookupErr := getRequestedRecordPolicy(ctx, r.client, r.config.RouteCache, r.config.Namespace, record.Namespace)
+		if lookupErr != nil {
+			log.Error(lookupErr, "failed to look up the policy for dnsrecord; will retry", "dnsrecord", record)
+			return reconcile.Result{RequeueAfter: 15 * time.Second}, nil
+		}
+		err = policy.validate(record)
+	}
+	if err != nil {
+		r.recorder.Eventf(record, "Warning", "Rejected", "Record is invalid and will not be published: %v", err)
+		statuses = invalidRecordZoneStatuses(zones, record, err)
+	} else {
+		requeue, statuses = r.publishRecordToZones(ctx, zones, record)
+	}
 
 	// Requeue if publishing records failed.
 	result := reconcile.Result{}
@@ -218,29 +317,64 @@ func (r *reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 	return result, nil
 }
 
+// providerState is a DNS provider together with the state from which it was
+// created.  A providerState is not modified after it is created.
+type providerState struct {
+	dnsProvider      dns.Provider
+	batchingProvider *dns.BatchingProvider
+	infraConfig      *configv1.Infrastructure
+	cloudCredentials *corev1.Secret
+}
+
+// sharedProviderState holds the current DNS provider of a reconciler.
+type sharedProviderState struct {
+	// lock guards current.  Reconciles hold a read lock while they check
+	// whether the current provider is up to date and a write lock while
+	// they replace it, but they do not hold the lock while they use the
+	// provider.
+	lock    sync.RWMutex
+	current *providerState
+}
+
+// isCurrent returns a Boolean value indicating whether the given provider
+// state was created from the given infrastructure config and cloud
+// credentials.
+func (s *providerState) isCurrent(infraConfig *configv1.Infrastructure, creds *corev1.Secret, checkCreds bool) bool {
+	if s == nil || s.infraConfig == nil || !reflect.DeepEqual(infraConfig.Status, s.infraConfig.Status) {
+		return false
+	}
+	if checkCreds && (s.cloudCredentials == nil || !reflect.DeepEqual(creds.Data, s.cloudCredentials.Data)) {
+		return false
+	}
+	return true
+}
+
 // createDNSProviderIfNeeded creates a new DNS provider if none has yet been
 // created or if the infrastructure platform status or cloud credentials have
-// changed since the current provider was created.  After creating a new
-// provider, createDNSProviderIfNeeded updates the reconciler state
-// with the new provider and current platform status and cloud credentials.
-func (r *reconciler) createDNSProviderIfNeeded(dnsConfig *configv1.DNS, record *iov1.DNSRecord) error {
-	var needUpdate bool
+// changed since the current provider was created.  It returns the provider
+// state that the reconcile should use, which is the current state if the
+// record is unmanaged.
+func (r *reconciler) createDNSProviderIfNeeded(dnsConfig *configv1.DNS, record *iov1.DNSRecord) (*providerState, error) {
+	r.providers.lock.RLock()
+	current := r.providers.current
+	r.providers.lock.RUnlock()
 
 	if record.Spec.DNSManagementPolicy == iov1.UnmanagedDNS {
-		return nil
+		return current, nil
 	}
 
 	infraConfig := &configv1.Infrastructure{}
 	if err := r.client.Get(context.TODO(), types.NamespacedName{Name: "cluster"}, infraConfig); err != nil {
-		return fmt.Errorf("failed to get infrastructure 'config': %v", err)
+		return nil, fmt.Errorf("failed to get infrastructure 'config': %v", err)
 	}
 
 	platformStatus := infraConfig.Status.PlatformStatus
 	if platformStatus == nil {
-		return fmt.Errorf("failed to determine infrastructure platform status: PlatformStatus is nil")
+		return nil, fmt.Errorf("failed to determine infrastructure platform status: PlatformStatus is nil")
 	}
 
 	creds := &corev1.Secret{}
+	var checkCreds bool
 	switch platformStatus.Type {
 	case configv1.AWSPlatformType, configv1.AzurePlatformType, configv1.GCPPlatformType,
 		configv1.IBMCloudPlatformType, configv1.PowerVSPlatformType, configv1.AlibabaCloudPlatformType:
@@ -249,46 +383,127 @@ func (r *reconciler) createDNSProviderIfNeeded(dnsConfig *configv1.DNS, record *
 		}
 		name := types.NamespacedName{Namespace: r.config.Namespace, Name: cloudCredentialsSecretName}
 		if err := r.cache.Get(context.TODO(), name, creds); err != nil {
-			return fmt.Errorf("failed to get cloud credentials from secret %s: %v", name, err)
+			return nil, fmt.Errorf("failed to get cloud credentials from secret %s: %v", name, err)
 		}
+		checkCreds = true
+	}
 
-		if r.cloudCredentials == nil || !reflect.DeepEqual(creds.Data, r.cloudCredentials.Data) {
-			needUpdate = true
-		}
+	if current.isCurrent(infraConfig, creds, checkCreds) {
+		return current, nil
 	}
 
-	if r.infraConfig == nil || !reflect.DeepEqual(infraConfig.Status, r.infraConfig.Status) {
-		needUpdate = true
+	r.providers.lock.Lock()
+	defer r.providers.lock.Unlock()
+
+	// Another reconcile may have replaced the provider while this one
+	// was waiting for the lock.
+	if r.providers.current.isCurrent(infraConfig, creds, checkCreds) {
+		return r.providers.current, nil
 	}
 
-	if needUpdate {
-		dnsProvider, err := r.createDNSProvider(dnsConfig, platformStatus, &infraConfig.Status, creds)
-		if err != nil {
-			return fmt.Errorf("failed to create DNS provider: %v", err)
-		}
+	dnsProvider, err := r.createDNSProvider(dnsConfig, platformStatus, &infraConfig.Status, creds)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create DNS provider: %v", err)
+	}
+	r.providers.current = &providerState{
+		dnsProvider:      dnsProvider,
+		batchingProvider: dns.NewBatchingProvider(dnsProvider, r.batchConfig),
+		infraConfig:      infraConfig,
+		cloudCredentials: creds,
+	}
+
+	return r.providers.current, nil
+}
 
-		r.dnsProvider, r.infraConfig, r.cloudCredentials = dnsProvider, infraConfig, creds
+// withProviderState returns a copy of the reconciler that uses the DNS
+// provider in the given provider state.
+func (r *reconciler) withProviderState(state *providerState) *reconciler {
+	rr := *r
+	if state != nil {
+		rr.dnsProvider = state.dnsProvider
+		rr.batchingProvider = state.batchingProvider
+		rr.infraConfig = state.infraConfig
+		rr.cloudCredentials = state.cloudCredentials
 	}
+	return &rr
+}
 
-	return nil
+// waitForRateLimit blocks until the batching provider's rate limiter allows a
+// call to the DNS provider or the given context is done.  Calls that the
+// reconciler makes to the DNS provider directly rather than through the
+// batching provider must first call waitForRateLimit.
+func (r *reconciler) waitForRateLimit(ctx context.Context) error {
+	if r.batchingProvider == nil {
+		return nil
+	}
+	return r.batchingProvider.Wait(ctx)
+}
+
+// publisher returns the provider with which records are published and
+// deleted: the batching provider that wraps the DNS provider, or the DNS
+// provider itself if it is not wrapped.
+func (r *reconciler) publisher() dns.Provider {
+	if r.batchingProvider != nil {
+		return r.batchingProvider
+	}
+	return r.dnsProvider
+}
+
+// ensureRecord publishes the given record to the given zone.  If the given owner
+// is not empty, the DNS provider, which implements dns.AtomicOwnershipRegistry,
+// writes the companion TXT record specifying the owner in the same change.
+func (r *reconciler) ensureRecord(ctx context.Context, record *iov1.DNSRecord, zone configv1.DNSZone, owner string) error {
+	if len(owner) != 0 {
+		if r.batchingProvider != nil {
+			return r.batchingProvider.EnsureWithOwner(ctx, record, zone, owner)
+		}
+		if registry, ok := r.dnsProvider.(dns.AtomicOwnershipRegistry); ok {
+			return registry.EnsureWithOwner(ctx, record, zone, owner)
+		}
+	}
+	return r.publisher().Ensure(ctx, record, zone)
+}
+
+// replaceRecord replaces the given record in the given zone.  If the given
+// owner is not empty, the DNS provider, which implements
+// dns.AtomicOwnershipRegistry, writes the companion TXT record specifying the
+// owner in the same change.
+func (r *reconciler) replaceRecord(ctx context.Context, record *iov1.DNSRecord, zone configv1.DNSZone, owner string) error {
+	if len(owner) != 0 {
+		if r.batchingProvider != nil {
+			return r.batchingProvider.ReplaceWithOwner(ctx, record, zone, owner)
+		}
+		if registry, ok := r.dnsProvider.(dns.AtomicOwnershipRegistry); ok {
+			return registry.ReplaceWithOwner(ctx, record, zone, owner)
+		}
+	}
+	return r.publisher().Replace(ctx, record, zone)
 }
 
 // replacePublishedRecord replaces a previously published record with the given record,
 // and the result is returned as a condition. Upon errors during publishing,
 // an error object is returned.
-func (r *reconciler) replacePublishedRecord(zone configv1.DNSZone, record *iov1.DNSRecord) (iov1.DNSZoneCondition, error) {
+func (r *reconciler) replacePublishedRecord(ctx context.Context, zone configv1.DNSZone, record *iov1.DNSRecord) (iov1.DNSZoneCondition, error) {
 	condition := iov1.DNSZoneCondition{
 		Status:             string(operatorv1.ConditionUnknown),
 		Type:               iov1.DNSRecordPublishedConditionType,
 		LastTransitionTime: metav1.Now(),
 	}
 
-	err := r.dnsProvider.Replace(record, zone)
+	owner, claimCondition, err := r.claimRecordOwnership(ctx, zone, record)
+	if err != nil {
+		return claimCondition, err
+	}
+
+	callCtx, cancel := context.WithTimeout(ctx, providerCallTimeout)
+	defer cancel()
+	err = r.replaceRecord(callCtx, record, zone, owner)
+	if err == nil {
+		err = r.deleteReplacedOwners(callCtx, zone, record)
+	}
 	if err != nil {
 		log.Error(err, "failed to replace DNS record in zone", "record", record.Spec, "dnszone", zone)
-		condition.Status = string(operatorv1.ConditionFalse)
-		condition.Reason = "ProviderError"
-		condition.Message = fmt.Sprintf("The DNS provider failed to replace the record: %v", err)
+		setProviderErrorCondition(callCtx, &condition, "replace", err)
 	} else {
 		log.Info("replaced DNS record in zone", "record", record.Spec, "dnszone", zone)
 		condition.Status = string(operatorv1.ConditionTrue)
@@ -299,22 +514,59 @@ func (r *reconciler) replacePublishedRecord(zone configv1.DNSZone, record *iov1.
 	return condition, err
 }
 
+// deleteReplacedOwners deletes the companion TXT records of the records of a
+// conflicting type that replacing the given record in the given zone has
+// deleted, if the DNS provider implements dns.OwnershipRegistry.  Companion
+// TXT records that specify another owner are left alone.
+func (r *reconciler) deleteReplacedOwners(ctx context.Context, zone configv1.DNSZone, record *iov1.DNSRecord) error {
+	registry, ok := r.dnsProvider.(dns.OwnershipRegistry)
+	if !ok {
+		return nil
+	}
+	desired := r.recordOwner(record)
+	for _, replaced := range dns.ReplacedRecords(record) {
+		if err := r.waitForRateLimit(ctx); err != nil {
+			return err
+		}
+		owner, err := registry.GetOwner(ctx, replaced, zone)
+		if err != nil {
+			return fmt.Errorf("failed to look up owner of replaced %s record: %w", replaced.Spec.RecordType, err)
+		}
+		if owner != desired {
+			continue
+		}
+		if err := r.waitForRateLimit(ctx); err != nil {
+			return err
+		}
+		if err := registry.DeleteOwner(ctx, replaced, zone); err != nil {
+			return fmt.Errorf("failed to delete owner of replaced %s record: %w", replaced.Spec.RecordType, err)
+		}
+		log.Info("deleted owner of replaced DNS record", "record", replaced.Spec, "dnszone", zone)
+	}
+	return nil
+}
+
 // publishRecord ensures the given record is published to the provided zone
 // and the result is returned as a condition. Upon errors during publishing
 // an error object is returned.
-func (r *reconciler) publishRecord(zone configv1.DNSZone, record *iov1.DNSRecord) (iov1.DNSZoneCondition, error) {
+func (r *reconciler) publishRecord(ctx context.Context, zone configv1.DNSZone, record *iov1.DNSRecord) (iov1.DNSZoneCondition, error) {
 	condition := iov1.DNSZoneCondition{
 		Status:             string(operatorv1.ConditionUnknown),
 		Type:               iov1.DNSRecordPublishedConditionType,
 		LastTransitionTime: metav1.Now(),
 	}
 
-	err := r.dnsProvider.Ensure(record, zone)
+	owner, claimCondition, err := r.claimRecordOwnership(ctx, zone, record)
+	if err != nil {
+		return claimCondition, err
+	}
+
+	callCtx, cancel := context.WithTimeout(ctx, providerCallTimeout)
+	defer cancel()
+	err = r.ensureRecord(callCtx, record, zone, owner)
 	if err != nil {
 		log.Error(err, "failed to publish DNS record to zone", "record", record.Spec, "dnszone", zone)
-		condition.Status = string(operatorv1.ConditionFalse)
-		condition.Reason = "ProviderError"
-		condition.Message = fmt.Sprintf("The DNS provider failed to ensure the record: %v", err)
+		setProviderErrorCondition(callCtx, &condition, "ensure", err)
 	} else {
 		log.Info("published DNS record to zone", "record", record.Spec, "dnszone", zone)
 		condition.Status = string(operatorv1.ConditionTrue)
@@ -325,9 +577,140 @@ func (r *reconciler) publishRecord(zone configv1.DNSZone, record *iov1.DNSRecord
 	return condition, err
 }
 
+// setProviderErrorCondition updates the given Published condition to report
+// the given error, which the DNS provider returned for the given operation
+// using the given context.  If the context was done before the provider
+// responded, the outcome of the operation is unknown, and the condition
+// reports that the operation will be retried.
+func setProviderErrorCondition(ctx context.Context, condition *iov1.DNSZoneCondition, operation string, err error) {
+	if ctx.Err() != nil {
+		condition.Status = string(operatorv1.ConditionUnknown)
+		condition.Reason = "ProviderTimeout"
+		condition.Message = fmt.Sprintf("The DNS provider did not %s the record in time and the operation will be retried: %v", operation, err)
+		return
+	}
+	condition.Status = string(operatorv1.ConditionFalse)
+	condition.Reason = "ProviderError"
+	condition.Message = fmt.Sprintf("The DNS provider failed to %s the record: %v", operation, err)
+}
+
+// recordOwner returns the owner that identifies the given record in the
+// companion TXT records of providers that implement dns.OwnershipRegistry.
+func (r *reconciler) recordOwner(record *iov1.DNSRecord) string {
+	var infraID string
+	if r.infraConfig != nil {
+		infraID = r.infraConfig.Status.InfrastructureName
+	}
+	if ingressName, ok := ingressControllerOwner(record, r.config.Namespace); ok {
+		return dns.RecordOwner(infraID, ingressName)
+	}
+	return dns.DNSRecordOwner(infraID, record.Namespace, record.Name)
+}
+
+// ingressControllerOwner returns the name of the ingresscontroller that owns
+// the given record and a Boolean value indicating whether an ingresscontroller
+// owns the record.  Only records in the operator's namespace can be owned by
+// an ingresscontroller.
+func ingressControllerOwner(record *iov1.DNSRecord, operatorNamespace string) (string, bool) {
+	ingressName, ok := record.Labels[manifests.OwningIngressControllerLabel]
+	if !ok || record.Namespace != operatorNamespace {
+		return "", false
+	}
+	return ingressName, true
+}
+
+// isRequestedRecord returns a Boolean value indicating whether the given
+// dnsrecord has been requested by another component, which is indicated by
+// ManagedDNSRecordLabel or an owner reference.
+func isRequestedRecord(o metav1.Object) bool {
+	return o.GetLabels()[manifests.ManagedDNSRecordLabel] == "true" || len(o.GetOwnerReferences()) != 0
+}
+
+// claimRecordOwnership verifies that the given record in the given zone is not
+// owned by another owner if the DNS provider implements dns.OwnershipRegistry.
+// A record without an owner is claimed only if the zone has no record that
+// publishing it would overwrite, if the operator published the record itself,
+// as the record's status indicates, or if the record has the
+// dns.AdoptUnownedRecordAnnotation annotation.  If the provider implements
+// dns.AtomicOwnershipRegistry, the owner to record is returned so that it is
+// written in the same change as the record; otherwise, the owner is recorded
+// before the record is published and the empty string is returned.  If the
+// record cannot be claimed, the Published condition and an error are returned.
+func (r *reconciler) claimRecordOwnership(ctx context.Context, zone configv1.DNSZone, record *iov1.DNSRecord) (string, iov1.DNSZoneCondition, error) {
+	registry, ok := r.dnsProvider.(dns.OwnershipRegistry)
+	if !ok {
+		return "", iov1.DNSZoneCondition{}, nil
+	}
+	callCtx, cancel := context.WithTimeout(ctx, providerCallTimeout)
+	defer cancel()
+	condition := iov1.DNSZoneCondition{
+		Status:             string(operatorv1.ConditionFalse),
+		Type:               iov1.DNSRecordPublishedConditionType,
+		LastTransitionTime: metav1.Now(),
+	}
+	desired := r.recordOwner(record)
+	var owner string
+	err := r.waitForRateLimit(callCtx)
+	if err == nil {
+		owner, err = registry.GetOwner(callCtx, record, zone)
+	}
+	if err != nil {
+		log.Error(err, "failed to look up owner of DNS record in zone", "record", record.Spec, "dnszone", zone)
+		condition.Reason = "ProviderError"
+		condition.Message = fmt.Sprintf("The DNS provider failed to look up the owner of the record: %v", err)
+		return "", condition, err
+	}
+	switch owner {
+	case desired:
+		return "", iov1.DNSZoneCondition{}, nil
+	case "":
+		if recordIsAlreadyPublishedToZone(record, &zone) || dns.AdoptsUnownedRecord(record) {
+			break
+		}
+		var exists bool
+		err := r.waitForRateLimit(callCtx)
+		if err == nil {
+			exists, err = registry.RecordExists(callCtx, record, zone)
+		}
+		if err != nil {
+			log.Error(err, "failed to look up existing DNS record in zone", "record", record.Spec, "dnszone", zone)
+			condition.Reason = "ProviderError"
+			condition.Message = fmt.Sprintf("The DNS provider failed to look up existing records: %v", err)
+			return "", condition, err
+		}
+		if exists {
+			err := fmt.Errorf("record %s exists without an owner", record.Spec.DNSName)
+			log.Error(err, "refusing to overwrite DNS record without an owner", "record", record.Spec, "dnszone", zone)
+			condition.Reason = "UnownedRecordExists"
+			condition.Message = fmt.Sprintf("A record with the same name exists in the zone without an owner and will not be overwritten unless the dnsrecord has the %s=true annotation", dns.AdoptUnownedRecordAnnotation)
+			return "", condition, err
+		}
+	default:
+		err := fmt.Errorf("record %s is owned by %q", record.Spec.DNSName, owner)
+		log.Error(err, "refusing to modify DNS record owned by another owner", "record", record.Spec, "dnszone", zone, "owner", desired)
+		condition.Reason = "OwnershipConflict"
+		condition.Message = fmt.Sprintf("The record is owned by %q and will not be modified by %q", owner, desired)
+		return "", condition, err
+	}
+	if _, ok := registry.(dns.AtomicOwnershipRegistry); ok {
+		return desired, iov1.DNSZoneCondition{}, nil
+	}
+	err = r.waitForRateLimit(callCtx)
+	if err == nil {
+		err = registry.SetOwner(callCtx, record, zone, desired)
+	}
+	if err != nil {
+		log.Error(err, "failed to record owner of DNS record in zone", "record", record.Spec, "dnszone", zone)
+		condition.Reason = "ProviderError"
+		condition.Message = fmt.Sprintf("The DNS provider failed to record the owner of the record: %v", err)
+		return "", condition, err
+	}
+	return "", iov1.DNSZoneCondition{}, nil
+}
+
 // publishRecordToZones attempts to publish records and returns a bool
 // indicating if we need to requeue due to errors and list of latest DNS Zone status.
-func (r *reconciler) publishRecordToZones(zones []configv1.DNSZone, record *iov1.DNSRecord) (bool, []iov1.DNSZoneStatus) {
+func (r *reconciler) publishRecordToZones(ctx context.Context, zones []configv1.DNSZone, record *iov1.DNSRecord) (bool, []iov1.DNSZoneStatus) {
 	var statuses []iov1.DNSZoneStatus
 	var requeue bool
 	dnsPolicy := record.Spec.DNSManagementPolicy
@@ -353,10 +736,16 @@ func (r *reconciler) publishRecordToZones(zones []configv1.DNSZone, record *iov1
 				Type:               iov1.DNSRecordPublishedConditionType,
 				LastTransitionTime: metav1.Now(),
 			}
-		} else if isRecordPublished {
-			condition, err = r.replacePublishedRecord(zones[i], record)
 		} else {
-			condition, err = r.publishRecord(zones[i], record)
+			start := time.Now()
+			if isRecordPublished {
+				condition, err = r.replacePublishedRecord(ctx, zones[i], record)
+			} else {
+				condition, err = r.publishRecord(ctx, zones[i], record)
+			}
+			if err == nil {
+				dnsPublishDuration.WithLabelValues(zoneLabel(zones[i])).Observe(time.Since(start).Seconds())
+			}
 		}
 
 		// Check if replacing or publishing record resulted in an error.
@@ -365,15 +754,80 @@ func (r *reconciler) publishRecordToZones(zones []configv1.DNSZone, record *iov1
 			requeue = true
 		}
 
+		conditions := []iov1.DNSZoneCondition{condition}
+		if dnsPolicy != iov1.UnmanagedDNS && err == nil {
+			if adjuster, ok := r.dnsProvider.(dns.TTLAdjuster); ok {
+				conditions = append(conditions, computeTTLAdjustedCondition(ctx, adjuster, zones[i], record))
+			}
+			conditions = append(conditions, r.computeOwnershipTrackedCondition())
+		}
+
 		statuses = append(statuses, iov1.DNSZoneStatus{
 			DNSZone:    zones[i],
-			Conditions: []iov1.DNSZoneCondition{condition},
+			Conditions: conditions,
 		})
 	}
 
 	return requeue, mergeStatuses(zones, record.Status.DeepCopy().Zones, statuses)
 }
 
+// invalidRecordZoneStatuses returns the zone statuses for the given record,
+// which failed validation with the given error, indicating that the record is
+// not published to any of the given zones.
+func invalidRecordZoneStatuses(zones []configv1.DNSZone, record *iov1.DNSRecord, err error) []iov1.DNSZoneStatus {
+	var statuses []iov1.DNSZoneStatus
+	for i := range zones {
+		statuses = append(statuses, iov1.DNSZoneStatus{
+			DNSZone: zones[i],
+			Conditions: []iov1.DNSZoneCondition{{
+				Type:    iov1.DNSRecordPublishedConditionType,
+				Status:  string(operatorv1.ConditionFalse),
+				Reason:  "InvalidRecord",
+				Message: fmt.Sprintf("The DNS record is invalid: %v", err),
+			}},
+		})
+	}
+	return mergeStatuses(zones, record.Status.DeepCopy().Zones, statuses)
+}
+
+// computeTTLAdjustedCondition returns the TTLAdjusted condition for the given
+// record in the given zone.
+func computeTTLAdjustedCondition(ctx context.Context, adjuster dns.TTLAdjuster, zone configv1.DNSZone, record *iov1.DNSRecord) iov1.DNSZoneCondition {
+	condition := iov1.DNSZoneCondition{
+		Type:               DNSRecordTTLAdjustedConditionType,
+		LastTransitionTime: metav1.Now(),
+	}
+	if ttl := adjuster.PublishedTTL(ctx, record, zone); ttl != record.Spec.RecordTTL {
+		condition.Status = string(operatorv1.ConditionTrue)
+		condition.Reason = "ProviderTTLConstraint"
+		condition.Message = fmt.Sprintf("The DNS provider does not support a TTL of %d seconds for the record and published it with a TTL of %d seconds", record.Spec.RecordTTL, ttl)
+	} else {
+		condition.Status = string(operatorv1.ConditionFalse)
+		condition.Reason = "AsRequested"
+		condition.Message = "The DNS provider published the record with the requested TTL"
+	}
+	return condition
+}
+
+// computeOwnershipTrackedCondition returns the OwnershipTracked condition for a
+// record that the DNS provider published.
+func (r *reconciler) computeOwnershipTrackedCondition() iov1.DNSZoneCondition {
+	condition := iov1.DNSZoneCondition{
+		Type:               DNSRecordOwnershipTrackedConditionType,
+		LastTransitionTime: metav1.Now(),
+	}
+	if _, ok := r.dnsProvider.(dns.OwnershipRegistry); ok {
+		condition.Status = string(operatorv1.ConditionTrue)
+		condition.Reason = "OwnerRecorded"
+		condition.Message = "The DNS provider records the owner of the record in a companion TXT record"
+	} else {
+		condition.Status = string(operatorv1.ConditionFalse)
+		condition.Reason = "Unsupported"
+		condition.Message = "The DNS provider does not support recording the owner of the record, so the operator cannot detect whether another cluster or tool manages a record with the same name"
+	}
+	return condition
+}
+
 // recordIsAlreadyPublishedToZone returns a Boolean value indicating whether the
 // given DNSRecord is already published to the given zone, as determined from
 // the DNSRecord's status conditions.
@@ -393,7 +847,7 @@ func recordIsAlreadyPublishedToZone(record *iov1.DNSRecord, zoneToPublish *confi
 	return false
 }
 
-func (r *reconciler) delete(record *iov1.DNSRecord) error {
+func (r *reconciler) delete(ctx context.Context, record *iov1.DNSRecord) error {
 	var errs []error
 	for i := range record.Status.Zones {
 		zone := record.Status.Zones[i].DNSZone
@@ -402,11 +856,39 @@ func (r *reconciler) delete(record *iov1.DNSRecord) error {
 		if !recordIsAlreadyPublishedToZone(record, &zone) {
 			continue
 		}
-		err := r.dnsProvider.Delete(record, zone)
+		// Never delete a record that another owner has claimed.
+		registry, hasRegistry := r.dnsProvider.(dns.OwnershipRegistry)
+		if hasRegistry {
+			var owner string
+			err := r.waitForRateLimit(ctx)
+			if err == nil {
+				owner, err = registry.GetOwner(ctx, record, zone)
+			}
+			if err != nil {
+				errs = append(errs, fmt.Errorf("failed to look up owner of dnsrecord %s: %w", record.Name, err))
+				continue
+			}
+			if desired := r.recordOwner(record); len(owner) != 0 && owner != desired {
+				log.Info("not deleting dnsrecord owned by another owner from DNS provider", "record", record.Spec, "zone", zone, "owner", owner)
+				continue
+			}
+		}
+		callCtx, cancel := context.WithTimeout(ctx, providerCallTimeout)
+		err := r.publisher().Delete(callCtx, record, zone)
+		cancel()
 		if err != nil {
 			errs = append(errs, err)
-		} else {
-			log.Info("deleted dnsrecord from DNS provider", "record", record.Spec, "zone", zone)
+			continue
+		}
+		log.Info("deleted dnsrecord from DNS provider", "record", record.Spec, "zone", zone)
+		if hasRegistry {
+			err := r.waitForRateLimit(ctx)
+			if err == nil {
+				err = registry.DeleteOwner(ctx, record, zone)
+			}
+			if err != nil {
+				errs = append(errs, fmt.Errorf("failed to delete owner of dnsrecord %s: %w", record.Name, err))
+			}
 		}
 	}
 	if len(errs) == 0 {
@@ -568,11 +1050,14 @@ func dnsZoneStatusSlicesEqual(a, b []iov1.DNSZoneStatus) bool {
 func (r *reconciler) ToDNSRecords(o client.Object) []reconcile.Request {
 	var requests []reconcile.Request
 	records := &iov1.DNSRecordList{}
-	if err := r.cache.List(context.Background(), records, client.InNamespace(r.config.Namespace)); err != nil {
+	if err := r.dnsRecordCache.List(context.Background(), records); err != nil {
 		log.Error(err, "failed to list dnsrecords", "related", o.GetSelfLink())
 		return requests
 	}
 	for _, record := range records.Items {
+		if record.Namespace != r.config.Namespace && !isRequestedRecord(&record) {
+			continue
+		}
 		log.Info("queueing dnsrecord", "name", record.Name, "related", o.GetSelfLink())
 		request := reconcile.Request{
 			NamespacedName: types.NamespacedName{
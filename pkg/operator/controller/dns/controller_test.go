@@ -1,7 +1,9 @@
 package dns
 
 import (
+	"context"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
@@ -9,6 +11,7 @@ import (
 	operatorv1 "github.com/openshift/api/operator/v1"
 	iov1 "github.com/openshift/api/operatoringress/v1"
 	"github.com/openshift/cluster-ingress-operator/pkg/dns"
+	"github.com/openshift/cluster-ingress-operator/pkg/manifests"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -36,6 +39,10 @@ func TestPublishRecordToZones(t *testing.T) {
 						Type:   "Published",
 						Status: "True",
 					},
+					{
+						Type:   "OwnershipTracked",
+						Status: "False",
+					},
 				},
 			}},
 		},
@@ -58,6 +65,10 @@ func TestPublishRecordToZones(t *testing.T) {
 							Type:   "Published",
 							Status: "True",
 						},
+						{
+							Type:   "OwnershipTracked",
+							Status: "False",
+						},
 					},
 				},
 				{
@@ -67,6 +78,10 @@ func TestPublishRecordToZones(t *testing.T) {
 							Type:   "Published",
 							Status: "True",
 						},
+						{
+							Type:   "OwnershipTracked",
+							Status: "False",
+						},
 					},
 				},
 			},
@@ -118,7 +133,7 @@ func TestPublishRecordToZones(t *testing.T) {
 			dnsProvider: &dns.FakeProvider{},
 		}
 
-		_, actual := r.publishRecordToZones(test.zones, record)
+		_, actual := r.publishRecordToZones(context.Background(), test.zones, record)
 		opts := cmpopts.IgnoreFields(iov1.DNSZoneCondition{}, "Reason", "Message", "LastTransitionTime")
 		if !cmp.Equal(actual, test.expect, opts) {
 			t.Fatalf("%q: found diff between actual and expected:\n%s", test.name, cmp.Diff(actual, test.expect, opts))
@@ -215,7 +230,7 @@ func TestPublishRecordToZonesMergesStatus(t *testing.T) {
 		r := &reconciler{dnsProvider: &dns.FakeProvider{}}
 		zone := []configv1.DNSZone{{ID: "zone2"}}
 		oldStatuses := record.Status.DeepCopy().Zones
-		_, newStatuses := r.publishRecordToZones(zone, record)
+		_, newStatuses := r.publishRecordToZones(context.Background(), zone, record)
 		if !dnsZoneStatusSlicesEqual(oldStatuses, tc.oldZoneStatuses) {
 			t.Fatalf("%q: publishRecordToZones mutated the record's status conditions\nold: %#v\nnew: %#v", tc.description, oldStatuses, tc.oldZoneStatuses)
 		}
@@ -225,6 +240,93 @@ func TestPublishRecordToZonesMergesStatus(t *testing.T) {
 	}
 }
 
+// fakeTTLAdjustingProvider is a fake dns.Provider that publishes records with
+// a TTL of at least minTTL.
+type fakeTTLAdjustingProvider struct {
+	dns.FakeProvider
+	minTTL int64
+}
+
+func (p *fakeTTLAdjustingProvider) PublishedTTL(ctx context.Context, record *iov1.DNSRecord, zone configv1.DNSZone) int64 {
+	if record.Spec.RecordTTL < p.minTTL {
+		return p.minTTL
+	}
+	return record.Spec.RecordTTL
+}
+
+// TestPublishRecordToZonesReportsTTLAdjustment verifies that
+// publishRecordToZones reports the TTLAdjusted condition for providers that
+// implement dns.TTLAdjuster.
+func TestPublishRecordToZonesReportsTTLAdjustment(t *testing.T) {
+	testCases := []struct {
+		description string
+		provider    dns.Provider
+		ttl         int64
+		expect      []iov1.DNSZoneCondition
+	}{
+		{
+			description: "provider that does not adjust TTLs",
+			provider:    &dns.FakeProvider{},
+			ttl:         30,
+			expect: []iov1.DNSZoneCondition{
+				{Type: "Published", Status: "True"},
+				{Type: "OwnershipTracked", Status: "False", Reason: "Unsupported"},
+			},
+		},
+		{
+			description: "TTL within the provider's range",
+			provider:    &fakeTTLAdjustingProvider{minTTL: 600},
+			ttl:         3600,
+			expect: []iov1.DNSZoneCondition{
+				{Type: "Published", Status: "True"},
+				{Type: "TTLAdjusted", Status: "False", Reason: "AsRequested"},
+				{Type: "OwnershipTracked", Status: "False", Reason: "Unsupported"},
+			},
+		},
+		{
+			description: "TTL below the provider's minimum",
+			provider:    &fakeTTLAdjustingProvider{minTTL: 600},
+			ttl:         30,
+			expect: []iov1.DNSZoneCondition{
+				{Type: "Published", Status: "True"},
+				{Type: "TTLAdjusted", Status: "True", Reason: "ProviderTTLConstraint"},
+				{Type: "OwnershipTracked", Status: "False", Reason: "Unsupported"},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			record := &iov1.DNSRecord{
+				Spec: iov1.DNSRecordSpec{
+					DNSName:             "subdomain.dnszone.io.",
+					RecordType:          iov1.ARecordType,
+					DNSManagementPolicy: iov1.ManagedDNS,
+					Targets:             []string{"55.11.22.33"},
+					RecordTTL:           tc.ttl,
+				},
+			}
+			r := &reconciler{dnsProvider: tc.provider}
+			_, statuses := r.publishRecordToZones(context.Background(), []configv1.DNSZone{{ID: "zone1"}}, record)
+			if len(statuses) != 1 {
+				t.Fatalf("expected 1 zone status, got %d", len(statuses))
+			}
+			opts := cmpopts.IgnoreFields(iov1.DNSZoneCondition{}, "Message", "LastTransitionTime")
+			ignoreReason := cmpopts.IgnoreFields(iov1.DNSZoneCondition{}, "Reason")
+			actual := statuses[0].Conditions
+			if len(actual) != len(tc.expect) {
+				t.Fatalf("expected %d conditions, got %#v", len(tc.expect), actual)
+			}
+			if !cmp.Equal(actual[0], tc.expect[0], opts, ignoreReason) {
+				t.Errorf("unexpected Published condition:\n%s", cmp.Diff(actual[0], tc.expect[0], opts, ignoreReason))
+			}
+			if !cmp.Equal(actual[1:], tc.expect[1:], opts, cmpopts.EquateEmpty()) {
+				t.Errorf("unexpected TTLAdjusted condition:\n%s", cmp.Diff(actual[1:], tc.expect[1:], opts, cmpopts.EquateEmpty()))
+			}
+		})
+	}
+}
+
 func TestMigrateDNSRecordStatus(t *testing.T) {
 	tests := []struct {
 		name       string
@@ -827,3 +929,369 @@ func TestCustomCABundle(t *testing.T) {
 		})
 	}
 }
+
+// fakeOwnershipRegistryProvider is a fake dns.Provider that stores the owners
+// of records by zone ID, reports the zones in existing as having a record, and
+// records which zones records were deleted from.
+type fakeOwnershipRegistryProvider struct {
+	dns.FakeProvider
+	owners        map[string]string
+	existing      map[string]bool
+	deleted       []string
+	setOwnerCalls int
+}
+
+func (p *fakeOwnershipRegistryProvider) Delete(ctx context.Context, record *iov1.DNSRecord, zone configv1.DNSZone) error {
+	p.deleted = append(p.deleted, zone.ID)
+	return nil
+}
+
+func (p *fakeOwnershipRegistryProvider) GetOwner(ctx context.Context, record *iov1.DNSRecord, zone configv1.DNSZone) (string, error) {
+	return p.owners[zone.ID], nil
+}
+
+func (p *fakeOwnershipRegistryProvider) SetOwner(ctx context.Context, record *iov1.DNSRecord, zone configv1.DNSZone, owner string) error {
+	p.setOwnerCalls++
+	p.owners[zone.ID] = owner
+	return nil
+}
+
+func (p *fakeOwnershipRegistryProvider) DeleteOwner(ctx context.Context, record *iov1.DNSRecord, zone configv1.DNSZone) error {
+	delete(p.owners, zone.ID)
+	return nil
+}
+
+func (p *fakeOwnershipRegistryProvider) RecordExists(ctx context.Context, record *iov1.DNSRecord, zone configv1.DNSZone) (bool, error) {
+	return p.existing[zone.ID], nil
+}
+
+// TestRecordOwnership verifies that publishRecordToZones claims records that
+// have no owner and do not exist yet, reports an OwnershipConflict for records
+// that another owner has claimed, refuses to overwrite an existing record
+// without an owner unless the dnsrecord opts in to adopting it, and that
+// delete deletes only the records that the operator owns.
+func TestRecordOwnership(t *testing.T) {
+	infraConfig := &configv1.Infrastructure{
+		Status: configv1.InfrastructureStatus{InfrastructureName: "cluster-a"},
+	}
+	ours := dns.RecordOwner("cluster-a", "default")
+	theirs := dns.RecordOwner("cluster-b", "default")
+	provider := &fakeOwnershipRegistryProvider{
+		owners:   map[string]string{"zone2": ours, "zone3": theirs},
+		existing: map[string]bool{"zone2": true, "zone3": true, "zone4": true},
+	}
+	r := &reconciler{dnsProvider: provider, infraConfig: infraConfig}
+	record := &iov1.DNSRecord{
+		ObjectMeta: metav1.ObjectMeta{
+			Labels: map[string]string{manifests.OwningIngressControllerLabel: "default"},
+		},
+		Spec: iov1.DNSRecordSpec{
+			DNSName:             "*.apps.dnszone.io.",
+			RecordType:          iov1.ARecordType,
+			DNSManagementPolicy: iov1.ManagedDNS,
+			Targets:             []string{"55.11.22.33"},
+			RecordTTL:           30,
+		},
+	}
+	zones := []configv1.DNSZone{{ID: "zone1"}, {ID: "zone2"}, {ID: "zone3"}, {ID: "zone4"}}
+
+	requeue, statuses := r.publishRecordToZones(context.Background(), zones, record)
+	if !requeue {
+		t.Error("expected requeue because of the ownership conflict")
+	}
+	expected := map[string]string{"zone1": "ProviderSuccess", "zone2": "ProviderSuccess", "zone3": "OwnershipConflict", "zone4": "UnownedRecordExists"}
+	for _, status := range statuses {
+		condition := status.Conditions[0]
+		if condition.Reason != expected[status.DNSZone.ID] {
+			t.Errorf("expected reason %s for zone %s, got %#v", expected[status.DNSZone.ID], status.DNSZone.ID, condition)
+		}
+	}
+	if !cmp.Equal(provider.owners, map[string]string{"zone1": ours, "zone2": ours, "zone3": theirs}) {
+		t.Errorf("unexpected owners: %v", provider.owners)
+	}
+
+	// The annotation allows the operator to adopt the existing record.
+	record.Annotations = map[string]string{dns.AdoptUnownedRecordAnnotation: "true"}
+	_, statuses = r.publishRecordToZones(context.Background(), zones, record)
+	for _, status := range statuses {
+		if status.DNSZone.ID == "zone4" && status.Conditions[0].Reason != "ProviderSuccess" {
+			t.Errorf("expected reason ProviderSuccess for zone4, got %#v", status.Conditions[0])
+		}
+	}
+	if provider.owners["zone4"] != ours {
+		t.Errorf("expected zone4 owner to be %q, got %v", ours, provider.owners)
+	}
+
+	// Mark the record as published in all zones so that delete tries to
+	// delete it from all zones.
+	for i := range statuses {
+		statuses[i].Conditions[0].Status = string(operatorv1.ConditionTrue)
+	}
+	record.Status.Zones = statuses
+	if err := r.delete(context.Background(), record); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !cmp.Equal(provider.deleted, []string{"zone1", "zone2", "zone4"}) {
+		t.Errorf("expected record to be deleted from zone1, zone2, and zone4, got %v", provider.deleted)
+	}
+	if !cmp.Equal(provider.owners, map[string]string{"zone3": theirs}) {
+		t.Errorf("expected only zone3 owner to remain, got %v", provider.owners)
+	}
+}
+
+// fakeAtomicOwnershipRegistryProvider is a fakeOwnershipRegistryProvider that
+// records owners in the same call that publishes records.
+type fakeAtomicOwnershipRegistryProvider struct {
+	fakeOwnershipRegistryProvider
+}
+
+func (p *fakeAtomicOwnershipRegistryProvider) EnsureWithOwner(ctx context.Context, record *iov1.DNSRecord, zone configv1.DNSZone, owner string) error {
+	p.owners[zone.ID] = owner
+	return nil
+}
+
+func (p *fakeAtomicOwnershipRegistryProvider) ReplaceWithOwner(ctx context.Context, record *iov1.DNSRecord, zone configv1.DNSZone, owner string) error {
+	p.owners[zone.ID] = owner
+	return nil
+}
+
+// TestAtomicRecordOwnership verifies that publishRecordToZones records the
+// owner of a record in the same call that publishes the record if the DNS
+// provider implements dns.AtomicOwnershipRegistry.
+func TestAtomicRecordOwnership(t *testing.T) {
+	infraConfig := &configv1.Infrastructure{
+		Status: configv1.InfrastructureStatus{InfrastructureName: "cluster-a"},
+	}
+	provider := &fakeAtomicOwnershipRegistryProvider{
+		fakeOwnershipRegistryProvider{owners: map[string]string{}},
+	}
+	for _, batching := range []bool{false, true} {
+		r := &reconciler{dnsProvider: provider, infraConfig: infraConfig}
+		if batching {
+			r.batchingProvider = dns.NewBatchingProvider(provider, dns.BatchConfig{Interval: time.Millisecond})
+		}
+		record := &iov1.DNSRecord{
+			ObjectMeta: metav1.ObjectMeta{
+				Labels: map[string]string{manifests.OwningIngressControllerLabel: "default"},
+			},
+			Spec: iov1.DNSRecordSpec{
+				DNSName:             "*.apps.dnszone.io.",
+				RecordType:          iov1.ARecordType,
+				DNSManagementPolicy: iov1.ManagedDNS,
+				Targets:             []string{"55.11.22.33"},
+				RecordTTL:           30,
+			},
+		}
+		delete(provider.owners, "zone1")
+		requeue, statuses := r.publishRecordToZones(context.Background(), []configv1.DNSZone{{ID: "zone1"}}, record)
+		if requeue || len(statuses) != 1 || statuses[0].Conditions[0].Reason != "ProviderSuccess" {
+			t.Fatalf("expected the record to be published, got %#v", statuses)
+		}
+		if owner := provider.owners["zone1"]; owner != dns.RecordOwner("cluster-a", "default") {
+			t.Errorf("expected the owner to be recorded, got %q", owner)
+		}
+		if provider.setOwnerCalls != 0 {
+			t.Errorf("expected SetOwner not to be called, got %d calls", provider.setOwnerCalls)
+		}
+	}
+}
+
+// fakeTypedOwnershipRegistryProvider is a fake dns.Provider that stores the
+// owners of records by zone ID and companion TXT record name, which includes
+// the record type.
+type fakeTypedOwnershipRegistryProvider struct {
+	dns.FakeProvider
+	owners map[string]string
+}
+
+func (p *fakeTypedOwnershipRegistryProvider) key(record *iov1.DNSRecord, zone configv1.DNSZone) string {
+	return zone.ID + "/" + dns.OwnershipRecordName(record)
+}
+
+func (p *fakeTypedOwnershipRegistryProvider) GetOwner(ctx context.Context, record *iov1.DNSRecord, zone configv1.DNSZone) (string, error) {
+	return p.owners[p.key(record, zone)], nil
+}
+
+func (p *fakeTypedOwnershipRegistryProvider) SetOwner(ctx context.Context, record *iov1.DNSRecord, zone configv1.DNSZone, owner string) error {
+	p.owners[p.key(record, zone)] = owner
+	return nil
+}
+
+func (p *fakeTypedOwnershipRegistryProvider) DeleteOwner(ctx context.Context, record *iov1.DNSRecord, zone configv1.DNSZone) error {
+	delete(p.owners, p.key(record, zone))
+	return nil
+}
+
+func (p *fakeTypedOwnershipRegistryProvider) RecordExists(ctx context.Context, record *iov1.DNSRecord, zone configv1.DNSZone) (bool, error) {
+	return true, nil
+}
+
+// TestReplaceDeletesReplacedOwner verifies that when publishRecordToZones
+// replaces a published record with a record of another type, it deletes the
+// companion TXT record of the replaced record if the operator owns it and
+// leaves it alone otherwise.
+func TestReplaceDeletesReplacedOwner(t *testing.T) {
+	infraConfig := &configv1.Infrastructure{
+		Status: configv1.InfrastructureStatus{InfrastructureName: "cluster-a"},
+	}
+	ours := dns.RecordOwner("cluster-a", "default")
+	theirs := dns.RecordOwner("cluster-b", "default")
+	zones := []configv1.DNSZone{{ID: "zone1"}, {ID: "zone2"}}
+	record := &iov1.DNSRecord{
+		ObjectMeta: metav1.ObjectMeta{
+			Generation: 2,
+			Labels:     map[string]string{manifests.OwningIngressControllerLabel: "default"},
+		},
+		Spec: iov1.DNSRecordSpec{
+			DNSName:             "*.apps.dnszone.io.",
+			RecordType:          iov1.CNAMERecordType,
+			DNSManagementPolicy: iov1.ManagedDNS,
+			Targets:             []string{"lb.dnszone.io"},
+			RecordTTL:           30,
+		},
+		Status: iov1.DNSRecordStatus{ObservedGeneration: 1},
+	}
+	for _, zone := range zones {
+		record.Status.Zones = append(record.Status.Zones, iov1.DNSZoneStatus{
+			DNSZone: zone,
+			Conditions: []iov1.DNSZoneCondition{{
+				Type:   iov1.DNSRecordPublishedConditionType,
+				Status: string(operatorv1.ConditionTrue),
+			}},
+		})
+	}
+	replaced := record.DeepCopy()
+	replaced.Spec.RecordType = iov1.ARecordType
+	provider := &fakeTypedOwnershipRegistryProvider{owners: map[string]string{
+		"zone1/" + dns.OwnershipRecordName(replaced): ours,
+		"zone2/" + dns.OwnershipRecordName(replaced): theirs,
+	}}
+	r := &reconciler{dnsProvider: provider, infraConfig: infraConfig}
+
+	requeue, statuses := r.publishRecordToZones(context.Background(), zones, record)
+	if requeue {
+		t.Errorf("expected no requeue, got %#v", statuses)
+	}
+	for _, status := range statuses {
+		if status.Conditions[0].Reason != "ProviderSuccess" {
+			t.Errorf("expected reason ProviderSuccess for zone %s, got %#v", status.DNSZone.ID, status.Conditions[0])
+		}
+	}
+	expected := map[string]string{
+		"zone1/" + dns.OwnershipRecordName(record):   ours,
+		"zone2/" + dns.OwnershipRecordName(record):   ours,
+		"zone2/" + dns.OwnershipRecordName(replaced): theirs,
+	}
+	if !cmp.Equal(provider.owners, expected) {
+		t.Errorf("unexpected owners: %s", cmp.Diff(expected, provider.owners))
+	}
+}
+
+// TestRecordOwner verifies that recordOwner identifies records that
+// ingresscontrollers own by the ingresscontroller and other requested records
+// by the dnsrecord, and that isRequestedRecord recognizes requested records.
+func TestRecordOwner(t *testing.T) {
+	ownerRef := metav1.OwnerReference{APIVersion: "v1", Kind: "Service", Name: "foo", UID: "1"}
+	testCases := []struct {
+		description     string
+		namespace       string
+		labels          map[string]string
+		ownerReferences []metav1.OwnerReference
+		expectRequested bool
+		expectOwner     string
+	}{
+		{
+			description: "ingresscontroller's record",
+			namespace:   "openshift-ingress-operator",
+			labels:      map[string]string{manifests.OwningIngressControllerLabel: "default"},
+			expectOwner: "infra-id=cluster-a,ingresscontroller=default",
+		},
+		{
+			description:     "record with owner label in another namespace",
+			namespace:       "app",
+			labels:          map[string]string{manifests.OwningIngressControllerLabel: "default", manifests.ManagedDNSRecordLabel: "true"},
+			expectRequested: true,
+			expectOwner:     "infra-id=cluster-a,dnsrecord=app/foo",
+		},
+		{
+			description:     "record with owner reference",
+			namespace:       "app",
+			ownerReferences: []metav1.OwnerReference{ownerRef},
+			expectRequested: true,
+			expectOwner:     "infra-id=cluster-a,dnsrecord=app/foo",
+		},
+		{
+			description: "record with managed label set to false",
+			namespace:   "app",
+			labels:      map[string]string{manifests.ManagedDNSRecordLabel: "false"},
+			expectOwner: "infra-id=cluster-a,dnsrecord=app/foo",
+		},
+	}
+
+	r := &reconciler{
+		config: Config{Namespace: "openshift-ingress-operator"},
+		infraConfig: &configv1.Infrastructure{
+			Status: configv1.InfrastructureStatus{InfrastructureName: "cluster-a"},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			record := &iov1.DNSRecord{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace:       tc.namespace,
+					Name:            "foo",
+					Labels:          tc.labels,
+					OwnerReferences: tc.ownerReferences,
+				},
+			}
+			if requested := isRequestedRecord(record); requested != tc.expectRequested {
+				t.Errorf("expected isRequestedRecord to return %t, got %t", tc.expectRequested, requested)
+			}
+			if owner := r.recordOwner(record); owner != tc.expectOwner {
+				t.Errorf("expected owner %q, got %q", tc.expectOwner, owner)
+			}
+		})
+	}
+}
+
+// fakeBlockingProvider is a dns.Provider whose calls block until their
+// contexts are done.
+type fakeBlockingProvider struct {
+	dns.FakeProvider
+}
+
+func (p *fakeBlockingProvider) Ensure(ctx context.Context, record *iov1.DNSRecord, zone configv1.DNSZone) error {
+	<-ctx.Done()
+	return ctx.Err()
+}
+
+// TestPublishRecordToZonesReportsProviderTimeout verifies that
+// publishRecordToZones reports a retryable Published condition with status
+// Unknown when the DNS provider does not respond before the call's context is
+// done.
+func TestPublishRecordToZonesReportsProviderTimeout(t *testing.T) {
+	record := &iov1.DNSRecord{
+		Spec: iov1.DNSRecordSpec{
+			DNSName:             "subdomain.dnszone.io.",
+			RecordType:          iov1.ARecordType,
+			DNSManagementPolicy: iov1.ManagedDNS,
+			Targets:             []string{"55.11.22.33"},
+			RecordTTL:           30,
+		},
+	}
+	r := &reconciler{dnsProvider: &fakeBlockingProvider{}}
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	requeue, statuses := r.publishRecordToZones(ctx, []configv1.DNSZone{{ID: "zone1"}}, record)
+	if !requeue {
+		t.Error("expected requeue")
+	}
+	if len(statuses) != 1 || len(statuses[0].Conditions) != 1 {
+		t.Fatalf("expected 1 zone status with 1 condition, got %#v", statuses)
+	}
+	expected := iov1.DNSZoneCondition{Type: "Published", Status: "Unknown", Reason: "ProviderTimeout"}
+	opts := cmpopts.IgnoreFields(iov1.DNSZoneCondition{}, "Message", "LastTransitionTime")
+	if diff := cmp.Diff(expected, statuses[0].Conditions[0], opts); diff != "" {
+		t.Errorf("unexpected condition (-want +got):\n%s", diff)
+	}
+}
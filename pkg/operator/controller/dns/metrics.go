@@ -0,0 +1,45 @@
+package dns
+
+import (
+	"fmt"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	configv1 "github.com/openshift/api/config/v1"
+)
+
+var (
+	// dnsPublishDuration observes how long publishing a DNS record to each
+	// zone took, including the time that the change was queued before it
+	// was passed to the DNS provider.
+	dnsPublishDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "ingress_operator_dns_publish_duration_seconds",
+		Help:    "Report how long publishing DNS records to each zone took.",
+		Buckets: prometheus.ExponentialBuckets(0.25, 2, 10),
+	}, []string{"zone"})
+
+	// metricsList is a list of metrics for this package.
+	metricsList = []prometheus.Collector{
+		dnsPublishDuration,
+	}
+)
+
+// zoneLabel returns the value of the zone label for the given zone: its ID if
+// it has one and its tags otherwise.
+func zoneLabel(zone configv1.DNSZone) string {
+	if len(zone.ID) != 0 {
+		return zone.ID
+	}
+	return fmt.Sprintf("%v", zone.Tags)
+}
+
+// RegisterMetrics calls prometheus.Register on each metric in metricsList, and
+// returns on errors.
+func RegisterMetrics() error {
+	for _, metric := range metricsList {
+		if err := prometheus.Register(metric); err != nil {
+			return err
+		}
+	}
+	return nil
+}
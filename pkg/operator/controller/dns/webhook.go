@@ -0,0 +1,234 @@
+package dns
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"reflect"
+	"strings"
+
+	operatorv1 "github.com/openshift/api/operator/v1"
+	iov1 "github.com/openshift/api/operatoringress/v1"
+	routev1 "github.com/openshift/api/route/v1"
+
+	"github.com/openshift/cluster-ingress-operator/pkg/manifests"
+
+	corev1 "k8s.io/api/core/v1"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+// ValidatingWebhookPath is the path on which the operator's webhook server
+// serves the validating admission webhook for dnsrecords.  It must match the
+// path in the operator's ValidatingWebhookConfiguration manifest.
+const ValidatingWebhookPath = "/validate-ingress-operator-openshift-io-v1-dnsrecord"
+
+// NewValidatingWebhook returns a validating admission webhook that rejects
+// dnsrecords that the reconciler would not publish.  The reconciler still
+// validates dnsrecords, so dnsrecords that are created while the webhook is
+// unavailable are reported as not published when they are reconciled.  The
+// given reader is used to look up namespaces and the ingresscontrollers in the
+// given operator namespace, and the given route reader is used to look up
+// routes in all namespaces.
+func NewValidatingWebhook(reader, routeReader client.Reader, namespace string) *admission.Webhook {
+	return admission.WithCustomValidator(&iov1.DNSRecord{}, &dnsRecordValidator{
+		reader:      reader,
+		routeReader: routeReader,
+		namespace:   namespace,
+	})
+}
+
+// dnsRecordValidator validates dnsrecords on behalf of the validating
+// admission webhook.
+type dnsRecordValidator struct {
+	reader      client.Reader
+	routeReader client.Reader
+	namespace   string
+}
+
+// ValidateCreate validates a new dnsrecord.
+func (v *dnsRecordValidator) ValidateCreate(ctx context.Context, obj runtime.Object) error {
+	record, ok := obj.(*iov1.DNSRecord)
+	if !ok {
+		return fmt.Errorf("expected a dnsrecord, got %T", obj)
+	}
+	return v.validate(ctx, record)
+}
+
+// ValidateUpdate validates an updated dnsrecord.  Updates that change
+// neither the spec, the labels, nor the annotations, such as updates of
+// finalizers, and updates of dnsrecords that are being deleted are always
+// allowed so that an invalid dnsrecord can still be finalized.
+func (v *dnsRecordValidator) ValidateUpdate(ctx context.Context, oldObj, newObj runtime.Object) error {
+	old, ok := oldObj.(*iov1.DNSRecord)
+	if !ok {
+		return fmt.Errorf("expected a dnsrecord, got %T", oldObj)
+	}
+	record, ok := newObj.(*iov1.DNSRecord)
+	if !ok {
+		return fmt.Errorf("expected a dnsrecord, got %T", newObj)
+	}
+	if record.DeletionTimestamp != nil || (reflect.DeepEqual(old.Spec, record.Spec) && reflect.DeepEqual(old.Labels, record.Labels) && reflect.DeepEqual(old.Annotations, record.Annotations)) {
+		return nil
+	}
+	return v.validate(ctx, record)
+}
+
+// ValidateDelete allows the deletion of any dnsrecord.
+func (v *dnsRecordValidator) ValidateDelete(ctx context.Context, obj runtime.Object) error {
+	return nil
+}
+
+// validate validates the given dnsrecord and, unless an ingresscontroller owns
+// the dnsrecord, checks that the dnsrecord's namespace and DNS name are
+// allowed.
+func (v *dnsRecordValidator) validate(ctx context.Context, record *iov1.DNSRecord) error {
+	if err := validateDNSRecord(record); err != nil {
+		return err
+	}
+	if _, ok := ingressControllerOwner(record, v.namespace); ok {
+		return nil
+	}
+	policy, err := getRequestedRecordPolicy(ctx, v.reader, v.routeReader, v.namespace, record.Namespace)
+	if err != nil {
+		return err
+	}
+	return policy.validate(record)
+}
+
+// requestedRecordPolicy specifies where dnsrecords that no ingresscontroller
+// owns may be published.
+type requestedRecordPolicy struct {
+	// namespaceAllowed indicates whether dnsrecords in the dnsrecord's
+	// namespace may be published.
+	namespaceAllowed bool
+	// domains are the domains of the ingresscontrollers.  A dnsrecord
+	// may be published only if its DNS name is a subdomain of one of them.
+	domains []string
+	// routeHosts maps the hosts of the routes in other namespaces that an
+	// ingresscontroller has admitted to the namespace and name of a route
+	// with the host.  A dnsrecord may not be published for such a host,
+	// which would divert the route's traffic away from the
+	// ingresscontroller.
+	routeHosts map[string]types.NamespacedName
+}
+
+// getRequestedRecordPolicy returns the policy for dnsrecords in the given
+// namespace that no ingresscontroller owns.  Dnsrecords in the operator
+// namespace or in a namespace with AllowDNSRecordsNamespaceLabel are allowed,
+// the ingresscontrollers in the operator namespace determine the allowed
+// domains, and the admitted routes in other namespaces, which the given route
+// reader lists, determine the reserved hosts.
+func getRequestedRecordPolicy(ctx context.Context, reader, routeReader client.Reader, operatorNamespace, namespace string) (*requestedRecordPolicy, error) {
+	policy := &requestedRecordPolicy{
+		namespaceAllowed: namespace == operatorNamespace,
+		routeHosts:       map[string]types.NamespacedName{},
+	}
+	if !policy.namespaceAllowed {
+		ns := &corev1.Namespace{}
+		if err := reader.Get(ctx, types.NamespacedName{Name: namespace}, ns); err != nil {
+			return nil, fmt.Errorf("failed to get namespace %q: %w", namespace, err)
+		}
+		policy.namespaceAllowed = ns.Labels[manifests.AllowDNSRecordsNamespaceLabel] == "true"
+	}
+	ingresses := &operatorv1.IngressControllerList{}
+	if err := reader.List(ctx, ingresses, client.InNamespace(operatorNamespace)); err != nil {
+		return nil, fmt.Errorf("failed to list ingresscontrollers in namespace %q: %w", operatorNamespace, err)
+	}
+	for _, ic := range ingresses.Items {
+		if len(ic.Status.Domain) != 0 {
+			policy.domains = append(policy.domains, strings.ToLower(strings.TrimSuffix(ic.Status.Domain, ".")))
+		}
+	}
+	routes := &routev1.RouteList{}
+	if err := routeReader.List(ctx, routes); err != nil {
+		return nil, fmt.Errorf("failed to list routes: %w", err)
+	}
+	for _, route := range routes.Items {
+		if route.Namespace == namespace {
+			continue
+		}
+		for _, ingress := range route.Status.Ingress {
+			if len(ingress.Host) == 0 || !routeIngressAdmitted(ingress) {
+				continue
+			}
+			host := strings.ToLower(strings.TrimSuffix(ingress.Host, "."))
+			policy.routeHosts[host] = types.NamespacedName{Namespace: route.Namespace, Name: route.Name}
+		}
+	}
+	return policy, nil
+}
+
+// routeIngressAdmitted returns a Boolean value indicating whether the given
+// route ingress reports that the ingresscontroller admitted the route.
+func routeIngressAdmitted(ingress routev1.RouteIngress) bool {
+	for _, cond := range ingress.Conditions {
+		if cond.Type == routev1.RouteAdmitted && cond.Status == corev1.ConditionTrue {
+			return true
+		}
+	}
+	return false
+}
+
+// validate returns an error if the given dnsrecord may not be published
+// according to the policy.  The DNS name must be a proper subdomain of the
+// domain of an ingresscontroller, must not be the wildcard name that the
+// ingresscontroller publishes itself, and must not match the host of an
+// admitted route in another namespace.  A wildcard name matches the hosts
+// that are subdomains of its parent domain.
+func (p *requestedRecordPolicy) validate(record *iov1.DNSRecord) error {
+	if !p.namespaceAllowed {
+		return fmt.Errorf("dnsrecords in namespace %q are not published; the namespace must have the %s=true label", record.Namespace, manifests.AllowDNSRecordsNamespaceLabel)
+	}
+	name := strings.ToLower(strings.TrimSuffix(record.Spec.DNSName, "."))
+	for host, route := range p.routeHosts {
+		if host == name || (strings.HasPrefix(name, "*.") && strings.HasSuffix(host, name[1:])) {
+			return fmt.Errorf("invalid value for spec.dnsName: %q; matches the host %q of route %s in another namespace", record.Spec.DNSName, host, route)
+		}
+	}
+	for _, domain := range p.domains {
+		if strings.HasSuffix(name, "."+domain) && name != "*."+domain {
+			return nil
+		}
+	}
+	return fmt.Errorf("invalid value for spec.dnsName: %q; must be a subdomain of the domain of an ingresscontroller", record.Spec.DNSName)
+}
+
+// validateDNSRecord validates the given dnsrecord and returns an aggregate of
+// the validation errors, or nil if the dnsrecord is valid.  Both the
+// reconciler and the validating admission webhook use this function so that
+// they accept and reject the same dnsrecords.
+func validateDNSRecord(record *iov1.DNSRecord) error {
+	var errs []error
+
+	if len(record.Spec.DNSName) == 0 {
+		errs = append(errs, fmt.Errorf("spec.dnsName is required"))
+	}
+	if len(record.Spec.Targets) == 0 {
+		errs = append(errs, fmt.Errorf("spec.targets is required"))
+	}
+	switch record.Spec.RecordType {
+	case iov1.ARecordType:
+		for i, target := range record.Spec.Targets {
+			if ip := net.ParseIP(target); ip == nil || ip.To4() == nil {
+				errs = append(errs, fmt.Errorf("invalid value for spec.targets[%d]: %q; must be an IPv4 address for an %q record", i, target, record.Spec.RecordType))
+			}
+		}
+	case iov1.CNAMERecordType:
+		if len(record.Spec.Targets) > 1 {
+			errs = append(errs, fmt.Errorf("spec.targets must have exactly one target for a %q record", record.Spec.RecordType))
+		}
+	default:
+		errs = append(errs, fmt.Errorf("invalid value for spec.recordType: %q; must be %q or %q", record.Spec.RecordType, iov1.ARecordType, iov1.CNAMERecordType))
+	}
+	if record.Spec.RecordTTL < 0 {
+		errs = append(errs, fmt.Errorf("invalid value for spec.recordTTL: %d; must not be negative", record.Spec.RecordTTL))
+	}
+
+	return utilerrors.NewAggregate(errs)
+}
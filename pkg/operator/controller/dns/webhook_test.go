@@ -0,0 +1,236 @@
+package dns
+
+import (
+	"context"
+	"testing"
+
+	operatorv1 "github.com/openshift/api/operator/v1"
+	iov1 "github.com/openshift/api/operatoringress/v1"
+	routev1 "github.com/openshift/api/route/v1"
+
+	"github.com/openshift/cluster-ingress-operator/pkg/manifests"
+	operatorclient "github.com/openshift/cluster-ingress-operator/pkg/operator/client"
+
+	corev1 "k8s.io/api/core/v1"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+// TestValidateDNSRecord verifies that validateDNSRecord rejects dnsrecords
+// with missing fields and targets that do not match the record type.
+func TestValidateDNSRecord(t *testing.T) {
+	testCases := []struct {
+		description string
+		recordType  iov1.DNSRecordType
+		dnsName     string
+		targets     []string
+		expectError bool
+	}{
+		{
+			description: "valid A record",
+			recordType:  iov1.ARecordType,
+			dnsName:     "*.apps.example.com.",
+			targets:     []string{"192.0.2.1", "192.0.2.2"},
+		},
+		{
+			description: "valid CNAME record",
+			recordType:  iov1.CNAMERecordType,
+			dnsName:     "*.apps.example.com.",
+			targets:     []string{"lb.example.com"},
+		},
+		{
+			description: "missing DNS name",
+			recordType:  iov1.ARecordType,
+			targets:     []string{"192.0.2.1"},
+			expectError: true,
+		},
+		{
+			description: "missing targets",
+			recordType:  iov1.ARecordType,
+			dnsName:     "*.apps.example.com.",
+			expectError: true,
+		},
+		{
+			description: "A record with a hostname target",
+			recordType:  iov1.ARecordType,
+			dnsName:     "*.apps.example.com.",
+			targets:     []string{"lb.example.com"},
+			expectError: true,
+		},
+		{
+			description: "A record with an IPv6 target",
+			recordType:  iov1.ARecordType,
+			dnsName:     "*.apps.example.com.",
+			targets:     []string{"2001:db8::1"},
+			expectError: true,
+		},
+		{
+			description: "CNAME record with multiple targets",
+			recordType:  iov1.CNAMERecordType,
+			dnsName:     "*.apps.example.com.",
+			targets:     []string{"lb1.example.com", "lb2.example.com"},
+			expectError: true,
+		},
+		{
+			description: "unsupported record type",
+			recordType:  "MX",
+			dnsName:     "*.apps.example.com.",
+			targets:     []string{"mail.example.com"},
+			expectError: true,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			record := &iov1.DNSRecord{
+				Spec: iov1.DNSRecordSpec{
+					DNSName:    tc.dnsName,
+					RecordType: tc.recordType,
+					Targets:    tc.targets,
+					RecordTTL:  30,
+				},
+			}
+			err := validateDNSRecord(record)
+			switch {
+			case tc.expectError && err == nil:
+				t.Error("expected an error, got nil")
+			case !tc.expectError && err != nil:
+				t.Errorf("unexpected error: %v", err)
+			}
+		})
+	}
+}
+
+// TestDNSRecordValidatorRequestedRecords verifies that the validator allows
+// dnsrecords that no ingresscontroller owns only in the operator namespace and
+// in namespaces that have opted in, only for subdomains of the domains of the
+// ingresscontrollers, and not for the hosts of admitted routes in other
+// namespaces.
+func TestDNSRecordValidatorRequestedRecords(t *testing.T) {
+	const operatorNamespace = "openshift-ingress-operator"
+	defaultIC := &operatorv1.IngressController{
+		ObjectMeta: metav1.ObjectMeta{Namespace: operatorNamespace, Name: "default"},
+		Status:     operatorv1.IngressControllerStatus{Domain: "apps.example.com"},
+	}
+	allowed := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{
+		Name:   "allowed",
+		Labels: map[string]string{manifests.AllowDNSRecordsNamespaceLabel: "true"},
+	}}
+	other := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "other"}}
+	newRoute := func(namespace, name, host string, admitted corev1.ConditionStatus) *routev1.Route {
+		return &routev1.Route{
+			ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name},
+			Spec:       routev1.RouteSpec{Host: host},
+			Status: routev1.RouteStatus{Ingress: []routev1.RouteIngress{{
+				Host:       host,
+				RouterName: "default",
+				Conditions: []routev1.RouteIngressCondition{{Type: routev1.RouteAdmitted, Status: admitted}},
+			}}},
+		}
+	}
+	routes := &routev1.RouteList{Items: []routev1.Route{
+		*newRoute("app", "console", "console.apps.example.com", corev1.ConditionTrue),
+		*newRoute("app", "api", "api.team.apps.example.com", corev1.ConditionTrue),
+		*newRoute("app", "pending", "pending.apps.example.com", corev1.ConditionFalse),
+		*newRoute("allowed", "own", "own.apps.example.com", corev1.ConditionTrue),
+	}}
+	testCases := []struct {
+		description string
+		namespace   string
+		labels      map[string]string
+		dnsName     string
+		expectError bool
+	}{
+		{
+			description: "subdomain in the operator namespace",
+			namespace:   operatorNamespace,
+			dnsName:     "foo.apps.example.com.",
+		},
+		{
+			description: "subdomain in a namespace that has opted in",
+			namespace:   "allowed",
+			dnsName:     "foo.apps.example.com.",
+		},
+		{
+			description: "wildcard subdomain in a namespace that has opted in",
+			namespace:   "allowed",
+			dnsName:     "*.foo.Apps.example.com",
+		},
+		{
+			description: "subdomain in a namespace that has not opted in",
+			namespace:   "other",
+			dnsName:     "foo.apps.example.com.",
+			expectError: true,
+		},
+		{
+			description: "name outside the ingresscontroller domains",
+			namespace:   "allowed",
+			dnsName:     "api.example.com.",
+			expectError: true,
+		},
+		{
+			description: "name with the domain as a suffix but not a subdomain",
+			namespace:   "allowed",
+			dnsName:     "fooapps.example.com.",
+			expectError: true,
+		},
+		{
+			description: "ingresscontroller wildcard name",
+			namespace:   "allowed",
+			dnsName:     "*.apps.example.com.",
+			expectError: true,
+		},
+		{
+			description: "host of an admitted route in another namespace",
+			namespace:   "allowed",
+			dnsName:     "Console.apps.example.com.",
+			expectError: true,
+		},
+		{
+			description: "wildcard name that matches the host of an admitted route in another namespace",
+			namespace:   "allowed",
+			dnsName:     "*.team.apps.example.com.",
+			expectError: true,
+		},
+		{
+			description: "host of a route in another namespace that is not admitted",
+			namespace:   "allowed",
+			dnsName:     "pending.apps.example.com.",
+		},
+		{
+			description: "host of an admitted route in the same namespace",
+			namespace:   "allowed",
+			dnsName:     "own.apps.example.com.",
+		},
+		{
+			description: "ingresscontroller wildcard name owned by the ingresscontroller",
+			namespace:   operatorNamespace,
+			labels:      map[string]string{manifests.OwningIngressControllerLabel: "default"},
+			dnsName:     "*.apps.example.com.",
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			client := fake.NewClientBuilder().WithScheme(operatorclient.GetScheme()).WithObjects(defaultIC, allowed, other).Build()
+			routeClient := fake.NewClientBuilder().WithScheme(operatorclient.GetScheme()).WithLists(routes).Build()
+			validator := &dnsRecordValidator{reader: client, routeReader: routeClient, namespace: operatorNamespace}
+			record := &iov1.DNSRecord{
+				ObjectMeta: metav1.ObjectMeta{Namespace: tc.namespace, Name: "foo", Labels: tc.labels},
+				Spec: iov1.DNSRecordSpec{
+					DNSName:    tc.dnsName,
+					RecordType: iov1.ARecordType,
+					Targets:    []string{"192.0.2.1"},
+					RecordTTL:  30,
+				},
+			}
+			err := validator.ValidateCreate(context.Background(), record)
+			switch {
+			case tc.expectError && err == nil:
+				t.Error("expected an error, got nil")
+			case !tc.expectError && err != nil:
+				t.Errorf("unexpected error: %v", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,136 @@
+package controller
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+)
+
+// maxEventDiffFields is the maximum number of changed fields that an
+// "Updated" operand event lists.  Events are meant to be read with `oc
+// describe`, and the API server truncates long event messages, so the
+// remaining fields are summarized by count.
+const maxEventDiffFields = 10
+
+// RecordOperandCreated records a "Created<kind>" event on the given owner,
+// typically an ingresscontroller, for the given operand.
+func RecordOperandCreated(recorder record.EventRecorder, owner runtime.Object, kind string, operand metav1.Object) {
+	recorder.Eventf(owner, corev1.EventTypeNormal, "Created"+kind, "Created %s %s", strings.ToLower(kind), operandName(operand))
+}
+
+// RecordOperandUpdated records an "Updated<kind>" event on the given owner,
+// typically an ingresscontroller, for the given operand.  The given diff is
+// the list of changed fields as computed by FieldDiff; callers must compute
+// it before updating the operand because the client may mutate the object.
+func RecordOperandUpdated(recorder record.EventRecorder, owner runtime.Object, kind string, operand metav1.Object, diff string) {
+	message := fmt.Sprintf("Updated %s %s", strings.ToLower(kind), operandName(operand))
+	if len(diff) != 0 {
+		message += ": " + diff
+	}
+	recorder.Event(owner, corev1.EventTypeNormal, "Updated"+kind, message)
+}
+
+// RecordOperandDeleted records a "Deleted<kind>" event on the given owner,
+// typically an ingresscontroller, for the given operand.
+func RecordOperandDeleted(recorder record.EventRecorder, owner runtime.Object, kind string, operand metav1.Object) {
+	recorder.Eventf(owner, corev1.EventTypeNormal, "Deleted"+kind, "Deleted %s %s", strings.ToLower(kind), operandName(operand))
+}
+
+// operandName returns the namespace and name of the given object, or only
+// the name if the object is cluster-scoped.
+func operandName(obj metav1.Object) string {
+	if len(obj.GetNamespace()) == 0 {
+		return obj.GetName()
+	}
+	return obj.GetNamespace() + "/" + obj.GetName()
+}
+
+// FieldDiff returns a compact, comma-separated list of the paths of the
+// fields that differ between the given objects, such as
+// "spec.template.spec.containers[0].image".  An absent field and an empty
+// field are considered equal.  At most maxEventDiffFields paths are listed.
+// FieldDiff returns the empty string if the objects cannot be converted to
+// unstructured content or if they do not differ.
+func FieldDiff(current, updated interface{}) string {
+	a, err := runtime.DefaultUnstructuredConverter.ToUnstructured(current)
+	if err != nil {
+		return ""
+	}
+	b, err := runtime.DefaultUnstructuredConverter.ToUnstructured(updated)
+	if err != nil {
+		return ""
+	}
+	var paths []string
+	diffFields("", a, b, &paths)
+	if len(paths) > maxEventDiffFields {
+		more := len(paths) - maxEventDiffFields
+		paths = append(paths[:maxEventDiffFields], fmt.Sprintf("and %d more", more))
+	}
+	return strings.Join(paths, ", ")
+}
+
+// diffFields appends to paths the paths, relative to the given path, of the
+// fields that differ between a and b.  Maps are compared key by key, even if
+// one of them is absent, and lists of the same length are compared element by
+// element.
+func diffFields(path string, a, b interface{}, paths *[]string) {
+	if isEmptyField(a) && isEmptyField(b) {
+		return
+	}
+	aMap, aIsMap := a.(map[string]interface{})
+	bMap, bIsMap := b.(map[string]interface{})
+	if (aIsMap || a == nil) && (bIsMap || b == nil) {
+		keys := make([]string, 0, len(aMap)+len(bMap))
+		for k := range aMap {
+			keys = append(keys, k)
+		}
+		for k := range bMap {
+			if _, ok := aMap[k]; !ok {
+				keys = append(keys, k)
+			}
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			p := k
+			if len(path) != 0 {
+				p = path + "." + k
+			}
+			diffFields(p, aMap[k], bMap[k], paths)
+		}
+		return
+	}
+	aSlice, aIsSlice := a.([]interface{})
+	bSlice, bIsSlice := b.([]interface{})
+	if aIsSlice && bIsSlice && len(aSlice) == len(bSlice) {
+		for i := range aSlice {
+			diffFields(fmt.Sprintf("%s[%d]", path, i), aSlice[i], bSlice[i], paths)
+		}
+		return
+	}
+	if !reflect.DeepEqual(a, b) {
+		*paths = append(*paths, path)
+	}
+}
+
+// isEmptyField returns a Boolean value indicating whether the given
+// unstructured value is absent or empty.
+func isEmptyField(v interface{}) bool {
+	switch v := v.(type) {
+	case nil:
+		return true
+	case map[string]interface{}:
+		return len(v) == 0
+	case []interface{}:
+		return len(v) == 0
+	case string:
+		return len(v) == 0
+	}
+	return false
+}
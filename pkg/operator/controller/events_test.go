@@ -0,0 +1,143 @@
+package controller
+
+import (
+	"fmt"
+	"testing"
+
+	operatorv1 "github.com/openshift/api/operator/v1"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/client-go/tools/record"
+)
+
+// TestFieldDiff verifies that FieldDiff lists the paths of the changed
+// fields.
+func TestFieldDiff(t *testing.T) {
+	deployment := &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "openshift-ingress",
+			Name:      "router-default",
+		},
+		Spec: appsv1.DeploymentSpec{
+			Template: corev1.PodTemplateSpec{
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{{
+						Name:  "router",
+						Image: "router:1",
+						Env:   []corev1.EnvVar{{Name: "A", Value: "1"}},
+					}},
+				},
+			},
+		},
+	}
+	testCases := []struct {
+		description string
+		mutate      func(*appsv1.Deployment)
+		expected    string
+	}{
+		{
+			description: "no change",
+			mutate:      func(*appsv1.Deployment) {},
+			expected:    "",
+		},
+		{
+			description: "empty and absent fields are equal",
+			mutate: func(d *appsv1.Deployment) {
+				d.Labels = map[string]string{}
+				d.Spec.Template.Spec.Volumes = []corev1.Volume{}
+			},
+			expected: "",
+		},
+		{
+			description: "changed image and added annotation",
+			mutate: func(d *appsv1.Deployment) {
+				d.Annotations = map[string]string{"foo": "bar"}
+				d.Spec.Template.Spec.Containers[0].Image = "router:2"
+			},
+			expected: "metadata.annotations.foo, spec.template.spec.containers[0].image",
+		},
+		{
+			description: "added env var",
+			mutate: func(d *appsv1.Deployment) {
+				c := &d.Spec.Template.Spec.Containers[0]
+				c.Env = append(c.Env, corev1.EnvVar{Name: "B", Value: "2"})
+			},
+			expected: "spec.template.spec.containers[0].env",
+		},
+		{
+			description: "changed strategy",
+			mutate: func(d *appsv1.Deployment) {
+				maxUnavailable := intstr.FromString("25%")
+				d.Spec.Strategy.RollingUpdate = &appsv1.RollingUpdateDeployment{MaxUnavailable: &maxUnavailable}
+			},
+			expected: "spec.strategy.rollingUpdate.maxUnavailable",
+		},
+		{
+			description: "too many changes",
+			mutate: func(d *appsv1.Deployment) {
+				d.Labels = map[string]string{}
+				for i := 0; i < 12; i++ {
+					d.Labels[fmt.Sprintf("label-%02d", i)] = "x"
+				}
+			},
+			expected: "metadata.labels.label-00, metadata.labels.label-01, metadata.labels.label-02, metadata.labels.label-03, metadata.labels.label-04, metadata.labels.label-05, metadata.labels.label-06, metadata.labels.label-07, metadata.labels.label-08, metadata.labels.label-09, and 2 more",
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			updated := deployment.DeepCopy()
+			tc.mutate(updated)
+			if actual := FieldDiff(deployment, updated); actual != tc.expected {
+				t.Errorf("expected %q, got %q", tc.expected, actual)
+			}
+		})
+	}
+}
+
+// TestRecordOperandEvents verifies that the operand event helpers record
+// events with the expected reasons and messages.
+func TestRecordOperandEvents(t *testing.T) {
+	ic := &operatorv1.IngressController{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "openshift-ingress-operator",
+			Name:      "default",
+		},
+	}
+	service := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "openshift-ingress",
+			Name:      "router-default",
+		},
+	}
+	class := &metav1.ObjectMeta{Name: "openshift-default"}
+
+	recorder := record.NewFakeRecorder(10)
+	RecordOperandCreated(recorder, ic, "Service", service)
+	RecordOperandUpdated(recorder, ic, "Service", service, "spec.ports[0].port")
+	RecordOperandUpdated(recorder, ic, "IngressClass", class, "")
+	RecordOperandDeleted(recorder, ic, "IngressClass", class)
+	close(recorder.Events)
+
+	expected := []string{
+		"Normal CreatedService Created service openshift-ingress/router-default",
+		"Normal UpdatedService Updated service openshift-ingress/router-default: spec.ports[0].port",
+		"Normal UpdatedIngressClass Updated ingressclass openshift-default",
+		"Normal DeletedIngressClass Deleted ingressclass openshift-default",
+	}
+	var actual []string
+	for event := range recorder.Events {
+		actual = append(actual, event)
+	}
+	if len(actual) != len(expected) {
+		t.Fatalf("expected events %q, got %q", expected, actual)
+	}
+	for i := range expected {
+		if actual[i] != expected[i] {
+			t.Errorf("expected event %q, got %q", expected[i], actual[i])
+		}
+	}
+}
@@ -0,0 +1,246 @@
+package gateway
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	operatorv1 "github.com/openshift/api/operator/v1"
+	logf "github.com/openshift/cluster-ingress-operator/pkg/log"
+	"github.com/openshift/cluster-ingress-operator/pkg/manifests"
+	operatorcontroller "github.com/openshift/cluster-ingress-operator/pkg/operator/controller"
+
+	corev1 "k8s.io/api/core/v1"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+
+	"sigs.k8s.io/controller-runtime/pkg/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+
+	gatewayapiv1beta1 "sigs.k8s.io/gateway-api/apis/v1beta1"
+)
+
+const (
+	controllerName = "gateway_controller"
+
+	// resyncPeriod is the period after which a gateway is reconciled
+	// again.  Secrets in the gateway's namespace are not watched, so
+	// periodic reconciliation is what propagates a rotated certificate to
+	// the ingresscontroller's default certificate.
+	resyncPeriod = 5 * time.Minute
+)
+
+var (
+	log = logf.Logger.WithName(controllerName)
+)
+
+// New creates and returns a controller that manages an IngressController for
+// each Gateway whose GatewayClass specifies the operator's controller name,
+// and that updates the Gateway's status to reflect the IngressController's.
+// Only the Gateway's listeners are provisioned; HTTPRoutes and other route
+// resources of the Gateway API are not programmed.
+//
+// The Gateway API CRDs must exist when this function is called.
+func New(mgr manager.Manager, config Config) (controller.Controller, error) {
+	// Create a new cache to watch on Gateway objects from every namespace.
+	gatewayCache, err := cache.New(mgr.GetConfig(), cache.Options{
+		Scheme: mgr.GetScheme(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	// Add the cache to the manager so that the cache is started along with the other runnables.
+	if err := mgr.Add(gatewayCache); err != nil {
+		return nil, err
+	}
+	reconciler := &reconciler{
+		config:       config,
+		client:       mgr.GetClient(),
+		cache:        mgr.GetCache(),
+		gatewayCache: gatewayCache,
+	}
+	c, err := controller.New(controllerName, mgr, controller.Options{Reconciler: reconciler})
+	if err != nil {
+		return nil, err
+	}
+	if err := c.Watch(source.NewKindWithCache(&gatewayapiv1beta1.Gateway{}, gatewayCache), &handler.EnqueueRequestForObject{}); err != nil {
+		return nil, err
+	}
+	if err := c.Watch(&source.Kind{Type: &gatewayapiv1beta1.GatewayClass{}}, handler.EnqueueRequestsFromMapFunc(reconciler.gatewayClassToGateways)); err != nil {
+		return nil, err
+	}
+	if err := c.Watch(&source.Kind{Type: &operatorv1.IngressController{}}, handler.EnqueueRequestsFromMapFunc(toOwningGateway), predicate.NewPredicateFuncs(hasOwningGatewayLabels)); err != nil {
+		return nil, err
+	}
+	isLoadBalancerService := predicate.NewPredicateFuncs(func(o client.Object) bool {
+		_, ok := o.GetLabels()[manifests.OwningIngressControllerLabel]
+		return ok && o.GetNamespace() == operatorcontroller.DefaultOperandNamespace
+	})
+	if err := c.Watch(&source.Kind{Type: &corev1.Service{}}, handler.EnqueueRequestsFromMapFunc(reconciler.serviceToGateway), isLoadBalancerService); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// hasOwningGatewayLabels returns a Boolean value indicating whether the given
+// object has the labels that identify its owning gateway.
+func hasOwningGatewayLabels(o client.Object) bool {
+	labels := o.GetLabels()
+	return len(labels[manifests.OwningGatewayNamespaceLabel]) != 0 && len(labels[manifests.OwningGatewayNameLabel]) != 0
+}
+
+// toOwningGateway returns a reconcile.Request for the gateway that is
+// identified by the given object's owning-gateway labels.
+func toOwningGateway(o client.Object) []reconcile.Request {
+	labels := o.GetLabels()
+	return []reconcile.Request{{
+		NamespacedName: types.NamespacedName{
+			Namespace: labels[manifests.OwningGatewayNamespaceLabel],
+			Name:      labels[manifests.OwningGatewayNameLabel],
+		},
+	}}
+}
+
+// serviceToGateway maps a service that belongs to an ingresscontroller to a
+// reconcile.Request for the gateway that owns the ingresscontroller, if any.
+func (r *reconciler) serviceToGateway(o client.Object) []reconcile.Request {
+	name := types.NamespacedName{
+		Namespace: r.config.Namespace,
+		Name:      o.GetLabels()[manifests.OwningIngressControllerLabel],
+	}
+	ic := &operatorv1.IngressController{}
+	if err := r.cache.Get(context.Background(), name, ic); err != nil {
+		if !errors.IsNotFound(err) {
+			log.Error(err, "failed to get ingresscontroller for service", "service", o.GetName())
+		}
+		return nil
+	}
+	if !hasOwningGatewayLabels(ic) {
+		return nil
+	}
+	return toOwningGateway(ic)
+}
+
+// gatewayClassToGateways maps a gatewayclass to reconcile.Requests for all
+// gateways of that class.
+func (r *reconciler) gatewayClassToGateways(o client.Object) []reconcile.Request {
+	gateways := &gatewayapiv1beta1.GatewayList{}
+	if err := r.gatewayCache.List(context.Background(), gateways); err != nil {
+		log.Error(err, "failed to list gateways for gatewayclass", "gatewayclass", o.GetName())
+		return nil
+	}
+	var requests []reconcile.Request
+	for i := range gateways.Items {
+		if string(gateways.Items[i].Spec.GatewayClassName) != o.GetName() {
+			continue
+		}
+		requests = append(requests, reconcile.Request{
+			NamespacedName: types.NamespacedName{
+				Namespace: gateways.Items[i].Namespace,
+				Name:      gateways.Items[i].Name,
+			},
+		})
+	}
+	return requests
+}
+
+// Config holds all the configuration that must be provided when creating the
+// controller.
+type Config struct {
+	// Namespace is the namespace in which ingresscontrollers are created.
+	Namespace string
+}
+
+// reconciler handles the actual gateway reconciliation logic.
+type reconciler struct {
+	config Config
+
+	client       client.Client
+	cache        cache.Cache
+	gatewayCache cache.Cache
+}
+
+// Reconcile expects request to refer to a Gateway.  If the Gateway exists and
+// belongs to a GatewayClass that specifies the operator's controller name,
+// Reconcile ensures that an IngressController exists for the Gateway and
+// updates the Gateway's status.  Otherwise, Reconcile ensures that no
+// IngressController exists for the Gateway.
+func (r *reconciler) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	log.Info("reconciling", "request", request)
+
+	gateway := &gatewayapiv1beta1.Gateway{}
+	if err := r.gatewayCache.Get(ctx, request.NamespacedName, gateway); err != nil {
+		if !errors.IsNotFound(err) {
+			return reconcile.Result{}, fmt.Errorf("failed to get gateway %q: %w", request.NamespacedName, err)
+		}
+		gateway = nil
+	}
+
+	managed := false
+	if gateway != nil && gateway.DeletionTimestamp == nil {
+		var err error
+		if managed, err = r.gatewayClassIsManaged(gateway.Spec.GatewayClassName); err != nil {
+			return reconcile.Result{}, err
+		}
+	}
+
+	if !managed {
+		if err := r.ensureGatewayResourcesDeleted(request.NamespacedName); err != nil {
+			return reconcile.Result{}, fmt.Errorf("failed to delete resources for gateway %q: %w", request.NamespacedName, err)
+		}
+		return reconcile.Result{}, nil
+	}
+
+	var errs []error
+	listeners := computeGatewayListeners(gateway)
+	icName := operatorcontroller.GatewayIngressControllerName(r.config.Namespace, gateway.Namespace, gateway.Name)
+	haveIC, ic, conflict, err := r.ensureGatewayIngressController(gateway, icName, &listeners)
+	if err != nil {
+		errs = append(errs, fmt.Errorf("failed to ensure ingresscontroller for gateway %q: %w", request.NamespacedName, err))
+	}
+	var service *corev1.Service
+	if haveIC {
+		if service, err = r.currentLoadBalancerService(ic); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	if err := r.updateGatewayStatus(gateway, &listeners, ic, conflict, service); err != nil {
+		errs = append(errs, fmt.Errorf("failed to update status for gateway %q: %w", request.NamespacedName, err))
+	}
+
+	return reconcile.Result{RequeueAfter: resyncPeriod}, utilerrors.NewAggregate(errs)
+}
+
+// gatewayClassIsManaged returns a Boolean value indicating whether the
+// gatewayclass with the given name specifies the operator's controller name.
+func (r *reconciler) gatewayClassIsManaged(name gatewayapiv1beta1.ObjectName) (bool, error) {
+	class := &gatewayapiv1beta1.GatewayClass{}
+	if err := r.cache.Get(context.TODO(), types.NamespacedName{Name: string(name)}, class); err != nil {
+		if errors.IsNotFound(err) {
+			return false, nil
+		}
+		return false, fmt.Errorf("failed to get gatewayclass %q: %w", name, err)
+	}
+	return class.Spec.ControllerName == operatorcontroller.OpenShiftGatewayClassControllerName, nil
+}
+
+// currentLoadBalancerService returns the ingresscontroller's load-balancer
+// service, or nil if the service does not exist.
+func (r *reconciler) currentLoadBalancerService(ic *operatorv1.IngressController) (*corev1.Service, error) {
+	service := &corev1.Service{}
+	if err := r.cache.Get(context.TODO(), operatorcontroller.LoadBalancerServiceName(ic), service); err != nil {
+		if errors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get load balancer service for ingresscontroller %q: %w", ic.Name, err)
+	}
+	return service, nil
+}
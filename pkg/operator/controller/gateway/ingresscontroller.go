@@ -0,0 +1,294 @@
+package gateway
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	operatorv1 "github.com/openshift/api/operator/v1"
+	"github.com/openshift/cluster-ingress-operator/pkg/manifests"
+	operatorcontroller "github.com/openshift/cluster-ingress-operator/pkg/operator/controller"
+
+	corev1 "k8s.io/api/core/v1"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	gatewayapiv1beta1 "sigs.k8s.io/gateway-api/apis/v1beta1"
+)
+
+// ensureGatewayIngressController ensures that the ingresscontroller for the
+// given gateway exists and has the desired spec.  If the gateway has no valid
+// listener, the ingresscontroller is deleted.  Returns a Boolean indicating
+// whether the ingresscontroller exists, the ingresscontroller if it does
+// exist, a message describing a conflict with an ingresscontroller that the
+// operator does not manage for the gateway, if any, and an error value.
+func (r *reconciler) ensureGatewayIngressController(gateway *gatewayapiv1beta1.Gateway, name types.NamespacedName, listeners *gatewayListeners) (bool, *operatorv1.IngressController, string, error) {
+	have, current, err := r.currentIngressController(name)
+	if err != nil {
+		return false, nil, "", err
+	}
+	if have && !isOwnedByGateway(current, gateway) {
+		return false, nil, fmt.Sprintf("IngressController %q already exists and is not managed for this Gateway.", name.Name), nil
+	}
+
+	secretName := ""
+	if listeners.certificateRef != nil {
+		secretName, err = r.ensureDefaultCertificateSecret(gateway, name, listeners)
+		if err != nil {
+			return have, current, "", err
+		}
+	} else if err := r.deleteDefaultCertificateSecrets(gateway); err != nil {
+		return have, current, "", err
+	}
+
+	want := len(listeners.domain) != 0
+	desired := desiredIngressController(gateway, name, listeners, secretName)
+
+	switch {
+	case !want && !have:
+		return false, nil, "", nil
+	case !want && have:
+		if err := r.deleteIngressController(current); err != nil {
+			return true, current, "", err
+		}
+		return false, nil, "", nil
+	case want && !have:
+		if err := r.client.Create(context.TODO(), desired); err != nil {
+			return false, nil, "", fmt.Errorf("failed to create ingresscontroller %q: %w", name, err)
+		}
+		log.Info("created ingresscontroller", "ingresscontroller", desired)
+		have, current, err := r.currentIngressController(name)
+		return have, current, "", err
+	case want && have:
+		// The ingresscontroller's domain cannot be changed once it is
+		// admitted, so recreate the ingresscontroller if the gateway's
+		// domain changes.
+		if len(current.Status.Domain) != 0 && current.Status.Domain != desired.Spec.Domain {
+			if err := r.deleteIngressController(current); err != nil {
+				return true, current, "", err
+			}
+			return false, nil, "", nil
+		}
+		if updated, err := r.updateIngressController(current, desired); err != nil {
+			return true, current, "", fmt.Errorf("failed to update ingresscontroller %q: %w", name, err)
+		} else if updated {
+			have, current, err := r.currentIngressController(name)
+			return have, current, "", err
+		}
+	}
+
+	return true, current, "", nil
+}
+
+// desiredIngressController returns the desired ingresscontroller for the given
+// gateway.
+func desiredIngressController(gateway *gatewayapiv1beta1.Gateway, name types.NamespacedName, listeners *gatewayListeners, secretName string) *operatorv1.IngressController {
+	ic := &operatorv1.IngressController{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: name.Namespace,
+			Name:      name.Name,
+			Labels:    owningGatewayLabels(gateway),
+		},
+		Spec: operatorv1.IngressControllerSpec{
+			Domain:            listeners.domain,
+			NamespaceSelector: listeners.namespaceSelector,
+		},
+	}
+	if len(secretName) != 0 {
+		ic.Spec.DefaultCertificate = &corev1.LocalObjectReference{Name: secretName}
+	}
+	return ic
+}
+
+// owningGatewayLabels returns the labels that identify the given gateway as
+// the owner of an object.
+func owningGatewayLabels(gateway *gatewayapiv1beta1.Gateway) map[string]string {
+	return map[string]string{
+		manifests.OwningGatewayNamespaceLabel: gateway.Namespace,
+		manifests.OwningGatewayNameLabel:      gateway.Name,
+	}
+}
+
+// isOwnedByGateway returns a Boolean value indicating whether the given object
+// has the labels that identify the given gateway as its owner.
+func isOwnedByGateway(o client.Object, gateway *gatewayapiv1beta1.Gateway) bool {
+	labels := o.GetLabels()
+	return labels[manifests.OwningGatewayNamespaceLabel] == gateway.Namespace && labels[manifests.OwningGatewayNameLabel] == gateway.Name
+}
+
+// currentIngressController returns a Boolean indicating whether an
+// ingresscontroller with the given name exists, as well as the
+// ingresscontroller if it does exist and an error value.
+func (r *reconciler) currentIngressController(name types.NamespacedName) (bool, *operatorv1.IngressController, error) {
+	ic := &operatorv1.IngressController{}
+	if err := r.client.Get(context.TODO(), name, ic); err != nil {
+		if errors.IsNotFound(err) {
+			return false, nil, nil
+		}
+		return false, nil, fmt.Errorf("failed to get ingresscontroller %q: %w", name, err)
+	}
+	return true, ic, nil
+}
+
+// deleteIngressController deletes the given ingresscontroller.
+func (r *reconciler) deleteIngressController(ic *operatorv1.IngressController) error {
+	if err := r.client.Delete(context.TODO(), ic); err != nil {
+		if errors.IsNotFound(err) {
+			return nil
+		}
+		return fmt.Errorf("failed to delete ingresscontroller %q: %w", ic.Name, err)
+	}
+	log.Info("deleted ingresscontroller", "namespace", ic.Namespace, "name", ic.Name)
+	return nil
+}
+
+// updateIngressController updates an ingresscontroller.  Returns a Boolean
+// indicating whether the ingresscontroller was updated, and an error value.
+func (r *reconciler) updateIngressController(current, desired *operatorv1.IngressController) (bool, error) {
+	changed, updated := ingressControllerChanged(current, desired)
+	if !changed {
+		return false, nil
+	}
+
+	// Diff before updating because the client may mutate the object.
+	diff := cmp.Diff(current, updated, cmpopts.EquateEmpty())
+	if err := r.client.Update(context.TODO(), updated); err != nil {
+		return false, err
+	}
+	log.Info("updated ingresscontroller", "namespace", updated.Namespace, "name", updated.Name, "diff", diff)
+	return true, nil
+}
+
+// ingressControllerChanged checks whether the fields of the current
+// ingresscontroller that the operator manages for a gateway match the expected
+// ingresscontroller and if not returns an updated one.
+func ingressControllerChanged(current, expected *operatorv1.IngressController) (bool, *operatorv1.IngressController) {
+	if current.Spec.Domain == expected.Spec.Domain &&
+		cmp.Equal(current.Spec.DefaultCertificate, expected.Spec.DefaultCertificate, cmpopts.EquateEmpty()) &&
+		cmp.Equal(current.Spec.NamespaceSelector, expected.Spec.NamespaceSelector, cmpopts.EquateEmpty()) {
+		return false, nil
+	}
+
+	updated := current.DeepCopy()
+	updated.Spec.Domain = expected.Spec.Domain
+	updated.Spec.DefaultCertificate = expected.Spec.DefaultCertificate
+	updated.Spec.NamespaceSelector = expected.Spec.NamespaceSelector
+
+	return true, updated
+}
+
+// ensureDefaultCertificateSecret copies the secret that the gateway's HTTPS
+// listeners refer to into the operand namespace so that the ingresscontroller
+// can use it as its default certificate.  If the secret does not exist or is
+// not a valid TLS secret, the listeners' ResolvedRefs condition is updated
+// accordingly.  Returns the name of the copy, or the empty string if the
+// secret could not be copied, and an error value.
+func (r *reconciler) ensureDefaultCertificateSecret(gateway *gatewayapiv1beta1.Gateway, icName types.NamespacedName, listeners *gatewayListeners) (string, error) {
+	sourceName := types.NamespacedName{
+		Namespace: gateway.Namespace,
+		Name:      string(listeners.certificateRef.Name),
+	}
+	source := &corev1.Secret{}
+	if err := r.client.Get(context.TODO(), sourceName, source); err != nil {
+		if !errors.IsNotFound(err) {
+			return "", fmt.Errorf("failed to get secret %q: %w", sourceName, err)
+		}
+		listeners.invalidateCertificateRef(gateway, fmt.Sprintf("Secret %q does not exist.", sourceName.Name))
+		return "", r.deleteDefaultCertificateSecrets(gateway)
+	}
+	if len(source.Data[corev1.TLSCertKey]) == 0 || len(source.Data[corev1.TLSPrivateKeyKey]) == 0 {
+		listeners.invalidateCertificateRef(gateway, fmt.Sprintf("Secret %q must have %q and %q keys.", sourceName.Name, corev1.TLSCertKey, corev1.TLSPrivateKeyKey))
+		return "", r.deleteDefaultCertificateSecrets(gateway)
+	}
+
+	ic := &operatorv1.IngressController{ObjectMeta: metav1.ObjectMeta{Name: icName.Name}}
+	name := operatorcontroller.GatewayDefaultCertificateSecretName(ic)
+	desired := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: name.Namespace,
+			Name:      name.Name,
+			Labels:    owningGatewayLabels(gateway),
+		},
+		Type: corev1.SecretTypeTLS,
+		Data: map[string][]byte{
+			corev1.TLSCertKey:       source.Data[corev1.TLSCertKey],
+			corev1.TLSPrivateKeyKey: source.Data[corev1.TLSPrivateKeyKey],
+		},
+	}
+
+	current := &corev1.Secret{}
+	if err := r.client.Get(context.TODO(), name, current); err != nil {
+		if !errors.IsNotFound(err) {
+			return "", fmt.Errorf("failed to get secret %q: %w", name, err)
+		}
+		if err := r.client.Create(context.TODO(), desired); err != nil {
+			return "", fmt.Errorf("failed to create secret %q: %w", name, err)
+		}
+		log.Info("created secret", "namespace", name.Namespace, "name", name.Name)
+		return name.Name, nil
+	}
+	if !isOwnedByGateway(current, gateway) {
+		listeners.invalidateCertificateRef(gateway, fmt.Sprintf("Secret %q already exists in namespace %q and is not managed for this Gateway.", name.Name, name.Namespace))
+		return "", nil
+	}
+	if cmp.Equal(current.Data, desired.Data, cmpopts.EquateEmpty()) {
+		return name.Name, nil
+	}
+	updated := current.DeepCopy()
+	updated.Data = desired.Data
+	if err := r.client.Update(context.TODO(), updated); err != nil {
+		return "", fmt.Errorf("failed to update secret %q: %w", name, err)
+	}
+	log.Info("updated secret", "namespace", name.Namespace, "name", name.Name)
+	return name.Name, nil
+}
+
+// deleteDefaultCertificateSecrets deletes the secrets in the operand
+// namespace that the operator copied for the given gateway.
+func (r *reconciler) deleteDefaultCertificateSecrets(gateway *gatewayapiv1beta1.Gateway) error {
+	secrets := &corev1.SecretList{}
+	if err := r.client.List(context.TODO(), secrets, client.InNamespace(operatorcontroller.DefaultOperandNamespace), client.MatchingLabels(owningGatewayLabels(gateway))); err != nil {
+		return fmt.Errorf("failed to list secrets for gateway %s/%s: %w", gateway.Namespace, gateway.Name, err)
+	}
+	var errs []error
+	for i := range secrets.Items {
+		if err := r.client.Delete(context.TODO(), &secrets.Items[i]); err != nil && !errors.IsNotFound(err) {
+			errs = append(errs, fmt.Errorf("failed to delete secret %q: %w", secrets.Items[i].Name, err))
+			continue
+		}
+		log.Info("deleted secret", "namespace", secrets.Items[i].Namespace, "name", secrets.Items[i].Name)
+	}
+	return utilerrors.NewAggregate(errs)
+}
+
+// ensureGatewayResourcesDeleted deletes the ingresscontroller and secrets that
+// the operator created for the gateway with the given name.
+func (r *reconciler) ensureGatewayResourcesDeleted(name types.NamespacedName) error {
+	gateway := &gatewayapiv1beta1.Gateway{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: name.Namespace,
+			Name:      name.Name,
+		},
+	}
+	var errs []error
+	ingresscontrollers := &operatorv1.IngressControllerList{}
+	if err := r.cache.List(context.TODO(), ingresscontrollers, client.InNamespace(r.config.Namespace), client.MatchingLabels(owningGatewayLabels(gateway))); err != nil {
+		errs = append(errs, fmt.Errorf("failed to list ingresscontrollers: %w", err))
+	}
+	for i := range ingresscontrollers.Items {
+		if err := r.deleteIngressController(&ingresscontrollers.Items[i]); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	if err := r.deleteDefaultCertificateSecrets(gateway); err != nil {
+		errs = append(errs, err)
+	}
+	return utilerrors.NewAggregate(errs)
+}
@@ -0,0 +1,80 @@
+package gateway
+
+import (
+	"testing"
+
+	operatorv1 "github.com/openshift/api/operator/v1"
+
+	corev1 "k8s.io/api/core/v1"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// TestIngressControllerChanged verifies that ingressControllerChanged detects
+// changes to the fields that the operator manages for a gateway and ignores
+// other fields.
+func TestIngressControllerChanged(t *testing.T) {
+	testCases := []struct {
+		description string
+		mutate      func(*operatorv1.IngressController)
+		expect      bool
+	}{
+		{
+			description: "if nothing changes",
+			mutate:      func(_ *operatorv1.IngressController) {},
+			expect:      false,
+		},
+		{
+			description: "if the domain changes",
+			mutate: func(ic *operatorv1.IngressController) {
+				ic.Spec.Domain = "other.example.com"
+			},
+			expect: true,
+		},
+		{
+			description: "if the default certificate is removed",
+			mutate: func(ic *operatorv1.IngressController) {
+				ic.Spec.DefaultCertificate = nil
+			},
+			expect: true,
+		},
+		{
+			description: "if the namespace selector changes",
+			mutate: func(ic *operatorv1.IngressController) {
+				ic.Spec.NamespaceSelector = nil
+			},
+			expect: true,
+		},
+		{
+			description: "if an unmanaged field changes",
+			mutate: func(ic *operatorv1.IngressController) {
+				replicas := int32(3)
+				ic.Spec.Replicas = &replicas
+			},
+			expect: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			original := operatorv1.IngressController{
+				Spec: operatorv1.IngressControllerSpec{
+					Domain:             "apps.example.com",
+					DefaultCertificate: &corev1.LocalObjectReference{Name: "cert"},
+					NamespaceSelector: &metav1.LabelSelector{
+						MatchLabels: map[string]string{"kubernetes.io/metadata.name": "ns"},
+					},
+				},
+			}
+			mutated := original.DeepCopy()
+			tc.mutate(mutated)
+			if changed, updated := ingressControllerChanged(&original, mutated); changed != tc.expect {
+				t.Errorf("expected ingressControllerChanged to be %t, got %t", tc.expect, changed)
+			} else if changed {
+				if changedAgain, _ := ingressControllerChanged(mutated, updated); changedAgain {
+					t.Error("ingressControllerChanged does not behave as a fixed point function")
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,208 @@
+package gateway
+
+import (
+	"fmt"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	gatewayapiv1beta1 "sigs.k8s.io/gateway-api/apis/v1beta1"
+)
+
+// gatewayListeners describes how a gateway's listeners map onto an
+// ingresscontroller.
+type gatewayListeners struct {
+	// domain is the ingresscontroller's domain, which is taken from the
+	// first valid listener's wildcard hostname.
+	domain string
+	// certificateRef is the reference to the secret that should be used
+	// as the ingresscontroller's default certificate, or nil if no HTTPS
+	// listener specifies a certificate.
+	certificateRef *gatewayapiv1beta1.SecretObjectReference
+	// namespaceSelector is the ingresscontroller's namespace selector,
+	// which is taken from the first valid listener's allowed routes.
+	namespaceSelector *metav1.LabelSelector
+	// conditions has the conditions for each listener, keyed by listener
+	// name.  Each listener has the Conflicted, Detached, and ResolvedRefs
+	// conditions.  The Ready condition is computed later, based on the
+	// ingresscontroller's status.
+	conditions map[gatewayapiv1beta1.SectionName][]metav1.Condition
+}
+
+// valid returns a Boolean value indicating whether the listener with the given
+// name is neither conflicted nor detached and has resolved references.
+func (l *gatewayListeners) valid(name gatewayapiv1beta1.SectionName) bool {
+	for _, cond := range l.conditions[name] {
+		switch cond.Type {
+		case string(gatewayapiv1beta1.ListenerConditionConflicted), string(gatewayapiv1beta1.ListenerConditionDetached):
+			if cond.Status != metav1.ConditionFalse {
+				return false
+			}
+		case string(gatewayapiv1beta1.ListenerConditionResolvedRefs):
+			if cond.Status != metav1.ConditionTrue {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// invalidateCertificateRef sets the ResolvedRefs condition to false with the
+// InvalidCertificateRef reason for every HTTPS listener that refers to the
+// certificate, and clears the certificate reference.
+func (l *gatewayListeners) invalidateCertificateRef(gateway *gatewayapiv1beta1.Gateway, message string) {
+	for _, listener := range gateway.Spec.Listeners {
+		if listener.Protocol != gatewayapiv1beta1.HTTPSProtocolType || !l.valid(listener.Name) {
+			continue
+		}
+		l.conditions[listener.Name] = setCondition(l.conditions[listener.Name], gateway, gatewayapiv1beta1.ListenerConditionResolvedRefs, metav1.ConditionFalse, gatewayapiv1beta1.ListenerReasonInvalidCertificateRef, message)
+	}
+	l.certificateRef = nil
+}
+
+// computeGatewayListeners validates the gateway's listeners and computes the
+// corresponding ingresscontroller parameters.  An ingresscontroller serves
+// HTTP on port 80 and HTTPS on port 443 for a single wildcard domain with a
+// single default certificate, so listeners that specify another port,
+// protocol, or domain, or a different certificate, are rejected.
+func computeGatewayListeners(gateway *gatewayapiv1beta1.Gateway) gatewayListeners {
+	result := gatewayListeners{
+		conditions: map[gatewayapiv1beta1.SectionName][]metav1.Condition{},
+	}
+	haveSelector := false
+	for _, listener := range gateway.Spec.Listeners {
+		var (
+			conditions   []metav1.Condition
+			conflicted   = metav1.ConditionFalse
+			conflictWhy  = gatewayapiv1beta1.ListenerReasonNoConflicts
+			conflictMsg  = "No conflicts."
+			detached     = metav1.ConditionFalse
+			detachWhy    = gatewayapiv1beta1.ListenerReasonAttached
+			detachMsg    = "Listener is attached."
+			resolved     = metav1.ConditionTrue
+			resolvedWhy  = gatewayapiv1beta1.ListenerReasonResolvedRefs
+			resolvedMsg  = "All references are resolved."
+			listenerCert *gatewayapiv1beta1.SecretObjectReference
+		)
+
+		switch listener.Protocol {
+		case gatewayapiv1beta1.HTTPProtocolType:
+			if listener.Port != 80 {
+				detached, detachWhy = metav1.ConditionTrue, gatewayapiv1beta1.ListenerReasonPortUnavailable
+				detachMsg = fmt.Sprintf("HTTP is only supported on port 80, not %d.", listener.Port)
+			}
+		case gatewayapiv1beta1.HTTPSProtocolType:
+			if listener.Port != 443 {
+				detached, detachWhy = metav1.ConditionTrue, gatewayapiv1beta1.ListenerReasonPortUnavailable
+				detachMsg = fmt.Sprintf("HTTPS is only supported on port 443, not %d.", listener.Port)
+				break
+			}
+			if listener.TLS != nil && listener.TLS.Mode != nil && *listener.TLS.Mode != gatewayapiv1beta1.TLSModeTerminate {
+				detached, detachWhy = metav1.ConditionTrue, gatewayapiv1beta1.ListenerReasonUnsupportedProtocol
+				detachMsg = fmt.Sprintf("TLS mode %q is not supported for HTTPS.", *listener.TLS.Mode)
+				break
+			}
+			if listener.TLS != nil && len(listener.TLS.CertificateRefs) != 0 {
+				ref := listener.TLS.CertificateRefs[0]
+				switch {
+				case ref.Group != nil && len(*ref.Group) != 0 && *ref.Group != corev1.GroupName:
+					resolved, resolvedWhy = metav1.ConditionFalse, gatewayapiv1beta1.ListenerReasonInvalidCertificateRef
+					resolvedMsg = fmt.Sprintf("Certificate reference group %q is not supported.", *ref.Group)
+				case ref.Kind != nil && *ref.Kind != "Secret":
+					resolved, resolvedWhy = metav1.ConditionFalse, gatewayapiv1beta1.ListenerReasonInvalidCertificateRef
+					resolvedMsg = fmt.Sprintf("Certificate reference kind %q is not supported.", *ref.Kind)
+				case ref.Namespace != nil && string(*ref.Namespace) != gateway.Namespace:
+					resolved, resolvedWhy = metav1.ConditionFalse, gatewayapiv1beta1.ListenerReasonRefNotPermitted
+					resolvedMsg = "Certificate references to other namespaces are not permitted."
+				default:
+					listenerCert = &ref
+				}
+			}
+		default:
+			detached, detachWhy = metav1.ConditionTrue, gatewayapiv1beta1.ListenerReasonUnsupportedProtocol
+			detachMsg = fmt.Sprintf("Protocol %q is not supported.", listener.Protocol)
+		}
+
+		if detached == metav1.ConditionFalse {
+			domain := ""
+			if listener.Hostname != nil && strings.HasPrefix(string(*listener.Hostname), "*.") {
+				domain = strings.TrimPrefix(string(*listener.Hostname), "*.")
+			}
+			switch {
+			case len(domain) == 0:
+				conflicted, conflictWhy = metav1.ConditionTrue, gatewayapiv1beta1.ListenerReasonHostnameConflict
+				conflictMsg = "Listener must specify a wildcard hostname."
+			case len(result.domain) != 0 && domain != result.domain:
+				conflicted, conflictWhy = metav1.ConditionTrue, gatewayapiv1beta1.ListenerReasonHostnameConflict
+				conflictMsg = fmt.Sprintf("Hostname %q does not match the gateway's domain %q.", *listener.Hostname, result.domain)
+			case listenerCert != nil && result.certificateRef != nil && listenerCert.Name != result.certificateRef.Name:
+				resolved, resolvedWhy = metav1.ConditionFalse, gatewayapiv1beta1.ListenerReasonInvalidCertificateRef
+				resolvedMsg = fmt.Sprintf("Only one certificate is supported per gateway; listener refers to %q, but the gateway uses %q.", listenerCert.Name, result.certificateRef.Name)
+			}
+		}
+
+		conditions = setCondition(conditions, gateway, gatewayapiv1beta1.ListenerConditionConflicted, conflicted, conflictWhy, conflictMsg)
+		conditions = setCondition(conditions, gateway, gatewayapiv1beta1.ListenerConditionDetached, detached, detachWhy, detachMsg)
+		conditions = setCondition(conditions, gateway, gatewayapiv1beta1.ListenerConditionResolvedRefs, resolved, resolvedWhy, resolvedMsg)
+		result.conditions[listener.Name] = conditions
+
+		if !result.valid(listener.Name) {
+			continue
+		}
+		if len(result.domain) == 0 {
+			result.domain = strings.TrimPrefix(string(*listener.Hostname), "*.")
+		}
+		if listenerCert != nil && result.certificateRef == nil {
+			result.certificateRef = listenerCert
+		}
+		if !haveSelector {
+			result.namespaceSelector = namespaceSelectorForListener(gateway, listener)
+			haveSelector = true
+		}
+	}
+	return result
+}
+
+// namespaceSelectorForListener returns the namespace selector that
+// corresponds to the given listener's allowed routes.  By default, a listener
+// only allows routes in the gateway's namespace.
+func namespaceSelectorForListener(gateway *gatewayapiv1beta1.Gateway, listener gatewayapiv1beta1.Listener) *metav1.LabelSelector {
+	sameNamespace := &metav1.LabelSelector{
+		MatchLabels: map[string]string{
+			corev1.LabelMetadataName: gateway.Namespace,
+		},
+	}
+	if listener.AllowedRoutes == nil || listener.AllowedRoutes.Namespaces == nil || listener.AllowedRoutes.Namespaces.From == nil {
+		return sameNamespace
+	}
+	switch *listener.AllowedRoutes.Namespaces.From {
+	case gatewayapiv1beta1.NamespacesFromAll:
+		return nil
+	case gatewayapiv1beta1.NamespacesFromSelector:
+		return listener.AllowedRoutes.Namespaces.Selector
+	default:
+		return sameNamespace
+	}
+}
+
+// setCondition sets the given listener condition in the given slice of
+// conditions, replacing any existing condition of the same type, and returns
+// the resulting slice.
+func setCondition(conditions []metav1.Condition, gateway *gatewayapiv1beta1.Gateway, conditionType gatewayapiv1beta1.ListenerConditionType, status metav1.ConditionStatus, reason gatewayapiv1beta1.ListenerConditionReason, message string) []metav1.Condition {
+	condition := metav1.Condition{
+		Type:               string(conditionType),
+		Status:             status,
+		Reason:             string(reason),
+		Message:            message,
+		ObservedGeneration: gateway.Generation,
+	}
+	for i := range conditions {
+		if conditions[i].Type == condition.Type {
+			conditions[i] = condition
+			return conditions
+		}
+	}
+	return append(conditions, condition)
+}
@@ -0,0 +1,180 @@
+package gateway
+
+import (
+	"reflect"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	gatewayapiv1beta1 "sigs.k8s.io/gateway-api/apis/v1beta1"
+)
+
+// TestComputeGatewayListeners verifies that computeGatewayListeners maps a
+// gateway's listeners to the expected domain, certificate, and namespace
+// selector, and rejects listeners that an ingresscontroller cannot implement.
+func TestComputeGatewayListeners(t *testing.T) {
+	hostname := func(s string) *gatewayapiv1beta1.Hostname {
+		h := gatewayapiv1beta1.Hostname(s)
+		return &h
+	}
+	http := func(name, host string, port gatewayapiv1beta1.PortNumber) gatewayapiv1beta1.Listener {
+		l := gatewayapiv1beta1.Listener{
+			Name:     gatewayapiv1beta1.SectionName(name),
+			Port:     port,
+			Protocol: gatewayapiv1beta1.HTTPProtocolType,
+		}
+		if len(host) != 0 {
+			l.Hostname = hostname(host)
+		}
+		return l
+	}
+	https := func(name, host string, secret string) gatewayapiv1beta1.Listener {
+		l := http(name, host, 443)
+		l.Protocol = gatewayapiv1beta1.HTTPSProtocolType
+		l.TLS = &gatewayapiv1beta1.GatewayTLSConfig{
+			CertificateRefs: []gatewayapiv1beta1.SecretObjectReference{{
+				Name: gatewayapiv1beta1.ObjectName(secret),
+			}},
+		}
+		return l
+	}
+	withNamespace := func(l gatewayapiv1beta1.Listener, ns string) gatewayapiv1beta1.Listener {
+		namespace := gatewayapiv1beta1.Namespace(ns)
+		l.TLS.CertificateRefs[0].Namespace = &namespace
+		return l
+	}
+	withFrom := func(l gatewayapiv1beta1.Listener, from gatewayapiv1beta1.FromNamespaces) gatewayapiv1beta1.Listener {
+		l.AllowedRoutes = &gatewayapiv1beta1.AllowedRoutes{
+			Namespaces: &gatewayapiv1beta1.RouteNamespaces{From: &from},
+		}
+		return l
+	}
+	sameNamespace := &metav1.LabelSelector{
+		MatchLabels: map[string]string{"kubernetes.io/metadata.name": "ns"},
+	}
+	type reasons map[string]string
+	testCases := []struct {
+		name              string
+		listeners         []gatewayapiv1beta1.Listener
+		expectDomain      string
+		expectCertificate string
+		expectSelector    *metav1.LabelSelector
+		// expectInvalid maps the names of invalid listeners to the
+		// reason of the condition that makes the listener invalid.
+		expectInvalid reasons
+	}{
+		{
+			name:           "single HTTP listener",
+			listeners:      []gatewayapiv1beta1.Listener{http("http", "*.apps.example.com", 80)},
+			expectDomain:   "apps.example.com",
+			expectSelector: sameNamespace,
+			expectInvalid:  reasons{},
+		},
+		{
+			name: "HTTP and HTTPS listeners",
+			listeners: []gatewayapiv1beta1.Listener{
+				withFrom(http("http", "*.apps.example.com", 80), gatewayapiv1beta1.NamespacesFromAll),
+				https("https", "*.apps.example.com", "cert"),
+			},
+			expectDomain:      "apps.example.com",
+			expectCertificate: "cert",
+			expectSelector:    nil,
+			expectInvalid:     reasons{},
+		},
+		{
+			name: "HTTP on the wrong port",
+			listeners: []gatewayapiv1beta1.Listener{
+				http("http", "*.apps.example.com", 8080),
+			},
+			expectInvalid: reasons{"http": "PortUnavailable"},
+		},
+		{
+			name: "missing hostname",
+			listeners: []gatewayapiv1beta1.Listener{
+				http("http", "", 80),
+			},
+			expectInvalid: reasons{"http": "HostnameConflict"},
+		},
+		{
+			name: "non-wildcard hostname",
+			listeners: []gatewayapiv1beta1.Listener{
+				http("http", "www.example.com", 80),
+			},
+			expectInvalid: reasons{"http": "HostnameConflict"},
+		},
+		{
+			name: "conflicting domains",
+			listeners: []gatewayapiv1beta1.Listener{
+				http("http", "*.apps.example.com", 80),
+				https("https", "*.other.example.com", "cert"),
+			},
+			expectDomain:   "apps.example.com",
+			expectSelector: sameNamespace,
+			expectInvalid:  reasons{"https": "HostnameConflict"},
+		},
+		{
+			name: "unsupported protocol",
+			listeners: []gatewayapiv1beta1.Listener{
+				{Name: "tcp", Port: 80, Protocol: gatewayapiv1beta1.TCPProtocolType, Hostname: hostname("*.apps.example.com")},
+				http("http", "*.apps.example.com", 80),
+			},
+			expectDomain:   "apps.example.com",
+			expectSelector: sameNamespace,
+			expectInvalid:  reasons{"tcp": "UnsupportedProtocol"},
+		},
+		{
+			name: "certificate in another namespace",
+			listeners: []gatewayapiv1beta1.Listener{
+				withNamespace(https("https", "*.apps.example.com", "cert"), "other"),
+			},
+			expectInvalid: reasons{"https": "RefNotPermitted"},
+		},
+		{
+			name: "different certificates",
+			listeners: []gatewayapiv1beta1.Listener{
+				https("https", "*.apps.example.com", "cert1"),
+				https("https2", "*.apps.example.com", "cert2"),
+			},
+			expectDomain:      "apps.example.com",
+			expectCertificate: "cert1",
+			expectSelector:    sameNamespace,
+			expectInvalid:     reasons{"https2": "InvalidCertificateRef"},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			gateway := &gatewayapiv1beta1.Gateway{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "gw"},
+				Spec:       gatewayapiv1beta1.GatewaySpec{Listeners: tc.listeners},
+			}
+			result := computeGatewayListeners(gateway)
+			if result.domain != tc.expectDomain {
+				t.Errorf("expected domain %q, got %q", tc.expectDomain, result.domain)
+			}
+			certificate := ""
+			if result.certificateRef != nil {
+				certificate = string(result.certificateRef.Name)
+			}
+			if certificate != tc.expectCertificate {
+				t.Errorf("expected certificate %q, got %q", tc.expectCertificate, certificate)
+			}
+			if !reflect.DeepEqual(result.namespaceSelector, tc.expectSelector) {
+				t.Errorf("expected namespace selector %v, got %v", tc.expectSelector, result.namespaceSelector)
+			}
+			invalid := reasons{}
+			for _, listener := range tc.listeners {
+				if result.valid(listener.Name) {
+					continue
+				}
+				for _, cond := range result.conditions[listener.Name] {
+					if (cond.Type == "ResolvedRefs") != (cond.Status == metav1.ConditionTrue) {
+						invalid[string(listener.Name)] = cond.Reason
+					}
+				}
+			}
+			if !reflect.DeepEqual(invalid, tc.expectInvalid) {
+				t.Errorf("expected invalid listeners %v, got %v", tc.expectInvalid, invalid)
+			}
+		})
+	}
+}
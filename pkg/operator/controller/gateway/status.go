@@ -0,0 +1,216 @@
+package gateway
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	operatorv1 "github.com/openshift/api/operator/v1"
+	ingresscontroller "github.com/openshift/cluster-ingress-operator/pkg/operator/controller/ingress"
+
+	corev1 "k8s.io/api/core/v1"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	gatewayapiv1beta1 "sigs.k8s.io/gateway-api/apis/v1beta1"
+)
+
+// updateGatewayStatus computes the gateway's status from the given listeners,
+// ingresscontroller, and load-balancer service, and updates the gateway's
+// status if it changed.
+func (r *reconciler) updateGatewayStatus(gateway *gatewayapiv1beta1.Gateway, listeners *gatewayListeners, ic *operatorv1.IngressController, conflict string, service *corev1.Service) error {
+	updated := gateway.DeepCopy()
+	updated.Status = computeGatewayStatus(gateway, listeners, ic, conflict, service)
+	if !gatewayStatusChanged(gateway.Status, updated.Status) {
+		return nil
+	}
+	if err := r.client.Status().Update(context.TODO(), updated); err != nil {
+		return err
+	}
+	log.Info("updated gateway status", "namespace", gateway.Namespace, "name", gateway.Name)
+	return nil
+}
+
+// computeGatewayStatus returns the status for the given gateway.  The Scheduled
+// condition reflects whether the ingresscontroller has been admitted, and the
+// Ready condition reflects whether the ingresscontroller is available and, if
+// it uses a load balancer, whether the load balancer has been provisioned.
+//
+// Only the listeners are provisioned, as the ingresscontroller; route
+// resources of the Gateway API, such as HTTPRoutes, are not programmed into
+// the ingresscontroller.  Each listener therefore reports no supported route
+// kinds and, unless a reference is invalid for another reason, the
+// ResolvedRefs condition with status False and reason InvalidRouteKinds.
+func computeGatewayStatus(gateway *gatewayapiv1beta1.Gateway, listeners *gatewayListeners, ic *operatorv1.IngressController, conflict string, service *corev1.Service) gatewayapiv1beta1.GatewayStatus {
+	status := gatewayapiv1beta1.GatewayStatus{
+		Addresses:  gatewayAddresses(service),
+		Conditions: append([]metav1.Condition{}, gateway.Status.Conditions...),
+	}
+
+	haveValidListener := false
+	for _, listener := range gateway.Spec.Listeners {
+		if listeners.valid(listener.Name) {
+			haveValidListener = true
+			break
+		}
+	}
+
+	scheduled := metav1.Condition{
+		Type:               string(gatewayapiv1beta1.GatewayConditionScheduled),
+		Status:             metav1.ConditionTrue,
+		Reason:             string(gatewayapiv1beta1.GatewayReasonScheduled),
+		Message:            "The IngressController has been admitted.",
+		ObservedGeneration: gateway.Generation,
+	}
+	admitted, available := false, false
+	if ic != nil {
+		for _, cond := range ic.Status.Conditions {
+			switch cond.Type {
+			case ingresscontroller.IngressControllerAdmittedConditionType:
+				admitted = cond.Status == operatorv1.ConditionTrue
+				if cond.Status == operatorv1.ConditionFalse {
+					scheduled.Status = metav1.ConditionFalse
+					scheduled.Reason = string(gatewayapiv1beta1.GatewayReasonNoResources)
+					scheduled.Message = fmt.Sprintf("The IngressController was rejected: %s", cond.Message)
+				}
+			case operatorv1.OperatorStatusTypeAvailable:
+				available = cond.Status == operatorv1.ConditionTrue
+			}
+		}
+	}
+	switch {
+	case len(conflict) != 0:
+		scheduled.Status = metav1.ConditionFalse
+		scheduled.Reason = string(gatewayapiv1beta1.GatewayReasonNoResources)
+		scheduled.Message = conflict
+	case !haveValidListener:
+		scheduled.Status = metav1.ConditionFalse
+		scheduled.Reason = string(gatewayapiv1beta1.GatewayReasonNoResources)
+		scheduled.Message = "The Gateway has no valid listeners."
+	case !admitted && scheduled.Status == metav1.ConditionTrue:
+		scheduled.Status = metav1.ConditionFalse
+		scheduled.Reason = string(gatewayapiv1beta1.GatewayReasonNotReconciled)
+		scheduled.Message = "Waiting for the IngressController to be admitted."
+	}
+	meta.SetStatusCondition(&status.Conditions, scheduled)
+
+	needAddress := ic != nil && ic.Status.EndpointPublishingStrategy != nil && ic.Status.EndpointPublishingStrategy.Type == operatorv1.LoadBalancerServiceStrategyType
+	ready := metav1.Condition{
+		Type:               string(gatewayapiv1beta1.GatewayConditionReady),
+		Status:             metav1.ConditionTrue,
+		Reason:             string(gatewayapiv1beta1.GatewayReasonReady),
+		Message:            "The IngressController is available.",
+		ObservedGeneration: gateway.Generation,
+	}
+	switch {
+	case !haveValidListener || len(conflict) != 0:
+		ready.Status = metav1.ConditionFalse
+		ready.Reason = string(gatewayapiv1beta1.GatewayReasonListenersNotValid)
+		ready.Message = "The Gateway has no valid listeners."
+		if len(conflict) != 0 {
+			ready.Message = conflict
+		}
+	case !available:
+		ready.Status = metav1.ConditionFalse
+		ready.Reason = string(gatewayapiv1beta1.GatewayReasonListenersNotReady)
+		ready.Message = "Waiting for the IngressController to become available."
+	case needAddress && len(status.Addresses) == 0:
+		ready.Status = metav1.ConditionFalse
+		ready.Reason = string(gatewayapiv1beta1.GatewayReasonAddressNotAssigned)
+		ready.Message = "Waiting for the load balancer to be provisioned."
+	}
+	meta.SetStatusCondition(&status.Conditions, ready)
+
+	for _, listener := range gateway.Spec.Listeners {
+		listenerStatus := gatewayapiv1beta1.ListenerStatus{
+			Name:           listener.Name,
+			SupportedKinds: []gatewayapiv1beta1.RouteGroupKind{},
+			Conditions:     currentListenerConditions(gateway, listener.Name),
+		}
+		for _, cond := range listeners.conditions[listener.Name] {
+			meta.SetStatusCondition(&listenerStatus.Conditions, cond)
+		}
+		if meta.IsStatusConditionTrue(listenerStatus.Conditions, string(gatewayapiv1beta1.ListenerConditionResolvedRefs)) {
+			meta.SetStatusCondition(&listenerStatus.Conditions, metav1.Condition{
+				Type:               string(gatewayapiv1beta1.ListenerConditionResolvedRefs),
+				Status:             metav1.ConditionFalse,
+				Reason:             string(gatewayapiv1beta1.ListenerReasonInvalidRouteKinds),
+				Message:            "No route kinds are supported. Only the listener is provisioned; HTTPRoutes are not programmed. Use routes or ingresses with hosts under the listener's hostname instead.",
+				ObservedGeneration: gateway.Generation,
+			})
+		}
+		listenerReady := metav1.Condition{
+			Type:               string(gatewayapiv1beta1.ListenerConditionReady),
+			Status:             metav1.ConditionTrue,
+			Reason:             string(gatewayapiv1beta1.ListenerReasonReady),
+			Message:            "Listener is ready.",
+			ObservedGeneration: gateway.Generation,
+		}
+		switch {
+		case !listeners.valid(listener.Name) || len(conflict) != 0:
+			listenerReady.Status = metav1.ConditionFalse
+			listenerReady.Reason = string(gatewayapiv1beta1.ListenerReasonInvalid)
+			listenerReady.Message = "Listener is invalid."
+		case ready.Status != metav1.ConditionTrue:
+			listenerReady.Status = metav1.ConditionFalse
+			listenerReady.Reason = string(gatewayapiv1beta1.ListenerReasonPending)
+			listenerReady.Message = ready.Message
+		}
+		meta.SetStatusCondition(&listenerStatus.Conditions, listenerReady)
+		status.Listeners = append(status.Listeners, listenerStatus)
+	}
+
+	return status
+}
+
+// currentListenerConditions returns a copy of the current conditions of the
+// gateway's listener with the given name so that transition times are
+// preserved.
+func currentListenerConditions(gateway *gatewayapiv1beta1.Gateway, name gatewayapiv1beta1.SectionName) []metav1.Condition {
+	for _, listenerStatus := range gateway.Status.Listeners {
+		if listenerStatus.Name == name {
+			return append([]metav1.Condition{}, listenerStatus.Conditions...)
+		}
+	}
+	return nil
+}
+
+// gatewayAddresses returns the addresses for a gateway from the given
+// load-balancer service's status.
+func gatewayAddresses(service *corev1.Service) []gatewayapiv1beta1.GatewayAddress {
+	if service == nil {
+		return nil
+	}
+	var addresses []gatewayapiv1beta1.GatewayAddress
+	ipAddressType := gatewayapiv1beta1.IPAddressType
+	hostnameAddressType := gatewayapiv1beta1.HostnameAddressType
+	for _, ingress := range service.Status.LoadBalancer.Ingress {
+		switch {
+		case len(ingress.IP) != 0:
+			addresses = append(addresses, gatewayapiv1beta1.GatewayAddress{
+				Type:  &ipAddressType,
+				Value: ingress.IP,
+			})
+		case len(ingress.Hostname) != 0:
+			addresses = append(addresses, gatewayapiv1beta1.GatewayAddress{
+				Type:  &hostnameAddressType,
+				Value: ingress.Hostname,
+			})
+		}
+	}
+	return addresses
+}
+
+// gatewayStatusChanged returns a Boolean indicating whether the current status
+// differs from the updated status, ignoring condition transition times.
+func gatewayStatusChanged(current, updated gatewayapiv1beta1.GatewayStatus) bool {
+	statusCmpOpts := []cmp.Option{
+		cmpopts.IgnoreFields(metav1.Condition{}, "LastTransitionTime"),
+		cmpopts.EquateEmpty(),
+		cmpopts.SortSlices(func(a, b metav1.Condition) bool { return a.Type < b.Type }),
+	}
+	return !cmp.Equal(current, updated, statusCmpOpts...)
+}
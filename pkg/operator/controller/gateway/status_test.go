@@ -0,0 +1,152 @@
+package gateway
+
+import (
+	"testing"
+
+	operatorv1 "github.com/openshift/api/operator/v1"
+
+	corev1 "k8s.io/api/core/v1"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	gatewayapiv1beta1 "sigs.k8s.io/gateway-api/apis/v1beta1"
+)
+
+// TestComputeGatewayStatus verifies that computeGatewayStatus computes the
+// expected gateway conditions and addresses from the ingresscontroller's status
+// and the load-balancer service.
+func TestComputeGatewayStatus(t *testing.T) {
+	hostname := gatewayapiv1beta1.Hostname("*.apps.example.com")
+	gateway := &gatewayapiv1beta1.Gateway{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "gw", Generation: 3},
+		Spec: gatewayapiv1beta1.GatewaySpec{
+			Listeners: []gatewayapiv1beta1.Listener{{
+				Name:     "http",
+				Hostname: &hostname,
+				Port:     80,
+				Protocol: gatewayapiv1beta1.HTTPProtocolType,
+			}},
+		},
+	}
+	invalidGateway := gateway.DeepCopy()
+	invalidGateway.Spec.Listeners[0].Port = 8080
+	ic := func(admitted, available operatorv1.ConditionStatus, strategy operatorv1.EndpointPublishingStrategyType) *operatorv1.IngressController {
+		return &operatorv1.IngressController{
+			Status: operatorv1.IngressControllerStatus{
+				EndpointPublishingStrategy: &operatorv1.EndpointPublishingStrategy{Type: strategy},
+				Conditions: []operatorv1.OperatorCondition{
+					{Type: "Admitted", Status: admitted},
+					{Type: "Available", Status: available},
+				},
+			},
+		}
+	}
+	service := &corev1.Service{
+		Status: corev1.ServiceStatus{
+			LoadBalancer: corev1.LoadBalancerStatus{
+				Ingress: []corev1.LoadBalancerIngress{{Hostname: "lb.example.com"}, {IP: "192.0.2.1"}},
+			},
+		},
+	}
+	testCases := []struct {
+		name            string
+		gateway         *gatewayapiv1beta1.Gateway
+		ic              *operatorv1.IngressController
+		conflict        string
+		service         *corev1.Service
+		expectScheduled string
+		expectReady     string
+		expectListener  string
+		expectAddresses int
+	}{
+		{
+			name:            "ingresscontroller not created yet",
+			gateway:         gateway,
+			expectScheduled: "NotReconciled",
+			expectReady:     "ListenersNotReady",
+			expectListener:  "Pending",
+		},
+		{
+			name:            "ingresscontroller rejected",
+			gateway:         gateway,
+			ic:              ic(operatorv1.ConditionFalse, operatorv1.ConditionFalse, operatorv1.LoadBalancerServiceStrategyType),
+			expectScheduled: "NoResources",
+			expectReady:     "ListenersNotReady",
+			expectListener:  "Pending",
+		},
+		{
+			name:            "load balancer pending",
+			gateway:         gateway,
+			ic:              ic(operatorv1.ConditionTrue, operatorv1.ConditionTrue, operatorv1.LoadBalancerServiceStrategyType),
+			service:         &corev1.Service{},
+			expectScheduled: "Scheduled",
+			expectReady:     "AddressNotAssigned",
+			expectListener:  "Pending",
+		},
+		{
+			name:            "load balancer provisioned",
+			gateway:         gateway,
+			ic:              ic(operatorv1.ConditionTrue, operatorv1.ConditionTrue, operatorv1.LoadBalancerServiceStrategyType),
+			service:         service,
+			expectScheduled: "Scheduled",
+			expectReady:     "Ready",
+			expectListener:  "Ready",
+			expectAddresses: 2,
+		},
+		{
+			name:            "host network",
+			gateway:         gateway,
+			ic:              ic(operatorv1.ConditionTrue, operatorv1.ConditionTrue, operatorv1.HostNetworkStrategyType),
+			expectScheduled: "Scheduled",
+			expectReady:     "Ready",
+			expectListener:  "Ready",
+		},
+		{
+			name:            "conflicting ingresscontroller",
+			gateway:         gateway,
+			conflict:        "conflict",
+			expectScheduled: "NoResources",
+			expectReady:     "ListenersNotValid",
+			expectListener:  "Invalid",
+		},
+		{
+			name:            "no valid listeners",
+			gateway:         invalidGateway,
+			expectScheduled: "NoResources",
+			expectReady:     "ListenersNotValid",
+			expectListener:  "Invalid",
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			listeners := computeGatewayListeners(tc.gateway)
+			status := computeGatewayStatus(tc.gateway, &listeners, tc.ic, tc.conflict, tc.service)
+			if cond := meta.FindStatusCondition(status.Conditions, "Scheduled"); cond == nil || cond.Reason != tc.expectScheduled {
+				t.Errorf("expected Scheduled condition with reason %q, got %+v", tc.expectScheduled, cond)
+			} else if cond.ObservedGeneration != tc.gateway.Generation {
+				t.Errorf("expected observed generation %d, got %d", tc.gateway.Generation, cond.ObservedGeneration)
+			}
+			if cond := meta.FindStatusCondition(status.Conditions, "Ready"); cond == nil || cond.Reason != tc.expectReady {
+				t.Errorf("expected Ready condition with reason %q, got %+v", tc.expectReady, cond)
+			}
+			if len(status.Listeners) != 1 {
+				t.Fatalf("expected 1 listener status, got %d", len(status.Listeners))
+			}
+			if cond := meta.FindStatusCondition(status.Listeners[0].Conditions, "Ready"); cond == nil || cond.Reason != tc.expectListener {
+				t.Errorf("expected listener Ready condition with reason %q, got %+v", tc.expectListener, cond)
+			}
+			if cond := meta.FindStatusCondition(status.Listeners[0].Conditions, "ResolvedRefs"); cond == nil || cond.Status != metav1.ConditionFalse {
+				t.Errorf("expected listener ResolvedRefs condition with status False, got %+v", cond)
+			} else if tc.expectListener != "Invalid" && cond.Reason != "InvalidRouteKinds" {
+				t.Errorf("expected listener ResolvedRefs condition with reason InvalidRouteKinds, got %+v", cond)
+			}
+			if len(status.Listeners[0].SupportedKinds) != 0 {
+				t.Errorf("expected no supported kinds, got %v", status.Listeners[0].SupportedKinds)
+			}
+			if len(status.Addresses) != tc.expectAddresses {
+				t.Errorf("expected %d addresses, got %v", tc.expectAddresses, status.Addresses)
+			}
+		})
+	}
+}
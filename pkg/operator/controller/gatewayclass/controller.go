@@ -0,0 +1,123 @@
+package gatewayclass
+
+import (
+	"context"
+	"fmt"
+
+	operatorv1 "github.com/openshift/api/operator/v1"
+	logf "github.com/openshift/cluster-ingress-operator/pkg/log"
+	operatorcontroller "github.com/openshift/cluster-ingress-operator/pkg/operator/controller"
+
+	"k8s.io/apimachinery/pkg/types"
+
+	"sigs.k8s.io/controller-runtime/pkg/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+
+	gatewayapiv1beta1 "sigs.k8s.io/gateway-api/apis/v1beta1"
+)
+
+const (
+	controllerName = "gatewayclass_controller"
+)
+
+var (
+	log = logf.Logger.WithName(controllerName)
+)
+
+// New creates and returns a controller that creates the default GatewayClass
+// and accepts GatewayClasses that specify the operator's controller name.
+//
+// The Gateway API CRDs must exist when this function is called.
+func New(mgr manager.Manager, config Config) (controller.Controller, error) {
+	reconciler := &reconciler{
+		config: config,
+		client: mgr.GetClient(),
+		cache:  mgr.GetCache(),
+	}
+	c, err := controller.New(controllerName, mgr, controller.Options{Reconciler: reconciler})
+	if err != nil {
+		return nil, err
+	}
+	if err := c.Watch(&source.Kind{Type: &gatewayapiv1beta1.GatewayClass{}}, &handler.EnqueueRequestForObject{}, predicate.NewPredicateFuncs(gatewayClassHasOurController)); err != nil {
+		return nil, err
+	}
+	// The default ingresscontroller always exists, so watching
+	// ingresscontrollers ensures that the default gatewayclass is
+	// reconciled when the operator starts.
+	if err := c.Watch(&source.Kind{Type: &operatorv1.IngressController{}}, handler.EnqueueRequestsFromMapFunc(toDefaultGatewayClass)); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// toDefaultGatewayClass returns a reconcile.Request for the default
+// gatewayclass.
+func toDefaultGatewayClass(_ client.Object) []reconcile.Request {
+	return []reconcile.Request{{
+		NamespacedName: types.NamespacedName{
+			Name: operatorcontroller.OpenShiftDefaultGatewayClassName,
+		},
+	}}
+}
+
+// gatewayClassHasOurController returns a value indicating whether the provided
+// object is a GatewayClass that specifies the operator's controller name.
+func gatewayClassHasOurController(o client.Object) bool {
+	class, ok := o.(*gatewayapiv1beta1.GatewayClass)
+	return ok && class.Spec.ControllerName == operatorcontroller.OpenShiftGatewayClassControllerName
+}
+
+// Config holds all the configuration that must be provided when creating the
+// controller.
+type Config struct {
+	// DefaultGatewayClassEnabled indicates whether the controller should
+	// create the default GatewayClass.
+	DefaultGatewayClassEnabled bool
+}
+
+// reconciler handles the actual gatewayclass reconciliation logic.
+type reconciler struct {
+	config Config
+
+	client client.Client
+	cache  cache.Cache
+}
+
+// Reconcile expects request to refer to a GatewayClass.  If the GatewayClass
+// is the default one, Reconcile ensures that it exists.  If the GatewayClass
+// specifies the operator's controller name, Reconcile sets its Accepted status
+// condition.
+func (r *reconciler) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	log.Info("reconciling", "request", request)
+
+	if r.config.DefaultGatewayClassEnabled && request.Name == operatorcontroller.OpenShiftDefaultGatewayClassName {
+		if err := r.ensureDefaultGatewayClass(); err != nil {
+			return reconcile.Result{}, fmt.Errorf("failed to ensure default gatewayclass: %w", err)
+		}
+	}
+
+	class := &gatewayapiv1beta1.GatewayClass{}
+	if err := r.client.Get(ctx, types.NamespacedName{Name: request.Name}, class); err != nil {
+		if client.IgnoreNotFound(err) == nil {
+			log.Info("gatewayclass not found; reconciliation will be skipped", "request", request)
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, fmt.Errorf("failed to get gatewayclass %q: %w", request.Name, err)
+	}
+
+	if !gatewayClassHasOurController(class) {
+		return reconcile.Result{}, nil
+	}
+
+	if err := r.updateGatewayClassStatus(class); err != nil {
+		return reconcile.Result{}, fmt.Errorf("failed to update status for gatewayclass %q: %w", class.Name, err)
+	}
+
+	return reconcile.Result{}, nil
+}
@@ -0,0 +1,98 @@
+package gatewayclass
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	operatorcontroller "github.com/openshift/cluster-ingress-operator/pkg/operator/controller"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	gatewayapiv1beta1 "sigs.k8s.io/gateway-api/apis/v1beta1"
+)
+
+// ensureDefaultGatewayClass ensures that the default GatewayClass exists.  The
+// GatewayClass's controller name is immutable, so if a GatewayClass with the
+// default name already exists, it is left as is.
+func (r *reconciler) ensureDefaultGatewayClass() error {
+	name := types.NamespacedName{Name: operatorcontroller.OpenShiftDefaultGatewayClassName}
+	current := &gatewayapiv1beta1.GatewayClass{}
+	if err := r.client.Get(context.TODO(), name, current); err == nil {
+		if current.Spec.ControllerName != operatorcontroller.OpenShiftGatewayClassControllerName {
+			log.Info("gatewayclass exists with a different controller name; not managing it", "gatewayclass", name.Name, "controllerName", current.Spec.ControllerName)
+		}
+		return nil
+	} else if !errors.IsNotFound(err) {
+		return fmt.Errorf("failed to get gatewayclass %q: %w", name.Name, err)
+	}
+
+	desired := desiredGatewayClass()
+	if err := r.client.Create(context.TODO(), desired); err != nil {
+		if errors.IsAlreadyExists(err) {
+			return nil
+		}
+		return fmt.Errorf("failed to create gatewayclass %q: %w", desired.Name, err)
+	}
+	log.Info("created gatewayclass", "gatewayclass", desired)
+	return nil
+}
+
+// desiredGatewayClass returns the desired default GatewayClass.
+func desiredGatewayClass() *gatewayapiv1beta1.GatewayClass {
+	description := "The default GatewayClass for OpenShift.  Gateways of this class are implemented using IngressControllers."
+	return &gatewayapiv1beta1.GatewayClass{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: operatorcontroller.OpenShiftDefaultGatewayClassName,
+		},
+		Spec: gatewayapiv1beta1.GatewayClassSpec{
+			ControllerName: gatewayapiv1beta1.GatewayController(operatorcontroller.OpenShiftGatewayClassControllerName),
+			Description:    &description,
+		},
+	}
+}
+
+// updateGatewayClassStatus sets the Accepted status condition on the given
+// GatewayClass and updates the GatewayClass's status if it changed.
+func (r *reconciler) updateGatewayClassStatus(class *gatewayapiv1beta1.GatewayClass) error {
+	updated := class.DeepCopy()
+	meta.SetStatusCondition(&updated.Status.Conditions, computeGatewayClassAcceptedCondition(class))
+	if !gatewayClassStatusChanged(class.Status, updated.Status) {
+		return nil
+	}
+	if err := r.client.Status().Update(context.TODO(), updated); err != nil {
+		return err
+	}
+	log.Info("updated gatewayclass status", "gatewayclass", class.Name)
+	return nil
+}
+
+// computeGatewayClassAcceptedCondition computes the Accepted status condition
+// for a GatewayClass that specifies the operator's controller name.  The
+// operator does not use the GatewayClass's parameters, so it accepts any such
+// GatewayClass.
+func computeGatewayClassAcceptedCondition(class *gatewayapiv1beta1.GatewayClass) metav1.Condition {
+	return metav1.Condition{
+		Type:               string(gatewayapiv1beta1.GatewayClassConditionStatusAccepted),
+		Status:             metav1.ConditionTrue,
+		Reason:             string(gatewayapiv1beta1.GatewayClassReasonAccepted),
+		Message:            "GatewayClass is accepted by the ingress operator.",
+		ObservedGeneration: class.Generation,
+	}
+}
+
+// gatewayClassStatusChanged returns a Boolean indicating whether the current
+// status differs from the updated status, ignoring condition transition times.
+func gatewayClassStatusChanged(current, updated gatewayapiv1beta1.GatewayClassStatus) bool {
+	conditionCmpOpts := []cmp.Option{
+		cmpopts.IgnoreFields(metav1.Condition{}, "LastTransitionTime"),
+		cmpopts.EquateEmpty(),
+		cmpopts.SortSlices(func(a, b metav1.Condition) bool { return a.Type < b.Type }),
+	}
+	return !cmp.Equal(current.Conditions, updated.Conditions, conditionCmpOpts...)
+}
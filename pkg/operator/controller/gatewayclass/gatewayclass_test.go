@@ -0,0 +1,68 @@
+package gatewayclass
+
+import (
+	"testing"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	gatewayapiv1beta1 "sigs.k8s.io/gateway-api/apis/v1beta1"
+)
+
+// TestGatewayClassStatusChanged verifies that gatewayClassStatusChanged
+// detects changes to conditions and ignores transition times.
+func TestGatewayClassStatusChanged(t *testing.T) {
+	class := &gatewayapiv1beta1.GatewayClass{
+		ObjectMeta: metav1.ObjectMeta{Generation: 2},
+	}
+	accepted := computeGatewayClassAcceptedCondition(class)
+	testCases := []struct {
+		description string
+		current     []metav1.Condition
+		expect      bool
+	}{
+		{
+			description: "no conditions",
+			current:     nil,
+			expect:      true,
+		},
+		{
+			description: "pending condition from the apiserver default",
+			current: []metav1.Condition{{
+				Type:               string(gatewayapiv1beta1.GatewayClassConditionStatusAccepted),
+				Status:             metav1.ConditionUnknown,
+				Reason:             "Waiting",
+				ObservedGeneration: 1,
+			}},
+			expect: true,
+		},
+		{
+			description: "stale observed generation",
+			current: []metav1.Condition{func() metav1.Condition {
+				c := accepted
+				c.ObservedGeneration = 1
+				return c
+			}()},
+			expect: true,
+		},
+		{
+			description: "only the transition time differs",
+			current: []metav1.Condition{func() metav1.Condition {
+				c := accepted
+				c.LastTransitionTime = metav1.Unix(1, 0)
+				return c
+			}()},
+			expect: false,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			current := gatewayapiv1beta1.GatewayClassStatus{Conditions: tc.current}
+			updated := *current.DeepCopy()
+			meta.SetStatusCondition(&updated.Conditions, accepted)
+			if actual := gatewayClassStatusChanged(current, updated); actual != tc.expect {
+				t.Errorf("expected %t, got %t", tc.expect, actual)
+			}
+		})
+	}
+}
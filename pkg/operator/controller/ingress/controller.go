@@ -58,6 +58,7 @@ const (
 	IngressControllerLoadBalancerProgressingConditionType        = "LoadBalancerProgressing"
 	IngressControllerCanaryCheckSuccessConditionType             = "CanaryChecksSucceeding"
 	IngressControllerEvaluationConditionsDetectedConditionType   = "EvaluationConditionsDetected"
+	IngressControllerDefaultCertificateValidConditionType        = "DefaultCertificateValid"
 
 	routerDefaultHeaderBufferSize           = 32768
 	routerDefaultHeaderBufferMaxRewriteSize = 8192
@@ -105,6 +106,14 @@ func New(mgr manager.Manager, config Config) (controller.Controller, error) {
 	if err := c.Watch(&source.Kind{Type: &corev1.Service{}}, enqueueRequestForOwningIngressController(config.Namespace)); err != nil {
 		return nil, err
 	}
+	// Watch secrets so that the controller validates a user-supplied
+	// default certificate whenever the secret is modified.
+	isInOperandNamespace := func(o client.Object) bool {
+		return o.GetNamespace() == operatorcontroller.DefaultOperandNamespace
+	}
+	if err := c.Watch(&source.Kind{Type: &corev1.Secret{}}, handler.EnqueueRequestsFromMapFunc(reconciler.defaultCertificateToIngressController), predicate.NewPredicateFuncs(isInOperandNamespace)); err != nil {
+		return nil, err
+	}
 	// Add watch for deleted pods specifically for ensuring ingress deletion.
 	if err := c.Watch(&source.Kind{Type: &corev1.Pod{}}, enqueueRequestForOwningIngressController(config.Namespace), predicate.Funcs{
 		CreateFunc:  func(e event.CreateEvent) bool { return false },
@@ -147,6 +156,32 @@ func (r *reconciler) ingressConfigToIngressController(o client.Object) []reconci
 	return requests
 }
 
+// defaultCertificateToIngressController maps a secret to a slice of reconcile
+// requests, one request per ingresscontroller that specifies the secret as its
+// default certificate.
+func (r *reconciler) defaultCertificateToIngressController(o client.Object) []reconcile.Request {
+	var requests []reconcile.Request
+	controllers := &operatorv1.IngressControllerList{}
+	if err := r.cache.List(context.Background(), controllers, client.InNamespace(r.config.Namespace)); err != nil {
+		log.Error(err, "failed to list ingresscontrollers for secret", "related", o.GetSelfLink())
+		return requests
+	}
+	for _, ic := range controllers.Items {
+		if ic.Spec.DefaultCertificate == nil || ic.Spec.DefaultCertificate.Name != o.GetName() {
+			continue
+		}
+		log.Info("queueing ingresscontroller", "name", ic.Name, "related", o.GetSelfLink())
+		request := reconcile.Request{
+			NamespacedName: types.NamespacedName{
+				Namespace: ic.Namespace,
+				Name:      ic.Name,
+			},
+		}
+		requests = append(requests, request)
+	}
+	return requests
+}
+
 func enqueueRequestForOwningIngressController(namespace string) handler.EventHandler {
 	return handler.EnqueueRequestsFromMapFunc(
 		func(a client.Object) []reconcile.Request {
@@ -723,17 +758,30 @@ func tlsProfileSpecForSecurityProfile(profile *configv1.TLSSecurityProfile) *con
 // admissionRejection if the ingresscontroller is invalid, or a non-nil value of
 // a different type if validation could not be completed.
 func (r *reconciler) validate(ic *operatorv1.IngressController) error {
-	var errors []error
-
 	ingresses := &operatorv1.IngressControllerList{}
 	if err := r.cache.List(context.TODO(), ingresses, client.InNamespace(r.config.Namespace)); err != nil {
 		return fmt.Errorf("failed to list ingresscontrollers: %v", err)
 	}
 
+	if err := validateIngressController(ic, ingresses.Items); err != nil {
+		return &admissionRejection{err.Error()}
+	}
+
+	return nil
+}
+
+// validateIngressController validates the given defaulted ingresscontroller
+// against the given existing ingresscontrollers and returns an aggregate of the
+// validation errors, or nil if the ingresscontroller is valid.  Both the
+// reconciler and the validating admission webhook use this function so that
+// they accept and reject the same ingresscontrollers.
+func validateIngressController(ic *operatorv1.IngressController, existing []operatorv1.IngressController) error {
+	var errors []error
+
 	if err := validateDomain(ic); err != nil {
 		errors = append(errors, err)
 	}
-	if err := validateDomainUniqueness(ic, ingresses.Items); err != nil {
+	if err := validateDomainUniqueness(ic, existing); err != nil {
 		errors = append(errors, err)
 	}
 	if err := validateTLSSecurityProfile(ic); err != nil {
@@ -745,11 +793,8 @@ func (r *reconciler) validate(ic *operatorv1.IngressController) error {
 	if err := validateClientTLS(ic); err != nil {
 		errors = append(errors, err)
 	}
-	if err := utilerrors.NewAggregate(errors); err != nil {
-		return &admissionRejection{err.Error()}
-	}
 
-	return nil
+	return utilerrors.NewAggregate(errors)
 }
 
 func validateDomain(ic *operatorv1.IngressController) error {
@@ -928,6 +973,7 @@ func (r *reconciler) ensureIngressDeleted(ingress *operatorv1.IngressController)
 	// Delete the metrics related to the ingresscontroller
 	DeleteIngressControllerConditionsMetric(ingress)
 	DeleteActiveNLBMetrics(ingress)
+	DeleteIngressControllerReconcileMetrics(ingress)
 
 	// Delete the RoutesPerShard metric label corresponding to the Ingress Controller.
 	routemetrics.DeleteRouteMetricsControllerRoutesPerShardMetric(ingress.Name)
@@ -1002,29 +1048,30 @@ func (r *reconciler) ensureIngressController(ci *operatorv1.IngressController, d
 
 	haveDepl, deployment, err := r.ensureRouterDeployment(ci, infraConfig, ingressConfig, apiConfig, networkConfig, haveClientCAConfigmap, clientCAConfigmap, platformStatus)
 	if err != nil {
+		reportReconcileError(ci, reconcileStepDeployment)
 		errs = append(errs, fmt.Errorf("failed to ensure deployment: %v", err))
 		return utilerrors.NewAggregate(errs)
 	} else if !haveDepl {
+		reportReconcileError(ci, reconcileStepDeployment)
 		errs = append(errs, fmt.Errorf("failed to get router deployment %s/%s", ci.Namespace, ci.Name))
 		return utilerrors.NewAggregate(errs)
 	}
 
-	trueVar := true
-	deploymentRef := metav1.OwnerReference{
-		APIVersion: "apps/v1",
-		Kind:       "Deployment",
-		Name:       deployment.Name,
-		UID:        deployment.UID,
-		Controller: &trueVar,
-	}
+	deploymentRef := routerDeploymentOwnerReference(deployment)
 
 	var lbService *corev1.Service
 	var wildcardRecord *iov1.DNSRecord
 	if haveLB, lb, err := r.ensureLoadBalancerService(ci, deploymentRef, platformStatus); err != nil {
+		reportReconcileError(ci, reconcileStepLoadBalancerService)
 		errs = append(errs, fmt.Errorf("failed to ensure load balancer service for %s: %v", ci.Name, err))
 	} else {
 		lbService = lb
-		if _, record, err := r.ensureWildcardDNSRecord(ci, lbService, haveLB); err != nil {
+		var targets *wildcardDNSTargets
+		if haveLB {
+			targets = loadBalancerDNSTargets(lbService)
+		}
+		if _, record, err := r.ensureWildcardDNSRecord(ci, targets); err != nil {
+			reportReconcileError(ci, reconcileStepDNS)
 			errs = append(errs, fmt.Errorf("failed to ensure wildcard dnsrecord for %s: %v", ci.Name, err))
 		} else {
 			wildcardRecord = record
@@ -1032,14 +1079,18 @@ func (r *reconciler) ensureIngressController(ci *operatorv1.IngressController, d
 	}
 
 	if _, _, err := r.ensureNodePortService(ci, deploymentRef); err != nil {
+		reportReconcileError(ci, reconcileStepNodePortService)
 		errs = append(errs, err)
 	}
 
 	if haveSvc, internalSvc, err := r.ensureInternalIngressControllerService(ci, deploymentRef); err != nil {
+		reportReconcileError(ci, reconcileStepInternalService)
 		errs = append(errs, fmt.Errorf("failed to create internal router service for ingresscontroller %s: %v", ci.Name, err))
 	} else if !haveSvc {
+		reportReconcileError(ci, reconcileStepInternalService)
 		errs = append(errs, fmt.Errorf("failed to get internal route service for ingresscontroller %s: %w", ci.Name, err))
 	} else if err := r.ensureMetricsIntegration(ci, internalSvc, deploymentRef); err != nil {
+		reportReconcileError(ci, reconcileStepMonitoring)
 		errs = append(errs, fmt.Errorf("failed to integrate metrics with openshift-monitoring for ingresscontroller %s: %v", ci.Name, err))
 	}
 
@@ -1048,6 +1099,7 @@ func (r *reconciler) ensureIngressController(ci *operatorv1.IngressController, d
 	}
 
 	if _, _, err := r.ensureRouterPodDisruptionBudget(ci, deploymentRef); err != nil {
+		reportReconcileError(ci, reconcileStepPDB)
 		errs = append(errs, err)
 	}
 
@@ -0,0 +1,447 @@
+package ingress
+
+import (
+	"bytes"
+	"context"
+	"crypto/ecdsa"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/hex"
+	"encoding/pem"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/openshift/cluster-ingress-operator/pkg/operator/controller"
+
+	configv1 "github.com/openshift/api/config/v1"
+	operatorv1 "github.com/openshift/api/operator/v1"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+)
+
+const (
+	// defaultCertificateVolumeName is the name of the router deployment's
+	// volume for the default certificate secret.
+	defaultCertificateVolumeName = "default-certificate"
+
+	// DefaultCertificateHashAnnotation is the router pod template
+	// annotation with a hash of the user-supplied default certificate that
+	// the operator validated and copied into the validated default
+	// certificate secret.  A change to the hash rolls out the router pods
+	// so that they use the new certificate.
+	DefaultCertificateHashAnnotation = "ingress.operator.openshift.io/default-certificate-hash"
+
+	// defaultCertificateExpiryWarningPeriod is the period before the
+	// default certificate expires during which the DefaultCertificateValid
+	// status condition warns that the certificate expires soon.
+	defaultCertificateExpiryWarningPeriod = 30 * 24 * time.Hour
+)
+
+// systemCertPool returns the system trust bundle against which the operator
+// verifies the chains of user-supplied default certificates.  It can be
+// replaced in unit tests.
+var systemCertPool = x509.SystemCertPool
+
+// parseDefaultCertificateChain parses the PEM-encoded certificate chain in the
+// given default certificate secret.  The first certificate is the leaf
+// certificate.
+func parseDefaultCertificateChain(secret *corev1.Secret) ([]*x509.Certificate, error) {
+	certData := secret.Data["tls.crt"]
+	if len(certData) == 0 {
+		return nil, fmt.Errorf("secret %s/%s has no certificate in %q", secret.Namespace, secret.Name, "tls.crt")
+	}
+	var certs []*x509.Certificate
+	for data := certData; len(data) > 0; {
+		block, rest := pem.Decode(data)
+		if block == nil {
+			break
+		}
+		data = rest
+		if block.Type != "CERTIFICATE" {
+			continue
+		}
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return nil, fmt.Errorf("secret %s/%s has an invalid certificate: %w", secret.Namespace, secret.Name, err)
+		}
+		certs = append(certs, cert)
+	}
+	if len(certs) == 0 {
+		return nil, fmt.Errorf("secret %s/%s has no PEM-encoded certificate in %q", secret.Namespace, secret.Name, "tls.crt")
+	}
+	return certs, nil
+}
+
+// validateDefaultCertificate validates the given user-supplied default
+// certificate secret and returns the leaf certificate if the secret has one
+// along with an aggregate of the validation errors, or nil if the certificate
+// is valid.  The certificate is valid if the secret has a well-formed
+// certificate and a private key that matches it, the certificate is valid at
+// the given time, the certificate chain can be built from the certificates in
+// the secret and the given roots, and the certificate can be used with the
+// given TLS profile.  Whether the certificate chain is trusted is not a
+// validation error because a certificate that a private CA issues is
+// legitimate; see verifyDefaultCertificateChain.
+func validateDefaultCertificate(secret *corev1.Secret, tlsProfile *configv1.TLSProfileSpec, roots *x509.CertPool, now time.Time) (*x509.Certificate, error) {
+	certs, err := parseDefaultCertificateChain(secret)
+	if err != nil {
+		return nil, err
+	}
+	leaf := certs[0]
+	keyData := secret.Data["tls.key"]
+	if len(keyData) == 0 {
+		return leaf, fmt.Errorf("secret %s/%s has no private key in %q", secret.Namespace, secret.Name, "tls.key")
+	}
+
+	var errs []error
+
+	if _, err := tls.X509KeyPair(secret.Data["tls.crt"], keyData); err != nil {
+		errs = append(errs, fmt.Errorf("private key does not match the certificate: %w", err))
+	}
+
+	switch {
+	case now.Before(leaf.NotBefore):
+		errs = append(errs, fmt.Errorf("certificate is not valid before %s", leaf.NotBefore.UTC().Format(time.RFC3339)))
+	case !now.Before(leaf.NotAfter):
+		errs = append(errs, fmt.Errorf("certificate expired at %s", leaf.NotAfter.UTC().Format(time.RFC3339)))
+	default:
+		if _, err := verifyDefaultCertificateChain(secret, roots, now); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	if err := checkDefaultCertificateKeySize(leaf, tlsProfile); err != nil {
+		errs = append(errs, err)
+	}
+
+	if err := checkDefaultCertificateCiphers(leaf, tlsProfile); err != nil {
+		errs = append(errs, err)
+	}
+
+	return leaf, utilerrors.NewAggregate(errs)
+}
+
+// verifyDefaultCertificateChain verifies the certificate chain in the given
+// default certificate secret at the given time and returns whether the given
+// roots trust it.  It returns an error if the chain cannot be built, that is,
+// if the leaf certificate is neither self-signed nor issued, through the
+// intermediates in the secret, by a certificate in the secret or in the given
+// roots.  A chain to a private CA whose certificate is in the secret but not
+// in the given roots is complete but untrusted.
+func verifyDefaultCertificateChain(secret *corev1.Secret, roots *x509.CertPool, now time.Time) (bool, error) {
+	certs, err := parseDefaultCertificateChain(secret)
+	if err != nil {
+		return false, err
+	}
+	leaf := certs[0]
+	if roots == nil {
+		roots = x509.NewCertPool()
+	}
+	intermediates := x509.NewCertPool()
+	for _, cert := range certs[1:] {
+		intermediates.AddCert(cert)
+	}
+	opts := x509.VerifyOptions{
+		Intermediates: intermediates,
+		Roots:         roots,
+		CurrentTime:   now,
+	}
+	if _, err := leaf.Verify(opts); err == nil {
+		return true, nil
+	}
+	// Any certificate in the secret may be the private CA that the
+	// clients trust, so the chain is complete if it ends with one.
+	anchors := roots.Clone()
+	for _, cert := range certs {
+		if cert != leaf || cert.CheckSignature(cert.SignatureAlgorithm, cert.RawTBSCertificate, cert.Signature) == nil {
+			anchors.AddCert(cert)
+		}
+	}
+	opts.Roots = anchors
+	if _, err := leaf.Verify(opts); err != nil {
+		return false, fmt.Errorf("certificate chain cannot be built from the certificates in the secret: %w", err)
+	}
+	return false, nil
+}
+
+// checkDefaultCertificateKeySize returns an error if the given certificate's
+// key is too weak for the given TLS profile.  RSA keys must have at least 2048
+// bits, or 1024 bits if the profile allows TLS 1.1 or older for legacy
+// clients, and ECDSA keys must use a curve of at least 256 bits.
+func checkDefaultCertificateKeySize(cert *x509.Certificate, tlsProfile *configv1.TLSProfileSpec) error {
+	switch key := cert.PublicKey.(type) {
+	case *rsa.PublicKey:
+		minBits := 2048
+		if tlsProfile != nil {
+			switch tlsProfile.MinTLSVersion {
+			case configv1.VersionTLS10, configv1.VersionTLS11:
+				minBits = 1024
+			}
+		}
+		if bits := key.N.BitLen(); bits < minBits {
+			return fmt.Errorf("certificate has a %d-bit RSA key, but the TLS profile requires at least %d bits", bits, minBits)
+		}
+	case *ecdsa.PublicKey:
+		if bits := key.Curve.Params().BitSize; bits < 256 {
+			return fmt.Errorf("certificate has a %d-bit ECDSA key, but at least 256 bits are required", bits)
+		}
+	}
+	return nil
+}
+
+// checkDefaultCertificateCiphers returns an error if the given TLS profile has
+// no cipher that can be used with the given certificate's key.  TLS 1.3 cipher
+// suites can be used with any key, and TLS 1.2 and older ciphers specify the
+// type of key that they can be used with.
+func checkDefaultCertificateCiphers(cert *x509.Certificate, tlsProfile *configv1.TLSProfileSpec) error {
+	if tlsProfile == nil || len(tlsProfile.Ciphers) == 0 {
+		return nil
+	}
+	var keyType string
+	switch cert.PublicKey.(type) {
+	case *rsa.PublicKey:
+		keyType = "RSA"
+	case *ecdsa.PublicKey:
+		keyType = "ECDSA"
+	default:
+		return nil
+	}
+	for _, cipher := range tlsProfile.Ciphers {
+		switch {
+		case strings.HasPrefix(cipher, "!"):
+			continue
+		case tlsVersion13Ciphers.Has(cipher):
+			return nil
+		case strings.Contains(cipher, "ECDSA") == (keyType == "ECDSA"):
+			return nil
+		}
+	}
+	return fmt.Errorf("certificate has an %s key, but the TLS profile has no cipher that can be used with it", keyType)
+}
+
+// defaultCertificateHash returns a hash of the certificate and private key in
+// the given default certificate secret.
+func defaultCertificateHash(secret *corev1.Secret) string {
+	hasher := sha256.New()
+	for _, key := range []string{"tls.crt", "tls.key"} {
+		data := secret.Data[key]
+		fmt.Fprintf(hasher, "%d:", len(data))
+		hasher.Write(data)
+	}
+	return hex.EncodeToString(hasher.Sum(nil))
+}
+
+// DeploymentDefaultCertificateSecretName returns the name of the default
+// certificate secret that the given router deployment uses, or the empty
+// string if the deployment has no default certificate volume.  If the
+// ingresscontroller specifies a user-supplied default certificate, the
+// deployment uses the validated copy of the certificate, or the
+// operator-generated certificate if the user-supplied certificate has never
+// been valid.  A deployment that an older operator created may use the
+// user-supplied default certificate secret directly.
+func DeploymentDefaultCertificateSecretName(deployment *appsv1.Deployment) string {
+	for _, volume := range deployment.Spec.Template.Spec.Volumes {
+		if volume.Name != defaultCertificateVolumeName {
+			continue
+		}
+		if volume.Secret != nil {
+			return volume.Secret.SecretName
+		}
+	}
+	return ""
+}
+
+// setDeploymentDefaultCertificate sets the name of the default certificate
+// secret that the given router deployment uses and the hash of the
+// user-supplied default certificate that the secret has, if any.
+func setDeploymentDefaultCertificate(deployment *appsv1.Deployment, name, hash string) {
+	for i := range deployment.Spec.Template.Spec.Volumes {
+		volume := &deployment.Spec.Template.Spec.Volumes[i]
+		if volume.Name != defaultCertificateVolumeName {
+			continue
+		}
+		if volume.Secret != nil {
+			volume.Secret.SecretName = name
+		}
+	}
+	if len(hash) == 0 {
+		delete(deployment.Spec.Template.Annotations, DefaultCertificateHashAnnotation)
+		return
+	}
+	if deployment.Spec.Template.Annotations == nil {
+		deployment.Spec.Template.Annotations = map[string]string{}
+	}
+	deployment.Spec.Template.Annotations[DefaultCertificateHashAnnotation] = hash
+}
+
+// defaultCertificateForRollout returns the name of the default certificate
+// secret that the router deployment should use, the hash of the user-supplied
+// default certificate that the secret has, if any, and the desired validated
+// default certificate secret, or nil if the operator should not update it.
+// The given current router deployment is nil if it does not exist.
+//
+// The router deployment does not use a user-supplied default certificate
+// secret directly because the kubelet would update the router pods' copy of
+// the secret if the secret were modified in place.  Instead, the operator
+// validates the certificate whenever its contents change and copies it into
+// the validated default certificate secret if it is valid.  If the certificate
+// is missing or invalid, the router deployment continues to use the validated
+// copy of the last valid certificate, or the operator-generated certificate if
+// the user-supplied certificate has never been valid.  A router deployment that
+// an older operator created uses the user-supplied secret directly; it keeps
+// doing so until the certificate is valid so that upgrading the operator does
+// not replace the certificate that the router serves.
+func (r *reconciler) defaultCertificateForRollout(ic *operatorv1.IngressController, current *appsv1.Deployment, tlsProfile *configv1.TLSProfileSpec) (string, string, *corev1.Secret, error) {
+	namespace := controller.DefaultOperandNamespace
+	generatedName := controller.RouterOperatorGeneratedDefaultCertificateSecretName(ic, namespace).Name
+	if ic.Spec.DefaultCertificate == nil || ic.Spec.DefaultCertificate.Name == generatedName {
+		return generatedName, "", nil, nil
+	}
+
+	validatedName := controller.RouterValidatedDefaultCertificateSecretName(ic, namespace).Name
+	name := controller.RouterEffectiveDefaultCertificateSecretName(ic, namespace)
+	currentName, currentHash := generatedName, ""
+	if current != nil {
+		switch DeploymentDefaultCertificateSecretName(current) {
+		case validatedName:
+			currentName, currentHash = validatedName, current.Spec.Template.Annotations[DefaultCertificateHashAnnotation]
+		case name.Name:
+			currentName = name.Name
+		}
+	}
+
+	secret := &corev1.Secret{}
+	if err := r.client.Get(context.TODO(), name, secret); err != nil {
+		if errors.IsNotFound(err) {
+			log.Info("default certificate secret not found; keeping the current default certificate", "ingresscontroller", ic.Name, "secret", name.Name, "current", currentName)
+			return currentName, currentHash, nil, nil
+		}
+		return "", "", nil, fmt.Errorf("failed to get the default certificate secret %s: %w", name, err)
+	}
+
+	// The certificate only needs to be validated if its contents have
+	// changed since it was last validated.
+	hash := defaultCertificateHash(secret)
+	if hash != currentHash {
+		roots, err := systemCertPool()
+		if err != nil {
+			log.Error(err, "failed to load the system trust bundle; default certificate chains are verified against no roots", "ingresscontroller", ic.Name)
+		}
+		if _, err := validateDefaultCertificate(secret, tlsProfile, roots, clock.Now()); err != nil {
+			log.Info("default certificate is invalid; keeping the current default certificate", "ingresscontroller", ic.Name, "secret", name.Name, "current", currentName, "error", err.Error())
+			return currentName, currentHash, nil, nil
+		}
+	}
+
+	validated := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      validatedName,
+			Namespace: namespace,
+		},
+		Type: corev1.SecretTypeTLS,
+		Data: map[string][]byte{
+			"tls.crt": secret.Data["tls.crt"],
+			"tls.key": secret.Data["tls.key"],
+		},
+	}
+	return validatedName, hash, validated, nil
+}
+
+// ensureValidatedDefaultCertificate creates or updates the validated default
+// certificate secret to match the given desired secret and sets the given
+// owner reference on it.
+func (r *reconciler) ensureValidatedDefaultCertificate(desired *corev1.Secret, deploymentRef metav1.OwnerReference) error {
+	desired.SetOwnerReferences([]metav1.OwnerReference{deploymentRef})
+	name := types.NamespacedName{Namespace: desired.Namespace, Name: desired.Name}
+	current := &corev1.Secret{}
+	if err := r.client.Get(context.TODO(), name, current); err != nil {
+		if !errors.IsNotFound(err) {
+			return fmt.Errorf("failed to get the validated default certificate secret %s: %w", name, err)
+		}
+		if err := r.client.Create(context.TODO(), desired); err != nil {
+			return fmt.Errorf("failed to create the validated default certificate secret %s: %w", name, err)
+		}
+		log.Info("created secret", "namespace", desired.Namespace, "name", desired.Name)
+		return nil
+	}
+	if bytes.Equal(current.Data["tls.crt"], desired.Data["tls.crt"]) && bytes.Equal(current.Data["tls.key"], desired.Data["tls.key"]) && len(current.Data) == len(desired.Data) && len(current.OwnerReferences) != 0 {
+		return nil
+	}
+	updated := current.DeepCopy()
+	updated.Data = desired.Data
+	updated.OwnerReferences = desired.OwnerReferences
+	if err := r.client.Update(context.TODO(), updated); err != nil {
+		return fmt.Errorf("failed to update the validated default certificate secret %s: %w", name, err)
+	}
+	log.Info("updated secret", "namespace", updated.Namespace, "name", updated.Name)
+	return nil
+}
+
+// computeDefaultCertificateValidCondition computes the ingresscontroller's
+// DefaultCertificateValid status condition using the given default certificate
+// secret, which is nil if the secret does not exist, and the router deployment.
+// The condition reports an untrusted certificate chain as a warning because the
+// operator cannot know which CAs the clients trust.
+func computeDefaultCertificateValidCondition(ic *operatorv1.IngressController, deployment *appsv1.Deployment, secret *corev1.Secret, tlsProfile *configv1.TLSProfileSpec, roots *x509.CertPool, now time.Time) operatorv1.OperatorCondition {
+	cond := operatorv1.OperatorCondition{
+		Type: IngressControllerDefaultCertificateValidConditionType,
+	}
+	generatedName := controller.RouterOperatorGeneratedDefaultCertificateSecretName(ic, controller.DefaultOperandNamespace).Name
+	if ic.Spec.DefaultCertificate == nil || ic.Spec.DefaultCertificate.Name == generatedName {
+		cond.Status = operatorv1.ConditionTrue
+		cond.Reason = "OperatorGenerated"
+		cond.Message = "The operator-generated default certificate is used."
+		return cond
+	}
+
+	name := controller.RouterEffectiveDefaultCertificateSecretName(ic, controller.DefaultOperandNamespace).Name
+	var keeping string
+	switch {
+	case DeploymentDefaultCertificateSecretName(deployment) == generatedName:
+		keeping = " The router continues to use the operator-generated default certificate."
+	case DeploymentDefaultCertificateSecretName(deployment) == name:
+		keeping = fmt.Sprintf(" The router continues to use secret %q directly.", name)
+	case secret == nil || deployment.Spec.Template.Annotations[DefaultCertificateHashAnnotation] != defaultCertificateHash(secret):
+		keeping = " The router continues to use the last valid default certificate."
+	}
+	if secret == nil {
+		cond.Status = operatorv1.ConditionFalse
+		cond.Reason = "SecretNotFound"
+		cond.Message = fmt.Sprintf("The default certificate secret %q does not exist.%s", name, keeping)
+		return cond
+	}
+	leaf, err := validateDefaultCertificate(secret, tlsProfile, roots, now)
+	if err != nil {
+		cond.Status = operatorv1.ConditionFalse
+		cond.Reason = "InvalidCertificate"
+		cond.Message = fmt.Sprintf("The default certificate in secret %q is invalid: %v.%s", name, err, keeping)
+		return cond
+	}
+	cond.Status = operatorv1.ConditionTrue
+	var expiring string
+	if leaf.NotAfter.Sub(now) < defaultCertificateExpiryWarningPeriod {
+		expiring = fmt.Sprintf(" It expires at %s.", leaf.NotAfter.UTC().Format(time.RFC3339))
+	}
+	if trusted, _ := verifyDefaultCertificateChain(secret, roots, now); !trusted {
+		cond.Reason = "UntrustedCertificateChain"
+		cond.Message = fmt.Sprintf("The default certificate in secret %q is valid, but clients that do not trust its issuer will reject it.%s%s", name, expiring, keeping)
+		return cond
+	}
+	if len(expiring) != 0 {
+		cond.Reason = "ExpiringSoon"
+		cond.Message = fmt.Sprintf("The default certificate in secret %q is valid.%s%s", name, expiring, keeping)
+		return cond
+	}
+	cond.Reason = "Valid"
+	cond.Message = fmt.Sprintf("The default certificate in secret %q is valid.%s", name, keeping)
+	return cond
+}
@@ -0,0 +1,687 @@
+package ingress
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"strings"
+	"testing"
+	"time"
+
+	operatorclient "github.com/openshift/cluster-ingress-operator/pkg/operator/client"
+
+	configv1 "github.com/openshift/api/config/v1"
+	operatorv1 "github.com/openshift/api/operator/v1"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+// testCertificate is a certificate and its private key for use in unit tests.
+type testCertificate struct {
+	cert *x509.Certificate
+	der  []byte
+	key  crypto.Signer
+}
+
+// newTestCertificate returns a certificate with the given common name and
+// validity period that is signed by the given issuer, or a self-signed
+// certificate if issuer is nil.
+func newTestCertificate(t *testing.T, cn string, isCA bool, key crypto.Signer, issuer *testCertificate, notBefore, notAfter time.Time) *testCertificate {
+	t.Helper()
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(time.Now().UnixNano()),
+		Subject:               pkix.Name{CommonName: cn},
+		NotBefore:             notBefore,
+		NotAfter:              notAfter,
+		IsCA:                  isCA,
+		BasicConstraintsValid: true,
+	}
+	if isCA {
+		template.KeyUsage = x509.KeyUsageCertSign
+	} else {
+		template.DNSNames = []string{cn}
+		template.KeyUsage = x509.KeyUsageDigitalSignature | x509.KeyUsageKeyEncipherment
+		template.ExtKeyUsage = []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth}
+	}
+	parent, signer := template, key
+	if issuer != nil {
+		parent, signer = issuer.cert, issuer.key
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, parent, key.Public(), signer)
+	if err != nil {
+		t.Fatalf("failed to create certificate: %v", err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatalf("failed to parse certificate: %v", err)
+	}
+	return &testCertificate{cert: cert, der: der, key: key}
+}
+
+func newTestECDSAKey(t *testing.T) crypto.Signer {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("failed to generate key: %v", err)
+	}
+	return key
+}
+
+// newTestDefaultCertificateSecret returns a default certificate secret with
+// the given certificate chain and the given certificate's private key.
+func newTestDefaultCertificateSecret(t *testing.T, name string, key *testCertificate, chain ...*testCertificate) *corev1.Secret {
+	t.Helper()
+	var certData []byte
+	for _, cert := range chain {
+		certData = append(certData, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: cert.der})...)
+	}
+	keyDER, err := x509.MarshalPKCS8PrivateKey(key.key)
+	if err != nil {
+		t.Fatalf("failed to marshal key: %v", err)
+	}
+	return &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: "openshift-ingress",
+		},
+		Data: map[string][]byte{
+			"tls.crt": certData,
+			"tls.key": pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: keyDER}),
+		},
+	}
+}
+
+// Test_validateDefaultCertificate verifies that validateDefaultCertificate
+// accepts well-formed, current certificates with complete chains that can be
+// used with the TLS profile, regardless of whether their chains are trusted,
+// and rejects others.
+func Test_validateDefaultCertificate(t *testing.T) {
+	now := time.Now()
+	notBefore, notAfter := now.Add(-time.Hour), now.Add(365*24*time.Hour)
+
+	root := newTestCertificate(t, "root", true, newTestECDSAKey(t), nil, notBefore, notAfter)
+	intermediate := newTestCertificate(t, "intermediate", true, newTestECDSAKey(t), root, notBefore, notAfter)
+	leaf := newTestCertificate(t, "*.apps.example.com", false, newTestECDSAKey(t), intermediate, notBefore, notAfter)
+	otherLeaf := newTestCertificate(t, "*.apps.example.com", false, newTestECDSAKey(t), intermediate, notBefore, notAfter)
+	expiredLeaf := newTestCertificate(t, "*.apps.example.com", false, newTestECDSAKey(t), intermediate, now.Add(-48*time.Hour), now.Add(-24*time.Hour))
+	futureLeaf := newTestCertificate(t, "*.apps.example.com", false, newTestECDSAKey(t), intermediate, now.Add(24*time.Hour), notAfter)
+	untrustedRoot := newTestCertificate(t, "untrusted", true, newTestECDSAKey(t), nil, notBefore, notAfter)
+	untrustedLeaf := newTestCertificate(t, "*.apps.example.com", false, newTestECDSAKey(t), untrustedRoot, notBefore, notAfter)
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 1024)
+	if err != nil {
+		t.Fatalf("failed to generate key: %v", err)
+	}
+	weakLeaf := newTestCertificate(t, "*.apps.example.com", false, rsaKey, intermediate, notBefore, notAfter)
+
+	roots := x509.NewCertPool()
+	roots.AddCert(root.cert)
+
+	intermediateProfile := configv1.TLSProfiles[configv1.TLSProfileIntermediateType]
+	oldProfile := configv1.TLSProfiles[configv1.TLSProfileOldType]
+	rsaOnlyProfile := &configv1.TLSProfileSpec{
+		Ciphers:       []string{"ECDHE-RSA-AES128-GCM-SHA256", "ECDHE-RSA-AES256-GCM-SHA384"},
+		MinTLSVersion: configv1.VersionTLS12,
+	}
+
+	testCases := []struct {
+		name        string
+		secret      *corev1.Secret
+		tlsProfile  *configv1.TLSProfileSpec
+		expectValid bool
+	}{
+		{
+			name:        "valid certificate with intermediate",
+			secret:      newTestDefaultCertificateSecret(t, "cert", leaf, leaf, intermediate),
+			tlsProfile:  intermediateProfile,
+			expectValid: true,
+		},
+		{
+			name:       "missing certificate",
+			secret:     &corev1.Secret{Data: map[string][]byte{"tls.key": newTestDefaultCertificateSecret(t, "cert", leaf, leaf).Data["tls.key"]}},
+			tlsProfile: intermediateProfile,
+		},
+		{
+			name:       "malformed certificate",
+			secret:     &corev1.Secret{Data: map[string][]byte{"tls.crt": pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: []byte("garbage")}), "tls.key": newTestDefaultCertificateSecret(t, "cert", leaf, leaf).Data["tls.key"]}},
+			tlsProfile: intermediateProfile,
+		},
+		{
+			name:       "missing private key",
+			secret:     &corev1.Secret{Data: map[string][]byte{"tls.crt": pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: leaf.der})}},
+			tlsProfile: intermediateProfile,
+		},
+		{
+			name: "mismatched private key",
+			secret: func() *corev1.Secret {
+				secret := newTestDefaultCertificateSecret(t, "cert", leaf, leaf, intermediate)
+				secret.Data["tls.key"] = newTestDefaultCertificateSecret(t, "other", otherLeaf, otherLeaf).Data["tls.key"]
+				return secret
+			}(),
+			tlsProfile: intermediateProfile,
+		},
+		{
+			name:       "missing intermediate",
+			secret:     newTestDefaultCertificateSecret(t, "cert", leaf, leaf),
+			tlsProfile: intermediateProfile,
+		},
+		{
+			name:       "expired certificate",
+			secret:     newTestDefaultCertificateSecret(t, "cert", expiredLeaf, expiredLeaf, intermediate),
+			tlsProfile: intermediateProfile,
+		},
+		{
+			name:       "certificate not yet valid",
+			secret:     newTestDefaultCertificateSecret(t, "cert", futureLeaf, futureLeaf, intermediate),
+			tlsProfile: intermediateProfile,
+		},
+		{
+			name:       "certificate issued by a private CA that the secret does not include",
+			secret:     newTestDefaultCertificateSecret(t, "cert", untrustedLeaf, untrustedLeaf),
+			tlsProfile: intermediateProfile,
+		},
+		{
+			name:        "certificate issued by a private CA that the secret includes",
+			secret:      newTestDefaultCertificateSecret(t, "cert", untrustedLeaf, untrustedLeaf, untrustedRoot),
+			tlsProfile:  intermediateProfile,
+			expectValid: true,
+		},
+		{
+			name:       "weak RSA key with intermediate profile",
+			secret:     newTestDefaultCertificateSecret(t, "cert", weakLeaf, weakLeaf, intermediate),
+			tlsProfile: intermediateProfile,
+		},
+		{
+			name:        "weak RSA key with old profile",
+			secret:      newTestDefaultCertificateSecret(t, "cert", weakLeaf, weakLeaf, intermediate),
+			tlsProfile:  oldProfile,
+			expectValid: true,
+		},
+		{
+			name:       "ECDSA key with a profile that only has RSA ciphers",
+			secret:     newTestDefaultCertificateSecret(t, "cert", leaf, leaf, intermediate),
+			tlsProfile: rsaOnlyProfile,
+		},
+		{
+			name:   "ECDSA key with a profile that has TLS 1.3 cipher suites",
+			secret: newTestDefaultCertificateSecret(t, "cert", leaf, leaf, intermediate),
+			tlsProfile: &configv1.TLSProfileSpec{
+				Ciphers:       append([]string{"TLS_AES_128_GCM_SHA256"}, rsaOnlyProfile.Ciphers...),
+				MinTLSVersion: configv1.VersionTLS12,
+			},
+			expectValid: true,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := validateDefaultCertificate(tc.secret, tc.tlsProfile, roots, now)
+			switch {
+			case tc.expectValid && err != nil:
+				t.Errorf("expected certificate to be valid, got error: %v", err)
+			case !tc.expectValid && err == nil:
+				t.Error("expected certificate to be invalid, got no error")
+			}
+		})
+	}
+}
+
+// Test_verifyDefaultCertificateChain verifies that
+// verifyDefaultCertificateChain rejects chains that cannot be built from the
+// certificates in the secret and reports whether complete chains are trusted.
+func Test_verifyDefaultCertificateChain(t *testing.T) {
+	now := time.Now()
+	notBefore, notAfter := now.Add(-time.Hour), now.Add(365*24*time.Hour)
+
+	root := newTestCertificate(t, "root", true, newTestECDSAKey(t), nil, notBefore, notAfter)
+	intermediate := newTestCertificate(t, "intermediate", true, newTestECDSAKey(t), root, notBefore, notAfter)
+	leaf := newTestCertificate(t, "*.apps.example.com", false, newTestECDSAKey(t), intermediate, notBefore, notAfter)
+	untrustedRoot := newTestCertificate(t, "untrusted", true, newTestECDSAKey(t), nil, notBefore, notAfter)
+	untrustedIntermediate := newTestCertificate(t, "untrusted intermediate", true, newTestECDSAKey(t), untrustedRoot, notBefore, notAfter)
+	untrustedLeaf := newTestCertificate(t, "*.apps.example.com", false, newTestECDSAKey(t), untrustedRoot, notBefore, notAfter)
+	untrustedIntermediateLeaf := newTestCertificate(t, "*.apps.example.com", false, newTestECDSAKey(t), untrustedIntermediate, notBefore, notAfter)
+	selfSignedLeaf := newTestCertificate(t, "*.apps.example.com", false, newTestECDSAKey(t), nil, notBefore, notAfter)
+
+	roots := x509.NewCertPool()
+	roots.AddCert(root.cert)
+
+	testCases := []struct {
+		name           string
+		secret         *corev1.Secret
+		expectComplete bool
+		expectTrusted  bool
+	}{
+		{
+			name:           "complete chain to a trusted root",
+			secret:         newTestDefaultCertificateSecret(t, "cert", leaf, leaf, intermediate),
+			expectComplete: true,
+			expectTrusted:  true,
+		},
+		{
+			name:   "missing intermediate",
+			secret: newTestDefaultCertificateSecret(t, "cert", leaf, leaf),
+		},
+		{
+			name:   "wrong intermediate",
+			secret: newTestDefaultCertificateSecret(t, "cert", leaf, leaf, untrustedIntermediate),
+		},
+		{
+			name:   "private CA not included in the secret",
+			secret: newTestDefaultCertificateSecret(t, "cert", untrustedLeaf, untrustedLeaf),
+		},
+		{
+			name:           "private CA included in the secret",
+			secret:         newTestDefaultCertificateSecret(t, "cert", untrustedLeaf, untrustedLeaf, untrustedRoot),
+			expectComplete: true,
+		},
+		{
+			name:           "intermediate of a private CA without the root",
+			secret:         newTestDefaultCertificateSecret(t, "cert", untrustedIntermediateLeaf, untrustedIntermediateLeaf, untrustedIntermediate),
+			expectComplete: true,
+		},
+		{
+			name:           "self-signed certificate",
+			secret:         newTestDefaultCertificateSecret(t, "cert", selfSignedLeaf, selfSignedLeaf),
+			expectComplete: true,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			trusted, err := verifyDefaultCertificateChain(tc.secret, roots, now)
+			switch {
+			case tc.expectComplete && err != nil:
+				t.Errorf("expected certificate chain to be complete, got error: %v", err)
+			case !tc.expectComplete && err == nil:
+				t.Error("expected certificate chain to be incomplete, got no error")
+			}
+			if trusted != tc.expectTrusted {
+				t.Errorf("expected certificate chain to be trusted to be %t, got %t", tc.expectTrusted, trusted)
+			}
+		})
+	}
+}
+
+// Test_computeDefaultCertificateValidCondition verifies that
+// computeDefaultCertificateValidCondition reports the expected status and
+// reason.
+func Test_computeDefaultCertificateValidCondition(t *testing.T) {
+	now := time.Now()
+	notBefore, notAfter := now.Add(-time.Hour), now.Add(365*24*time.Hour)
+
+	root := newTestCertificate(t, "root", true, newTestECDSAKey(t), nil, notBefore, notAfter)
+	leaf := newTestCertificate(t, "*.apps.example.com", false, newTestECDSAKey(t), root, notBefore, notAfter)
+	expiringLeaf := newTestCertificate(t, "*.apps.example.com", false, newTestECDSAKey(t), root, notBefore, now.Add(7*24*time.Hour))
+	expiredLeaf := newTestCertificate(t, "*.apps.example.com", false, newTestECDSAKey(t), root, now.Add(-48*time.Hour), now.Add(-24*time.Hour))
+	untrustedRoot := newTestCertificate(t, "untrusted", true, newTestECDSAKey(t), nil, notBefore, notAfter)
+	untrustedLeaf := newTestCertificate(t, "*.apps.example.com", false, newTestECDSAKey(t), untrustedRoot, notBefore, notAfter)
+	expiringUntrustedLeaf := newTestCertificate(t, "*.apps.example.com", false, newTestECDSAKey(t), untrustedRoot, notBefore, now.Add(7*24*time.Hour))
+
+	roots := x509.NewCertPool()
+	roots.AddCert(root.cert)
+
+	ic := func(secretName string) *operatorv1.IngressController {
+		ic := &operatorv1.IngressController{ObjectMeta: metav1.ObjectMeta{Name: "default"}}
+		if len(secretName) != 0 {
+			ic.Spec.DefaultCertificate = &corev1.LocalObjectReference{Name: secretName}
+		}
+		return ic
+	}
+	deployment := func(secretName string, secret *corev1.Secret) *appsv1.Deployment {
+		deployment := &appsv1.Deployment{
+			Spec: appsv1.DeploymentSpec{
+				Template: corev1.PodTemplateSpec{
+					Spec: corev1.PodSpec{
+						Volumes: []corev1.Volume{{
+							Name: defaultCertificateVolumeName,
+							VolumeSource: corev1.VolumeSource{
+								Secret: &corev1.SecretVolumeSource{SecretName: secretName},
+							},
+						}},
+					},
+				},
+			},
+		}
+		if secret != nil {
+			deployment.Spec.Template.Annotations = map[string]string{
+				DefaultCertificateHashAnnotation: defaultCertificateHash(secret),
+			}
+		}
+		return deployment
+	}
+	validSecret := newTestDefaultCertificateSecret(t, "custom", leaf, leaf)
+	untrustedSecret := newTestDefaultCertificateSecret(t, "custom", untrustedLeaf, untrustedLeaf, untrustedRoot)
+	expiringUntrustedSecret := newTestDefaultCertificateSecret(t, "custom", expiringUntrustedLeaf, expiringUntrustedLeaf, untrustedRoot)
+
+	testCases := []struct {
+		name           string
+		ic             *operatorv1.IngressController
+		deployment     *appsv1.Deployment
+		secret         *corev1.Secret
+		expectStatus   operatorv1.ConditionStatus
+		expectReason   string
+		expectKeeping  bool
+		expectExpiring bool
+	}{
+		{
+			name:         "operator-generated certificate",
+			ic:           ic(""),
+			deployment:   deployment("router-certs-default", nil),
+			secret:       &corev1.Secret{},
+			expectStatus: operatorv1.ConditionTrue,
+			expectReason: "OperatorGenerated",
+		},
+		{
+			name:          "secret not found",
+			ic:            ic("custom"),
+			deployment:    deployment("router-certs-default", nil),
+			expectStatus:  operatorv1.ConditionFalse,
+			expectReason:  "SecretNotFound",
+			expectKeeping: true,
+		},
+		{
+			name:          "invalid certificate",
+			ic:            ic("custom"),
+			deployment:    deployment("router-validated-certs-default", validSecret),
+			secret:        newTestDefaultCertificateSecret(t, "custom", expiredLeaf, expiredLeaf),
+			expectStatus:  operatorv1.ConditionFalse,
+			expectReason:  "InvalidCertificate",
+			expectKeeping: true,
+		},
+		{
+			name:          "incomplete certificate chain",
+			ic:            ic("custom"),
+			deployment:    deployment("router-validated-certs-default", validSecret),
+			secret:        newTestDefaultCertificateSecret(t, "custom", untrustedLeaf, untrustedLeaf),
+			expectStatus:  operatorv1.ConditionFalse,
+			expectReason:  "InvalidCertificate",
+			expectKeeping: true,
+		},
+		{
+			name:          "invalid certificate in a secret that the router uses directly",
+			ic:            ic("custom"),
+			deployment:    deployment("custom", nil),
+			secret:        newTestDefaultCertificateSecret(t, "custom", expiredLeaf, expiredLeaf),
+			expectStatus:  operatorv1.ConditionFalse,
+			expectReason:  "InvalidCertificate",
+			expectKeeping: true,
+		},
+		{
+			name:           "certificate expiring soon",
+			ic:             ic("custom"),
+			deployment:     deployment("router-validated-certs-default", newTestDefaultCertificateSecret(t, "custom", expiringLeaf, expiringLeaf)),
+			secret:         newTestDefaultCertificateSecret(t, "custom", expiringLeaf, expiringLeaf),
+			expectStatus:   operatorv1.ConditionTrue,
+			expectReason:   "ExpiringSoon",
+			expectExpiring: true,
+		},
+		{
+			name:         "certificate with an untrusted chain",
+			ic:           ic("custom"),
+			deployment:   deployment("router-validated-certs-default", untrustedSecret),
+			secret:       untrustedSecret,
+			expectStatus: operatorv1.ConditionTrue,
+			expectReason: "UntrustedCertificateChain",
+		},
+		{
+			name:           "certificate with an untrusted chain expiring soon",
+			ic:             ic("custom"),
+			deployment:     deployment("router-validated-certs-default", expiringUntrustedSecret),
+			secret:         expiringUntrustedSecret,
+			expectStatus:   operatorv1.ConditionTrue,
+			expectReason:   "UntrustedCertificateChain",
+			expectExpiring: true,
+		},
+		{
+			name:         "valid certificate",
+			ic:           ic("custom"),
+			deployment:   deployment("router-validated-certs-default", validSecret),
+			secret:       validSecret,
+			expectStatus: operatorv1.ConditionTrue,
+			expectReason: "Valid",
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			cond := computeDefaultCertificateValidCondition(tc.ic, tc.deployment, tc.secret, configv1.TLSProfiles[configv1.TLSProfileIntermediateType], roots, now)
+			if cond.Type != IngressControllerDefaultCertificateValidConditionType {
+				t.Errorf("expected condition type %q, got %q", IngressControllerDefaultCertificateValidConditionType, cond.Type)
+			}
+			if cond.Status != tc.expectStatus || cond.Reason != tc.expectReason {
+				t.Errorf("expected status %q and reason %q, got status %q and reason %q: %s", tc.expectStatus, tc.expectReason, cond.Status, cond.Reason, cond.Message)
+			}
+			if keeping := strings.Contains(cond.Message, "continues to use"); keeping != tc.expectKeeping {
+				t.Errorf("expected the message to report that the router keeps its certificate to be %t, got message %q", tc.expectKeeping, cond.Message)
+			}
+			if expiring := strings.Contains(cond.Message, "expires at"); expiring != tc.expectExpiring {
+				t.Errorf("expected the message to report that the certificate expires soon to be %t, got message %q", tc.expectExpiring, cond.Message)
+			}
+		})
+	}
+}
+
+// Test_defaultCertificateForRollout verifies that the router deployment uses a
+// validated copy of the user-supplied default certificate, that the
+// certificate is validated whenever its contents change, including on the
+// first rollout, that the deployment keeps its current certificate if the
+// user-supplied certificate is missing or invalid, and that a deployment that
+// uses the user-supplied secret directly keeps doing so until the certificate
+// is valid.
+func Test_defaultCertificateForRollout(t *testing.T) {
+	now := time.Now()
+	notBefore, notAfter := now.Add(-time.Hour), now.Add(365*24*time.Hour)
+
+	untrustedRoot := newTestCertificate(t, "untrusted", true, newTestECDSAKey(t), nil, notBefore, notAfter)
+	leaf := newTestCertificate(t, "*.apps.example.com", false, newTestECDSAKey(t), untrustedRoot, notBefore, notAfter)
+	renewedLeaf := newTestCertificate(t, "*.apps.example.com", false, newTestECDSAKey(t), untrustedRoot, notBefore, notAfter)
+	expiredLeaf := newTestCertificate(t, "*.apps.example.com", false, newTestECDSAKey(t), untrustedRoot, now.Add(-48*time.Hour), now.Add(-24*time.Hour))
+
+	validSecret := newTestDefaultCertificateSecret(t, "custom", leaf, leaf, untrustedRoot)
+	renewedSecret := newTestDefaultCertificateSecret(t, "custom", renewedLeaf, renewedLeaf, untrustedRoot)
+	expiredSecret := newTestDefaultCertificateSecret(t, "custom", expiredLeaf, expiredLeaf, untrustedRoot)
+	incompleteSecret := newTestDefaultCertificateSecret(t, "custom", leaf, leaf)
+
+	deployment := func(secretName string, secret *corev1.Secret) *appsv1.Deployment {
+		deployment := &appsv1.Deployment{
+			ObjectMeta: metav1.ObjectMeta{Name: "router-default", Namespace: "openshift-ingress"},
+			Spec: appsv1.DeploymentSpec{
+				Template: corev1.PodTemplateSpec{
+					Spec: corev1.PodSpec{
+						Volumes: []corev1.Volume{{
+							Name: defaultCertificateVolumeName,
+							VolumeSource: corev1.VolumeSource{
+								Secret: &corev1.SecretVolumeSource{SecretName: secretName},
+							},
+						}},
+					},
+				},
+			},
+		}
+		if secret != nil {
+			deployment.Spec.Template.Annotations = map[string]string{
+				DefaultCertificateHashAnnotation: defaultCertificateHash(secret),
+			}
+		}
+		return deployment
+	}
+
+	testCases := []struct {
+		name            string
+		secretName      string
+		current         *appsv1.Deployment
+		objects         []client.Object
+		expectName      string
+		expectHash      string
+		expectValidated *corev1.Secret
+	}{
+		{
+			name:       "operator-generated certificate",
+			current:    deployment("router-certs-default", nil),
+			expectName: "router-certs-default",
+		},
+		{
+			name:       "first rollout with a missing user-supplied certificate",
+			secretName: "custom",
+			expectName: "router-certs-default",
+		},
+		{
+			name:       "first rollout with an invalid user-supplied certificate",
+			secretName: "custom",
+			objects:    []client.Object{expiredSecret},
+			expectName: "router-certs-default",
+		},
+		{
+			name:       "first rollout with an incomplete user-supplied certificate chain",
+			secretName: "custom",
+			objects:    []client.Object{incompleteSecret},
+			expectName: "router-certs-default",
+		},
+		{
+			name:            "first rollout with a valid user-supplied certificate",
+			secretName:      "custom",
+			objects:         []client.Object{validSecret},
+			expectName:      "router-validated-certs-default",
+			expectHash:      defaultCertificateHash(validSecret),
+			expectValidated: validSecret,
+		},
+		{
+			name:       "user-supplied secret deleted",
+			secretName: "custom",
+			current:    deployment("router-validated-certs-default", validSecret),
+			expectName: "router-validated-certs-default",
+			expectHash: defaultCertificateHash(validSecret),
+		},
+		{
+			name:       "user-supplied secret updated in place with an invalid certificate",
+			secretName: "custom",
+			current:    deployment("router-validated-certs-default", validSecret),
+			objects:    []client.Object{expiredSecret},
+			expectName: "router-validated-certs-default",
+			expectHash: defaultCertificateHash(validSecret),
+		},
+		{
+			name:            "user-supplied secret updated in place with a valid certificate",
+			secretName:      "custom",
+			current:         deployment("router-validated-certs-default", validSecret),
+			objects:         []client.Object{renewedSecret},
+			expectName:      "router-validated-certs-default",
+			expectHash:      defaultCertificateHash(renewedSecret),
+			expectValidated: renewedSecret,
+		},
+		{
+			name:            "unchanged user-supplied certificate is not validated again",
+			secretName:      "custom",
+			current:         deployment("router-validated-certs-default", expiredSecret),
+			objects:         []client.Object{expiredSecret},
+			expectName:      "router-validated-certs-default",
+			expectHash:      defaultCertificateHash(expiredSecret),
+			expectValidated: expiredSecret,
+		},
+		{
+			name:       "invalid user-supplied certificate with the operator-generated certificate in use",
+			secretName: "custom",
+			current:    deployment("router-certs-default", nil),
+			objects:    []client.Object{expiredSecret},
+			expectName: "router-certs-default",
+		},
+		{
+			name:       "invalid user-supplied certificate with the user-supplied secret in use",
+			secretName: "custom",
+			current:    deployment("custom", nil),
+			objects:    []client.Object{expiredSecret},
+			expectName: "custom",
+		},
+		{
+			name:            "valid user-supplied certificate with the user-supplied secret in use",
+			secretName:      "custom",
+			current:         deployment("custom", nil),
+			objects:         []client.Object{validSecret},
+			expectName:      "router-validated-certs-default",
+			expectHash:      defaultCertificateHash(validSecret),
+			expectValidated: validSecret,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ic := &operatorv1.IngressController{ObjectMeta: metav1.ObjectMeta{Name: "default"}}
+			if len(tc.secretName) != 0 {
+				ic.Spec.DefaultCertificate = &corev1.LocalObjectReference{Name: tc.secretName}
+			}
+			r := &reconciler{
+				client: fake.NewClientBuilder().WithScheme(operatorclient.GetScheme()).WithObjects(tc.objects...).Build(),
+			}
+			name, hash, validated, err := r.defaultCertificateForRollout(ic, tc.current, configv1.TLSProfiles[configv1.TLSProfileIntermediateType])
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if name != tc.expectName {
+				t.Errorf("expected secret name %q, got %q", tc.expectName, name)
+			}
+			if hash != tc.expectHash {
+				t.Errorf("expected hash %q, got %q", tc.expectHash, hash)
+			}
+			switch {
+			case tc.expectValidated == nil && validated != nil:
+				t.Errorf("expected no validated secret, got %s", validated.Name)
+			case tc.expectValidated != nil && validated == nil:
+				t.Error("expected a validated secret, got nil")
+			case tc.expectValidated != nil:
+				if validated.Name != "router-validated-certs-default" || validated.Namespace != "openshift-ingress" {
+					t.Errorf("expected validated secret openshift-ingress/router-validated-certs-default, got %s/%s", validated.Namespace, validated.Name)
+				}
+				if defaultCertificateHash(validated) != defaultCertificateHash(tc.expectValidated) {
+					t.Error("expected the validated secret to have the user-supplied certificate")
+				}
+			}
+		})
+	}
+}
+
+// Test_ensureValidatedDefaultCertificate verifies that
+// ensureValidatedDefaultCertificate creates the validated default certificate
+// secret and updates it when the certificate changes.
+func Test_ensureValidatedDefaultCertificate(t *testing.T) {
+	now := time.Now()
+	notBefore, notAfter := now.Add(-time.Hour), now.Add(365*24*time.Hour)
+	root := newTestCertificate(t, "root", true, newTestECDSAKey(t), nil, notBefore, notAfter)
+	leaf := newTestCertificate(t, "*.apps.example.com", false, newTestECDSAKey(t), root, notBefore, notAfter)
+	renewedLeaf := newTestCertificate(t, "*.apps.example.com", false, newTestECDSAKey(t), root, notBefore, notAfter)
+
+	desired := func(cert *testCertificate) *corev1.Secret {
+		secret := newTestDefaultCertificateSecret(t, "router-validated-certs-default", cert, cert)
+		secret.Type = corev1.SecretTypeTLS
+		return secret
+	}
+	deploymentRef := metav1.OwnerReference{APIVersion: "apps/v1", Kind: "Deployment", Name: "router-default"}
+	r := &reconciler{
+		client: fake.NewClientBuilder().WithScheme(operatorclient.GetScheme()).Build(),
+	}
+	name := types.NamespacedName{Namespace: "openshift-ingress", Name: "router-validated-certs-default"}
+
+	for _, cert := range []*testCertificate{leaf, leaf, renewedLeaf} {
+		if err := r.ensureValidatedDefaultCertificate(desired(cert), deploymentRef); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		secret := &corev1.Secret{}
+		if err := r.client.Get(context.Background(), name, secret); err != nil {
+			t.Fatalf("failed to get secret: %v", err)
+		}
+		if defaultCertificateHash(secret) != defaultCertificateHash(desired(cert)) {
+			t.Error("expected the validated secret to have the desired certificate")
+		}
+		if len(secret.OwnerReferences) != 1 || secret.OwnerReferences[0].Name != deploymentRef.Name {
+			t.Errorf("expected an owner reference to %s, got %+v", deploymentRef.Name, secret.OwnerReferences)
+		}
+	}
+}
@@ -27,6 +27,7 @@ import (
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 
+	"k8s.io/apimachinery/pkg/api/equality"
 	"k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -120,15 +121,38 @@ func (r *reconciler) ensureRouterDeployment(ci *operatorv1.IngressController, in
 	if err != nil {
 		return haveDepl, current, fmt.Errorf("failed to build router deployment: %v", err)
 	}
+	secretName, certificateHash, validatedCertificate, err := r.defaultCertificateForRollout(ci, current, tlsProfileSpecForIngressController(ci, apiConfig))
+	if err != nil {
+		return haveDepl, current, err
+	}
+	setDeploymentDefaultCertificate(desired, secretName, certificateHash)
 
 	switch {
 	case !haveDepl:
 		if err := r.createRouterDeployment(desired); err != nil {
 			return false, nil, err
 		}
-		return r.currentRouterDeployment(ci)
+		controller.RecordOperandCreated(r.recorder, ci, "Deployment", desired)
+		reportRouterRollout(ci)
+		haveDepl, current, err = r.currentRouterDeployment(ci)
+		if err != nil || !haveDepl || validatedCertificate == nil {
+			return haveDepl, current, err
+		}
+		// The validated default certificate secret has an owner
+		// reference to the deployment, so it can only be created after
+		// the deployment.  The router pods do not start until the
+		// secret exists.
+		return true, current, r.ensureValidatedDefaultCertificate(validatedCertificate, routerDeploymentOwnerReference(current))
 	case haveDepl:
-		if updated, err := r.updateRouterDeployment(current, desired); err != nil {
+		// Update the validated default certificate secret before the
+		// deployment so that the rolled out router pods use the new
+		// certificate.
+		if validatedCertificate != nil {
+			if err := r.ensureValidatedDefaultCertificate(validatedCertificate, routerDeploymentOwnerReference(current)); err != nil {
+				return true, current, err
+			}
+		}
+		if updated, err := r.updateRouterDeployment(ci, current, desired); err != nil {
 			return true, current, err
 		} else if updated {
 			return r.currentRouterDeployment(ci)
@@ -148,9 +172,10 @@ func (r *reconciler) ensureRouterDeleted(ci *operatorv1.IngressController) error
 		if !errors.IsNotFound(err) {
 			return err
 		}
+		return nil
 	}
 	log.Info("deleted deployment", "namespace", deployment.Namespace, "name", deployment.Name)
-	r.recorder.Eventf(ci, "Normal", "DeletedDeployment", "Deleted deployment %s/%s", deployment.Namespace, deployment.Name)
+	controller.RecordOperandDeleted(r.recorder, ci, "Deployment", deployment)
 	return nil
 }
 
@@ -1394,7 +1419,7 @@ func hashableDeployment(deployment *appsv1.Deployment, onlyTemplate bool) *appsv
 	})
 	hashableDeployment.Spec.Template.Spec.Volumes = volumes
 	hashableDeployment.Spec.Template.Annotations = make(map[string]string)
-	annotations := []string{LivenessGracePeriodSecondsAnnotation, WorkloadPartitioningManagement}
+	annotations := []string{LivenessGracePeriodSecondsAnnotation, WorkloadPartitioningManagement, DefaultCertificateHashAnnotation}
 	for _, key := range annotations {
 		if val, ok := deployment.Spec.Template.Annotations[key]; ok && len(val) > 0 {
 			hashableDeployment.Spec.Template.Annotations[key] = val
@@ -1500,6 +1525,19 @@ func (r *reconciler) currentRouterDeployment(ci *operatorv1.IngressController) (
 	return true, deployment, nil
 }
 
+// routerDeploymentOwnerReference returns an owner reference to the given router
+// deployment for the operands that the deployment owns.
+func routerDeploymentOwnerReference(deployment *appsv1.Deployment) metav1.OwnerReference {
+	trueVar := true
+	return metav1.OwnerReference{
+		APIVersion: "apps/v1",
+		Kind:       "Deployment",
+		Name:       deployment.Name,
+		UID:        deployment.UID,
+		Controller: &trueVar,
+	}
+}
+
 // createRouterDeployment creates a router deployment.
 func (r *reconciler) createRouterDeployment(deployment *appsv1.Deployment) error {
 	if err := r.client.Create(context.TODO(), deployment); err != nil {
@@ -1510,7 +1548,7 @@ func (r *reconciler) createRouterDeployment(deployment *appsv1.Deployment) error
 }
 
 // updateRouterDeployment updates a router deployment.
-func (r *reconciler) updateRouterDeployment(current, desired *appsv1.Deployment) (bool, error) {
+func (r *reconciler) updateRouterDeployment(ci *operatorv1.IngressController, current, desired *appsv1.Deployment) (bool, error) {
 	changed, updated := deploymentConfigChanged(current, desired)
 	if !changed {
 		return false, nil
@@ -1518,10 +1556,16 @@ func (r *reconciler) updateRouterDeployment(current, desired *appsv1.Deployment)
 
 	// Diff before updating because the client may mutate the object.
 	diff := cmp.Diff(current, updated, cmpopts.EquateEmpty())
+	fieldDiff := controller.FieldDiff(current, updated)
+	rollout := !equality.Semantic.DeepEqual(current.Spec.Template, updated.Spec.Template)
 	if err := r.client.Update(context.TODO(), updated); err != nil {
 		return false, fmt.Errorf("failed to update router deployment %s/%s: %v", updated.Namespace, updated.Name, err)
 	}
 	log.Info("updated router deployment", "namespace", updated.Namespace, "name", updated.Name, "diff", diff)
+	controller.RecordOperandUpdated(r.recorder, ci, "Deployment", updated, fieldDiff)
+	if rollout {
+		reportRouterRollout(ci)
+	}
 	return true, nil
 }
 
@@ -1560,7 +1604,7 @@ func deploymentConfigChanged(current, expected *appsv1.Deployment) (bool, *appsv
 	updated.Spec.Template.Spec.DNSPolicy = expected.Spec.Template.Spec.DNSPolicy
 	updated.Spec.Template.Labels = expected.Spec.Template.Labels
 
-	annotations := []string{LivenessGracePeriodSecondsAnnotation, WorkloadPartitioningManagement}
+	annotations := []string{LivenessGracePeriodSecondsAnnotation, WorkloadPartitioningManagement, DefaultCertificateHashAnnotation}
 	for _, key := range annotations {
 		currentVal, have := current.Spec.Template.Annotations[key]
 		expectedVal, want := expected.Spec.Template.Annotations[key]
@@ -0,0 +1,439 @@
+package ingress
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/openshift/cluster-ingress-operator/pkg/operator/controller"
+	"github.com/openshift/cluster-ingress-operator/pkg/util/ingresscontroller"
+
+	configv1 "github.com/openshift/api/config/v1"
+	operatorv1 "github.com/openshift/api/operator/v1"
+	iov1 "github.com/openshift/api/operatoringress/v1"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// DiagnosisSeverity is the severity of a diagnosis finding.
+type DiagnosisSeverity string
+
+const (
+	// DiagnosisSeverityError indicates a finding that is a likely root
+	// cause of a problem with the ingresscontroller.
+	DiagnosisSeverityError DiagnosisSeverity = "Error"
+	// DiagnosisSeverityWarning indicates a finding that may cause or
+	// indicate a problem with the ingresscontroller.
+	DiagnosisSeverityWarning DiagnosisSeverity = "Warning"
+	// DiagnosisSeverityInfo indicates a finding that is informational.
+	DiagnosisSeverityInfo DiagnosisSeverity = "Info"
+)
+
+// diagnosisSeverityOrder orders findings by severity, most severe first.
+var diagnosisSeverityOrder = map[DiagnosisSeverity]int{
+	DiagnosisSeverityError:   0,
+	DiagnosisSeverityWarning: 1,
+	DiagnosisSeverityInfo:    2,
+}
+
+// DiagnosisFinding is a single finding of a diagnosis.
+type DiagnosisFinding struct {
+	// Severity is the severity of the finding.
+	Severity DiagnosisSeverity `json:"severity"`
+	// Object describes the object that the finding is about, for example
+	// "deployment openshift-ingress/router-default".
+	Object string `json:"object"`
+	// Message describes the finding.
+	Message string `json:"message"`
+}
+
+// Diagnosis is the result of diagnosing an ingresscontroller.
+type Diagnosis struct {
+	// IngressController is the name of the diagnosed ingresscontroller.
+	IngressController string `json:"ingressController"`
+	// Findings are the findings of the diagnosis, ordered by severity,
+	// most severe first.
+	Findings []DiagnosisFinding `json:"findings"`
+}
+
+// add adds a finding to the diagnosis.
+func (d *Diagnosis) add(severity DiagnosisSeverity, object, format string, args ...interface{}) {
+	d.Findings = append(d.Findings, DiagnosisFinding{
+		Severity: severity,
+		Object:   object,
+		Message:  fmt.Sprintf(format, args...),
+	})
+}
+
+// DiagnosisInput is the cluster state from which an ingresscontroller is
+// diagnosed.  Operands that do not exist are nil.
+type DiagnosisInput struct {
+	IngressController *operatorv1.IngressController
+	// IngressControllerImage is the router image.  If it is empty, the
+	// image of the current router deployment is assumed.
+	IngressControllerImage string
+
+	APIConfig     *configv1.APIServer
+	DNSConfig     *configv1.DNS
+	InfraConfig   *configv1.Infrastructure
+	IngressConfig *configv1.Ingress
+	NetworkConfig *configv1.Network
+
+	Deployment          *appsv1.Deployment
+	Pods                []corev1.Pod
+	LoadBalancerService *corev1.Service
+	NodePortService     *corev1.Service
+	InternalService     *corev1.Service
+	PodDisruptionBudget *policyv1.PodDisruptionBudget
+	WildcardRecord      *iov1.DNSRecord
+	DefaultCertificate  *corev1.Secret
+	ClientCAConfigMap   *corev1.ConfigMap
+	OperandEvents       []corev1.Event
+}
+
+// GatherDiagnosisInputs uses the given reader to read the cluster state that
+// is needed to diagnose the ingresscontrollers in the given namespace.
+func GatherDiagnosisInputs(ctx context.Context, reader client.Reader, namespace string) ([]*DiagnosisInput, error) {
+	apiConfig := &configv1.APIServer{}
+	dnsConfig := &configv1.DNS{}
+	infraConfig := &configv1.Infrastructure{}
+	ingressConfig := &configv1.Ingress{}
+	networkConfig := &configv1.Network{}
+	configs := []struct {
+		resource string
+		obj      client.Object
+	}{
+		{"apiserver", apiConfig},
+		{"dns", dnsConfig},
+		{"infrastructure", infraConfig},
+		{"ingress", ingressConfig},
+		{"network", networkConfig},
+	}
+	for _, config := range configs {
+		if err := reader.Get(ctx, types.NamespacedName{Name: "cluster"}, config.obj); err != nil {
+			return nil, fmt.Errorf("failed to get %s 'cluster': %w", config.resource, err)
+		}
+	}
+
+	ingresses := &operatorv1.IngressControllerList{}
+	if err := reader.List(ctx, ingresses, client.InNamespace(namespace)); err != nil {
+		return nil, fmt.Errorf("failed to list ingresscontrollers in namespace %q: %w", namespace, err)
+	}
+	pods := &corev1.PodList{}
+	if err := reader.List(ctx, pods, client.InNamespace(controller.DefaultOperandNamespace)); err != nil {
+		return nil, fmt.Errorf("failed to list pods in namespace %q: %w", controller.DefaultOperandNamespace, err)
+	}
+	events := &corev1.EventList{}
+	if err := reader.List(ctx, events, client.InNamespace(controller.DefaultOperandNamespace)); err != nil {
+		return nil, fmt.Errorf("failed to list events in namespace %q: %w", controller.DefaultOperandNamespace, err)
+	}
+
+	var inputs []*DiagnosisInput
+	for i := range ingresses.Items {
+		ic := &ingresses.Items[i]
+		input := &DiagnosisInput{
+			IngressController: ic,
+			APIConfig:         apiConfig,
+			DNSConfig:         dnsConfig,
+			InfraConfig:       infraConfig,
+			IngressConfig:     ingressConfig,
+			NetworkConfig:     networkConfig,
+			Pods:              pods.Items,
+			OperandEvents:     events.Items,
+		}
+		var err error
+		// get returns the object with the given name, or nil if it does
+		// not exist or if getting it or a previous object failed.
+		get := func(name types.NamespacedName, obj client.Object) client.Object {
+			if err != nil {
+				return nil
+			}
+			if e := reader.Get(ctx, name, obj); e != nil {
+				if !errors.IsNotFound(e) {
+					err = fmt.Errorf("failed to get %s for ingresscontroller %s: %w", name, ic.Name, e)
+				}
+				return nil
+			}
+			return obj
+		}
+		input.Deployment, _ = get(controller.RouterDeploymentName(ic), &appsv1.Deployment{}).(*appsv1.Deployment)
+		input.LoadBalancerService, _ = get(controller.LoadBalancerServiceName(ic), &corev1.Service{}).(*corev1.Service)
+		input.NodePortService, _ = get(controller.NodePortServiceName(ic), &corev1.Service{}).(*corev1.Service)
+		input.InternalService, _ = get(controller.InternalIngressControllerServiceName(ic), &corev1.Service{}).(*corev1.Service)
+		input.PodDisruptionBudget, _ = get(controller.RouterPodDisruptionBudgetName(ic), &policyv1.PodDisruptionBudget{}).(*policyv1.PodDisruptionBudget)
+		input.WildcardRecord, _ = get(controller.WildcardDNSRecordName(ic), &iov1.DNSRecord{}).(*iov1.DNSRecord)
+		input.DefaultCertificate, _ = get(controller.RouterEffectiveDefaultCertificateSecretName(ic, controller.DefaultOperandNamespace), &corev1.Secret{}).(*corev1.Secret)
+		if len(ic.Spec.ClientTLS.ClientCA.Name) != 0 {
+			input.ClientCAConfigMap, _ = get(controller.ClientCAConfigMapName(ic), &corev1.ConfigMap{}).(*corev1.ConfigMap)
+		}
+		if err != nil {
+			return nil, err
+		}
+		inputs = append(inputs, input)
+	}
+	return inputs, nil
+}
+
+// Diagnose diagnoses the ingresscontroller in the given input.  It compares
+// the operands in the input with the operands that the operator would
+// reconcile, recomputes the ingresscontroller's status conditions, checks the
+// default certificate, and checks the publishing status of the wildcard DNS
+// records.
+func Diagnose(in *DiagnosisInput) *Diagnosis {
+	ic := in.IngressController
+	d := &Diagnosis{IngressController: ic.Name}
+	icObject := fmt.Sprintf("ingresscontroller %s/%s", ic.Namespace, ic.Name)
+
+	switch {
+	case ic.DeletionTimestamp != nil:
+		d.add(DiagnosisSeverityInfo, icObject, "ingresscontroller is being deleted")
+		return d
+	case !ingresscontroller.IsAdmitted(ic):
+		message := "ingresscontroller has not been admitted"
+		if cond := findOperatorCondition(ic.Status.Conditions, IngressControllerAdmittedConditionType); cond != nil && len(cond.Message) != 0 {
+			message += ": " + cond.Message
+		}
+		d.add(DiagnosisSeverityError, icObject, message)
+		return d
+	}
+
+	platformStatus := in.InfraConfig.Status.PlatformStatus
+	if platformStatus == nil {
+		d.add(DiagnosisSeverityError, "infrastructure cluster", "status.platformStatus is not set, so the operator cannot reconcile ingresscontrollers")
+		return d
+	}
+
+	d.diagnoseOperands(in, platformStatus)
+	if in.Deployment != nil {
+		d.diagnoseConditions(in, platformStatus)
+	}
+	d.diagnoseDefaultCertificate(in)
+	d.diagnoseDNSRecord(in.WildcardRecord)
+
+	sort.SliceStable(d.Findings, func(i, j int) bool {
+		return diagnosisSeverityOrder[d.Findings[i].Severity] < diagnosisSeverityOrder[d.Findings[j].Severity]
+	})
+	return d
+}
+
+// diagnoseOperands compares the ingresscontroller's operands with the desired
+// operands.
+func (d *Diagnosis) diagnoseOperands(in *DiagnosisInput, platformStatus *configv1.PlatformStatus) {
+	ic := in.IngressController
+
+	haveClientCAConfigmap := in.ClientCAConfigMap != nil
+	clientCAConfigmap := in.ClientCAConfigMap
+	if len(ic.Spec.ClientTLS.ClientCA.Name) != 0 && !haveClientCAConfigmap {
+		name := controller.ClientCAConfigMapName(ic)
+		d.add(DiagnosisSeverityError, fmt.Sprintf("configmap %s", name), "client CA configmap does not exist, so the operator cannot reconcile the router deployment or its other operands")
+		return
+	} else if !haveClientCAConfigmap {
+		clientCAConfigmap = &corev1.ConfigMap{}
+	}
+
+	image := in.IngressControllerImage
+	if len(image) == 0 && in.Deployment != nil {
+		for _, container := range in.Deployment.Spec.Template.Spec.Containers {
+			if container.Name == "router" {
+				image = container.Image
+			}
+		}
+	}
+	deploymentName := controller.RouterDeploymentName(ic)
+	proxyNeeded, err := IsProxyProtocolNeeded(ic, platformStatus)
+	if err != nil {
+		d.add(DiagnosisSeverityError, fmt.Sprintf("deployment %s", deploymentName), "failed to determine if proxy protocol is needed: %v", err)
+		return
+	}
+	desiredDeployment, err := desiredRouterDeployment(ic, image, in.IngressConfig, in.InfraConfig, in.APIConfig, in.NetworkConfig, proxyNeeded, haveClientCAConfigmap, clientCAConfigmap)
+	if err != nil {
+		d.add(DiagnosisSeverityError, fmt.Sprintf("deployment %s", deploymentName), "failed to build the desired router deployment: %v", err)
+		return
+	}
+	if in.Deployment == nil {
+		d.diagnoseOperand("deployment", deploymentName, true, false, false, nil, nil)
+		return
+	}
+	changed, updated := deploymentConfigChanged(in.Deployment, desiredDeployment)
+	d.diagnoseOperand("deployment", deploymentName, true, true, changed, in.Deployment, updated)
+
+	deploymentRef := routerDeploymentOwnerReference(in.Deployment)
+
+	lbName := controller.LoadBalancerServiceName(ic)
+	if want, desired, err := desiredLoadBalancerService(ic, deploymentRef, platformStatus); err != nil {
+		d.add(DiagnosisSeverityError, fmt.Sprintf("service %s", lbName), "failed to build the desired load balancer service: %v", err)
+	} else {
+		current := in.LoadBalancerService
+		if current != nil && !isServiceOwnedByIngressController(current, ic) {
+			d.add(DiagnosisSeverityError, fmt.Sprintf("service %s", lbName), "a conflicting service exists that is not owned by the ingresscontroller")
+		} else {
+			var changed bool
+			var updated *corev1.Service
+			if want && current != nil {
+				changed, updated = loadBalancerServiceChanged(current, desired)
+			}
+			d.diagnoseOperand("service", lbName, want, current != nil, changed, current, updated)
+		}
+		if want && current != nil && len(current.Status.LoadBalancer.Ingress) == 0 {
+			d.add(DiagnosisSeverityWarning, fmt.Sprintf("service %s", lbName), "load balancer has not been provisioned")
+		}
+	}
+
+	nodePortName := controller.NodePortServiceName(ic)
+	if want, desired, err := desiredNodePortService(ic, deploymentRef, nodePortServiceWantsMetricsPort(in.NodePortService)); err != nil {
+		d.add(DiagnosisSeverityError, fmt.Sprintf("service %s", nodePortName), "failed to build the desired NodePort service: %v", err)
+	} else {
+		current := in.NodePortService
+		if current != nil && !isServiceOwnedByIngressController(current, ic) {
+			if want {
+				d.add(DiagnosisSeverityError, fmt.Sprintf("service %s", nodePortName), "a conflicting service exists that is not owned by the ingresscontroller")
+			}
+		} else {
+			var changed bool
+			var updated *corev1.Service
+			if want && current != nil {
+				changed, updated = nodePortServiceChanged(current, desired)
+			}
+			d.diagnoseOperand("service", nodePortName, want, current != nil, changed, current, updated)
+		}
+	}
+
+	internalName := controller.InternalIngressControllerServiceName(ic)
+	var internalChanged bool
+	var updatedInternal *corev1.Service
+	if in.InternalService != nil {
+		internalChanged, updatedInternal = internalServiceChanged(in.InternalService, desiredInternalIngressControllerService(ic, deploymentRef))
+	}
+	d.diagnoseOperand("service", internalName, true, in.InternalService != nil, internalChanged, in.InternalService, updatedInternal)
+
+	pdbName := controller.RouterPodDisruptionBudgetName(ic)
+	if want, desired, err := desiredRouterPodDisruptionBudget(ic, deploymentRef); err != nil {
+		d.add(DiagnosisSeverityError, fmt.Sprintf("poddisruptionbudget %s", pdbName), "failed to build the desired pod disruption budget: %v", err)
+	} else {
+		var changed bool
+		var updated *policyv1.PodDisruptionBudget
+		if want && in.PodDisruptionBudget != nil {
+			changed, updated = podDisruptionBudgetChanged(in.PodDisruptionBudget, desired)
+		}
+		d.diagnoseOperand("poddisruptionbudget", pdbName, want, in.PodDisruptionBudget != nil, changed, in.PodDisruptionBudget, updated)
+	}
+
+	// The wildcard DNS record's targets are the load balancer's, so the
+	// desired record is only known if the load balancer service exists.
+	if in.LoadBalancerService == nil {
+		return
+	}
+	want, desired := desiredWildcardDNSRecord(ic, loadBalancerDNSTargets(in.LoadBalancerService))
+	var recordChanged bool
+	var updatedRecord *iov1.DNSRecord
+	if want && in.WildcardRecord != nil {
+		recordChanged, updatedRecord = dnsRecordChanged(in.WildcardRecord, desired)
+	}
+	d.diagnoseOperand("dnsrecord", controller.WildcardDNSRecordName(ic), want, in.WildcardRecord != nil, recordChanged, in.WildcardRecord, updatedRecord)
+}
+
+// diagnoseOperand adds a finding for an operand of the given kind and name if
+// the operand is missing but desired, exists but is not desired, or differs
+// from the desired operand.  If changed is true, current and updated are the
+// current operand and the operand with the desired changes applied.
+func (d *Diagnosis) diagnoseOperand(kind string, name types.NamespacedName, want, have, changed bool, current, updated interface{}) {
+	object := fmt.Sprintf("%s %s", kind, name)
+	switch {
+	case want && !have:
+		d.add(DiagnosisSeverityError, object, "%s does not exist", kind)
+	case !want && have:
+		d.add(DiagnosisSeverityWarning, object, "%s exists but is not desired; the operator deletes it when it next reconciles the ingresscontroller", kind)
+	case changed:
+		d.add(DiagnosisSeverityWarning, object, "%s differs from the desired %s in the following fields: %s", kind, kind, controller.FieldDiff(current, updated))
+	}
+}
+
+// diagnoseConditions recomputes the ingresscontroller's status conditions
+// and reports the unhealthy ones as well as those that differ from the
+// recorded ones.
+func (d *Diagnosis) diagnoseConditions(in *DiagnosisInput, platformStatus *configv1.PlatformStatus) {
+	ic := in.IngressController
+	icObject := fmt.Sprintf("ingresscontroller %s/%s", ic.Namespace, ic.Name)
+
+	roots, err := systemCertPool()
+	if err != nil {
+		d.add(DiagnosisSeverityWarning, icObject, "failed to load the system trust bundle, so the default certificate chain cannot be verified: %v", err)
+	}
+	conditions, _ := computeIngressControllerConditions(ic, in.Deployment, routerDeploymentOwnerReference(in.Deployment), in.Pods, in.LoadBalancerService, in.OperandEvents, in.WildcardRecord, in.DNSConfig, platformStatus, in.DefaultCertificate, roots)
+
+	unhealthy := []struct {
+		conditionType string
+		status        operatorv1.ConditionStatus
+		severity      DiagnosisSeverity
+		description   string
+	}{
+		{operatorv1.OperatorStatusTypeAvailable, operatorv1.ConditionFalse, DiagnosisSeverityError, "not available"},
+		{operatorv1.OperatorStatusTypeDegraded, operatorv1.ConditionTrue, DiagnosisSeverityError, "degraded"},
+		{operatorv1.OperatorStatusTypeProgressing, operatorv1.ConditionTrue, DiagnosisSeverityWarning, "progressing"},
+		{operatorv1.OperatorStatusTypeUpgradeable, operatorv1.ConditionFalse, DiagnosisSeverityWarning, "not upgradeable"},
+		{IngressControllerDefaultCertificateValidConditionType, operatorv1.ConditionFalse, DiagnosisSeverityError, "using an invalid default certificate"},
+	}
+	for _, u := range unhealthy {
+		cond := findOperatorCondition(conditions, u.conditionType)
+		if cond == nil || cond.Status != u.status {
+			continue
+		}
+		d.add(u.severity, icObject, "ingresscontroller is %s: %s", u.description, cond.Message)
+	}
+
+	for i := range conditions {
+		recorded := findOperatorCondition(ic.Status.Conditions, conditions[i].Type)
+		if recorded == nil || recorded.Status == conditions[i].Status {
+			continue
+		}
+		d.add(DiagnosisSeverityInfo, icObject, "status condition %s is recorded as %s but is %s for the current cluster state; the operator has not yet updated the ingresscontroller's status", conditions[i].Type, recorded.Status, conditions[i].Status)
+	}
+}
+
+// diagnoseDefaultCertificate checks the ingresscontroller's default
+// certificate.
+func (d *Diagnosis) diagnoseDefaultCertificate(in *DiagnosisInput) {
+	ic := in.IngressController
+	name := controller.RouterEffectiveDefaultCertificateSecretName(ic, controller.DefaultOperandNamespace)
+	object := fmt.Sprintf("secret %s", name)
+	if in.DefaultCertificate == nil {
+		d.add(DiagnosisSeverityWarning, object, "default certificate secret was not found, so the default certificate was not checked")
+		return
+	}
+	if err := checkDefaultCertificate(in.DefaultCertificate, "*."+ic.Status.Domain); err != nil {
+		d.add(DiagnosisSeverityError, object, "%v", err)
+	}
+}
+
+// diagnoseDNSRecord checks the publishing status of the given DNS record in
+// its zones.
+func (d *Diagnosis) diagnoseDNSRecord(record *iov1.DNSRecord) {
+	if record == nil || record.DeletionTimestamp != nil || record.Spec.DNSManagementPolicy == iov1.UnmanagedDNS {
+		return
+	}
+	object := fmt.Sprintf("dnsrecord %s/%s", record.Namespace, record.Name)
+	if len(record.Status.Zones) == 0 {
+		d.add(DiagnosisSeverityWarning, object, "%s has not been published to any zone", record.Spec.DNSName)
+		return
+	}
+	for _, zone := range record.Status.Zones {
+		for _, cond := range zone.Conditions {
+			if cond.Type != iov1.DNSRecordPublishedConditionType {
+				continue
+			}
+			switch cond.Status {
+			case string(operatorv1.ConditionFalse):
+				d.add(DiagnosisSeverityError, object, "failed to publish %s in %s: %s: %s", record.Spec.DNSName, controller.DNSZoneDescription(zone.DNSZone), cond.Reason, cond.Message)
+			case string(operatorv1.ConditionUnknown):
+				d.add(DiagnosisSeverityWarning, object, "unknown publishing status for %s in %s: %s: %s", record.Spec.DNSName, controller.DNSZoneDescription(zone.DNSZone), cond.Reason, cond.Message)
+			}
+		}
+	}
+}
@@ -0,0 +1,239 @@
+package ingress
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"strings"
+	"testing"
+
+	configv1 "github.com/openshift/api/config/v1"
+	operatorv1 "github.com/openshift/api/operator/v1"
+	iov1 "github.com/openshift/api/operatoringress/v1"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// TestDiagnose verifies that Diagnose reports the expected findings for
+// various problems with an ingresscontroller and its operands.
+func TestDiagnose(t *testing.T) {
+	makeDefaultCertificateSecret := func(cn string, sans []string) *corev1.Secret {
+		key, err := rsa.GenerateKey(rand.Reader, 2048)
+		if err != nil {
+			t.Fatalf("failed to generate key: %v", err)
+		}
+		certTemplate := &x509.Certificate{
+			SerialNumber: big.NewInt(1),
+			Subject:      pkix.Name{CommonName: cn},
+			DNSNames:     sans,
+		}
+		cert, err := x509.CreateCertificate(rand.Reader, certTemplate, certTemplate, &key.PublicKey, key)
+		if err != nil {
+			t.Fatalf("failed to generate certificate: %v", err)
+		}
+		certData := pem.EncodeToMemory(&pem.Block{
+			Type:  "CERTIFICATE",
+			Bytes: cert,
+		})
+		return &corev1.Secret{
+			Data: map[string][]byte{"tls.crt": certData},
+		}
+	}
+	// makeInput returns the input for a healthy ingresscontroller with
+	// the "Private" endpoint publishing strategy type.
+	makeInput := func() *DiagnosisInput {
+		ic, ingressConfig, infraConfig, apiConfig, networkConfig, proxyNeeded := getRouterDeploymentComponents(t)
+		ic.Namespace = "openshift-ingress-operator"
+		ic.Status.Domain = "apps.example.com"
+		ic.Status.Conditions = []operatorv1.OperatorCondition{{
+			Type:   IngressControllerAdmittedConditionType,
+			Status: operatorv1.ConditionTrue,
+		}}
+		deployment, err := desiredRouterDeployment(ic, ingressControllerImage, ingressConfig, infraConfig, apiConfig, networkConfig, proxyNeeded, false, nil)
+		if err != nil {
+			t.Fatalf("failed to get desired router deployment: %v", err)
+		}
+		deployment.Status = appsv1.DeploymentStatus{
+			Replicas:          1,
+			UpdatedReplicas:   1,
+			AvailableReplicas: 1,
+			Conditions: []appsv1.DeploymentCondition{{
+				Type:   appsv1.DeploymentAvailable,
+				Status: corev1.ConditionTrue,
+			}},
+		}
+		deploymentRef := routerDeploymentOwnerReference(deployment)
+		_, pdb, err := desiredRouterPodDisruptionBudget(ic, deploymentRef)
+		if err != nil {
+			t.Fatalf("failed to get desired pod disruption budget: %v", err)
+		}
+		return &DiagnosisInput{
+			IngressController:      ic,
+			IngressControllerImage: ingressControllerImage,
+			APIConfig:              apiConfig,
+			DNSConfig:              &configv1.DNS{},
+			InfraConfig:            infraConfig,
+			IngressConfig:          ingressConfig,
+			NetworkConfig:          networkConfig,
+			Deployment:             deployment,
+			InternalService:        desiredInternalIngressControllerService(ic, deploymentRef),
+			PodDisruptionBudget:    pdb,
+			DefaultCertificate:     makeDefaultCertificateSecret("", []string{"*.apps.example.com"}),
+		}
+	}
+
+	testCases := []struct {
+		description string
+		mutate      func(*DiagnosisInput)
+		// expected are the expected error findings, each given as
+		// the expected object followed by a substring of the
+		// expected message.
+		expected [][2]string
+	}{
+		{
+			description: "healthy ingresscontroller",
+			mutate:      func(*DiagnosisInput) {},
+		},
+		{
+			description: "ingresscontroller not admitted",
+			mutate: func(in *DiagnosisInput) {
+				in.IngressController.Status.Conditions = []operatorv1.OperatorCondition{{
+					Type:    IngressControllerAdmittedConditionType,
+					Status:  operatorv1.ConditionFalse,
+					Message: "domain is already in use",
+				}}
+			},
+			expected: [][2]string{
+				{"ingresscontroller openshift-ingress-operator/default", "has not been admitted: domain is already in use"},
+			},
+		},
+		{
+			description: "missing router deployment",
+			mutate: func(in *DiagnosisInput) {
+				in.Deployment = nil
+			},
+			expected: [][2]string{
+				{"deployment openshift-ingress/router-default", "deployment does not exist"},
+			},
+		},
+		{
+			description: "missing internal service",
+			mutate: func(in *DiagnosisInput) {
+				in.InternalService = nil
+			},
+			expected: [][2]string{
+				{"service openshift-ingress/router-internal-default", "service does not exist"},
+			},
+		},
+		{
+			description: "unavailable router deployment",
+			mutate: func(in *DiagnosisInput) {
+				in.Deployment.Status.Conditions[0].Status = corev1.ConditionFalse
+				in.Deployment.Status.AvailableReplicas = 0
+			},
+			expected: [][2]string{
+				{"ingresscontroller openshift-ingress-operator/default", "is not available"},
+			},
+		},
+		{
+			description: "default certificate without SAN",
+			mutate: func(in *DiagnosisInput) {
+				in.DefaultCertificate = makeDefaultCertificateSecret("*.apps.example.com", nil)
+			},
+			expected: [][2]string{
+				{"secret openshift-ingress/router-certs-default", "legacy Common Name"},
+			},
+		},
+		{
+			description: "unpublished wildcard DNS record",
+			mutate: func(in *DiagnosisInput) {
+				in.WildcardRecord = &iov1.DNSRecord{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "openshift-ingress-operator",
+						Name:      "default-wildcard",
+					},
+					Spec: iov1.DNSRecordSpec{
+						DNSName:             "*.apps.example.com.",
+						DNSManagementPolicy: iov1.ManagedDNS,
+					},
+					Status: iov1.DNSRecordStatus{
+						Zones: []iov1.DNSZoneStatus{{
+							DNSZone: configv1.DNSZone{ID: "example"},
+							Conditions: []iov1.DNSZoneCondition{{
+								Type:    iov1.DNSRecordPublishedConditionType,
+								Status:  string(operatorv1.ConditionFalse),
+								Reason:  "ProviderError",
+								Message: "access denied",
+							}},
+						}},
+					},
+				}
+			},
+			expected: [][2]string{
+				{"dnsrecord openshift-ingress-operator/default-wildcard", `failed to publish *.apps.example.com. in zone "example": ProviderError: access denied`},
+			},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			in := makeInput()
+			tc.mutate(in)
+			d := Diagnose(in)
+			var errorFindings []DiagnosisFinding
+			for _, finding := range d.Findings {
+				if finding.Severity == DiagnosisSeverityError {
+					errorFindings = append(errorFindings, finding)
+				}
+			}
+			if len(errorFindings) != len(tc.expected) {
+				t.Fatalf("expected %d error findings, got %d: %+v", len(tc.expected), len(errorFindings), d.Findings)
+			}
+			for i, expected := range tc.expected {
+				if errorFindings[i].Object != expected[0] || !strings.Contains(errorFindings[i].Message, expected[1]) {
+					t.Errorf("expected finding for %q with message containing %q, got %+v", expected[0], expected[1], errorFindings[i])
+				}
+			}
+		})
+	}
+}
+
+// TestDiagnoseDeploymentDrift verifies that Diagnose reports the fields in
+// which the router deployment differs from the desired deployment.
+func TestDiagnoseDeploymentDrift(t *testing.T) {
+	ic, ingressConfig, infraConfig, apiConfig, networkConfig, proxyNeeded := getRouterDeploymentComponents(t)
+	ic.Status.Conditions = []operatorv1.OperatorCondition{{
+		Type:   IngressControllerAdmittedConditionType,
+		Status: operatorv1.ConditionTrue,
+	}}
+	deployment, err := desiredRouterDeployment(ic, ingressControllerImage, ingressConfig, infraConfig, apiConfig, networkConfig, proxyNeeded, false, nil)
+	if err != nil {
+		t.Fatalf("failed to get desired router deployment: %v", err)
+	}
+	deployment.Spec.Template.Spec.Containers[0].Image = "quay.io/openshift/router:old"
+	in := &DiagnosisInput{
+		IngressController:      ic,
+		IngressControllerImage: ingressControllerImage,
+		APIConfig:              apiConfig,
+		DNSConfig:              &configv1.DNS{},
+		InfraConfig:            infraConfig,
+		IngressConfig:          ingressConfig,
+		NetworkConfig:          networkConfig,
+		Deployment:             deployment,
+	}
+	d := Diagnose(in)
+	for _, finding := range d.Findings {
+		if finding.Object == "deployment openshift-ingress/router-default" && finding.Severity == DiagnosisSeverityWarning {
+			if !strings.Contains(finding.Message, "spec.template.spec.containers[0].image") {
+				t.Errorf("expected the finding to list the changed image, got %q", finding.Message)
+			}
+			return
+		}
+	}
+	t.Errorf("expected a warning for the router deployment, got %+v", d.Findings)
+}
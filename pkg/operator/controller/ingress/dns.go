@@ -3,6 +3,7 @@ package ingress
 import (
 	"context"
 	"fmt"
+	"net"
 	"strings"
 
 	"github.com/google/go-cmp/cmp"
@@ -17,6 +18,7 @@ import (
 
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
 )
 
 // defaultRecordTTL is the TTL (in seconds) assigned to all new DNS records.
@@ -27,28 +29,48 @@ import (
 // [1] https://docs.aws.amazon.com/Route53/latest/DeveloperGuide/resource-record-sets-choosing-alias-non-alias.html
 const defaultRecordTTL int64 = 30
 
-// ensureWildcardDNSRecord will create DNS records for the given LB service.
-// If service is nil (haveLBS is false), nothing is done.
-func (r *reconciler) ensureWildcardDNSRecord(ic *operatorv1.IngressController, service *corev1.Service, haveLBS bool) (bool, *iov1.DNSRecord, error) {
-	if !haveLBS {
-		return false, nil, nil
-	}
+// wildcardDNSTargets holds the targets for an ingresscontroller's wildcard
+// DNS records.
+type wildcardDNSTargets struct {
+	// hostnames are the targets for a CNAME record.
+	hostnames []string
+	// ipv4 are the targets for an A record.
+	ipv4 []string
+}
 
-	wantWC, desired := desiredWildcardDNSRecord(ic, service)
+// ensureWildcardDNSRecord will create DNS records for the given targets.  If
+// targets is nil, the current record (if any) is left as is, unless the
+// ingresscontroller no longer allows wildcard DNS records, in which case the
+// record is deleted.
+func (r *reconciler) ensureWildcardDNSRecord(ic *operatorv1.IngressController, targets *wildcardDNSTargets) (bool, *iov1.DNSRecord, error) {
 	haveWC, current, err := r.currentWildcardDNSRecord(ic)
 	if err != nil {
 		return false, nil, err
 	}
 
+	if targets == nil {
+		if haveWC && !wildcardDNSRecordAllowed(ic) {
+			if err := r.deleteWildcardDNSRecord(ic); err != nil {
+				return true, current, fmt.Errorf("failed to delete dnsrecord %s/%s: %v", current.Namespace, current.Name, err)
+			}
+			log.Info("deleted dnsrecord", "dnsrecord", current)
+			return false, nil, nil
+		}
+		return haveWC, current, nil
+	}
+
+	wantWC, desired := desiredWildcardDNSRecord(ic, targets)
+
 	switch {
 	case wantWC && !haveWC:
 		if err := r.client.Create(context.TODO(), desired); err != nil {
 			return false, nil, fmt.Errorf("failed to create dnsrecord %s/%s: %v", desired.Namespace, desired.Name, err)
 		}
 		log.Info("created dnsrecord", "dnsrecord", desired)
+		controller.RecordOperandCreated(r.recorder, ic, "DNSRecord", desired)
 		return r.currentWildcardDNSRecord(ic)
 	case wantWC && haveWC:
-		if updated, err := r.updateDNSRecord(current, desired); err != nil {
+		if updated, err := r.updateDNSRecord(ic, current, desired); err != nil {
 			return true, current, fmt.Errorf("failed to update dnsrecord %s/%s: %v", desired.Namespace, desired.Name, err)
 		} else if updated {
 			return r.currentWildcardDNSRecord(ic)
@@ -58,64 +80,90 @@ func (r *reconciler) ensureWildcardDNSRecord(ic *operatorv1.IngressController, s
 	return haveWC, current, nil
 }
 
+// loadBalancerDNSTargets returns the wildcard DNS targets for the given
+// service's load balancer ingress entries.  Entries that have both a hostname
+// and an IP address are ignored because we don't know how to handle them (is
+// that even a valid state?).
+func loadBalancerDNSTargets(service *corev1.Service) *wildcardDNSTargets {
+	targets := &wildcardDNSTargets{}
+	for _, ingress := range service.Status.LoadBalancer.Ingress {
+		switch {
+		case len(ingress.Hostname) > 0 && len(ingress.IP) > 0:
+			continue
+		case len(ingress.Hostname) > 0:
+			targets.hostnames = append(targets.hostnames, ingress.Hostname)
+		case len(ingress.IP) > 0:
+			targets.addIP(ingress.IP)
+		}
+	}
+	return targets
+}
+
+// addIP adds the given IP address to the IPv4 targets.  Invalid addresses and
+// IPv6 addresses are ignored because the DNSRecord API has no record type for
+// IPv6 addresses.
+func (t *wildcardDNSTargets) addIP(address string) {
+	if ip := net.ParseIP(address); ip != nil && ip.To4() != nil {
+		t.ipv4 = append(t.ipv4, address)
+	}
+}
+
 // desiredWildcardDNSRecord will return any necessary wildcard DNS records for the
 // ingresscontroller.
 //
-// For now, if the service has more than one .status.loadbalancer.ingress, only
-// the first will be used.
+// If there are hostname targets, a CNAME record for the first hostname is
+// desired.  Otherwise, if there are IPv4 targets, an A record for all of them
+// is desired.
 //
 // TODO: If .status.loadbalancer.ingress is processed once as non-empty and then
 // later becomes empty, what should we do? Currently we'll treat it as an intent
 // to not have a desired record.
-func desiredWildcardDNSRecord(ic *operatorv1.IngressController, service *corev1.Service) (bool, *iov1.DNSRecord) {
-	// If the ingresscontroller has no ingress domain, we cannot configure any
-	// DNS records.
-	if len(ic.Status.Domain) == 0 {
-		return false, nil
-	}
-
-	// DNS is only managed for LB publishing.
-	if ic.Status.EndpointPublishingStrategy.Type != operatorv1.LoadBalancerServiceStrategyType {
+func desiredWildcardDNSRecord(ic *operatorv1.IngressController, targets *wildcardDNSTargets) (bool, *iov1.DNSRecord) {
+	if !wildcardDNSRecordAllowed(ic) {
 		return false, nil
 	}
 
-	// No LB target exists for the domain record to point at.
-	if len(service.Status.LoadBalancer.Ingress) == 0 {
-		return false, nil
+	switch {
+	case len(targets.hostnames) > 0:
+		// A CNAME record can have only one target.
+		return true, newWildcardDNSRecord(ic, controller.WildcardDNSRecordName(ic), iov1.CNAMERecordType, targets.hostnames[:1])
+	case len(targets.ipv4) > 0:
+		return true, newWildcardDNSRecord(ic, controller.WildcardDNSRecordName(ic), iov1.ARecordType, targets.ipv4)
 	}
 
-	ingress := service.Status.LoadBalancer.Ingress[0]
+	// No target exists for the domain record to point at.
+	return false, nil
+}
 
-	// Quick sanity check since we don't know how to handle both being set (is
-	// that even a valid state?)
-	if len(ingress.Hostname) > 0 && len(ingress.IP) > 0 {
-		return false, nil
+// wildcardDNSRecordAllowed returns a Boolean value indicating whether the
+// ingresscontroller can have wildcard DNS records.
+func wildcardDNSRecordAllowed(ic *operatorv1.IngressController) bool {
+	// If the ingresscontroller has no ingress domain, we cannot configure any
+	// DNS records.
+	if len(ic.Status.Domain) == 0 {
+		return false
 	}
 
-	name := controller.WildcardDNSRecordName(ic)
+	// DNS is only managed for LB publishing.
+	return ic.Status.EndpointPublishingStrategy.Type == operatorv1.LoadBalancerServiceStrategyType
+}
+
+// newWildcardDNSRecord returns a wildcard DNSRecord with the given name,
+// record type, and targets for the given ingresscontroller.
+func newWildcardDNSRecord(ic *operatorv1.IngressController, name types.NamespacedName, recordType iov1.DNSRecordType, targets []string) *iov1.DNSRecord {
 	// Use an absolute name to prevent any ambiguity.
 	domain := fmt.Sprintf("*.%s.", ic.Status.Domain)
-	var target string
-	var recordType iov1.DNSRecordType
-
-	if len(ingress.Hostname) > 0 {
-		recordType = iov1.CNAMERecordType
-		target = ingress.Hostname
-	} else {
-		recordType = iov1.ARecordType
-		target = ingress.IP
-	}
 
 	dnsPolicy := iov1.ManagedDNS
 
 	// Set the DNS management policy on the dnsrecord to "Unmanaged" if ingresscontroller has "Unmanaged" DNS policy or
 	// if the ingresscontroller domain isn't a subdomain of the cluster's base domain.
-	if ic.Status.EndpointPublishingStrategy.LoadBalancer.DNSManagementPolicy == operatorv1.UnmanagedLoadBalancerDNS {
+	if lb := ic.Status.EndpointPublishingStrategy.LoadBalancer; lb != nil && lb.DNSManagementPolicy == operatorv1.UnmanagedLoadBalancerDNS {
 		dnsPolicy = iov1.UnmanagedDNS
 	}
 
 	trueVar := true
-	return true, &iov1.DNSRecord{
+	return &iov1.DNSRecord{
 		ObjectMeta: metav1.ObjectMeta{
 			Namespace: name.Namespace,
 			Name:      name.Name,
@@ -137,7 +185,7 @@ func desiredWildcardDNSRecord(ic *operatorv1.IngressController, service *corev1.
 		Spec: iov1.DNSRecordSpec{
 			DNSName:             domain,
 			DNSManagementPolicy: dnsPolicy,
-			Targets:             []string{target},
+			Targets:             targets,
 			RecordType:          recordType,
 			RecordTTL:           defaultRecordTTL,
 		},
@@ -145,8 +193,13 @@ func desiredWildcardDNSRecord(ic *operatorv1.IngressController, service *corev1.
 }
 
 func (r *reconciler) currentWildcardDNSRecord(ic *operatorv1.IngressController) (bool, *iov1.DNSRecord, error) {
+	return r.currentDNSRecord(controller.WildcardDNSRecordName(ic))
+}
+
+// currentDNSRecord returns the DNSRecord with the given name, if it exists.
+func (r *reconciler) currentDNSRecord(name types.NamespacedName) (bool, *iov1.DNSRecord, error) {
 	current := &iov1.DNSRecord{}
-	err := r.client.Get(context.TODO(), controller.WildcardDNSRecordName(ic), current)
+	err := r.client.Get(context.TODO(), name, current)
 	if err != nil {
 		if errors.IsNotFound(err) {
 			return false, nil, nil
@@ -157,7 +210,12 @@ func (r *reconciler) currentWildcardDNSRecord(ic *operatorv1.IngressController)
 }
 
 func (r *reconciler) deleteWildcardDNSRecord(ic *operatorv1.IngressController) error {
-	name := controller.WildcardDNSRecordName(ic)
+	return r.deleteDNSRecord(ic, controller.WildcardDNSRecordName(ic))
+}
+
+// deleteDNSRecord deletes the DNSRecord with the given name, if it exists, and
+// records an event on the given ingresscontroller if it did.
+func (r *reconciler) deleteDNSRecord(ic *operatorv1.IngressController, name types.NamespacedName) error {
 	record := &iov1.DNSRecord{}
 	record.Namespace = name.Namespace
 	record.Name = name.Name
@@ -167,12 +225,13 @@ func (r *reconciler) deleteWildcardDNSRecord(ic *operatorv1.IngressController) e
 		}
 		return err
 	}
+	controller.RecordOperandDeleted(r.recorder, ic, "DNSRecord", record)
 	return nil
 }
 
 // updateDNSRecord updates a DNSRecord. Returns a boolean indicating whether
 // the record was updated, and an error value.
-func (r *reconciler) updateDNSRecord(current, desired *iov1.DNSRecord) (bool, error) {
+func (r *reconciler) updateDNSRecord(ic *operatorv1.IngressController, current, desired *iov1.DNSRecord) (bool, error) {
 	changed, updated := dnsRecordChanged(current, desired)
 	if !changed {
 		return false, nil
@@ -180,10 +239,12 @@ func (r *reconciler) updateDNSRecord(current, desired *iov1.DNSRecord) (bool, er
 
 	// Diff before updating because the client may mutate the object.
 	diff := cmp.Diff(current, updated, cmpopts.EquateEmpty())
+	fieldDiff := controller.FieldDiff(current, updated)
 	if err := r.client.Update(context.TODO(), updated); err != nil {
 		return false, err
 	}
 	log.Info("updated dnsrecord", "namespace", updated.Namespace, "name", updated.Name, "diff", diff)
+	controller.RecordOperandUpdated(r.recorder, ic, "DNSRecord", updated, fieldDiff)
 	return true, nil
 }
 
@@ -39,9 +39,10 @@ func (r *reconciler) ensureInternalIngressControllerService(ic *operatorv1.Ingre
 			return false, nil, fmt.Errorf("failed to create internal ingresscontroller service: %w", err)
 		}
 		log.Info("created internal ingresscontroller service", "service", desired)
+		controller.RecordOperandCreated(r.recorder, ic, "Service", desired)
 		return r.currentInternalIngressControllerService(ic)
 	case have:
-		if updated, err := r.updateInternalService(current, desired); err != nil {
+		if updated, err := r.updateInternalService(ic, current, desired); err != nil {
 			return true, current, fmt.Errorf("failed to update internal service: %v", err)
 		} else if updated {
 			return r.currentInternalIngressControllerService(ic)
@@ -89,7 +90,7 @@ func desiredInternalIngressControllerService(ic *operatorv1.IngressController, d
 
 // updateInternalService updates a ClusterIP service.  Returns a Boolean
 // indicating whether the service was updated, and an error value.
-func (r *reconciler) updateInternalService(current, desired *corev1.Service) (bool, error) {
+func (r *reconciler) updateInternalService(ic *operatorv1.IngressController, current, desired *corev1.Service) (bool, error) {
 	changed, updated := internalServiceChanged(current, desired)
 	if !changed {
 		return false, nil
@@ -97,10 +98,12 @@ func (r *reconciler) updateInternalService(current, desired *corev1.Service) (bo
 
 	// Diff before updating because the client may mutate the object.
 	diff := cmp.Diff(current, updated, cmpopts.EquateEmpty())
+	fieldDiff := controller.FieldDiff(current, updated)
 	if err := r.client.Update(context.TODO(), updated); err != nil {
 		return false, err
 	}
 	log.Info("updated internal service", "namespace", updated.Namespace, "name", updated.Name, "diff", diff)
+	controller.RecordOperandUpdated(r.recorder, ic, "Service", updated, fieldDiff)
 	return true, nil
 }
 
@@ -282,12 +282,12 @@ func (r *reconciler) ensureLoadBalancerService(ci *operatorv1.IngressController,
 		if !ownLBS {
 			return false, nil, fmt.Errorf("a conflicting load balancer service exists that is not owned by the ingress controller: %s", controller.LoadBalancerServiceName(ci))
 		}
-		if err := r.deleteLoadBalancerService(currentLBService, &crclient.DeleteOptions{}); err != nil {
+		if err := r.deleteLoadBalancerService(ci, currentLBService, &crclient.DeleteOptions{}); err != nil {
 			return true, currentLBService, err
 		}
 		return false, nil, nil
 	case wantLBS && !haveLBS:
-		if err := r.createLoadBalancerService(desiredLBService); err != nil {
+		if err := r.createLoadBalancerService(ci, desiredLBService); err != nil {
 			return false, nil, err
 		}
 		return r.currentLoadBalancerService(ci)
@@ -295,7 +295,7 @@ func (r *reconciler) ensureLoadBalancerService(ci *operatorv1.IngressController,
 		if !ownLBS {
 			return false, nil, fmt.Errorf("a conflicting load balancer service exists that is not owned by the ingress controller: %s", controller.LoadBalancerServiceName(ci))
 		}
-		if updated, err := r.normalizeLoadBalancerServiceAnnotations(currentLBService); err != nil {
+		if updated, err := r.normalizeLoadBalancerServiceAnnotations(ci, currentLBService); err != nil {
 			return true, currentLBService, fmt.Errorf("failed to normalize annotations for load balancer service: %w", err)
 		} else if updated {
 			haveLBS, currentLBService, err = r.currentLoadBalancerService(ci)
@@ -307,7 +307,7 @@ func (r *reconciler) ensureLoadBalancerService(ci *operatorv1.IngressController,
 		if _, ok := ci.Annotations[autoDeleteLoadBalancerAnnotation]; ok {
 			deleteIfScopeChanged = true
 		}
-		if updated, err := r.updateLoadBalancerService(currentLBService, desiredLBService, platformStatus, deleteIfScopeChanged); err != nil {
+		if updated, err := r.updateLoadBalancerService(ci, currentLBService, desiredLBService, platformStatus, deleteIfScopeChanged); err != nil {
 			return true, currentLBService, fmt.Errorf("failed to update load balancer service: %v", err)
 		} else if updated {
 			return r.currentLoadBalancerService(ci)
@@ -500,8 +500,8 @@ func (r *reconciler) currentLoadBalancerService(ci *operatorv1.IngressController
 }
 
 // normalizeLoadBalancerServiceAnnotations normalizes annotations for the
-// provided LoadBalancer-type service.
-func (r *reconciler) normalizeLoadBalancerServiceAnnotations(service *corev1.Service) (bool, error) {
+// provided LoadBalancer-type service of the given ingresscontroller.
+func (r *reconciler) normalizeLoadBalancerServiceAnnotations(ci *operatorv1.IngressController, service *corev1.Service) (bool, error) {
 	// On AWS, the service.beta.kubernetes.io/aws-load-balancer-internal
 	// annotation can have either the value "0.0.0.0/0" or the value "true"
 	// to indicate that the service load-balancer should be internal.
@@ -515,11 +515,13 @@ func (r *reconciler) normalizeLoadBalancerServiceAnnotations(service *corev1.Ser
 		// (i.e. it could have been from a cache).
 		updated := service.DeepCopy()
 		updated.Annotations[awsInternalLBAnnotation] = "true"
+		fieldDiff := controller.FieldDiff(service, updated)
 		if err := r.client.Update(context.TODO(), updated); err != nil {
 			return false, fmt.Errorf("failed to normalize %s annotation on service %s/%s: %w", awsInternalLBAnnotation, service.Namespace, service.Name, err)
 		}
 
 		log.Info("normalized annotation", "namespace", service.Namespace, "name", service.Name, "annotation", awsInternalLBAnnotation, "old", v, "new", updated.Annotations[awsInternalLBAnnotation])
+		controller.RecordOperandUpdated(r.recorder, ci, "Service", updated, fieldDiff)
 
 		return true, nil
 	}
@@ -527,17 +529,20 @@ func (r *reconciler) normalizeLoadBalancerServiceAnnotations(service *corev1.Ser
 	return false, nil
 }
 
-// createLoadBalancerService creates a load balancer service.
-func (r *reconciler) createLoadBalancerService(service *corev1.Service) error {
+// createLoadBalancerService creates a load balancer service for the given
+// ingresscontroller.
+func (r *reconciler) createLoadBalancerService(ci *operatorv1.IngressController, service *corev1.Service) error {
 	if err := r.client.Create(context.TODO(), service); err != nil {
 		return fmt.Errorf("failed to create load balancer service %s/%s: %v", service.Namespace, service.Name, err)
 	}
 	log.Info("created load balancer service", "namespace", service.Namespace, "name", service.Name)
+	controller.RecordOperandCreated(r.recorder, ci, "Service", service)
 	return nil
 }
 
-// deleteLoadBalancerService deletes a load balancer service.
-func (r *reconciler) deleteLoadBalancerService(service *corev1.Service, options *crclient.DeleteOptions) error {
+// deleteLoadBalancerService deletes a load balancer service of the given
+// ingresscontroller.
+func (r *reconciler) deleteLoadBalancerService(ci *operatorv1.IngressController, service *corev1.Service, options *crclient.DeleteOptions) error {
 	if err := r.client.Delete(context.TODO(), service, options); err != nil {
 		if errors.IsNotFound(err) {
 			return nil
@@ -545,21 +550,23 @@ func (r *reconciler) deleteLoadBalancerService(service *corev1.Service, options
 		return fmt.Errorf("failed to delete load balancer service %s/%s: %v", service.Namespace, service.Name, err)
 	}
 	log.Info("deleted load balancer service", "namespace", service.Namespace, "name", service.Name)
+	controller.RecordOperandDeleted(r.recorder, ci, "Service", service)
 	return nil
 }
 
-// updateLoadBalancerService updates a load balancer service.  Returns a Boolean
-// indicating whether the service was updated, and an error value.
-func (r *reconciler) updateLoadBalancerService(current, desired *corev1.Service, platform *configv1.PlatformStatus, deleteIfScopeChanged bool) (bool, error) {
+// updateLoadBalancerService updates a load balancer service of the given
+// ingresscontroller.  Returns a Boolean indicating whether the service was
+// updated, and an error value.
+func (r *reconciler) updateLoadBalancerService(ci *operatorv1.IngressController, current, desired *corev1.Service, platform *configv1.PlatformStatus, deleteIfScopeChanged bool) (bool, error) {
 	_, platformHasMutableScope := platformsWithMutableScope[platform.Type]
 	if !platformHasMutableScope && deleteIfScopeChanged && !scopeEqual(current, desired, platform) {
 		log.Info("deleting and recreating the load balancer because its scope changed", "namespace", desired.Namespace, "name", desired.Name)
 		foreground := metav1.DeletePropagationForeground
 		deleteOptions := crclient.DeleteOptions{PropagationPolicy: &foreground}
-		if err := r.deleteLoadBalancerService(current, &deleteOptions); err != nil {
+		if err := r.deleteLoadBalancerService(ci, current, &deleteOptions); err != nil {
 			return false, err
 		}
-		if err := r.createLoadBalancerService(desired); err != nil {
+		if err := r.createLoadBalancerService(ci, desired); err != nil {
 			return false, err
 		}
 		return true, nil
@@ -571,10 +578,12 @@ func (r *reconciler) updateLoadBalancerService(current, desired *corev1.Service,
 	}
 	// Diff before updating because the client may mutate the object.
 	diff := cmp.Diff(current, updated, cmpopts.EquateEmpty())
+	fieldDiff := controller.FieldDiff(current, updated)
 	if err := r.client.Update(context.TODO(), updated); err != nil {
 		return false, err
 	}
 	log.Info("updated load balancer service", "namespace", updated.Namespace, "name", updated.Name, "diff", diff)
+	controller.RecordOperandUpdated(r.recorder, ci, "Service", updated, fieldDiff)
 	return true, nil
 }
 
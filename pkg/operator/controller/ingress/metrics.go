@@ -31,13 +31,74 @@ var (
 		Help: "Report the number of active NLBs on AWS clusters.",
 	}, []string{"name"})
 
+	// routerRollouts counts the updates to each IngressController's
+	// router deployment that change its pod template and thus roll out
+	// new router pods.
+	routerRollouts = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "ingress_controller_router_rollouts_total",
+		Help: "Count the rollouts of the router deployment for ingress controllers.",
+	}, []string{"name"})
+
+	// progressingDuration observes how long each IngressController was
+	// Progressing, when it stops progressing.
+	progressingDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "ingress_controller_progressing_duration_seconds",
+		Help:    "Report how long ingress controllers were Progressing before they stopped progressing.",
+		Buckets: prometheus.ExponentialBuckets(15, 2, 10),
+	}, []string{"name"})
+
+	// loadBalancerProvisioningDuration observes the time from the
+	// creation of each IngressController's load balancer service until
+	// the load balancer is provisioned.
+	loadBalancerProvisioningDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "ingress_controller_load_balancer_provisioning_duration_seconds",
+		Help:    "Report the time from the creation of the load balancer service for ingress controllers until the load balancer is provisioned.",
+		Buckets: prometheus.ExponentialBuckets(5, 2, 10),
+	}, []string{"name"})
+
+	// reconcileErrors counts the errors in reconciling each
+	// IngressController by the step that failed.
+	reconcileErrors = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "ingress_controller_reconcile_errors_total",
+		Help: "Count the errors in reconciling ingress controllers by the step that failed.",
+	}, []string{"name", "step"})
+
 	// metricsList is a list of metrics for this package.
 	metricsList = []prometheus.Collector{
 		ingressControllerConditions,
 		activeNLBs,
+		routerRollouts,
+		progressingDuration,
+		loadBalancerProvisioningDuration,
+		reconcileErrors,
 	}
 )
 
+// reconcileStep is a step in reconciling an IngressController for which
+// errors are reported in the ingress_controller_reconcile_errors_total metric.
+type reconcileStep string
+
+const (
+	reconcileStepDeployment          reconcileStep = "deployment"
+	reconcileStepLoadBalancerService reconcileStep = "load_balancer_service"
+	reconcileStepDNS                 reconcileStep = "dns"
+	reconcileStepNodePortService     reconcileStep = "node_port_service"
+	reconcileStepInternalService     reconcileStep = "internal_service"
+	reconcileStepMonitoring          reconcileStep = "monitoring"
+	reconcileStepPDB                 reconcileStep = "pdb"
+)
+
+// reconcileSteps is the list of all reconcile steps.
+var reconcileSteps = []reconcileStep{
+	reconcileStepDeployment,
+	reconcileStepLoadBalancerService,
+	reconcileStepDNS,
+	reconcileStepNodePortService,
+	reconcileStepInternalService,
+	reconcileStepMonitoring,
+	reconcileStepPDB,
+}
+
 // reportedConditions is the set of ingresscontroller status conditions that are
 // reported in the ingress_controller_conditions metric.
 var reportedConditions = sets.NewString("Available", "Degraded")
@@ -78,6 +139,61 @@ func DeleteActiveNLBMetrics(ic *operatorv1.IngressController) {
 	activeNLBs.DeleteLabelValues(ic.Name)
 }
 
+// reportReconcileError increments the ingress_controller_reconcile_errors_total
+// metric for the given IngressController and step.
+func reportReconcileError(ic *operatorv1.IngressController, step reconcileStep) {
+	reconcileErrors.WithLabelValues(ic.Name, string(step)).Inc()
+}
+
+// reportRouterRollout increments the ingress_controller_router_rollouts_total
+// metric for the given IngressController.
+func reportRouterRollout(ic *operatorv1.IngressController) {
+	routerRollouts.WithLabelValues(ic.Name).Inc()
+}
+
+// observeStatusTransitions observes the durations of the Progressing status
+// and of load balancer provisioning for an IngressController whose status is
+// updated from old to updated.  The given service is the IngressController's
+// load balancer service, if any.
+func observeStatusTransitions(old, updated *operatorv1.IngressController, service *corev1.Service) {
+	oldProgressing := findOperatorCondition(old.Status.Conditions, operatorv1.OperatorStatusTypeProgressing)
+	newProgressing := findOperatorCondition(updated.Status.Conditions, operatorv1.OperatorStatusTypeProgressing)
+	if oldProgressing != nil && newProgressing != nil && oldProgressing.Status == operatorv1.ConditionTrue && newProgressing.Status == operatorv1.ConditionFalse {
+		duration := newProgressing.LastTransitionTime.Sub(oldProgressing.LastTransitionTime.Time)
+		progressingDuration.WithLabelValues(updated.Name).Observe(duration.Seconds())
+	}
+
+	oldReady := findOperatorCondition(old.Status.Conditions, operatorv1.LoadBalancerReadyIngressConditionType)
+	newReady := findOperatorCondition(updated.Status.Conditions, operatorv1.LoadBalancerReadyIngressConditionType)
+	if service != nil && newReady != nil && newReady.Status == operatorv1.ConditionTrue && (oldReady == nil || oldReady.Status != operatorv1.ConditionTrue) {
+		duration := newReady.LastTransitionTime.Sub(service.CreationTimestamp.Time)
+		loadBalancerProvisioningDuration.WithLabelValues(updated.Name).Observe(duration.Seconds())
+	}
+}
+
+// findOperatorCondition returns the condition of the given type, or nil if there is
+// none.
+func findOperatorCondition(conditions []operatorv1.OperatorCondition, conditionType string) *operatorv1.OperatorCondition {
+	for i := range conditions {
+		if conditions[i].Type == conditionType {
+			return &conditions[i]
+		}
+	}
+	return nil
+}
+
+// DeleteIngressControllerReconcileMetrics deletes the rollout, Progressing,
+// load balancer provisioning, and reconcile error metrics of the given
+// ingresscontroller.
+func DeleteIngressControllerReconcileMetrics(ic *operatorv1.IngressController) {
+	routerRollouts.DeleteLabelValues(ic.Name)
+	progressingDuration.DeleteLabelValues(ic.Name)
+	loadBalancerProvisioningDuration.DeleteLabelValues(ic.Name)
+	for _, step := range reconcileSteps {
+		reconcileErrors.DeleteLabelValues(ic.Name, string(step))
+	}
+}
+
 func SetIngressControllerNLBMetric(ci *operatorv1.IngressController) {
 	labelVal := 0
 	if ci.Status.EndpointPublishingStrategy != nil &&
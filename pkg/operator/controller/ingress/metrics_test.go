@@ -3,9 +3,11 @@ package ingress
 import (
 	"strings"
 	"testing"
+	"time"
 
 	operatorv1 "github.com/openshift/api/operator/v1"
 	"github.com/prometheus/client_golang/prometheus/testutil"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -209,6 +211,177 @@ func TestIngressControllerNLBMetric(t *testing.T) {
 	}
 }
 
+func TestIngressControllerReconcileMetrics(t *testing.T) {
+	routerRollouts.Reset()
+	reconcileErrors.Reset()
+
+	test1 := testIngressControllerWithConditions("test1", nil)
+	test2 := testIngressControllerWithConditions("test2", nil)
+	reportRouterRollout(test1)
+	reportRouterRollout(test1)
+	reportRouterRollout(test2)
+	reportReconcileError(test1, reconcileStepDNS)
+	reportReconcileError(test2, reconcileStepDeployment)
+	reportReconcileError(test2, reconcileStepDeployment)
+
+	expectedRollouts := `
+	# HELP ingress_controller_router_rollouts_total Count the rollouts of the router deployment for ingress controllers.
+	# TYPE ingress_controller_router_rollouts_total counter
+	ingress_controller_router_rollouts_total{name="test1"} 2
+	ingress_controller_router_rollouts_total{name="test2"} 1
+	`
+	if err := testutil.CollectAndCompare(routerRollouts, strings.NewReader(expectedRollouts)); err != nil {
+		t.Error(err)
+	}
+	expectedErrors := `
+	# HELP ingress_controller_reconcile_errors_total Count the errors in reconciling ingress controllers by the step that failed.
+	# TYPE ingress_controller_reconcile_errors_total counter
+	ingress_controller_reconcile_errors_total{name="test1",step="dns"} 1
+	ingress_controller_reconcile_errors_total{name="test2",step="deployment"} 2
+	`
+	if err := testutil.CollectAndCompare(reconcileErrors, strings.NewReader(expectedErrors)); err != nil {
+		t.Error(err)
+	}
+
+	DeleteIngressControllerReconcileMetrics(test1)
+
+	expectedRollouts = `
+	# HELP ingress_controller_router_rollouts_total Count the rollouts of the router deployment for ingress controllers.
+	# TYPE ingress_controller_router_rollouts_total counter
+	ingress_controller_router_rollouts_total{name="test2"} 1
+	`
+	if err := testutil.CollectAndCompare(routerRollouts, strings.NewReader(expectedRollouts)); err != nil {
+		t.Error(err)
+	}
+	expectedErrors = `
+	# HELP ingress_controller_reconcile_errors_total Count the errors in reconciling ingress controllers by the step that failed.
+	# TYPE ingress_controller_reconcile_errors_total counter
+	ingress_controller_reconcile_errors_total{name="test2",step="deployment"} 2
+	`
+	if err := testutil.CollectAndCompare(reconcileErrors, strings.NewReader(expectedErrors)); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestObserveStatusTransitions(t *testing.T) {
+	start := metav1.NewTime(time.Date(2022, time.January, 1, 0, 0, 0, 0, time.UTC))
+	later := metav1.NewTime(start.Add(90 * time.Second))
+	service := &corev1.Service{ObjectMeta: metav1.ObjectMeta{CreationTimestamp: start}}
+	condition := func(conditionType string, status operatorv1.ConditionStatus, t metav1.Time) operatorv1.OperatorCondition {
+		return operatorv1.OperatorCondition{Type: conditionType, Status: status, LastTransitionTime: t}
+	}
+	progressing := operatorv1.OperatorStatusTypeProgressing
+	lbReady := operatorv1.LoadBalancerReadyIngressConditionType
+
+	testCases := []struct {
+		name                     string
+		old, updated             []operatorv1.OperatorCondition
+		service                  *corev1.Service
+		expectProgressing        bool
+		expectLoadBalancerStatus bool
+	}{
+		{
+			name:              "progressing becomes false",
+			old:               []operatorv1.OperatorCondition{condition(progressing, operatorv1.ConditionTrue, start)},
+			updated:           []operatorv1.OperatorCondition{condition(progressing, operatorv1.ConditionFalse, later)},
+			expectProgressing: true,
+		},
+		{
+			name:    "progressing stays true",
+			old:     []operatorv1.OperatorCondition{condition(progressing, operatorv1.ConditionTrue, start)},
+			updated: []operatorv1.OperatorCondition{condition(progressing, operatorv1.ConditionTrue, start)},
+		},
+		{
+			name:    "progressing becomes true",
+			old:     []operatorv1.OperatorCondition{condition(progressing, operatorv1.ConditionFalse, start)},
+			updated: []operatorv1.OperatorCondition{condition(progressing, operatorv1.ConditionTrue, later)},
+		},
+		{
+			name:                     "load balancer becomes ready",
+			old:                      []operatorv1.OperatorCondition{condition(lbReady, operatorv1.ConditionFalse, start)},
+			updated:                  []operatorv1.OperatorCondition{condition(lbReady, operatorv1.ConditionTrue, later)},
+			service:                  service,
+			expectLoadBalancerStatus: true,
+		},
+		{
+			name:                     "load balancer is ready on first status update",
+			updated:                  []operatorv1.OperatorCondition{condition(lbReady, operatorv1.ConditionTrue, later)},
+			service:                  service,
+			expectLoadBalancerStatus: true,
+		},
+		{
+			name:    "load balancer stays ready",
+			old:     []operatorv1.OperatorCondition{condition(lbReady, operatorv1.ConditionTrue, start)},
+			updated: []operatorv1.OperatorCondition{condition(lbReady, operatorv1.ConditionTrue, start)},
+			service: service,
+		},
+		{
+			name:    "load balancer becomes ready without a service",
+			old:     []operatorv1.OperatorCondition{condition(lbReady, operatorv1.ConditionFalse, start)},
+			updated: []operatorv1.OperatorCondition{condition(lbReady, operatorv1.ConditionTrue, later)},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			progressingDuration.Reset()
+			loadBalancerProvisioningDuration.Reset()
+
+			old := testIngressControllerWithConditions("test1", tc.old)
+			updated := testIngressControllerWithConditions("test1", tc.updated)
+			observeStatusTransitions(old, updated, tc.service)
+
+			expected := `
+			# HELP ingress_controller_progressing_duration_seconds Report how long ingress controllers were Progressing before they stopped progressing.
+			# TYPE ingress_controller_progressing_duration_seconds histogram
+			ingress_controller_progressing_duration_seconds_bucket{name="test1",le="15"} 0
+			ingress_controller_progressing_duration_seconds_bucket{name="test1",le="30"} 0
+			ingress_controller_progressing_duration_seconds_bucket{name="test1",le="60"} 0
+			ingress_controller_progressing_duration_seconds_bucket{name="test1",le="120"} 1
+			ingress_controller_progressing_duration_seconds_bucket{name="test1",le="240"} 1
+			ingress_controller_progressing_duration_seconds_bucket{name="test1",le="480"} 1
+			ingress_controller_progressing_duration_seconds_bucket{name="test1",le="960"} 1
+			ingress_controller_progressing_duration_seconds_bucket{name="test1",le="1920"} 1
+			ingress_controller_progressing_duration_seconds_bucket{name="test1",le="3840"} 1
+			ingress_controller_progressing_duration_seconds_bucket{name="test1",le="7680"} 1
+			ingress_controller_progressing_duration_seconds_bucket{name="test1",le="+Inf"} 1
+			ingress_controller_progressing_duration_seconds_sum{name="test1"} 90
+			ingress_controller_progressing_duration_seconds_count{name="test1"} 1
+			`
+			if !tc.expectProgressing {
+				expected = ""
+			}
+			if err := testutil.CollectAndCompare(progressingDuration, strings.NewReader(expected)); err != nil {
+				t.Error(err)
+			}
+
+			expected = `
+			# HELP ingress_controller_load_balancer_provisioning_duration_seconds Report the time from the creation of the load balancer service for ingress controllers until the load balancer is provisioned.
+			# TYPE ingress_controller_load_balancer_provisioning_duration_seconds histogram
+			ingress_controller_load_balancer_provisioning_duration_seconds_bucket{name="test1",le="5"} 0
+			ingress_controller_load_balancer_provisioning_duration_seconds_bucket{name="test1",le="10"} 0
+			ingress_controller_load_balancer_provisioning_duration_seconds_bucket{name="test1",le="20"} 0
+			ingress_controller_load_balancer_provisioning_duration_seconds_bucket{name="test1",le="40"} 0
+			ingress_controller_load_balancer_provisioning_duration_seconds_bucket{name="test1",le="80"} 0
+			ingress_controller_load_balancer_provisioning_duration_seconds_bucket{name="test1",le="160"} 1
+			ingress_controller_load_balancer_provisioning_duration_seconds_bucket{name="test1",le="320"} 1
+			ingress_controller_load_balancer_provisioning_duration_seconds_bucket{name="test1",le="640"} 1
+			ingress_controller_load_balancer_provisioning_duration_seconds_bucket{name="test1",le="1280"} 1
+			ingress_controller_load_balancer_provisioning_duration_seconds_bucket{name="test1",le="2560"} 1
+			ingress_controller_load_balancer_provisioning_duration_seconds_bucket{name="test1",le="+Inf"} 1
+			ingress_controller_load_balancer_provisioning_duration_seconds_sum{name="test1"} 90
+			ingress_controller_load_balancer_provisioning_duration_seconds_count{name="test1"} 1
+			`
+			if !tc.expectLoadBalancerStatus {
+				expected = ""
+			}
+			if err := testutil.CollectAndCompare(loadBalancerProvisioningDuration, strings.NewReader(expected)); err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
+
 func testIngressControllerWithConditions(name string, conditions []operatorv1.OperatorCondition) *operatorv1.IngressController {
 	return &operatorv1.IngressController{
 		ObjectMeta: metav1.ObjectMeta{
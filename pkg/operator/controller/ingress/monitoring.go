@@ -39,9 +39,10 @@ func (r *reconciler) ensureServiceMonitor(ic *operatorv1.IngressController, svc
 			return false, nil, fmt.Errorf("failed to create servicemonitor %s/%s: %v", desired.GetNamespace(), desired.GetName(), err)
 		}
 		log.Info("created servicemonitor", "namespace", desired.GetNamespace(), "name", desired.GetName())
+		controller.RecordOperandCreated(r.recorder, ic, "ServiceMonitor", desired)
 		return r.currentServiceMonitor(ic)
 	case haveSM:
-		if updated, err := r.updateServiceMonitor(current, desired); err != nil {
+		if updated, err := r.updateServiceMonitor(ic, current, desired); err != nil {
 			return true, current, fmt.Errorf("failed to update servicemonitor %s/%s: %v", desired.GetNamespace(), desired.GetName(), err)
 		} else if updated {
 			return r.currentServiceMonitor(ic)
@@ -127,7 +128,7 @@ func (r *reconciler) currentServiceMonitor(ic *operatorv1.IngressController) (bo
 
 // updateServiceMonitor updates a servicemonitor.  Returns a Boolean indicating
 // whether the servicemonitor was updated, and an error value.
-func (r *reconciler) updateServiceMonitor(current, desired *unstructured.Unstructured) (bool, error) {
+func (r *reconciler) updateServiceMonitor(ic *operatorv1.IngressController, current, desired *unstructured.Unstructured) (bool, error) {
 	changed, updated := serviceMonitorChanged(current, desired)
 	if !changed {
 		return false, nil
@@ -135,10 +136,12 @@ func (r *reconciler) updateServiceMonitor(current, desired *unstructured.Unstruc
 
 	// Diff before updating because the client may mutate the object.
 	diff := cmp.Diff(current, updated, cmpopts.EquateEmpty())
+	fieldDiff := controller.FieldDiff(current, updated)
 	if err := r.client.Update(context.TODO(), updated); err != nil {
 		return false, err
 	}
 	log.Info("updated servicemonitor", "namespace", updated.GetNamespace(), "name", updated.GetName(), "diff", diff)
+	controller.RecordOperandUpdated(r.recorder, ic, "ServiceMonitor", updated, fieldDiff)
 	return true, nil
 }
 
@@ -30,24 +30,7 @@ func (r *reconciler) ensureNodePortService(ic *operatorv1.IngressController, dep
 		return false, nil, err
 	}
 
-	// For compatibility, omit the "metrics" port iff the service already
-	// exists and doesn't have a "metrics" port.  This serves two purposes:
-	// (1) It avoids exhausting the nodeport range on upgrades if the
-	// cluster has many nodeport services and few available nodeports.
-	// (2) It enables the cluster administrator to remove the metrics port
-	// from an existing nodeport service to avoid exposing the port.
-	wantMetricsPort := false
-	if !haveService {
-		wantMetricsPort = true
-	} else {
-		for _, port := range current.Spec.Ports {
-			if port.Name == "metrics" {
-				wantMetricsPort = true
-			}
-		}
-	}
-
-	wantService, desired, err := desiredNodePortService(ic, deploymentRef, wantMetricsPort)
+	wantService, desired, err := desiredNodePortService(ic, deploymentRef, nodePortServiceWantsMetricsPort(current))
 	if err != nil {
 		return false, nil, err
 	}
@@ -68,6 +51,7 @@ func (r *reconciler) ensureNodePortService(ic *operatorv1.IngressController, dep
 			}
 		} else {
 			log.Info("deleted NodePort service", "service", current)
+			controller.RecordOperandDeleted(r.recorder, ic, "Service", current)
 		}
 		return false, nil, nil
 	case wantService && !haveService:
@@ -75,12 +59,13 @@ func (r *reconciler) ensureNodePortService(ic *operatorv1.IngressController, dep
 			return false, nil, fmt.Errorf("failed to create NodePort service: %v", err)
 		}
 		log.Info("created NodePort service", "service", desired)
+		controller.RecordOperandCreated(r.recorder, ic, "Service", desired)
 		return r.currentNodePortService(ic)
 	case wantService && haveService:
 		if !ownLBS {
 			return false, nil, fmt.Errorf("a conflicting nodeport service exists that is not owned by the ingress controller: %s", current.Name)
 		}
-		if updated, err := r.updateNodePortService(current, desired); err != nil {
+		if updated, err := r.updateNodePortService(ic, current, desired); err != nil {
 			return true, current, fmt.Errorf("failed to update NodePort service: %v", err)
 		} else if updated {
 			return r.currentNodePortService(ic)
@@ -163,9 +148,29 @@ func (r *reconciler) currentNodePortService(ic *operatorv1.IngressController) (b
 	return true, service, nil
 }
 
+// nodePortServiceWantsMetricsPort returns a Boolean value indicating whether
+// the NodePort service should have a "metrics" port, given the current service
+// or nil if there is none.  For compatibility, the "metrics" port is omitted
+// iff the service already exists and doesn't have a "metrics" port.  This
+// serves two purposes: (1) It avoids exhausting the nodeport range on upgrades
+// if the cluster has many nodeport services and few available nodeports.  (2)
+// It enables the cluster administrator to remove the metrics port from an
+// existing nodeport service to avoid exposing the port.
+func nodePortServiceWantsMetricsPort(current *corev1.Service) bool {
+	if current == nil {
+		return true
+	}
+	for _, port := range current.Spec.Ports {
+		if port.Name == "metrics" {
+			return true
+		}
+	}
+	return false
+}
+
 // updateNodePortService updates a NodePort service.  Returns a Boolean
 // indicating whether the service was updated, and an error value.
-func (r *reconciler) updateNodePortService(current, desired *corev1.Service) (bool, error) {
+func (r *reconciler) updateNodePortService(ic *operatorv1.IngressController, current, desired *corev1.Service) (bool, error) {
 	changed, updated := nodePortServiceChanged(current, desired)
 	if !changed {
 		return false, nil
@@ -173,10 +178,12 @@ func (r *reconciler) updateNodePortService(current, desired *corev1.Service) (bo
 
 	// Diff before updating because the client may mutate the object.
 	diff := cmp.Diff(current, updated, cmpopts.EquateEmpty())
+	fieldDiff := controller.FieldDiff(current, updated)
 	if err := r.client.Update(context.TODO(), updated); err != nil {
 		return false, err
 	}
 	log.Info("updated NodePort service", "namespace", updated.Namespace, "name", updated.Name, "diff", diff)
+	controller.RecordOperandUpdated(r.recorder, ic, "Service", updated, fieldDiff)
 	return true, nil
 }
 
@@ -41,6 +41,7 @@ func (r *reconciler) ensureRouterPodDisruptionBudget(ic *operatorv1.IngressContr
 			}
 		} else {
 			log.Info("deleted pod disruption budget", "poddisruptionbudget", current)
+			controller.RecordOperandDeleted(r.recorder, ic, "PodDisruptionBudget", current)
 		}
 		return false, nil, nil
 	case wantPDB && !havePDB:
@@ -48,9 +49,10 @@ func (r *reconciler) ensureRouterPodDisruptionBudget(ic *operatorv1.IngressContr
 			return false, nil, fmt.Errorf("failed to create pod disruption budget: %v", err)
 		}
 		log.Info("created pod disruption budget", "poddisruptionbudget", desired)
+		controller.RecordOperandCreated(r.recorder, ic, "PodDisruptionBudget", desired)
 		return r.currentRouterPodDisruptionBudget(ic)
 	case wantPDB && havePDB:
-		if updated, err := r.updateRouterPodDisruptionBudget(current, desired); err != nil {
+		if updated, err := r.updateRouterPodDisruptionBudget(ic, current, desired); err != nil {
 			return true, current, fmt.Errorf("failed to update pod disruption budget: %v", err)
 		} else if updated {
 			return r.currentRouterPodDisruptionBudget(ic)
@@ -108,7 +110,7 @@ func (r *reconciler) currentRouterPodDisruptionBudget(ic *operatorv1.IngressCont
 
 // updateRouterPodDisruptionBudget updates a pod disruption budget.  Returns a
 // Boolean indicating whether the PDB was updated, and an error value.
-func (r *reconciler) updateRouterPodDisruptionBudget(current, desired *policyv1.PodDisruptionBudget) (bool, error) {
+func (r *reconciler) updateRouterPodDisruptionBudget(ic *operatorv1.IngressController, current, desired *policyv1.PodDisruptionBudget) (bool, error) {
 	changed, updated := podDisruptionBudgetChanged(current, desired)
 	if !changed {
 		return false, nil
@@ -116,10 +118,12 @@ func (r *reconciler) updateRouterPodDisruptionBudget(current, desired *policyv1.
 
 	// Diff before updating because the client may mutate the object.
 	diff := cmp.Diff(current, updated, cmpopts.EquateEmpty())
+	fieldDiff := controller.FieldDiff(current, updated)
 	if err := r.client.Update(context.TODO(), updated); err != nil {
 		return false, err
 	}
 	log.Info("updated pod disruption budget", "namespace", updated.Namespace, "name", updated.Name, "diff", diff)
+	controller.RecordOperandUpdated(r.recorder, ic, "PodDisruptionBudget", updated, fieldDiff)
 	return true, nil
 }
 
@@ -50,6 +50,7 @@ func (r *reconciler) ensureRsyslogConfigMap(ic *operatorv1.IngressController, de
 			}
 		} else {
 			log.Info("deleted configmap", "configmap", current)
+			controller.RecordOperandDeleted(r.recorder, ic, "ConfigMap", current)
 		}
 		return false, nil, nil
 	case wantCM && !haveCM:
@@ -57,9 +58,10 @@ func (r *reconciler) ensureRsyslogConfigMap(ic *operatorv1.IngressController, de
 			return false, nil, fmt.Errorf("failed to create configmap: %v", err)
 		}
 		log.Info("created configmap", "configmap", desired)
+		controller.RecordOperandCreated(r.recorder, ic, "ConfigMap", desired)
 		return r.currentRsyslogConfigMap(ic)
 	case wantCM && haveCM:
-		if updated, err := r.updateRsyslogConfigMap(current, desired); err != nil {
+		if updated, err := r.updateRsyslogConfigMap(ic, current, desired); err != nil {
 			return true, current, fmt.Errorf("failed to update configmap: %v", err)
 		} else if updated {
 			return r.currentRsyslogConfigMap(ic)
@@ -109,7 +111,7 @@ func (r *reconciler) currentRsyslogConfigMap(ic *operatorv1.IngressController) (
 
 // updateRsyslogConfigMap updates a configmap.  Returns a Boolean indicating
 // whether the configmap was updated, and an error value.
-func (r *reconciler) updateRsyslogConfigMap(current, desired *corev1.ConfigMap) (bool, error) {
+func (r *reconciler) updateRsyslogConfigMap(ic *operatorv1.IngressController, current, desired *corev1.ConfigMap) (bool, error) {
 	if rsyslogConfigmapsEqual(current, desired) {
 		return false, nil
 	}
@@ -117,6 +119,7 @@ func (r *reconciler) updateRsyslogConfigMap(current, desired *corev1.ConfigMap)
 	updated.Data = desired.Data
 	// Diff before updating because the client may mutate the object.
 	diff := cmp.Diff(current, updated, cmpopts.EquateEmpty())
+	fieldDiff := controller.FieldDiff(current, updated)
 	if err := r.client.Update(context.TODO(), updated); err != nil {
 		if errors.IsAlreadyExists(err) {
 			return false, nil
@@ -124,6 +127,7 @@ func (r *reconciler) updateRsyslogConfigMap(current, desired *corev1.ConfigMap)
 		return false, err
 	}
 	log.Info("updated configmap", "namespace", updated.Namespace, "name", updated.Name, "diff", diff)
+	controller.RecordOperandUpdated(r.recorder, ic, "ConfigMap", updated, fieldDiff)
 	return true, nil
 }
 
@@ -60,8 +60,16 @@ func (r *reconciler) syncIngressControllerStatus(ic *operatorv1.IngressControlle
 
 	secret := &corev1.Secret{}
 	secretName := controller.RouterEffectiveDefaultCertificateSecretName(ic, deployment.Namespace)
-	if err := r.client.Get(context.TODO(), secretName, secret); err != nil && !apierrors.IsNotFound(err) {
-		return fmt.Errorf("failed to get the default certificate secret %s for ingresscontroller %s/%s: %w", secretName, ic.Namespace, ic.Name, err), updatedIc
+	if err := r.client.Get(context.TODO(), secretName, secret); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return fmt.Errorf("failed to get the default certificate secret %s for ingresscontroller %s/%s: %w", secretName, ic.Namespace, ic.Name, err), updatedIc
+		}
+		secret = nil
+	}
+
+	roots, err := systemCertPool()
+	if err != nil {
+		log.Error(err, "failed to load the system trust bundle; default certificate chains are verified against no roots", "ingresscontroller", ic.Name)
 	}
 
 	var errs []error
@@ -75,22 +83,9 @@ func (r *reconciler) syncIngressControllerStatus(ic *operatorv1.IngressControlle
 		updated.Status.EndpointPublishingStrategy.LoadBalancer.AllowedSourceRanges = computeAllowedSourceRanges(service)
 	}
 
-	updated.Status.Conditions = MergeConditions(updated.Status.Conditions, computeDeploymentAvailableCondition(deployment))
-	updated.Status.Conditions = MergeConditions(updated.Status.Conditions, computeDeploymentReplicasMinAvailableCondition(deployment, pods))
-	updated.Status.Conditions = MergeConditions(updated.Status.Conditions, computeDeploymentReplicasAllAvailableCondition(deployment))
-	updated.Status.Conditions = MergeConditions(updated.Status.Conditions, computeDeploymentRollingOutCondition(deployment))
-	updated.Status.Conditions = MergeConditions(updated.Status.Conditions, computeLoadBalancerStatus(ic, service, operandEvents)...)
-	updated.Status.Conditions = MergeConditions(updated.Status.Conditions, computeLoadBalancerProgressingStatus(ic, service, platformStatus))
-	updated.Status.Conditions = MergeConditions(updated.Status.Conditions, computeDNSStatus(ic, wildcardRecord, platformStatus, dnsConfig)...)
-	updated.Status.Conditions = MergeConditions(updated.Status.Conditions, computeIngressAvailableCondition(updated.Status.Conditions))
-	degradedCondition, err := computeIngressDegradedCondition(updated.Status.Conditions, updated.Name)
+	conditions, err := computeIngressControllerConditions(ic, deployment, deploymentRef, pods, service, operandEvents, wildcardRecord, dnsConfig, platformStatus, secret, roots)
 	errs = append(errs, err)
-	updated.Status.Conditions = MergeConditions(updated.Status.Conditions, computeIngressProgressingCondition(updated.Status.Conditions))
-	updated.Status.Conditions = MergeConditions(updated.Status.Conditions, degradedCondition)
-	updated.Status.Conditions = MergeConditions(updated.Status.Conditions, computeIngressUpgradeableCondition(ic, deploymentRef, service, platformStatus, secret))
-	updated.Status.Conditions = MergeConditions(updated.Status.Conditions, computeIngressEvaluationConditionsDetectedCondition(ic, service))
-
-	updated.Status.Conditions = PruneConditions(updated.Status.Conditions)
+	updated.Status.Conditions = conditions
 
 	if !IngressStatusesEqual(updated.Status, ic.Status) {
 		if err := r.client.Status().Update(context.TODO(), updated); err != nil {
@@ -98,12 +93,42 @@ func (r *reconciler) syncIngressControllerStatus(ic *operatorv1.IngressControlle
 		} else {
 			updatedIc = true
 			SetIngressControllerConditionsMetric(updated)
+			observeStatusTransitions(ic, updated, service)
 		}
 	}
 
 	return retryableerror.NewMaybeRetryableAggregate(errs), updatedIc
 }
 
+// computeIngressControllerConditions computes the status conditions of the
+// given ingresscontroller from the state of its operands, starting from its
+// current status conditions.  The returned error value is the one from
+// computeIngressDegradedCondition and indicates whether the ingresscontroller
+// is, or may soon become, degraded.  The default certificate secret is nil if
+// it does not exist, and its certificate chain is verified against the given
+// roots.
+func computeIngressControllerConditions(ic *operatorv1.IngressController, deployment *appsv1.Deployment, deploymentRef metav1.OwnerReference, pods []corev1.Pod, service *corev1.Service, operandEvents []corev1.Event, wildcardRecord *iov1.DNSRecord, dnsConfig *configv1.DNS, platformStatus *configv1.PlatformStatus, secret *corev1.Secret, roots *x509.CertPool) ([]operatorv1.OperatorCondition, error) {
+	conditions := make([]operatorv1.OperatorCondition, len(ic.Status.Conditions))
+	copy(conditions, ic.Status.Conditions)
+
+	conditions = MergeConditions(conditions, computeDeploymentAvailableCondition(deployment))
+	conditions = MergeConditions(conditions, computeDeploymentReplicasMinAvailableCondition(deployment, pods))
+	conditions = MergeConditions(conditions, computeDeploymentReplicasAllAvailableCondition(deployment))
+	conditions = MergeConditions(conditions, computeDeploymentRollingOutCondition(deployment))
+	conditions = MergeConditions(conditions, computeLoadBalancerStatus(ic, service, operandEvents)...)
+	conditions = MergeConditions(conditions, computeLoadBalancerProgressingStatus(ic, service, platformStatus))
+	conditions = MergeConditions(conditions, computeDNSStatus(ic, wildcardRecord, platformStatus, dnsConfig)...)
+	conditions = MergeConditions(conditions, computeIngressAvailableCondition(conditions))
+	degradedCondition, err := computeIngressDegradedCondition(conditions, ic.Name)
+	conditions = MergeConditions(conditions, computeIngressProgressingCondition(conditions))
+	conditions = MergeConditions(conditions, degradedCondition)
+	conditions = MergeConditions(conditions, computeIngressUpgradeableCondition(ic, deploymentRef, service, platformStatus, secret))
+	conditions = MergeConditions(conditions, computeIngressEvaluationConditionsDetectedCondition(ic, service))
+	conditions = MergeConditions(conditions, computeDefaultCertificateValidCondition(ic, deployment, secret, computeIngressTLSProfile(ic.Status.TLSProfile, deployment), roots, clock.Now()))
+
+	return PruneConditions(conditions), err
+}
+
 // syncIngressControllerSelectorStatus syncs the routeSelector and namespaceSelector
 // from the spec to the status for tracking selector state.
 func (r *reconciler) syncIngressControllerSelectorStatus(ic *operatorv1.IngressController) error {
@@ -699,6 +724,9 @@ func computeIngressEvaluationConditionsDetectedCondition(ic *operatorv1.IngressC
 // without SANs.  Note that this function only checks the validity of the
 // certificate insofar as it affects upgrades.
 func checkDefaultCertificate(secret *corev1.Secret, domain string) error {
+	if secret == nil {
+		return nil
+	}
 	var certData []byte
 	if v, ok := secret.Data["tls.crt"]; !ok {
 		return nil
@@ -968,7 +996,7 @@ func computeDNSStatus(ic *operatorv1.IngressController, wildcardRecord *iov1.DNS
 		}
 	}
 	var conditions []operatorv1.OperatorCondition
-	if ic.Status.EndpointPublishingStrategy.LoadBalancer.DNSManagementPolicy == operatorv1.UnmanagedLoadBalancerDNS {
+	if lb := ic.Status.EndpointPublishingStrategy.LoadBalancer; lb != nil && lb.DNSManagementPolicy == operatorv1.UnmanagedLoadBalancerDNS {
 		conditions = append(conditions, operatorv1.OperatorCondition{
 			Type:    operatorv1.DNSManagedIngressConditionType,
 			Status:  operatorv1.ConditionFalse,
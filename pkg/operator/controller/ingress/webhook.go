@@ -0,0 +1,97 @@
+package ingress
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+
+	operatorcontroller "github.com/openshift/cluster-ingress-operator/pkg/operator/controller"
+
+	configv1 "github.com/openshift/api/config/v1"
+	operatorv1 "github.com/openshift/api/operator/v1"
+
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+// ValidatingWebhookPath is the path on which the operator's webhook server
+// serves the validating admission webhook for ingresscontrollers.  It must
+// match the path in the operator's ValidatingWebhookConfiguration manifest.
+const ValidatingWebhookPath = "/validate-operator-openshift-io-v1-ingresscontroller"
+
+// NewValidatingWebhook returns a validating admission webhook that rejects
+// ingresscontrollers in the given namespace that the reconciler would not
+// admit.  The reconciler still validates ingresscontrollers, so
+// ingresscontrollers that are created while the webhook is unavailable are
+// rejected when they are reconciled.
+func NewValidatingWebhook(reader client.Reader, namespace string) *admission.Webhook {
+	return admission.WithCustomValidator(&operatorv1.IngressController{}, &ingressControllerValidator{
+		reader:    reader,
+		namespace: namespace,
+	})
+}
+
+// ingressControllerValidator validates ingresscontrollers on behalf of the
+// validating admission webhook.
+type ingressControllerValidator struct {
+	reader    client.Reader
+	namespace string
+}
+
+// ValidateCreate validates a new ingresscontroller.
+func (v *ingressControllerValidator) ValidateCreate(ctx context.Context, obj runtime.Object) error {
+	ic, ok := obj.(*operatorv1.IngressController)
+	if !ok {
+		return fmt.Errorf("expected an ingresscontroller, got %T", obj)
+	}
+	return v.validate(ctx, ic)
+}
+
+// ValidateUpdate validates an updated ingresscontroller.  Updates that do
+// not change the spec, such as the operator's own updates of finalizers, and
+// updates of ingresscontrollers that are being deleted are always allowed so
+// that an invalid ingresscontroller can still be finalized.
+func (v *ingressControllerValidator) ValidateUpdate(ctx context.Context, oldObj, newObj runtime.Object) error {
+	old, ok := oldObj.(*operatorv1.IngressController)
+	if !ok {
+		return fmt.Errorf("expected an ingresscontroller, got %T", oldObj)
+	}
+	ic, ok := newObj.(*operatorv1.IngressController)
+	if !ok {
+		return fmt.Errorf("expected an ingresscontroller, got %T", newObj)
+	}
+	if ic.DeletionTimestamp != nil || reflect.DeepEqual(old.Spec, ic.Spec) {
+		return nil
+	}
+	return v.validate(ctx, ic)
+}
+
+// ValidateDelete allows the deletion of any ingresscontroller.
+func (v *ingressControllerValidator) ValidateDelete(ctx context.Context, obj runtime.Object) error {
+	return nil
+}
+
+// validate defaults the given ingresscontroller's domain the same way that
+// the reconciler does when it admits the ingresscontroller and then validates
+// the ingresscontroller against the existing ones.
+func (v *ingressControllerValidator) validate(ctx context.Context, ic *operatorv1.IngressController) error {
+	// The operator ignores ingresscontrollers in other namespaces.
+	if ic.Namespace != v.namespace {
+		return nil
+	}
+
+	ingressConfig := &configv1.Ingress{}
+	if err := v.reader.Get(ctx, operatorcontroller.IngressClusterConfigName(), ingressConfig); err != nil {
+		return fmt.Errorf("failed to get ingress 'cluster': %w", err)
+	}
+	ingresses := &operatorv1.IngressControllerList{}
+	if err := v.reader.List(ctx, ingresses, client.InNamespace(v.namespace)); err != nil {
+		return fmt.Errorf("failed to list ingresscontrollers: %w", err)
+	}
+
+	defaulted := ic.DeepCopy()
+	setDefaultDomain(defaulted, ingressConfig)
+	return validateIngressController(defaulted, ingresses.Items)
+}
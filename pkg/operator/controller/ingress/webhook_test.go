@@ -0,0 +1,128 @@
+package ingress
+
+import (
+	"context"
+	"testing"
+
+	operatorclient "github.com/openshift/cluster-ingress-operator/pkg/operator/client"
+
+	configv1 "github.com/openshift/api/config/v1"
+	operatorv1 "github.com/openshift/api/operator/v1"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+// TestIngressControllerValidator verifies that the validating admission
+// webhook rejects invalid ingresscontrollers and allows valid ones as well as
+// updates that do not change the spec.
+func TestIngressControllerValidator(t *testing.T) {
+	const namespace = "openshift-ingress-operator"
+	ingressConfig := &configv1.Ingress{
+		ObjectMeta: metav1.ObjectMeta{Name: "cluster"},
+		Spec:       configv1.IngressSpec{Domain: "apps.example.com"},
+	}
+	defaultIC := &operatorv1.IngressController{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: namespace,
+			Name:      "default",
+			UID:       "1",
+		},
+		Status: operatorv1.IngressControllerStatus{
+			Domain: "apps.example.com",
+			Conditions: []operatorv1.OperatorCondition{{
+				Type:   IngressControllerAdmittedConditionType,
+				Status: operatorv1.ConditionTrue,
+			}},
+		},
+	}
+	newIC := func(namespace, domain string) *operatorv1.IngressController {
+		return &operatorv1.IngressController{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: namespace,
+				Name:      "test",
+			},
+			Spec: operatorv1.IngressControllerSpec{
+				Domain: domain,
+			},
+		}
+	}
+	withInvalidTLSProfile := func(ic *operatorv1.IngressController) *operatorv1.IngressController {
+		ic.Spec.TLSSecurityProfile = &configv1.TLSSecurityProfile{
+			Type:   configv1.TLSProfileCustomType,
+			Custom: &configv1.CustomTLSProfile{},
+		}
+		return ic
+	}
+	deleting := func(ic *operatorv1.IngressController) *operatorv1.IngressController {
+		now := metav1.Now()
+		ic.DeletionTimestamp = &now
+		return ic
+	}
+
+	testCases := []struct {
+		description string
+		old         *operatorv1.IngressController
+		new         *operatorv1.IngressController
+		expectError bool
+	}{
+		{
+			description: "create with a unique domain",
+			new:         newIC(namespace, "apps2.example.com"),
+		},
+		{
+			description: "create with the domain of an existing ingresscontroller",
+			new:         newIC(namespace, "apps.example.com"),
+			expectError: true,
+		},
+		{
+			description: "create with the defaulted domain of an existing ingresscontroller",
+			new:         newIC(namespace, ""),
+			expectError: true,
+		},
+		{
+			description: "create with an invalid TLS security profile",
+			new:         withInvalidTLSProfile(newIC(namespace, "apps2.example.com")),
+			expectError: true,
+		},
+		{
+			description: "create an invalid ingresscontroller in another namespace",
+			new:         withInvalidTLSProfile(newIC("other", "apps.example.com")),
+		},
+		{
+			description: "update with an invalid TLS security profile",
+			old:         newIC(namespace, "apps2.example.com"),
+			new:         withInvalidTLSProfile(newIC(namespace, "apps2.example.com")),
+			expectError: true,
+		},
+		{
+			description: "update without spec changes of an invalid ingresscontroller",
+			old:         withInvalidTLSProfile(newIC(namespace, "apps2.example.com")),
+			new:         withInvalidTLSProfile(newIC(namespace, "apps2.example.com")),
+		},
+		{
+			description: "update of an ingresscontroller that is being deleted",
+			old:         newIC(namespace, "apps2.example.com"),
+			new:         deleting(withInvalidTLSProfile(newIC(namespace, "apps2.example.com"))),
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			client := fake.NewClientBuilder().WithScheme(operatorclient.GetScheme()).WithObjects(ingressConfig, defaultIC).Build()
+			validator := &ingressControllerValidator{reader: client, namespace: namespace}
+			var err error
+			if tc.old == nil {
+				err = validator.ValidateCreate(context.Background(), tc.new)
+			} else {
+				err = validator.ValidateUpdate(context.Background(), tc.old, tc.new)
+			}
+			switch {
+			case tc.expectError && err == nil:
+				t.Error("expected an error, got nil")
+			case !tc.expectError && err != nil:
+				t.Errorf("unexpected error: %v", err)
+			}
+		})
+	}
+}
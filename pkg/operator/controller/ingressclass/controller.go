@@ -35,8 +35,13 @@ var (
 )
 
 // New creates and returns a controller that creates and manages IngressClass
-// objects for IngressControllers.
+// objects for IngressControllers.  New also creates a controller that applies
+// the defaults from IngressClass parameters to Ingresses.
 func New(mgr manager.Manager, config Config) (controller.Controller, error) {
+	if _, err := newParametersController(mgr); err != nil {
+		return nil, err
+	}
+
 	reconciler := &reconciler{
 		config:   config,
 		client:   mgr.GetClient(),
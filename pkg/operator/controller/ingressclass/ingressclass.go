@@ -30,6 +30,9 @@ func (r *reconciler) ensureIngressClass(icName types.NamespacedName, ingressClas
 		if !errors.IsNotFound(err) {
 			return false, nil, fmt.Errorf("failed to get ingresscontroller: %w", err)
 		}
+		// Events about the IngressClass are recorded on the
+		// ingresscontroller, which no longer exists.
+		ic = nil
 	} else if ic.DeletionTimestamp == nil {
 		haveIngressController = true
 	}
@@ -51,6 +54,9 @@ func (r *reconciler) ensureIngressClass(icName types.NamespacedName, ingressClas
 			}
 		} else {
 			log.Info("deleted IngressClass", "ingressclass", current)
+			if ic != nil {
+				controller.RecordOperandDeleted(r.recorder, ic, "IngressClass", current)
+			}
 		}
 		return false, nil, nil
 	case want && !have:
@@ -58,9 +64,10 @@ func (r *reconciler) ensureIngressClass(icName types.NamespacedName, ingressClas
 			return false, nil, fmt.Errorf("failed to create IngressClass: %w", err)
 		}
 		log.Info("created IngressClass", "ingressclass", desired)
+		controller.RecordOperandCreated(r.recorder, ic, "IngressClass", desired)
 		return r.currentIngressClass(icName.Name)
 	case want && have:
-		if updated, err := r.updateIngressClass(current, desired); err != nil {
+		if updated, err := r.updateIngressClass(ic, current, desired); err != nil {
 			return true, current, fmt.Errorf("failed to update IngressClass: %w", err)
 		} else if updated {
 			return r.currentIngressClass(icName.Name)
@@ -135,9 +142,10 @@ func (r *reconciler) currentIngressClass(ingressControllerName string) (bool, *n
 	return true, class, nil
 }
 
-// updateIngressClass updates an IngressClass.  Returns a Boolean indicating
-// whether the IngressClass was updated, and an error value.
-func (r *reconciler) updateIngressClass(current, desired *networkingv1.IngressClass) (bool, error) {
+// updateIngressClass updates the IngressClass for the given
+// IngressController.  Returns a Boolean indicating whether the IngressClass was
+// updated, and an error value.
+func (r *reconciler) updateIngressClass(ic *operatorv1.IngressController, current, desired *networkingv1.IngressClass) (bool, error) {
 	changed, updated := ingressClassChanged(current, desired)
 	if !changed {
 		return false, nil
@@ -145,10 +153,12 @@ func (r *reconciler) updateIngressClass(current, desired *networkingv1.IngressCl
 
 	// Diff before updating because the client may mutate the object.
 	diff := cmp.Diff(current, updated, cmpopts.EquateEmpty())
+	fieldDiff := controller.FieldDiff(current, updated)
 	if err := r.client.Update(context.TODO(), updated); err != nil {
 		return false, err
 	}
 	log.Info("updated IngressClass", "name", updated.Name, "diff", diff)
+	controller.RecordOperandUpdated(r.recorder, ic, "IngressClass", updated, fieldDiff)
 	return true, nil
 }
 
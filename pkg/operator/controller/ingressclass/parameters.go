@@ -0,0 +1,437 @@
+package ingressclass
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+
+	routev1 "github.com/openshift/api/route/v1"
+	operatorcontroller "github.com/openshift/cluster-ingress-operator/pkg/operator/controller"
+
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+
+	"k8s.io/client-go/tools/record"
+
+	"sigs.k8s.io/controller-runtime/pkg/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+)
+
+const (
+	parametersControllerName = "ingressclass_parameters_controller"
+
+	// Keys in an IngressClass parameters configmap.
+	//
+	// TLSTerminationParameter is the default TLS termination type
+	// ("edge", "passthrough", or "reencrypt") for routes that are
+	// generated from ingresses of the ingressclass.
+	TLSTerminationParameter = "tlsTermination"
+	// InsecureEdgeTerminationPolicyParameter is the default insecure edge
+	// termination policy ("Allow", "None", or "Redirect") for routes
+	// with edge TLS termination that are generated from ingresses of the
+	// ingressclass.
+	InsecureEdgeTerminationPolicyParameter = "insecureEdgeTerminationPolicy"
+	// TimeoutParameter is the default server timeout for routes that are
+	// generated from ingresses of the ingressclass.
+	TimeoutParameter = "timeout"
+	// TimeoutTunnelParameter is the default tunnel timeout for routes
+	// that are generated from ingresses of the ingressclass.
+	TimeoutTunnelParameter = "timeoutTunnel"
+
+	// terminationAnnotation is the ingress annotation that the
+	// ingress-to-route controller uses to determine the TLS termination
+	// type of the routes that it generates.
+	terminationAnnotation = "route.openshift.io/termination"
+	// insecureEdgeTerminationPolicyAnnotation is the ingress annotation
+	// that the ingress-to-route controller uses to determine the insecure
+	// edge termination policy of the routes with edge TLS termination that
+	// it generates.
+	insecureEdgeTerminationPolicyAnnotation = "route.openshift.io/insecure-edge-termination-policy"
+	// timeoutAnnotation and timeoutTunnelAnnotation are route
+	// annotations; the ingress-to-route controller copies an ingress's
+	// annotations to the routes that it generates.
+	timeoutAnnotation       = "haproxy.router.openshift.io/timeout"
+	timeoutTunnelAnnotation = "haproxy.router.openshift.io/timeout-tunnel"
+
+	// defaultedAnnotationsAnnotation is the annotation in which the
+	// controller records the ingress annotations that it set from the
+	// ingressclass's parameters and their values, as a JSON object, so
+	// that it can update or remove them if the parameters change without
+	// overwriting annotations that the user set or modified.
+	defaultedAnnotationsAnnotation = "ingress.operator.openshift.io/ingressclass-defaulted-annotations"
+
+	// ingressClassAnnotation is the deprecated annotation for specifying
+	// an ingress's class.
+	ingressClassAnnotation = "kubernetes.io/ingress.class"
+)
+
+// newParametersController creates and returns a controller that applies the
+// defaults from IngressClass parameters configmaps to ingresses of those
+// IngressClasses.  The ingress-to-route controller applies the annotations that
+// this controller sets on ingresses to the routes that it generates from them;
+// this controller never modifies the generated routes itself.
+func newParametersController(mgr manager.Manager) (controller.Controller, error) {
+	// Create a new cache to watch on Ingress objects from every namespace.
+	ingressCache, err := cache.New(mgr.GetConfig(), cache.Options{
+		Scheme: mgr.GetScheme(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	// Add the cache to the manager so that the cache is started along with the other runnables.
+	if err := mgr.Add(ingressCache); err != nil {
+		return nil, err
+	}
+	reconciler := &parametersReconciler{
+		client:           mgr.GetClient(),
+		cache:            mgr.GetCache(),
+		ingressCache:     ingressCache,
+		recorder:         mgr.GetEventRecorderFor(parametersControllerName),
+		reportedProblems: map[string]string{},
+	}
+	c, err := controller.New(parametersControllerName, mgr, controller.Options{Reconciler: reconciler})
+	if err != nil {
+		return nil, err
+	}
+	if err := c.Watch(source.NewKindWithCache(&networkingv1.Ingress{}, ingressCache), &handler.EnqueueRequestForObject{}); err != nil {
+		return nil, err
+	}
+	// Watch all ingressclasses for the ingress-to-route controller, rather
+	// than only those that reference a parameters configmap, so that
+	// defaults are removed if the reference is removed.
+	isIngressToRouteClass := predicate.NewPredicateFuncs(func(o client.Object) bool {
+		return o.(*networkingv1.IngressClass).Spec.Controller == routev1.IngressToRouteIngressClassControllerName
+	})
+	if err := c.Watch(&source.Kind{Type: &networkingv1.IngressClass{}}, handler.EnqueueRequestsFromMapFunc(reconciler.ingressClassToIngresses), isIngressToRouteClass); err != nil {
+		return nil, err
+	}
+	isInConfigNamespace := predicate.NewPredicateFuncs(func(o client.Object) bool {
+		return o.GetNamespace() == operatorcontroller.GlobalUserSpecifiedConfigNamespace
+	})
+	if err := c.Watch(&source.Kind{Type: &corev1.ConfigMap{}}, handler.EnqueueRequestsFromMapFunc(reconciler.configMapToIngresses), isInConfigNamespace); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+// ingressClassHasParametersConfigMap returns a value indicating whether the
+// provided ingressclass is for the ingress-to-route controller and references
+// a parameters configmap in the namespace for user-specified configuration.
+func ingressClassHasParametersConfigMap(o client.Object) bool {
+	class, ok := o.(*networkingv1.IngressClass)
+	if !ok || class.Spec.Controller != routev1.IngressToRouteIngressClassControllerName {
+		return false
+	}
+	params := class.Spec.Parameters
+	return params != nil &&
+		(params.APIGroup == nil || len(*params.APIGroup) == 0) &&
+		params.Kind == "ConfigMap" &&
+		params.Scope != nil && *params.Scope == networkingv1.IngressClassParametersReferenceScopeNamespace &&
+		params.Namespace != nil && *params.Namespace == operatorcontroller.GlobalUserSpecifiedConfigNamespace
+}
+
+// ingressClassName returns the name of the given ingress's class, or the empty
+// string if the ingress does not specify a class.
+func ingressClassName(ingress *networkingv1.Ingress) string {
+	if ingress.Spec.IngressClassName != nil {
+		return *ingress.Spec.IngressClassName
+	}
+	return ingress.Annotations[ingressClassAnnotation]
+}
+
+// ingressClassToIngresses returns reconcile.Requests for the ingresses of the
+// given ingressclass.
+func (r *parametersReconciler) ingressClassToIngresses(o client.Object) []reconcile.Request {
+	return r.ingressesForClasses(map[string]struct{}{o.GetName(): {}})
+}
+
+// configMapToIngresses returns reconcile.Requests for the ingresses of the
+// ingressclasses that reference the given configmap as their parameters.
+func (r *parametersReconciler) configMapToIngresses(o client.Object) []reconcile.Request {
+	classes := &networkingv1.IngressClassList{}
+	if err := r.cache.List(context.Background(), classes); err != nil {
+		log.Error(err, "failed to list ingressclasses for configmap", "namespace", o.GetNamespace(), "name", o.GetName())
+		return nil
+	}
+	names := map[string]struct{}{}
+	for i := range classes.Items {
+		if ingressClassHasParametersConfigMap(&classes.Items[i]) && classes.Items[i].Spec.Parameters.Name == o.GetName() {
+			names[classes.Items[i].Name] = struct{}{}
+		}
+	}
+	if len(names) == 0 {
+		return nil
+	}
+	return r.ingressesForClasses(names)
+}
+
+// ingressesForClasses returns reconcile.Requests for the ingresses of the
+// ingressclasses with the given names, as well as for any ingresses that have
+// defaulted annotations, which may need to be removed if the ingress's class
+// changed.
+func (r *parametersReconciler) ingressesForClasses(names map[string]struct{}) []reconcile.Request {
+	ingresses := &networkingv1.IngressList{}
+	if err := r.ingressCache.List(context.Background(), ingresses); err != nil {
+		log.Error(err, "failed to list ingresses")
+		return nil
+	}
+	var requests []reconcile.Request
+	for i := range ingresses.Items {
+		ingress := &ingresses.Items[i]
+		_, ofClass := names[ingressClassName(ingress)]
+		if !ofClass && len(ingress.Annotations[defaultedAnnotationsAnnotation]) == 0 {
+			continue
+		}
+		requests = append(requests, reconcile.Request{
+			NamespacedName: types.NamespacedName{
+				Namespace: ingress.Namespace,
+				Name:      ingress.Name,
+			},
+		})
+	}
+	return requests
+}
+
+// parametersReconciler applies ingressclass parameters to ingresses.
+type parametersReconciler struct {
+	client       client.Client
+	cache        cache.Cache
+	ingressCache cache.Cache
+	recorder     record.EventRecorder
+
+	// reportedProblemsLock guards reportedProblems.
+	reportedProblemsLock sync.Mutex
+	// reportedProblems maps the names of ingressclasses to the reason and
+	// message of the last Warning event that was emitted for their
+	// parameters configmaps, so that the event is emitted once rather than
+	// once for every ingress of the ingressclass that is reconciled.
+	reportedProblems map[string]string
+}
+
+// Reconcile expects request to refer to an Ingress.  If the Ingress's
+// IngressClass references a parameters configmap, Reconcile sets the
+// Ingress's annotations to the defaults from the configmap, unless the user
+// has set the annotations.
+func (r *parametersReconciler) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	log.Info("reconciling", "request", request)
+
+	ingress := &networkingv1.Ingress{}
+	if err := r.ingressCache.Get(ctx, request.NamespacedName, ingress); err != nil {
+		if errors.IsNotFound(err) {
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, fmt.Errorf("failed to get ingress %q: %w", request.NamespacedName, err)
+	}
+
+	params, err := r.currentIngressClassParameters(ctx, ingressClassName(ingress))
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+
+	if err := r.ensureIngressAnnotations(ctx, ingress, params); err != nil {
+		return reconcile.Result{}, fmt.Errorf("failed to ensure annotations for ingress %q: %w", request.NamespacedName, err)
+	}
+	return reconcile.Result{}, nil
+}
+
+// ingressClassParameters holds the validated parameters from an ingressclass's
+// parameters configmap.
+type ingressClassParameters struct {
+	// annotations are the default annotations for ingresses of the
+	// ingressclass.
+	annotations map[string]string
+}
+
+// currentIngressClassParameters returns the parameters for the ingressclass
+// with the given name, or nil if the ingressclass does not exist, does not
+// reference a parameters configmap, or references a configmap that does not
+// exist or is invalid.  A missing or invalid configmap is reported using an
+// event on the ingressclass.
+func (r *parametersReconciler) currentIngressClassParameters(ctx context.Context, className string) (*ingressClassParameters, error) {
+	if len(className) == 0 {
+		return nil, nil
+	}
+	class := &networkingv1.IngressClass{}
+	if err := r.cache.Get(ctx, types.NamespacedName{Name: className}, class); err != nil {
+		if errors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get ingressclass %q: %w", className, err)
+	}
+	if !ingressClassHasParametersConfigMap(class) {
+		r.reportParametersProblem(class, "", "")
+		return nil, nil
+	}
+	name := types.NamespacedName{
+		Namespace: *class.Spec.Parameters.Namespace,
+		Name:      class.Spec.Parameters.Name,
+	}
+	cm := &corev1.ConfigMap{}
+	if err := r.cache.Get(ctx, name, cm); err != nil {
+		if errors.IsNotFound(err) {
+			r.reportParametersProblem(class, "ParametersNotFound", fmt.Sprintf("configmap %q does not exist", name))
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get configmap %q: %w", name, err)
+	}
+	params, err := parseIngressClassParameters(cm)
+	if err != nil {
+		r.reportParametersProblem(class, "InvalidParameters", fmt.Sprintf("configmap %q is invalid: %v", name, err))
+		return nil, nil
+	}
+	r.reportParametersProblem(class, "", "")
+	return params, nil
+}
+
+// reportParametersProblem emits a Warning event with the given reason and
+// message on the given ingressclass unless the same event was the last one
+// emitted for the ingressclass.  An empty reason indicates that the
+// ingressclass's parameters have no problem, so that the next problem is
+// reported again.
+func (r *parametersReconciler) reportParametersProblem(class *networkingv1.IngressClass, reason, message string) {
+	problem := reason + ": " + message
+	r.reportedProblemsLock.Lock()
+	defer r.reportedProblemsLock.Unlock()
+	if len(reason) == 0 {
+		delete(r.reportedProblems, class.Name)
+		return
+	}
+	if r.reportedProblems[class.Name] == problem {
+		return
+	}
+	r.reportedProblems[class.Name] = problem
+	r.recorder.Event(class, "Warning", reason, message)
+}
+
+// parseIngressClassParameters validates the given parameters configmap and
+// returns the parameters that it specifies.
+func parseIngressClassParameters(cm *corev1.ConfigMap) (*ingressClassParameters, error) {
+	params := &ingressClassParameters{annotations: map[string]string{}}
+	var errs []error
+	for k, v := range cm.Data {
+		v = strings.TrimSpace(v)
+		switch k {
+		case TLSTerminationParameter:
+			switch routev1.TLSTerminationType(v) {
+			case routev1.TLSTerminationEdge, routev1.TLSTerminationPassthrough, routev1.TLSTerminationReencrypt:
+				params.annotations[terminationAnnotation] = v
+			default:
+				errs = append(errs, fmt.Errorf("invalid value for %s: %q", k, v))
+			}
+		case InsecureEdgeTerminationPolicyParameter:
+			switch routev1.InsecureEdgeTerminationPolicyType(v) {
+			case routev1.InsecureEdgeTerminationPolicyAllow, routev1.InsecureEdgeTerminationPolicyNone, routev1.InsecureEdgeTerminationPolicyRedirect:
+				params.annotations[insecureEdgeTerminationPolicyAnnotation] = v
+			default:
+				errs = append(errs, fmt.Errorf("invalid value for %s: %q", k, v))
+			}
+		case TimeoutParameter, TimeoutTunnelParameter:
+			d, err := time.ParseDuration(v)
+			if err != nil || d <= 0 {
+				errs = append(errs, fmt.Errorf("invalid value for %s: %q", k, v))
+				continue
+			}
+			if k == TimeoutParameter {
+				params.annotations[timeoutAnnotation] = v
+			} else {
+				params.annotations[timeoutTunnelAnnotation] = v
+			}
+		default:
+			errs = append(errs, fmt.Errorf("unknown parameter %q", k))
+		}
+	}
+	if len(errs) != 0 {
+		return nil, utilerrors.NewAggregate(errs)
+	}
+	return params, nil
+}
+
+// ensureIngressAnnotations updates the ingress's annotations to reflect the
+// given parameters.
+func (r *parametersReconciler) ensureIngressAnnotations(ctx context.Context, ingress *networkingv1.Ingress, params *ingressClassParameters) error {
+	var defaults map[string]string
+	if params != nil {
+		defaults = params.annotations
+	}
+	changed, updated := desiredIngressAnnotations(ingress.Annotations, defaults)
+	if !changed {
+		return nil
+	}
+	updatedIngress := ingress.DeepCopy()
+	updatedIngress.Annotations = updated
+	// Diff before updating because the client may mutate the object.
+	diff := cmp.Diff(ingress.Annotations, updated, cmpopts.EquateEmpty())
+	if err := r.client.Update(ctx, updatedIngress); err != nil {
+		return err
+	}
+	log.Info("updated ingress annotations", "namespace", ingress.Namespace, "name", ingress.Name, "diff", diff)
+	return nil
+}
+
+// desiredIngressAnnotations returns a Boolean value indicating whether the
+// given annotations need to be updated to reflect the given default
+// annotations, and the updated annotations.  An annotation that the user set is
+// never overwritten.  An annotation that was set from a default is updated or,
+// if the default no longer exists, removed only if its value is still the
+// value that was defaulted; otherwise, the user has modified it, and it is
+// treated as an annotation that the user set.
+func desiredIngressAnnotations(current, defaults map[string]string) (bool, map[string]string) {
+	previouslyDefaulted := map[string]string{}
+	if v, ok := current[defaultedAnnotationsAnnotation]; ok {
+		if err := json.Unmarshal([]byte(v), &previouslyDefaulted); err != nil {
+			// Treat all annotations as set by the user rather than
+			// risk overwriting them.
+			log.Info("ignoring invalid annotation", "annotation", defaultedAnnotationsAnnotation, "value", v)
+			previouslyDefaulted = map[string]string{}
+		}
+	}
+
+	updated := map[string]string{}
+	for k, v := range current {
+		if defaultedValue, ok := previouslyDefaulted[k]; ok && defaultedValue == v {
+			continue
+		}
+		if k == defaultedAnnotationsAnnotation {
+			continue
+		}
+		updated[k] = v
+	}
+	defaulted := map[string]string{}
+	for k, v := range defaults {
+		if _, userSet := updated[k]; userSet {
+			continue
+		}
+		updated[k] = v
+		defaulted[k] = v
+	}
+	if len(defaulted) != 0 {
+		// Marshaling a map sorts its keys, so the value is stable.
+		v, err := json.Marshal(defaulted)
+		if err != nil {
+			log.Error(err, "failed to marshal defaulted annotations", "annotations", defaulted)
+			return false, nil
+		}
+		updated[defaultedAnnotationsAnnotation] = string(v)
+	}
+
+	if cmp.Equal(current, updated, cmpopts.EquateEmpty()) {
+		return false, nil
+	}
+	return true, updated
+}
@@ -0,0 +1,223 @@
+package ingressclass
+
+import (
+	"reflect"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"k8s.io/client-go/tools/record"
+)
+
+// TestParseIngressClassParameters verifies that parseIngressClassParameters
+// maps the keys of a parameters configmap to the expected ingress annotations
+// and rejects invalid configmaps.
+func TestParseIngressClassParameters(t *testing.T) {
+	testCases := []struct {
+		description       string
+		data              map[string]string
+		expectError       bool
+		expectAnnotations map[string]string
+	}{
+		{
+			description:       "empty configmap",
+			data:              map[string]string{},
+			expectAnnotations: map[string]string{},
+		},
+		{
+			description: "all parameters",
+			data: map[string]string{
+				"tlsTermination":                "reencrypt",
+				"insecureEdgeTerminationPolicy": "Allow",
+				"timeout":                       "30s",
+				"timeoutTunnel":                 "1h",
+			},
+			expectAnnotations: map[string]string{
+				"route.openshift.io/termination":                      "reencrypt",
+				"route.openshift.io/insecure-edge-termination-policy": "Allow",
+				"haproxy.router.openshift.io/timeout":                 "30s",
+				"haproxy.router.openshift.io/timeout-tunnel":          "1h",
+			},
+		},
+		{
+			description: "invalid termination",
+			data:        map[string]string{"tlsTermination": "none"},
+			expectError: true,
+		},
+		{
+			description: "invalid insecure edge termination policy",
+			data:        map[string]string{"insecureEdgeTerminationPolicy": "redirect"},
+			expectError: true,
+		},
+		{
+			description: "invalid timeout",
+			data:        map[string]string{"timeout": "30"},
+			expectError: true,
+		},
+		{
+			description: "unknown key",
+			data:        map[string]string{"foo": "bar"},
+			expectError: true,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			params, err := parseIngressClassParameters(&corev1.ConfigMap{Data: tc.data})
+			switch {
+			case tc.expectError && err == nil:
+				t.Fatal("expected error, got nil")
+			case !tc.expectError && err != nil:
+				t.Fatalf("unexpected error: %v", err)
+			case tc.expectError:
+				return
+			}
+			if !reflect.DeepEqual(params.annotations, tc.expectAnnotations) {
+				t.Errorf("expected annotations %v, got %v", tc.expectAnnotations, params.annotations)
+			}
+		})
+	}
+}
+
+// TestDesiredIngressAnnotations verifies that desiredIngressAnnotations sets
+// default annotations without overwriting annotations that the user set, and
+// updates or removes annotations that it previously defaulted.
+func TestDesiredIngressAnnotations(t *testing.T) {
+	testCases := []struct {
+		description   string
+		current       map[string]string
+		defaults      map[string]string
+		expectChanged bool
+		expect        map[string]string
+	}{
+		{
+			description: "no annotations and no defaults",
+		},
+		{
+			description: "defaults are added",
+			current:     map[string]string{"foo": "bar"},
+			defaults: map[string]string{
+				"route.openshift.io/termination":      "edge",
+				"haproxy.router.openshift.io/timeout": "30s",
+			},
+			expectChanged: true,
+			expect: map[string]string{
+				"foo":                                 "bar",
+				"route.openshift.io/termination":      "edge",
+				"haproxy.router.openshift.io/timeout": "30s",
+				"ingress.operator.openshift.io/ingressclass-defaulted-annotations": `{"haproxy.router.openshift.io/timeout":"30s","route.openshift.io/termination":"edge"}`,
+			},
+		},
+		{
+			description: "user-set annotation is not overwritten",
+			current:     map[string]string{"route.openshift.io/termination": "passthrough"},
+			defaults:    map[string]string{"route.openshift.io/termination": "edge"},
+		},
+		{
+			description: "defaulted annotation is updated",
+			current: map[string]string{
+				"haproxy.router.openshift.io/timeout":                              "30s",
+				"ingress.operator.openshift.io/ingressclass-defaulted-annotations": `{"haproxy.router.openshift.io/timeout":"30s"}`,
+			},
+			defaults:      map[string]string{"haproxy.router.openshift.io/timeout": "1m"},
+			expectChanged: true,
+			expect: map[string]string{
+				"haproxy.router.openshift.io/timeout":                              "1m",
+				"ingress.operator.openshift.io/ingressclass-defaulted-annotations": `{"haproxy.router.openshift.io/timeout":"1m"}`,
+			},
+		},
+		{
+			description: "defaulted annotation is removed",
+			current: map[string]string{
+				"foo":                                 "bar",
+				"haproxy.router.openshift.io/timeout": "30s",
+				"ingress.operator.openshift.io/ingressclass-defaulted-annotations": `{"haproxy.router.openshift.io/timeout":"30s"}`,
+			},
+			expectChanged: true,
+			expect:        map[string]string{"foo": "bar"},
+		},
+		{
+			description: "defaulted annotation that the user modified is not overwritten",
+			current: map[string]string{
+				"haproxy.router.openshift.io/timeout":                              "5m",
+				"ingress.operator.openshift.io/ingressclass-defaulted-annotations": `{"haproxy.router.openshift.io/timeout":"30s"}`,
+			},
+			defaults:      map[string]string{"haproxy.router.openshift.io/timeout": "1m"},
+			expectChanged: true,
+			expect:        map[string]string{"haproxy.router.openshift.io/timeout": "5m"},
+		},
+		{
+			description: "defaulted annotation that the user modified is not removed",
+			current: map[string]string{
+				"haproxy.router.openshift.io/timeout":                              "5m",
+				"ingress.operator.openshift.io/ingressclass-defaulted-annotations": `{"haproxy.router.openshift.io/timeout":"30s"}`,
+			},
+			expectChanged: true,
+			expect:        map[string]string{"haproxy.router.openshift.io/timeout": "5m"},
+		},
+		{
+			description: "invalid record of defaulted annotations",
+			current: map[string]string{
+				"haproxy.router.openshift.io/timeout":                              "30s",
+				"ingress.operator.openshift.io/ingressclass-defaulted-annotations": "haproxy.router.openshift.io/timeout",
+			},
+			defaults:      map[string]string{"haproxy.router.openshift.io/timeout": "1m"},
+			expectChanged: true,
+			expect:        map[string]string{"haproxy.router.openshift.io/timeout": "30s"},
+		},
+		{
+			description: "defaults are already applied",
+			current: map[string]string{
+				"haproxy.router.openshift.io/timeout":                              "30s",
+				"ingress.operator.openshift.io/ingressclass-defaulted-annotations": `{"haproxy.router.openshift.io/timeout":"30s"}`,
+			},
+			defaults: map[string]string{"haproxy.router.openshift.io/timeout": "30s"},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.description, func(t *testing.T) {
+			changed, updated := desiredIngressAnnotations(tc.current, tc.defaults)
+			if changed != tc.expectChanged {
+				t.Fatalf("expected changed to be %t, got %t", tc.expectChanged, changed)
+			}
+			if changed && !reflect.DeepEqual(updated, tc.expect) {
+				t.Errorf("expected %v, got %v", tc.expect, updated)
+			}
+		})
+	}
+}
+
+// TestReportParametersProblem verifies that reportParametersProblem emits an
+// event for a problem with an ingressclass's parameters once, rather than once
+// for every ingress that is reconciled, and again after the problem changes or
+// is resolved and recurs.
+func TestReportParametersProblem(t *testing.T) {
+	recorder := record.NewFakeRecorder(10)
+	r := &parametersReconciler{recorder: recorder, reportedProblems: map[string]string{}}
+	class := &networkingv1.IngressClass{ObjectMeta: metav1.ObjectMeta{Name: "openshift-default"}}
+	other := &networkingv1.IngressClass{ObjectMeta: metav1.ObjectMeta{Name: "other"}}
+
+	r.reportParametersProblem(class, "ParametersNotFound", `configmap "openshift-config/params" does not exist`)
+	r.reportParametersProblem(class, "ParametersNotFound", `configmap "openshift-config/params" does not exist`)
+	r.reportParametersProblem(other, "ParametersNotFound", `configmap "openshift-config/params" does not exist`)
+	r.reportParametersProblem(class, "InvalidParameters", `configmap "openshift-config/params" is invalid: unknown parameter "foo"`)
+	r.reportParametersProblem(class, "", "")
+	r.reportParametersProblem(class, "InvalidParameters", `configmap "openshift-config/params" is invalid: unknown parameter "foo"`)
+
+	expected := []string{
+		`Warning ParametersNotFound configmap "openshift-config/params" does not exist`,
+		`Warning ParametersNotFound configmap "openshift-config/params" does not exist`,
+		`Warning InvalidParameters configmap "openshift-config/params" is invalid: unknown parameter "foo"`,
+		`Warning InvalidParameters configmap "openshift-config/params" is invalid: unknown parameter "foo"`,
+	}
+	close(recorder.Events)
+	var events []string
+	for event := range recorder.Events {
+		events = append(events, event)
+	}
+	if !reflect.DeepEqual(events, expected) {
+		t.Errorf("expected events %q, got %q", expected, events)
+	}
+}
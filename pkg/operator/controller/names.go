@@ -2,11 +2,15 @@ package controller
 
 import (
 	"fmt"
+	"hash/fnv"
+	"strings"
 
+	configv1 "github.com/openshift/api/config/v1"
 	operatorv1 "github.com/openshift/api/operator/v1"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation"
 )
 
 const (
@@ -44,6 +48,15 @@ const (
 	// Remote worker label, used for node affinity of router deployment.
 	// Router should not run on remote worker nodes
 	RemoteWorkerLabel = "node.openshift.io/remote-worker"
+
+	// OpenShiftGatewayClassControllerName is the value of
+	// spec.controllerName for GatewayClasses whose Gateways the operator
+	// manages.
+	OpenShiftGatewayClassControllerName = "openshift.io/ingress-operator"
+
+	// OpenShiftDefaultGatewayClassName is the name of the GatewayClass that
+	// the operator creates.
+	OpenShiftDefaultGatewayClassName = "openshift-default"
 )
 
 // IngressClusterOperatorName returns the namespaced name of the ClusterOperator
@@ -118,6 +131,16 @@ func RouterOperatorGeneratedDefaultCertificateSecretName(ci *operatorv1.IngressC
 	}
 }
 
+// RouterValidatedDefaultCertificateSecretName returns the namespaced name for
+// the operator-managed copy of the user-supplied router default certificate
+// secret, which has the certificate that the operator last validated.
+func RouterValidatedDefaultCertificateSecretName(ci *operatorv1.IngressController, namespace string) types.NamespacedName {
+	return types.NamespacedName{
+		Namespace: namespace,
+		Name:      fmt.Sprintf("router-validated-certs-%s", ci.Name),
+	}
+}
+
 // ClientCAConfigMapName returns the namespaced name for the operator-managed
 // client CA configmap, which is a copy of the user-managed configmap from the
 // openshift-config namespace.
@@ -218,6 +241,15 @@ func WildcardDNSRecordName(ic *operatorv1.IngressController) types.NamespacedNam
 	}
 }
 
+// DNSZoneDescription returns a description of the given zone for use in
+// status messages.
+func DNSZoneDescription(zone configv1.DNSZone) string {
+	if len(zone.ID) != 0 {
+		return fmt.Sprintf("zone %q", zone.ID)
+	}
+	return fmt.Sprintf("zone with tags %v", zone.Tags)
+}
+
 func CanaryDaemonSetName() types.NamespacedName {
 	return types.NamespacedName{
 		Namespace: DefaultCanaryNamespace,
@@ -250,3 +282,30 @@ func CanaryRouteName() types.NamespacedName {
 func IngressClassName(ingressControllerName string) types.NamespacedName {
 	return types.NamespacedName{Name: "openshift-" + ingressControllerName}
 }
+
+// GatewayIngressControllerName returns the namespaced name of the
+// IngressController that the operator manages for the Gateway with the given
+// namespace and name.  Because the IngressController's name is used as a label
+// value on the router deployment's pods, a name that would exceed the maximum
+// length for a label value is truncated and suffixed with a hash of the
+// Gateway's namespace and name.
+func GatewayIngressControllerName(operatorNamespace, gatewayNamespace, gatewayName string) types.NamespacedName {
+	name := fmt.Sprintf("gateway-%s-%s", gatewayNamespace, gatewayName)
+	if len(name) > validation.DNS1123LabelMaxLength {
+		hash := fnv.New32a()
+		hash.Write([]byte(gatewayNamespace + "/" + gatewayName))
+		suffix := fmt.Sprintf("-%08x", hash.Sum32())
+		name = strings.TrimRight(name[:validation.DNS1123LabelMaxLength-len(suffix)], "-.") + suffix
+	}
+	return types.NamespacedName{Namespace: operatorNamespace, Name: name}
+}
+
+// GatewayDefaultCertificateSecretName returns the namespaced name for the copy
+// of a Gateway's certificate that the operator uses as the default certificate
+// of the IngressController that it manages for the Gateway.
+func GatewayDefaultCertificateSecretName(ic *operatorv1.IngressController) types.NamespacedName {
+	return types.NamespacedName{
+		Namespace: DefaultOperandNamespace,
+		Name:      ic.Name + "-default-cert",
+	}
+}
@@ -37,19 +37,11 @@ var (
 
 // New creates the route metrics controller. This is the controller
 // that handles all the logic for gathering and exporting
-// metrics related to route resources.
-func New(mgr manager.Manager, namespace string) (controller.Controller, error) {
-	// Create a new cache to watch on Route objects from every namespace.
-	newCache, err := cache.New(mgr.GetConfig(), cache.Options{
-		Scheme: mgr.GetScheme(),
-	})
-	if err != nil {
-		return nil, err
-	}
-	// Add the cache to the manager so that the cache is started along with the other runnables.
-	mgr.Add(newCache)
+// metrics related to route resources.  The given cache must watch
+// Route objects from every namespace.
+func New(mgr manager.Manager, namespace string, routeCache cache.Cache) (controller.Controller, error) {
 	reconciler := &reconciler{
-		cache:            newCache,
+		cache:            routeCache,
 		namespace:        namespace,
 		routeToIngresses: make(map[types.NamespacedName]sets.String),
 	}
@@ -72,7 +64,7 @@ func New(mgr manager.Manager, namespace string) (controller.Controller, error) {
 		return nil, err
 	}
 	// add watch for changes in Route
-	if err := c.Watch(source.NewKindWithCache(&routev1.Route{}, newCache),
+	if err := c.Watch(source.NewKindWithCache(&routev1.Route{}, routeCache),
 		handler.EnqueueRequestsFromMapFunc(reconciler.routeToIngressController)); err != nil {
 		return nil, err
 	}
@@ -44,6 +44,10 @@ const (
 
 	ingressesEqualConditionMessage = "desired and current number of IngressControllers are equal"
 
+	// maxDNSRecordZoneMessages is the maximum number of messages about
+	// dnsrecords that the clusteroperator's conditions list individually.
+	maxDNSRecordZoneMessages = 5
+
 	controllerName = "status_controller"
 )
 
@@ -55,10 +59,10 @@ var clock utilclock.Clock = utilclock.RealClock{}
 // New creates the status controller. This is the controller that handles all
 // the logic for creating the ClusterOperator operator and updating its status.
 //
-// The controller watches IngressController resources in the manager namespace
-// and uses them to compute the operator status.  It also watches the
-// clusteroperators resource so that it reconciles the ingress clusteroperator
-// in case something else updates or deletes it.
+// The controller watches IngressController and DNSRecord resources in the
+// manager namespace and uses them to compute the operator status.  It also
+// watches the clusteroperators resource so that it reconciles the ingress
+// clusteroperator in case something else updates or deletes it.
 func New(mgr manager.Manager, config Config) (controller.Controller, error) {
 	reconciler := &reconciler{
 		config: config,
@@ -95,6 +99,16 @@ func New(mgr manager.Manager, config Config) (controller.Controller, error) {
 	); err != nil {
 		return nil, err
 	}
+	isInOperatorNamespace := func(o client.Object) bool {
+		return o.GetNamespace() == config.Namespace
+	}
+	if err := c.Watch(
+		&source.Kind{Type: &iov1.DNSRecord{}},
+		handler.EnqueueRequestsFromMapFunc(toDefaultIngressController),
+		predicate.NewPredicateFuncs(isInOperatorNamespace),
+	); err != nil {
+		return nil, err
+	}
 	return c, nil
 }
 
@@ -162,6 +176,13 @@ func (r *reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 		related = append(related, configv1.ObjectReference{
 			Resource: "namespaces",
 			Name:     state.IngressNamespace.Name,
+		}, configv1.ObjectReference{
+			Group:     "apps",
+			Resource:  "deployments",
+			Namespace: state.IngressNamespace.Name,
+		}, configv1.ObjectReference{
+			Resource:  "services",
+			Namespace: state.IngressNamespace.Name,
 		})
 	}
 	if state.CanaryNamespace != nil {
@@ -181,6 +202,7 @@ func (r *reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 		computeOperatorAvailableCondition(state.IngressControllers),
 		computeOperatorProgressingCondition(
 			state.IngressControllers,
+			state.DNSRecords,
 			allIngressesAvailable,
 			oldStatus.Versions,
 			co.Status.Versions,
@@ -188,7 +210,7 @@ func (r *reconciler) Reconcile(ctx context.Context, request reconcile.Request) (
 			r.config.IngressControllerImage,
 			r.config.CanaryImage,
 		),
-		computeOperatorDegradedCondition(state.IngressControllers),
+		computeOperatorDegradedCondition(state.IngressControllers, state.DNSRecords),
 		computeOperatorUpgradeableCondition(state.IngressControllers),
 		computeOperatorEvaluationConditionsDetectedCondition(state.IngressControllers),
 	)
@@ -271,6 +293,13 @@ func (r *reconciler) getOperatorState(ingressNamespace, canaryNamespace string)
 		state.IngressControllers = ingressList.Items
 	}
 
+	dnsRecordList := &iov1.DNSRecordList{}
+	if err := r.cache.List(context.TODO(), dnsRecordList, client.InNamespace(r.config.Namespace)); err != nil {
+		return state, fmt.Errorf("failed to list dnsrecords in %q: %v", r.config.Namespace, err)
+	} else {
+		state.DNSRecords = dnsRecordList.Items
+	}
+
 	return state, nil
 }
 
@@ -316,8 +345,11 @@ func checkAllIngressesAvailable(ingresses []operatorv1.IngressController) bool {
 	return len(ingresses) != 0
 }
 
-// computeOperatorDegradedCondition computes the operator's current Degraded status state.
-func computeOperatorDegradedCondition(ingresses []operatorv1.IngressController) configv1.ClusterOperatorStatusCondition {
+// computeOperatorDegradedCondition computes the operator's current Degraded
+// status state.  The status is determined by the default ingresscontroller;
+// failures to publish dnsrecords are added to the message so that they are
+// visible even when they do not degrade the default ingresscontroller.
+func computeOperatorDegradedCondition(ingresses []operatorv1.IngressController, dnsRecords []iov1.DNSRecord) configv1.ClusterOperatorStatusCondition {
 	degradedCondition := configv1.ClusterOperatorStatusCondition{
 		Type: configv1.OperatorDegraded,
 	}
@@ -361,6 +393,15 @@ func computeOperatorDegradedCondition(ingresses []operatorv1.IngressController)
 		degradedCondition.Message = fmt.Sprintf("The %q ingress controller does not exist.", manifests.DefaultIngressControllerName)
 	}
 
+	if failed, _ := dnsRecordZoneMessages(dnsRecords); len(failed) != 0 {
+		var messages []string
+		if len(degradedCondition.Message) != 0 {
+			messages = append(messages, degradedCondition.Message)
+		}
+		messages = append(messages, summarizeDNSRecordZoneMessages(failed, "failed to publish")...)
+		degradedCondition.Message = strings.Join(messages, "\n")
+	}
+
 	return degradedCondition
 }
 
@@ -441,8 +482,52 @@ func computeOperatorEvaluationConditionsDetectedCondition(ingresses []operatorv1
 	}
 }
 
+// dnsRecordZoneMessages returns messages describing the zones to which the given
+// dnsrecords failed to be published and the zones in which their publishing
+// status is unknown, ordered by dnsrecord and zone.
+func dnsRecordZoneMessages(dnsRecords []iov1.DNSRecord) (failed, unknown []string) {
+	records := make([]*iov1.DNSRecord, 0, len(dnsRecords))
+	for i := range dnsRecords {
+		if dnsRecords[i].DeletionTimestamp != nil || dnsRecords[i].Spec.DNSManagementPolicy == iov1.UnmanagedDNS {
+			continue
+		}
+		records = append(records, &dnsRecords[i])
+	}
+	sort.Slice(records, func(i, j int) bool {
+		return oputil.ObjectLess(&records[i].ObjectMeta, &records[j].ObjectMeta)
+	})
+	for _, record := range records {
+		for _, zone := range record.Status.Zones {
+			for _, cond := range zone.Conditions {
+				if cond.Type != iov1.DNSRecordPublishedConditionType {
+					continue
+				}
+				switch cond.Status {
+				case string(operatorv1.ConditionFalse):
+					failed = append(failed, fmt.Sprintf("dnsrecord %q failed to publish %s in %s: %s: %s", record.Name, record.Spec.DNSName, operatorcontroller.DNSZoneDescription(zone.DNSZone), cond.Reason, cond.Message))
+				case string(operatorv1.ConditionUnknown):
+					unknown = append(unknown, fmt.Sprintf("dnsrecord %q has unknown publishing status for %s in %s: %s: %s", record.Name, record.Spec.DNSName, operatorcontroller.DNSZoneDescription(zone.DNSZone), cond.Reason, cond.Message))
+				}
+			}
+		}
+	}
+	return failed, unknown
+}
+
+// summarizeDNSRecordZoneMessages returns at most maxDNSRecordZoneMessages of
+// the given messages from dnsRecordZoneMessages, followed by a message that
+// counts the omitted ones using the given description, so that many failing
+// dnsrecords do not make the clusteroperator's condition messages unbounded.
+func summarizeDNSRecordZoneMessages(messages []string, description string) []string {
+	if len(messages) <= maxDNSRecordZoneMessages {
+		return messages
+	}
+	summary := append([]string{}, messages[:maxDNSRecordZoneMessages]...)
+	return append(summary, fmt.Sprintf("%d more dnsrecord zone(s) %s.", len(messages)-maxDNSRecordZoneMessages, description))
+}
+
 // computeOperatorProgressingCondition computes the operator's current Progressing status state.
-func computeOperatorProgressingCondition(ingresscontrollers []operatorv1.IngressController, allIngressesAvailable bool, oldVersions, curVersions []configv1.OperandVersion, operatorReleaseVersion, ingressControllerImage string, canaryImage string) configv1.ClusterOperatorStatusCondition {
+func computeOperatorProgressingCondition(ingresscontrollers []operatorv1.IngressController, dnsRecords []iov1.DNSRecord, allIngressesAvailable bool, oldVersions, curVersions []configv1.OperandVersion, operatorReleaseVersion, ingressControllerImage string, canaryImage string) configv1.ClusterOperatorStatusCondition {
 	progressingCondition := configv1.ClusterOperatorStatusCondition{
 		Type: configv1.OperatorProgressing,
 	}
@@ -466,6 +551,12 @@ func computeOperatorProgressingCondition(ingresscontrollers []operatorv1.Ingress
 		progressing = true
 	}
 
+	// A dnsrecord whose publishing status is unknown is mentioned, but it
+	// does not make the operator progressing because the status may not
+	// change until the dnsrecord is reconciled again.
+	_, unknown := dnsRecordZoneMessages(dnsRecords)
+	messages = append(messages, summarizeDNSRecordZoneMessages(unknown, "have unknown publishing status")...)
+
 	oldVersionsMap := make(map[string]string)
 	for _, opv := range oldVersions {
 		oldVersionsMap[opv.Name] = opv.Version
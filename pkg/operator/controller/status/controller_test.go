@@ -8,8 +8,10 @@ import (
 
 	configv1 "github.com/openshift/api/config/v1"
 	operatorv1 "github.com/openshift/api/operator/v1"
+	iov1 "github.com/openshift/api/operatoringress/v1"
 	"github.com/openshift/cluster-ingress-operator/pkg/operator/controller/ingress"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
 )
 
 func TestComputeOperatorProgressingCondition(t *testing.T) {
@@ -157,7 +159,7 @@ func TestComputeOperatorProgressingCondition(t *testing.T) {
 			ingresscontrollers[0].Status.Conditions[0].Status = operatorv1.ConditionTrue
 		}
 
-		actual := computeOperatorProgressingCondition(ingresscontrollers, tc.allIngressesAvailable, oldVersions, reportedVersions, tc.curVersions.operator, tc.curVersions.operand1, tc.curVersions.operand2)
+		actual := computeOperatorProgressingCondition(ingresscontrollers, nil, tc.allIngressesAvailable, oldVersions, reportedVersions, tc.curVersions.operator, tc.curVersions.operand1, tc.curVersions.operand2)
 		conditionsCmpOpts := []cmp.Option{
 			cmpopts.IgnoreFields(configv1.ClusterOperatorStatusCondition{}, "LastTransitionTime", "Reason", "Message"),
 		}
@@ -654,3 +656,130 @@ func Test_computeOperatorEvaluationConditionsDetectedCondition(t *testing.T) {
 		}
 	}
 }
+
+func TestComputeOperatorDegradedConditionDNSRecords(t *testing.T) {
+	defaultIC := operatorv1.IngressController{
+		ObjectMeta: metav1.ObjectMeta{Name: "default"},
+		Status: operatorv1.IngressControllerStatus{
+			Conditions: []operatorv1.OperatorCondition{{
+				Type:   operatorv1.OperatorStatusTypeDegraded,
+				Status: operatorv1.ConditionFalse,
+			}},
+		},
+	}
+	dnsRecord := func(name string, policy iov1.DNSManagementPolicy, zones ...iov1.DNSZoneStatus) iov1.DNSRecord {
+		return iov1.DNSRecord{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "openshift-ingress-operator", Name: name, UID: types.UID(name)},
+			Spec: iov1.DNSRecordSpec{
+				DNSName:             "*.apps.example.com.",
+				DNSManagementPolicy: policy,
+			},
+			Status: iov1.DNSRecordStatus{Zones: zones},
+		}
+	}
+	zoneStatus := func(zone configv1.DNSZone, status, reason, message string) iov1.DNSZoneStatus {
+		return iov1.DNSZoneStatus{
+			DNSZone: zone,
+			Conditions: []iov1.DNSZoneCondition{{
+				Type:    iov1.DNSRecordPublishedConditionType,
+				Status:  status,
+				Reason:  reason,
+				Message: message,
+			}},
+		}
+	}
+	publicZone := configv1.DNSZone{ID: "public"}
+	privateZone := configv1.DNSZone{Tags: map[string]string{"Name": "private"}}
+
+	testCases := []struct {
+		name            string
+		dnsRecords      []iov1.DNSRecord
+		expectedMessage string
+	}{
+		{
+			name:            "no dnsrecords",
+			expectedMessage: `The "default" ingress controller reports Degraded=False.`,
+		},
+		{
+			name: "published dnsrecord",
+			dnsRecords: []iov1.DNSRecord{
+				dnsRecord("default-wildcard", iov1.ManagedDNS, zoneStatus(publicZone, "True", "ProviderSuccess", "")),
+			},
+			expectedMessage: `The "default" ingress controller reports Degraded=False.`,
+		},
+		{
+			name: "dnsrecords that failed in some zones",
+			dnsRecords: []iov1.DNSRecord{
+				dnsRecord("other", iov1.ManagedDNS, zoneStatus(privateZone, "False", "ProviderError", "throttled")),
+				dnsRecord("default-wildcard", iov1.ManagedDNS,
+					zoneStatus(publicZone, "False", "ProviderError", "access denied"),
+					zoneStatus(privateZone, "True", "ProviderSuccess", ""),
+				),
+			},
+			expectedMessage: `The "default" ingress controller reports Degraded=False.
+dnsrecord "default-wildcard" failed to publish *.apps.example.com. in zone "public": ProviderError: access denied
+dnsrecord "other" failed to publish *.apps.example.com. in zone with tags map[Name:private]: ProviderError: throttled`,
+		},
+		{
+			name: "unmanaged dnsrecord",
+			dnsRecords: []iov1.DNSRecord{
+				dnsRecord("default-wildcard", iov1.UnmanagedDNS, zoneStatus(publicZone, "False", "ProviderError", "access denied")),
+			},
+			expectedMessage: `The "default" ingress controller reports Degraded=False.`,
+		},
+		{
+			name: "more failed dnsrecords than are listed",
+			dnsRecords: []iov1.DNSRecord{
+				dnsRecord("a", iov1.ManagedDNS, zoneStatus(publicZone, "False", "ProviderError", "throttled")),
+				dnsRecord("b", iov1.ManagedDNS, zoneStatus(publicZone, "False", "ProviderError", "throttled")),
+				dnsRecord("c", iov1.ManagedDNS, zoneStatus(publicZone, "False", "ProviderError", "throttled")),
+				dnsRecord("d", iov1.ManagedDNS, zoneStatus(publicZone, "False", "ProviderError", "throttled")),
+				dnsRecord("e", iov1.ManagedDNS, zoneStatus(publicZone, "False", "ProviderError", "throttled")),
+				dnsRecord("f", iov1.ManagedDNS, zoneStatus(publicZone, "False", "ProviderError", "throttled"), zoneStatus(privateZone, "False", "ProviderError", "throttled")),
+			},
+			expectedMessage: `The "default" ingress controller reports Degraded=False.
+dnsrecord "a" failed to publish *.apps.example.com. in zone "public": ProviderError: throttled
+dnsrecord "b" failed to publish *.apps.example.com. in zone "public": ProviderError: throttled
+dnsrecord "c" failed to publish *.apps.example.com. in zone "public": ProviderError: throttled
+dnsrecord "d" failed to publish *.apps.example.com. in zone "public": ProviderError: throttled
+dnsrecord "e" failed to publish *.apps.example.com. in zone "public": ProviderError: throttled
+2 more dnsrecord zone(s) failed to publish.`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			actual := computeOperatorDegradedCondition([]operatorv1.IngressController{defaultIC}, tc.dnsRecords)
+			if actual.Status != configv1.ConditionFalse {
+				t.Errorf("expected Degraded=False, got Degraded=%s", actual.Status)
+			}
+			if actual.Message != tc.expectedMessage {
+				t.Errorf("expected message:\n%s\ngot:\n%s", tc.expectedMessage, actual.Message)
+			}
+		})
+	}
+}
+
+func TestComputeOperatorProgressingConditionDNSRecords(t *testing.T) {
+	dnsRecords := []iov1.DNSRecord{{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "openshift-ingress-operator", Name: "default-wildcard"},
+		Spec:       iov1.DNSRecordSpec{DNSName: "*.apps.example.com."},
+		Status: iov1.DNSRecordStatus{Zones: []iov1.DNSZoneStatus{{
+			DNSZone: configv1.DNSZone{ID: "public"},
+			Conditions: []iov1.DNSZoneCondition{{
+				Type:    iov1.DNSRecordPublishedConditionType,
+				Status:  "Unknown",
+				Reason:  "ProviderError",
+				Message: "timed out",
+			}},
+		}}},
+	}}
+	actual := computeOperatorProgressingCondition(nil, dnsRecords, true, nil, nil, "", "", "")
+	if actual.Status != configv1.ConditionFalse {
+		t.Errorf("expected Progressing=False, got Progressing=%s", actual.Status)
+	}
+	expected := `dnsrecord "default-wildcard" has unknown publishing status for *.apps.example.com. in zone "public": ProviderError: timed out`
+	if actual.Message != expected {
+		t.Errorf("expected message %q, got %q", expected, actual.Message)
+	}
+}
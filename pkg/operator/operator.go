@@ -28,6 +28,8 @@ import (
 	configurableroutecontroller "github.com/openshift/cluster-ingress-operator/pkg/operator/controller/configurable-route"
 	crlcontroller "github.com/openshift/cluster-ingress-operator/pkg/operator/controller/crl"
 	dnscontroller "github.com/openshift/cluster-ingress-operator/pkg/operator/controller/dns"
+	gatewaycontroller "github.com/openshift/cluster-ingress-operator/pkg/operator/controller/gateway"
+	gatewayclasscontroller "github.com/openshift/cluster-ingress-operator/pkg/operator/controller/gatewayclass"
 	ingress "github.com/openshift/cluster-ingress-operator/pkg/operator/controller/ingress"
 	ingresscontroller "github.com/openshift/cluster-ingress-operator/pkg/operator/controller/ingress"
 	ingressclasscontroller "github.com/openshift/cluster-ingress-operator/pkg/operator/controller/ingressclass"
@@ -44,12 +46,18 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/cache"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
+
+	gatewayapiv1beta1 "sigs.k8s.io/gateway-api/apis/v1beta1"
 )
 
 var (
 	log = logf.Logger.WithName("init")
 )
 
+// gatewayAPIPollInterval is the interval at which the operator checks whether
+// the Gateway API CRDs have been installed.
+const gatewayAPIPollInterval = time.Minute
+
 func init() {
 	// Setup controller-runtime logging
 	logf.SetRuntimeLogger(log)
@@ -74,6 +82,7 @@ func New(config operatorconfig.Config, kubeConfig *rest.Config) (*Operator, erro
 	mgr, err := manager.New(kubeConfig, manager.Options{
 		Namespace: config.Namespace,
 		Scheme:    scheme,
+		CertDir:   config.WebhookCertDir,
 		NewCache: cache.MultiNamespacedCacheBuilder([]string{
 			config.Namespace,
 			operatorcontroller.GlobalUserSpecifiedConfigNamespace,
@@ -182,10 +191,25 @@ func New(config operatorconfig.Config, kubeConfig *rest.Config) (*Operator, erro
 		return nil, fmt.Errorf("failed to create crl controller: %v", err)
 	}
 
+	// Create a cache to watch on Route objects from every namespace for
+	// the route metrics controller and the DNS controller, and add the
+	// cache to the manager so that it is started along with the other
+	// runnables.
+	routeCache, err := cache.New(mgr.GetConfig(), cache.Options{
+		Scheme: mgr.GetScheme(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create route cache: %w", err)
+	}
+	if err := mgr.Add(routeCache); err != nil {
+		return nil, fmt.Errorf("failed to add route cache: %w", err)
+	}
+
 	// Set up the DNS controller
 	if _, err := dnscontroller.New(mgr, dnscontroller.Config{
 		Namespace:              config.Namespace,
 		OperatorReleaseVersion: config.OperatorReleaseVersion,
+		RouteCache:             routeCache,
 	}); err != nil {
 		return nil, fmt.Errorf("failed to create dns controller: %v", err)
 	}
@@ -197,6 +221,17 @@ func New(config operatorconfig.Config, kubeConfig *rest.Config) (*Operator, erro
 		return nil, fmt.Errorf("failed to create ingressclass controller: %w", err)
 	}
 
+	// Set up the gatewayclass and gateway controllers once the Gateway API
+	// CRDs are installed.  Watching a resource whose CRD does not exist
+	// would prevent the manager from starting, so the CRDs are polled for,
+	// and the controllers are added to the running manager when the CRDs
+	// appear.
+	if err := mgr.Add(manager.RunnableFunc(func(ctx context.Context) error {
+		return startGatewayAPIControllersWhenInstalled(ctx, mgr, kubeClient, config)
+	})); err != nil {
+		return nil, fmt.Errorf("failed to add gateway api controller starter: %w", err)
+	}
+
 	// Set up the canary controller when the config.CanaryImage is not empty
 	// Canary can be disabled when running the operator locally.
 	if len(config.CanaryImage) != 0 {
@@ -210,10 +245,21 @@ func New(config operatorconfig.Config, kubeConfig *rest.Config) (*Operator, erro
 	}
 
 	// Set up the route metrics controller.
-	if _, err := routemetricscontroller.New(mgr, config.Namespace); err != nil {
+	if _, err := routemetricscontroller.New(mgr, config.Namespace, routeCache); err != nil {
 		return nil, fmt.Errorf("failed to create route metrics controller: %w", err)
 	}
 
+	// Set up the validating admission webhooks if a serving certificate
+	// is provided.  Webhooks can be disabled when running the operator
+	// locally.
+	if len(config.WebhookCertDir) != 0 {
+		webhookServer := mgr.GetWebhookServer()
+		webhookServer.Register(ingresscontroller.ValidatingWebhookPath, ingresscontroller.NewValidatingWebhook(mgr.GetClient(), config.Namespace))
+		webhookServer.Register(dnscontroller.ValidatingWebhookPath, dnscontroller.NewValidatingWebhook(mgr.GetClient(), routeCache, config.Namespace))
+	} else {
+		log.Info("no webhook certificate directory is specified; not serving validating admission webhooks")
+	}
+
 	return &Operator{
 		manager: mgr,
 		// TODO: These are only needed for the default ingress controller stuff, which
@@ -393,3 +439,61 @@ func (o *Operator) ensureDefaultIngressController(infraConfig *configv1.Infrastr
 	log.Info("created default ingresscontroller", "namespace", ic.Namespace, "name", ic.Name)
 	return nil
 }
+
+// startGatewayAPIControllersWhenInstalled polls for the Gateway API CRDs until
+// they are installed or the given context is done, and then creates the
+// gatewayclass and gateway controllers, which the manager starts because it
+// is already running.
+func startGatewayAPIControllersWhenInstalled(ctx context.Context, mgr manager.Manager, kubeClient kubernetes.Interface, config operatorconfig.Config) error {
+	logged := false
+	err := wait.PollImmediateUntilWithContext(ctx, gatewayAPIPollInterval, func(ctx context.Context) (bool, error) {
+		installed, err := gatewayAPIInstalled(kubeClient)
+		if err != nil {
+			log.Error(err, "failed to check for gateway api; will retry")
+			return false, nil
+		}
+		if !installed {
+			if !logged {
+				log.Info("gateway api is not installed; gatewayclass and gateway controllers will be started when it is installed")
+				logged = true
+			}
+			return false, nil
+		}
+		return true, nil
+	})
+	if err != nil {
+		if ctx.Err() != nil {
+			return nil
+		}
+		return err
+	}
+	if _, err := gatewayclasscontroller.New(mgr, gatewayclasscontroller.Config{
+		DefaultGatewayClassEnabled: true,
+	}); err != nil {
+		return fmt.Errorf("failed to create gatewayclass controller: %w", err)
+	}
+	if _, err := gatewaycontroller.New(mgr, gatewaycontroller.Config{
+		Namespace: config.Namespace,
+	}); err != nil {
+		return fmt.Errorf("failed to create gateway controller: %w", err)
+	}
+	log.Info("gateway api is installed; started gatewayclass and gateway controllers")
+	return nil
+}
+
+// gatewayAPIInstalled returns a Boolean value indicating whether the Gateway
+// API CRDs are installed.
+func gatewayAPIInstalled(kubeClient kubernetes.Interface) (bool, error) {
+	resources, err := kubeClient.Discovery().ServerResourcesForGroupVersion(gatewayapiv1beta1.GroupVersion.String())
+	if err != nil {
+		if errors.IsNotFound(err) {
+			return false, nil
+		}
+		return false, err
+	}
+	have := map[string]bool{}
+	for _, resource := range resources.APIResources {
+		have[resource.Name] = true
+	}
+	return have["gatewayclasses"] && have["gateways"], nil
+}
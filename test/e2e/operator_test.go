@@ -175,6 +175,15 @@ func TestClusterOperatorStatusRelatedObjects(t *testing.T) {
 			Resource: "namespaces",
 			Name:     "openshift-ingress",
 		},
+		{
+			Group:     "apps",
+			Resource:  "deployments",
+			Namespace: "openshift-ingress",
+		},
+		{
+			Resource:  "services",
+			Namespace: "openshift-ingress",
+		},
 		{
 			Resource: "namespaces",
 			Name:     "openshift-ingress-canary",